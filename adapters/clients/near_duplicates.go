@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/dedup"
+)
+
+type RemoteNearDuplicates struct {
+	client       *http.Client
+	nodeResolver nodeResolver
+}
+
+func NewRemoteNearDuplicates(httpClient *http.Client, nodeResolver nodeResolver) *RemoteNearDuplicates {
+	return &RemoteNearDuplicates{client: httpClient, nodeResolver: nodeResolver}
+}
+
+func (c *RemoteNearDuplicates) NearDuplicatePairs(ctx context.Context, nodeName string,
+	desc distributedtask.TaskDescriptor,
+) ([]dedup.Pair, error) {
+	hostName, found := c.nodeResolver.NodeHostname(nodeName)
+	if !found {
+		return nil, fmt.Errorf("unable to resolve hostname for %s", nodeName)
+	}
+	query := url.Values{}
+	query.Set("id", desc.ID)
+	query.Set("version", strconv.FormatUint(desc.Version, 10))
+	url := url.URL{Scheme: "http", Host: hostName, Path: "/near-duplicates/pairs", RawQuery: query.Encode()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode == http.StatusNotFound {
+		return nil, dedup.ErrResultNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var pairs []dedup.Pair
+	if err := json.Unmarshal(body, &pairs); err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+	return pairs, nil
+}
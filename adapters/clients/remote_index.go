@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -578,6 +579,50 @@ func (c *RemoteIndex) GetShardQueueSize(ctx context.Context,
 	return size, c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) GetShardChanges(ctx context.Context,
+	hostName, indexName, shardName string, after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	query := url.Values{}
+	query.Set("after", strconv.FormatUint(after, 10))
+	query.Set("since", strconv.FormatInt(since, 10))
+	query.Set("limit", strconv.Itoa(limit))
+	req, err := setupRequest(ctx, http.MethodGet, hostName,
+		fmt.Sprintf("/indices/%s/shards/%s/changes", indexName, shardName),
+		query.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var out []changes.Change
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.GetShardChangesResults.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		out, err = clusterapi.IndicesPayloads.GetShardChangesResults.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return out, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
@@ -1022,6 +1067,70 @@ func (c *RemoteIndex) RemoveAsyncReplicationTargetNode(
 	return c.retry(ctx, 9, try)
 }
 
+// SplitShardReplica copies the shard replica on the specified host into the replica of
+// targetShard, the shard split off it, on the same host. Files which are up to date already are
+// not copied again.
+func (c *RemoteIndex) SplitShardReplica(ctx context.Context,
+	hostName, indexName, shardName, targetShard string, schemaVersion uint64,
+) error {
+	value := []string{strconv.FormatUint(schemaVersion, 10)}
+	req, err := setupRequest(ctx, http.MethodPost, hostName,
+		fmt.Sprintf("/indices/%s/shards/%s/background:split/%s", indexName, shardName, targetShard),
+		url.Values{replica.SchemaVersionKey: value}.Encode(),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
+// DropUnownedObjects deletes the objects of the shard replica on the specified host which belong
+// to a different shard after a split. The host applies schemaVersion, which contains the split,
+// before it looks for such objects.
+func (c *RemoteIndex) DropUnownedObjects(ctx context.Context,
+	hostName, indexName, shardName string, schemaVersion uint64,
+) error {
+	value := []string{strconv.FormatUint(schemaVersion, 10)}
+	req, err := setupRequest(ctx, http.MethodPost, hostName,
+		fmt.Sprintf("/indices/%s/shards/%s/background:drop-unowned", indexName, shardName),
+		url.Values{replica.SchemaVersionKey: value}.Encode(),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
 // setupRequest is a simple helper to create a new http request with the given method, host, path,
 // query, and body. Note that you can leave the query empty if you don't need it and the body can
 // be nil. This does not send the request, just creates the request object.
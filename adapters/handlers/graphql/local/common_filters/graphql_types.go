@@ -18,6 +18,8 @@ import (
 	"github.com/tailor-inc/graphql/language/ast"
 
 	"github.com/tailor-inc/graphql"
+
+	"github.com/weaviate/weaviate/entities/dto"
 )
 
 var Vector func(prefix string) *graphql.Scalar = func(prefix string) *graphql.Scalar {
@@ -28,6 +30,9 @@ var Vector func(prefix string) *graphql.Scalar = func(prefix string) *graphql.Sc
 			switch v := value.(type) {
 			case []float32, [][]float32:
 				return v
+			case dto.CompactVector:
+				// int8, uint8 and binary vectors are returned as numbers
+				return v.Float32()
 			default:
 				return nil
 			}
@@ -67,6 +67,15 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 			args.NearVectorParams = &arguments
 
 		}
+
+		if namedSearches["nearSparseVector"] != nil {
+			nearSparseVector := namedSearches["nearSparseVector"].(map[string]interface{})
+			arguments, err := ExtractNearSparseVector(nearSparseVector)
+			if err != nil {
+				return nil, nil, err
+			}
+			args.NearSparseVectorParams = &arguments
+		}
 	}
 
 	var weightedSearchResults []searchparams.WeightedSearchResult
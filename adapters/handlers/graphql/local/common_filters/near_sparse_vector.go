@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func NearSparseVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"indices": &graphql.InputObjectFieldConfig{
+			Description: "The dimensions of the sparse query vector",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Int)),
+		},
+		"values": &graphql.InputObjectFieldConfig{
+			Description: "The weights of the sparse query vector, one per index",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: "The sparse vector to search",
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+	}
+}
+
+// ExtractNearSparseVector arguments, such as "indices" and "values"
+func ExtractNearSparseVector(source map[string]interface{}) (searchparams.NearSparseVector, error) {
+	var args searchparams.NearSparseVector
+
+	indices, ok := source["indices"].([]interface{})
+	if !ok {
+		return args, fmt.Errorf("nearSparseVector: indices is a required field")
+	}
+	values, ok := source["values"].([]interface{})
+	if !ok {
+		return args, fmt.Errorf("nearSparseVector: values is a required field")
+	}
+	if len(indices) != len(values) {
+		return args, fmt.Errorf("nearSparseVector: got %d indices but %d values", len(indices), len(values))
+	}
+
+	args.Indices = make([]uint32, len(indices))
+	for i, raw := range indices {
+		index, ok := raw.(int)
+		if !ok || index < 0 {
+			return args, fmt.Errorf("nearSparseVector: index %v is not a non-negative integer", raw)
+		}
+		args.Indices[i] = uint32(index)
+	}
+
+	args.Values = make([]float32, len(values))
+	for i, raw := range values {
+		switch v := raw.(type) {
+		case float64:
+			args.Values[i] = float32(v)
+		case int:
+			args.Values[i] = float32(v)
+		default:
+			return args, fmt.Errorf("nearSparseVector: value %v is not a number", raw)
+		}
+	}
+
+	targetVector, ok := source["targetVector"].(string)
+	if !ok || targetVector == "" {
+		return args, fmt.Errorf("nearSparseVector: targetVector is a required field")
+	}
+	args.TargetVector = targetVector
+
+	return args, nil
+}
@@ -240,6 +240,7 @@ func buildGetClassField(classObject *graphql.Object,
 	}
 
 	field.Args["bm25"] = bm25Argument(class.Class)
+	field.Args["nearSparseVector"] = nearSparseVectorArgument(class.Class)
 	field.Args["hybrid"] = hybridArgument(classObject, class, modulesProvider, fusionEnum)
 
 	if modulesProvider != nil {
@@ -440,6 +441,20 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		keywordRankingParams = &p
 	}
 
+	if nearSparseVector, ok := p.Args["nearSparseVector"]; ok {
+		if keywordRankingParams != nil {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with bm25")
+		}
+		if len(sort) > 0 {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with sort")
+		}
+		p, err := common_filters.ExtractNearSparseVector(nearSparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		keywordRankingParams = &searchparams.KeywordRanking{Type: "sparse", SparseVector: &p}
+	}
+
 	// Extract hybrid search params from the processed query
 	// Everything hybrid can go in another namespace AFTER modulesprovider is
 	// refactored
@@ -28,6 +28,18 @@ func nearObjectArgument(className string) *graphql.ArgumentConfig {
 	return common_filters.NearObjectArgument("GetObjects", className, true)
 }
 
+func nearSparseVectorArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: "Search a sparse vector by the dot product with the given sparse query vector",
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("GetObjects%sNearSparseVectorInpObj", className),
+				Fields: common_filters.NearSparseVectorFields(),
+			},
+		),
+	}
+}
+
 func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
 	nearTextFields := graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
@@ -107,6 +107,16 @@ func hybridOperands(classObject *graphql.Object,
 									},
 								),
 							},
+							"nearSparseVector": &graphql.InputObjectFieldConfig{
+								Description: "nearSparseVector element, replaces the bm25 search",
+								Type: graphql.NewInputObject(
+									graphql.InputObjectConfig{
+										Name:        fmt.Sprintf("%sNearSparseVectorInpObj", prefixName),
+										Description: "Near sparse vector search",
+										Fields:      common_filters.NearSparseVectorFields(),
+									},
+								),
+							},
 						}
 						for key, fieldConfig := range fieldMap {
 							subSearchFields[key] = fieldConfig
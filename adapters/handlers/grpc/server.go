@@ -13,24 +13,34 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
+	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_sentry "github.com/johnbellone/grpc-middleware-sentry"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	pbv0 "github.com/weaviate/weaviate/grpc/generated/protocol/v0"
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -38,6 +48,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	v0 "github.com/weaviate/weaviate/adapters/handlers/grpc/v0"
 	v1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
@@ -54,8 +65,7 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 
 	// Add TLS creds for the GRPC connection, if defined.
 	if len(state.ServerConfig.Config.GRPC.CertFile) > 0 || len(state.ServerConfig.Config.GRPC.KeyFile) > 0 {
-		c, err := credentials.NewServerTLSFromFile(state.ServerConfig.Config.GRPC.CertFile,
-			state.ServerConfig.Config.GRPC.KeyFile)
+		c, err := serverTLSCredentials(state.ServerConfig.Config.GRPC)
 		if err != nil {
 			state.Logger.WithField("action", "grpc_startup").
 				Fatalf("grpc server TLS credential error: %s", err)
@@ -66,6 +76,7 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 	var interceptors []grpc.UnaryServerInterceptor
 
 	interceptors = append(interceptors, makeAuthInterceptor())
+	interceptors = append(interceptors, makeRateLimitInterceptor())
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
@@ -79,6 +90,12 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 	}
 
 	interceptors = append(interceptors, makeIPInterceptor())
+	interceptors = append(interceptors, makeRequestIDInterceptor())
+	interceptors = append(interceptors, makeSessionInterceptor())
+	interceptors = append(interceptors, makeShardingKeyHintInterceptor())
+	if state.AuditLogger != nil {
+		interceptors = append(interceptors, makeAuditInterceptor(state.AuditLogger))
+	}
 
 	if len(interceptors) > 0 {
 		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
@@ -91,9 +108,11 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 		composer.New(
 			state.ServerConfig.Config.Authentication,
 			state.APIKey, state.OIDC),
+		state.MTLS,
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		state.DB,
 		&state.ServerConfig.Config,
 		state.Authorizer,
 		state.Logger,
@@ -105,6 +124,34 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 	return s
 }
 
+// serverTLSCredentials loads the server certificate. If a client CA is
+// configured, clients need to present a certificate signed by it (mTLS).
+func serverTLSCredentials(cfg config.GRPC) (credentials.TransportCredentials, error) {
+	if cfg.ClientCAFile == "" {
+		return credentials.NewServerTLSFromFile(cfg.CertFile, cfg.KeyFile)
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client CA file: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("client CA file %s contains no valid certificates", cfg.ClientCAFile)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 func makeMetricsInterceptor(logger logrus.FieldLogger, metrics *monitoring.PrometheusMetrics) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		if info.FullMethod != "/weaviate.v1.Weaviate/BatchObjects" {
@@ -155,6 +202,36 @@ func makeAuthInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// makeRateLimitInterceptor translates rate limit errors to RESOURCE_EXHAUSTED,
+// the time after which the client can retry is attached as RetryInfo.
+// Requests that exceed the burst size of a limit can never succeed and are
+// answered with INVALID_ARGUMENT.
+func makeRateLimitInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+
+		var errTooLarge *ratelimiter.ErrRequestTooLarge
+		if errors.As(err, &errTooLarge) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var errRateLimited *ratelimiter.ErrRateLimited
+		if errors.As(err, &errRateLimited) {
+			st := status.New(codes.ResourceExhausted, err.Error())
+			if withDetails, detailsErr := st.WithDetails(&errdetails.RetryInfo{
+				RetryDelay: durationpb.New(errRateLimited.RetryAfter),
+			}); detailsErr == nil {
+				st = withDetails
+			}
+			return nil, st.Err()
+		}
+
+		return resp, err
+	}
+}
+
 func makeIPInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		clientIP := getRealClientIP(ctx)
@@ -166,6 +243,88 @@ func makeIPInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+const requestIDHeader = "x-request-id"
+
+// makeRequestIDInterceptor attaches the request id passed by the client, or a
+// new one, to the context so that it shows up in the audit log. The id is
+// returned to the client in the response header.
+func makeRequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if ids := md.Get(requestIDHeader); len(ids) > 0 {
+				requestID = ids[0]
+			}
+		}
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		// the header can only be set once per call, ignore failures e.g. if
+		// the handler already sent headers
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		return handler(audit.WithRequestID(ctx, requestID), req)
+	}
+}
+
+// sessionHeader is the metadata key of the replication session token, gRPC
+// metadata keys are lower case
+var sessionHeader = strings.ToLower(replica.SessionHeader)
+
+// makeSessionInterceptor attaches the replication session passed by the
+// client, or a new one, to the context. Writes record the replicas which
+// applied them in the session and reads at the SESSION consistency level are
+// served by these replicas. The updated session token is returned in the
+// response header.
+func makeSessionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		session := replica.NewSession()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if tokens := md.Get(sessionHeader); len(tokens) > 0 && tokens[0] != "" {
+				var err error
+				if session, err = replica.ParseSessionToken(tokens[0]); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid %s metadata: %v", sessionHeader, err)
+				}
+			}
+		}
+
+		resp, err := handler(replica.ContextWithSession(ctx, session), req)
+		if token := session.Token(); token != "" {
+			// ignore failures e.g. if the handler already sent headers
+			_ = grpc.SetHeader(ctx, metadata.Pairs(sessionHeader, token))
+		}
+		return resp, err
+	}
+}
+
+// shardingKeyHintHeader is the metadata key of the sharding key hint
+var shardingKeyHintHeader = strings.ToLower(sharding.KeyHintHeader)
+
+// makeShardingKeyHintInterceptor attaches the sharding key hint passed by the
+// client to the context, see sharding.ContextWithKeyHint
+func makeShardingKeyHintInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if hints := md.Get(shardingKeyHintHeader); len(hints) > 0 && hints[0] != "" {
+				ctx = sharding.ContextWithKeyHint(ctx, hints[0])
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// makeAuditInterceptor records the outcome of every call for which an
+// audited action was allowed
+func makeAuditInterceptor(auditLogger *audit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = auditLogger.Track(ctx)
+		resp, err := handler(ctx, req)
+		auditLogger.Finish(ctx, err != nil, status.Code(err).String(), err)
+		return resp, err
+	}
+}
+
 func getRealClientIP(ctx context.Context) string {
 	// First, check for forwarded headers in metadata
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -16,7 +16,9 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // This should probably be run as part of a middleware. In the initial gRPC
@@ -27,28 +29,55 @@ import (
 func (s *Service) principalFromContext(ctx context.Context) (*models.Principal, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return s.tryAnonymous()
+		return s.tryClientCertificate(ctx)
 	}
 
 	// the grpc library will lowercase all md keys, so we need to make sure to
 	// check a lowercase key
 	authValue, ok := md["authorization"]
 	if !ok {
-		return s.tryAnonymous()
+		return s.tryClientCertificate(ctx)
 	}
 
 	if len(authValue) == 0 {
-		return s.tryAnonymous()
+		return s.tryClientCertificate(ctx)
 	}
 
 	if !strings.HasPrefix(authValue[0], "Bearer ") {
-		return s.tryAnonymous()
+		return s.tryClientCertificate(ctx)
 	}
 
 	token := strings.TrimPrefix(authValue[0], "Bearer ")
 	return s.authComposer(token, nil)
 }
 
+// tryClientCertificate authenticates requests without a bearer token by the
+// verified client certificate of the connection, if mTLS is enabled.
+func (s *Service) tryClientCertificate(ctx context.Context) (*models.Principal, error) {
+	if s.mtls == nil {
+		return s.tryAnonymous()
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return s.tryAnonymous()
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return s.tryAnonymous()
+	}
+
+	principal, err := s.mtls.ValidateAndExtract(&tlsInfo.State)
+	if err != nil {
+		return nil, err
+	}
+	if principal == nil {
+		return s.tryAnonymous()
+	}
+	return principal, nil
+}
+
 func (s *Service) tryAnonymous() (*models.Principal, error) {
 	if s.allowAnonymousAccess {
 		return nil, nil
@@ -13,13 +13,20 @@ package v1
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
+	"github.com/weaviate/weaviate/usecases/config"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func TestAuth(t *testing.T) {
@@ -126,3 +133,44 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthClientCertificate(t *testing.T) {
+	mtlsClient, err := mtls.New(config.Config{Authentication: config.Authentication{
+		MTLS: config.MTLS{Enabled: true, UsernameField: config.MTLSUsernameFieldCommonName},
+	}})
+	require.Nil(t, err)
+
+	s := &Service{
+		mtls: mtlsClient,
+		authComposer: func(token string, scopes []string) (*models.Principal, error) {
+			if token == "" {
+				return nil, fmt.Errorf("not allowed")
+			}
+			return &models.Principal{Username: token}, nil
+		},
+	}
+
+	withPeer := func(state tls.ConnectionState) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ingest-service"}}
+
+	t.Run("verified client certificate", func(t *testing.T) {
+		p, err := s.principalFromContext(withPeer(tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}))
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "mtls:ingest-service", UserType: models.UserTypeInputOidc}, p)
+	})
+
+	t.Run("bearer token takes precedence", func(t *testing.T) {
+		ctx := withPeer(tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer Foo"))
+		p, err := s.principalFromContext(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "Foo"}, p)
+	})
+
+	t.Run("unverified client certificate", func(t *testing.T) {
+		_, err := s.principalFromContext(withPeer(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}))
+		require.NotNil(t, err)
+	})
+}
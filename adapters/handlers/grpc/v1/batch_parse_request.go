@@ -91,6 +91,7 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 		if len(obj.Vectors) > 0 {
 			parsedVectors := make(map[string][]float32)
 			parsedMultiVectors := make(map[string][][]float32)
+			parsedCompactVectors := make(map[string]models.Vector)
 			for _, vec := range obj.Vectors {
 				switch vec.Type {
 				case *pb.Vectors_VECTOR_TYPE_UNSPECIFIED.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_FP32.Enum():
@@ -103,16 +104,21 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 					}
 					parsedMultiVectors[vec.Name] = out
 				default:
-					// do nothing
+					if compact, ok := extractCompactVector(vec); ok {
+						parsedCompactVectors[vec.Name] = compact
+					}
 				}
 			}
-			vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors))
+			vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors)+len(parsedCompactVectors))
 			for targetVector, vector := range parsedVectors {
 				vectors[targetVector] = vector
 			}
 			for targetVector, multiVector := range parsedMultiVectors {
 				vectors[targetVector] = multiVector
 			}
+			for targetVector, compactVector := range parsedCompactVectors {
+				vectors[targetVector] = compactVector
+			}
 		}
 
 		objOriginalIndex[insertCounter] = i
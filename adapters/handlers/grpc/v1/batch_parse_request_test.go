@@ -431,6 +431,34 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "named int8, uint8 and binary vectors",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "int8",
+					VectorBytes: []byte{0x80, 0x00, 0x7f},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_INT8,
+				},
+				{
+					Name:        "uint8",
+					VectorBytes: []byte{0x00, 0xff},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_UINT8,
+				},
+				{
+					Name:        "binary",
+					VectorBytes: []byte{0b10100000},
+					Type:        pb.Vectors_VECTOR_TYPE_SINGLE_BINARY,
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"int8":   models.Int8Vector{-128, 0, 127},
+					"uint8":  models.Uint8Vector{0, 255},
+					"binary": models.BinaryVector{0b10100000},
+				},
+			}},
+		},
 	}
 	getClass := func(class, shard string) (*models.Class, error) {
 		return scheme.GetClass(class), nil
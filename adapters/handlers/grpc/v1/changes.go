@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// changesBatchSize is the number of changes read per request to the
+	// change stream of a collection
+	changesBatchSize = 100
+	// changesPollInterval is how long to wait for new changes once a client
+	// has caught up
+	changesPollInterval = 500 * time.Millisecond
+)
+
+type changesReader interface {
+	Changes(ctx context.Context, className, tenant string, after changes.Offset, limit int) ([]changes.Change, error)
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+}
+
+// Changes streams the changes of a collection, starting after the offset of
+// the request, until the client cancels the stream. Properties the principal
+// may not read are removed. If the principal's reads are restricted by a
+// read_data filter, only changes of objects currently matching the filter are
+// streamed and deletions are left out, as a deleted object can no longer be
+// matched.
+func (s *Service) Changes(req *pb.ChangesRequest, stream pb.Weaviate_ChangesServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return fmt.Errorf("missing collection")
+	}
+	class, err := s.classGetterWithAuthzFunc(ctx, principal, req.GetTenant())(req.Collection)
+	if err != nil {
+		return err
+	}
+	multiTenant := schema.MultiTenancyEnabled(class)
+
+	offset, err := changes.ParseOffset(req.Offset)
+	if err != nil {
+		return err
+	}
+
+	mask := authzfilter.NewPropertyMask(ctx, s.authorizer, principal, req.GetTenant())
+	restriction, err := authzfilter.ReadData(ctx, s.authorizer, principal, class.Class, req.GetTenant())
+	if err != nil {
+		return err
+	}
+
+	for {
+		batch, err := s.changes.Changes(ctx, class.Class, req.GetTenant(), offset, changesBatchSize)
+		if err != nil {
+			return fmt.Errorf("read changes: %w", err)
+		}
+		readable, err := s.readableChanges(ctx, class.Class, req.GetTenant(), restriction, batch)
+		if err != nil {
+			return fmt.Errorf("read changes: %w", err)
+		}
+
+		for _, change := range batch {
+			// changes which are not streamed still move the offset, the
+			// offset sent with the next change includes them
+			offset.Advance(change)
+			if readable != nil {
+				if _, ok := readable[change.ID]; !ok {
+					continue
+				}
+			}
+
+			reply, err := changeToGRPC(class.Class, multiTenant, change, offset, mask, req.IncludeVectors)
+			if err != nil {
+				return fmt.Errorf("change %s/%d: %w", change.Shard, change.Seq, err)
+			}
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) == changesBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(changesPollInterval):
+		}
+	}
+}
+
+// readableChanges returns the ids of the changed objects matching the
+// read_data restriction, nil if there is no restriction
+func (s *Service) readableChanges(ctx context.Context, className, tenant string,
+	restriction *filters.LocalFilter, batch []changes.Change,
+) (map[uuid.UUID]struct{}, error) {
+	if restriction == nil {
+		return nil, nil
+	}
+
+	readable := map[uuid.UUID]struct{}{}
+	seen := map[uuid.UUID]struct{}{}
+	var operands []filters.Clause
+	for _, change := range batch {
+		if change.Operation == changes.OperationDelete {
+			continue
+		}
+		if _, ok := seen[change.ID]; ok {
+			continue
+		}
+		seen[change.ID] = struct{}{}
+		operands = append(operands, filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: filters.InternalPropID},
+			Value:    &filters.Value{Value: change.ID.String(), Type: schema.DataTypeText},
+		})
+	}
+	if len(operands) == 0 {
+		return readable, nil
+	}
+
+	byID := &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorOr, Operands: operands}}
+	res, qerr := s.changes.Query(ctx, &objects.QueryInput{
+		Class:   className,
+		Limit:   len(operands),
+		Filters: authzfilter.And(byID, restriction),
+		Tenant:  tenant,
+	})
+	if qerr != nil {
+		return nil, qerr
+	}
+	for _, r := range res {
+		id, err := uuid.Parse(r.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		readable[id] = struct{}{}
+	}
+	return readable, nil
+}
+
+// changeToGRPC converts a change, offset is the offset to resume the stream
+// after it
+func changeToGRPC(className string, multiTenant bool, change changes.Change, offset changes.Offset,
+	mask *authzfilter.PropertyMask, includeVectors bool,
+) (*pb.ChangesReply, error) {
+	reply := &pb.ChangesReply{
+		Offset:     offset.String(),
+		Collection: className,
+		Uuid:       change.ID.String(),
+		Timestamp:  change.Time,
+	}
+	if multiTenant {
+		reply.Tenant = change.Shard
+	}
+
+	switch change.Operation {
+	case changes.OperationInsert:
+		reply.Operation = pb.ChangesReply_OPERATION_INSERT
+	case changes.OperationUpdate:
+		reply.Operation = pb.ChangesReply_OPERATION_UPDATE
+	case changes.OperationDelete:
+		reply.Operation = pb.ChangesReply_OPERATION_DELETE
+	default:
+		reply.Operation = pb.ChangesReply_OPERATION_UNSPECIFIED
+	}
+
+	if len(change.Object) == 0 {
+		return reply, nil
+	}
+
+	obj, err := storobj.FromBinary(change.Object)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal object: %w", err)
+	}
+
+	// round trip through json to turn the stored property types into the
+	// generic types supported by structpb
+	propsJSON, err := json.Marshal(obj.Properties())
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+	props := map[string]interface{}{}
+	if err := json.Unmarshal(propsJSON, &props); err != nil {
+		return nil, fmt.Errorf("unmarshal properties: %w", err)
+	}
+	if err := mask.Prune(className, props); err != nil {
+		return nil, err
+	}
+	if reply.Properties, err = structpb.NewStruct(props); err != nil {
+		return nil, fmt.Errorf("convert properties: %w", err)
+	}
+
+	if includeVectors {
+		if vec := vectorToGRPC(modelsext.DefaultNamedVectorName, obj.Vector); vec != nil {
+			reply.Vectors = append(reply.Vectors, vec)
+		}
+		vectors := obj.GetVectors()
+		names := make([]string, 0, len(vectors))
+		for name := range vectors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if vec := vectorToGRPC(name, vectors[name]); vec != nil {
+				reply.Vectors = append(reply.Vectors, vec)
+			}
+		}
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestChangeToGRPC(t *testing.T) {
+	id := uuid.New()
+	obj := storobj.FromObject(&models.Object{
+		ID:         strfmt.UUID(id.String()),
+		Class:      "Article",
+		Properties: map[string]interface{}{"title": "hello", "wordCount": int64(2)},
+	}, nil, map[string][]float32{"title": {1, 2}}, nil)
+	objBytes, err := obj.MarshalBinary()
+	require.NoError(t, err)
+
+	ts := int64(1700000000000)
+	offset := changes.Offset{"tenant1": {Node: "node1", Seq: 3, Time: ts}}
+	noMask := authzfilter.NewPropertyMask(context.Background(), mocks.NewMockAuthorizer(), nil, "")
+
+	t.Run("update with vectors", func(t *testing.T) {
+		reply, err := changeToGRPC("Article", true, changes.Change{
+			Seq:       3,
+			Node:      "node1",
+			Time:      ts,
+			ID:        id,
+			Operation: changes.OperationUpdate,
+			Shard:     "tenant1",
+			Object:    objBytes,
+		}, offset, noMask, true)
+		require.NoError(t, err)
+
+		require.Equal(t, pb.ChangesReply_OPERATION_UPDATE, reply.Operation)
+		require.Equal(t, offset.String(), reply.Offset)
+		require.Equal(t, "Article", reply.Collection)
+		require.Equal(t, "tenant1", reply.Tenant)
+		require.Equal(t, id.String(), reply.Uuid)
+		require.Equal(t, ts, reply.Timestamp)
+		require.Equal(t, "hello", reply.Properties.Fields["title"].GetStringValue())
+		require.Equal(t, float64(2), reply.Properties.Fields["wordCount"].GetNumberValue())
+		require.Len(t, reply.Vectors, 1)
+		require.Equal(t, "title", reply.Vectors[0].Name)
+	})
+
+	t.Run("without vectors on single tenant collection", func(t *testing.T) {
+		reply, err := changeToGRPC("Article", false, changes.Change{
+			ID:        id,
+			Operation: changes.OperationInsert,
+			Shard:     "abcd",
+			Object:    objBytes,
+		}, offset, noMask, false)
+		require.NoError(t, err)
+		require.Equal(t, pb.ChangesReply_OPERATION_INSERT, reply.Operation)
+		require.Empty(t, reply.Tenant)
+		require.Empty(t, reply.Vectors)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		reply, err := changeToGRPC("Article", false, changes.Change{
+			ID:        id,
+			Operation: changes.OperationDelete,
+		}, offset, noMask, true)
+		require.NoError(t, err)
+		require.Equal(t, pb.ChangesReply_OPERATION_DELETE, reply.Operation)
+		require.Nil(t, reply.Properties)
+	})
+
+	t.Run("hidden properties are removed", func(t *testing.T) {
+		authorizer := &propertyRestrictedAuthorizer{
+			FakeAuthorizer: mocks.NewMockAuthorizer(),
+			access:         authorization.PropertyAccess{{ExcludedProperties: []string{"wordCount"}}},
+		}
+		mask := authzfilter.NewPropertyMask(context.Background(), authorizer, &models.Principal{Username: "user"}, "")
+		reply, err := changeToGRPC("Article", false, changes.Change{
+			ID:        id,
+			Operation: changes.OperationUpdate,
+			Object:    objBytes,
+		}, offset, mask, false)
+		require.NoError(t, err)
+		require.Equal(t, "hello", reply.Properties.Fields["title"].GetStringValue())
+		require.NotContains(t, reply.Properties.Fields, "wordCount")
+	})
+}
+
+type propertyRestrictedAuthorizer struct {
+	*mocks.FakeAuthorizer
+	access authorization.PropertyAccess
+}
+
+func (a *propertyRestrictedAuthorizer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return nil, nil
+}
+
+func (a *propertyRestrictedAuthorizer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return false, nil
+}
+
+func (a *propertyRestrictedAuthorizer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return a.access, nil
+}
+
+type fakeChangesReader struct {
+	matching []strfmt.UUID
+	queries  []*objects.QueryInput
+}
+
+func (f *fakeChangesReader) Changes(ctx context.Context, className, tenant string,
+	after changes.Offset, limit int,
+) ([]changes.Change, error) {
+	return nil, nil
+}
+
+func (f *fakeChangesReader) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	f.queries = append(f.queries, q)
+	res := make(search.Results, len(f.matching))
+	for i, id := range f.matching {
+		res[i] = search.Result{ID: id}
+	}
+	return res, nil
+}
+
+func TestReadableChanges(t *testing.T) {
+	visible, hidden, deleted := uuid.New(), uuid.New(), uuid.New()
+	batch := []changes.Change{
+		{ID: visible, Operation: changes.OperationInsert},
+		{ID: visible, Operation: changes.OperationUpdate},
+		{ID: hidden, Operation: changes.OperationInsert},
+		{ID: deleted, Operation: changes.OperationDelete},
+	}
+	restriction := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: "Article", Property: "department"},
+		Value:    &filters.Value{Value: "legal", Type: schema.DataTypeText},
+	}}
+
+	t.Run("unrestricted", func(t *testing.T) {
+		reader := &fakeChangesReader{}
+		s := &Service{changes: reader}
+		readable, err := s.readableChanges(context.Background(), "Article", "", nil, batch)
+		require.NoError(t, err)
+		require.Nil(t, readable)
+		require.Empty(t, reader.queries)
+	})
+
+	t.Run("restricted", func(t *testing.T) {
+		reader := &fakeChangesReader{matching: []strfmt.UUID{strfmt.UUID(visible.String())}}
+		s := &Service{changes: reader}
+		readable, err := s.readableChanges(context.Background(), "Article", "", restriction, batch)
+		require.NoError(t, err)
+		require.Equal(t, map[uuid.UUID]struct{}{visible: {}}, readable)
+
+		require.Len(t, reader.queries, 1)
+		q := reader.queries[0]
+		require.Equal(t, 2, q.Limit)
+		require.Equal(t, filters.OperatorAnd, q.Filters.Root.Operator)
+		require.Len(t, q.Filters.Root.Operands[0].Operands, 2)
+	})
+}
@@ -1345,12 +1345,35 @@ func extractVector(vector *pb.Vectors) (models.Vector, error) {
 			}
 			return out, nil
 		default:
+			if compact, ok := extractCompactVector(vector); ok {
+				// queries are always searched as float32
+				return compact.Float32(), nil
+			}
 			return nil, fmt.Errorf("cannot extract vector: unknown vector type: %T", vector.Type)
 		}
 	}
 	return nil, fmt.Errorf("cannot extract vector: empty vectors")
 }
 
+// extractCompactVector returns int8, uint8 and binary vectors, the second
+// return value is false for all other vector types
+func extractCompactVector(vector *pb.Vectors) (dto.CompactVector, bool) {
+	switch vector.Type {
+	case pb.Vectors_VECTOR_TYPE_SINGLE_INT8:
+		out := make(models.Int8Vector, len(vector.VectorBytes))
+		for i, b := range vector.VectorBytes {
+			out[i] = int8(b)
+		}
+		return out, true
+	case pb.Vectors_VECTOR_TYPE_SINGLE_UINT8:
+		return models.Uint8Vector(vector.VectorBytes), true
+	case pb.Vectors_VECTOR_TYPE_SINGLE_BINARY:
+		return models.BinaryVector(vector.VectorBytes), true
+	default:
+		return nil, false
+	}
+}
+
 func isTargetVectorMultiVector(class *models.Class, targetVector string) bool {
 	switch targetVector {
 	case "":
@@ -267,6 +267,7 @@ func TestGRPCSearchRequest(t *testing.T) {
 
 	defaultPagination := &filters.Pagination{Limit: 10}
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
+	session := pb.ConsistencyLevel_CONSISTENCY_LEVEL_SESSION
 	someString1 := "a word"
 	someString2 := "other"
 
@@ -1615,6 +1616,20 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Session consistency",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				ConsistencyLevel: &session,
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:            defaultTestClassProps,
+				AdditionalProperties:  additional.Properties{Vector: true, NoProps: false},
+				ReplicationProperties: &additional.ReplicationProperties{ConsistencyLevel: "SESSION"},
+			},
+			error: false,
+		},
 		{
 			name: "Generative",
 			req: &pb.SearchRequest{
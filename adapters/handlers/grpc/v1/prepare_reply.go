@@ -253,26 +253,8 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 			if ok2 {
 				addProps.Metadata.Vectors = make([]*pb.Vectors, 0, len(additionalPropsParams.Vectors))
 				for _, name := range additionalPropsParams.Vectors {
-					vector := vectorfmt[name]
-					switch vec := vector.(type) {
-					case []float32:
-						if len(vec) != 0 {
-							addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, &pb.Vectors{
-								VectorBytes: byteops.Fp32SliceToBytes(vec),
-								Name:        name,
-								Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
-							})
-						}
-					case [][]float32:
-						if len(vec) != 0 {
-							addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, &pb.Vectors{
-								VectorBytes: byteops.Fp32SliceOfSlicesToBytes(vec),
-								Name:        name,
-								Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
-							})
-						}
-					default:
-						// do nothing
+					if vec := vectorToGRPC(name, vectorfmt[name]); vec != nil {
+						addProps.Metadata.Vectors = append(addProps.Metadata.Vectors, vec)
 					}
 				}
 			}
@@ -590,3 +572,57 @@ type additionalProps struct {
 	GenerativeGrouped           *pb.GenerativeResult
 	GenerativeGroupedDeprecated string
 }
+
+// vectorToGRPC converts a vector to its gRPC representation, it returns nil
+// for empty vectors and vector types that cannot be represented
+func vectorToGRPC(name string, vector models.Vector) *pb.Vectors {
+	switch vec := vector.(type) {
+	case []float32:
+		if len(vec) != 0 {
+			return &pb.Vectors{
+				VectorBytes: byteops.Fp32SliceToBytes(vec),
+				Name:        name,
+				Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+			}
+		}
+	case [][]float32:
+		if len(vec) != 0 {
+			return &pb.Vectors{
+				VectorBytes: byteops.Fp32SliceOfSlicesToBytes(vec),
+				Name:        name,
+				Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+			}
+		}
+	case models.Int8Vector:
+		if len(vec) != 0 {
+			vectorBytes := make([]byte, len(vec))
+			for i := range vec {
+				vectorBytes[i] = byte(vec[i])
+			}
+			return &pb.Vectors{
+				VectorBytes: vectorBytes,
+				Name:        name,
+				Type:        pb.Vectors_VECTOR_TYPE_SINGLE_INT8,
+			}
+		}
+	case models.Uint8Vector:
+		if len(vec) != 0 {
+			return &pb.Vectors{
+				VectorBytes: vec,
+				Name:        name,
+				Type:        pb.Vectors_VECTOR_TYPE_SINGLE_UINT8,
+			}
+		}
+	case models.BinaryVector:
+		if len(vec) != 0 {
+			return &pb.Vectors{
+				VectorBytes: vec,
+				Name:        name,
+				Type:        pb.Vectors_VECTOR_TYPE_SINGLE_BINARY,
+			}
+		}
+	default:
+		// do nothing
+	}
+	return nil
+}
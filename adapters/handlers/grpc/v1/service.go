@@ -35,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -43,25 +44,29 @@ type Service struct {
 	pb.UnimplementedWeaviateServer
 	traverser            *traverser.Traverser
 	authComposer         composer.TokenFunc
+	mtls                 *mtls.Client
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	changes              changesReader
 	config               *config.Config
 	authorizer           authorization.Authorizer
 	logger               logrus.FieldLogger
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
-	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
-	logger logrus.FieldLogger,
+	mtlsClient *mtls.Client, allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
+	batchManager *objects.BatchManager, changes changesReader, config *config.Config,
+	authorization authorization.Authorizer, logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
 		authComposer:         authComposer,
+		mtls:                 mtlsClient,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		changes:              changes,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
@@ -386,6 +391,8 @@ func extractReplicationProperties(level *pb.ConsistencyLevel) *additional.Replic
 		return &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"}
 	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_ALL:
 		return &additional.ReplicationProperties{ConsistencyLevel: "ALL"}
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_SESSION:
+		return &additional.ReplicationProperties{ConsistencyLevel: "SESSION"}
 	default:
 		return nil
 	}
@@ -64,8 +64,18 @@ func tenantToGRPC(tenant *models.Tenant) (*pb.Tenant, error) {
 		return nil, fmt.Errorf("unknown tenant activity status %s", tenant.ActivityStatus)
 	}
 
+	var quotas *pb.TenantQuotas
+	if tenant.Quotas != nil {
+		quotas = &pb.TenantQuotas{
+			MaxObjects:          tenant.Quotas.MaxObjects,
+			MaxBytes:            tenant.Quotas.MaxBytes,
+			MaxVectorDimensions: tenant.Quotas.MaxVectorDimensions,
+		}
+	}
+
 	return &pb.Tenant{
 		Name:           tenant.Name,
 		ActivityStatus: pb.TenantActivityStatus(status),
+		Quotas:         quotas,
 	}, nil
 }
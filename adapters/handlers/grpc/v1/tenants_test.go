@@ -58,3 +58,22 @@ func TestGRPCTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCTenantQuotas(t *testing.T) {
+	tenantGRPC, err := tenantToGRPC(&models.Tenant{
+		Name:           "TestTenant",
+		ActivityStatus: models.TenantActivityStatusHOT,
+	})
+	require.Nil(t, err)
+	require.Nil(t, tenantGRPC.GetQuotas())
+
+	tenantGRPC, err = tenantToGRPC(&models.Tenant{
+		Name:           "TestTenant",
+		ActivityStatus: models.TenantActivityStatusHOT,
+		Quotas:         &models.TenantQuotas{MaxObjects: 10, MaxBytes: 20, MaxVectorDimensions: 30},
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(10), tenantGRPC.GetQuotas().GetMaxObjects())
+	require.Equal(t, int64(20), tenantGRPC.GetQuotas().GetMaxBytes())
+	require.Equal(t, int64(30), tenantGRPC.GetQuotas().GetMaxVectorDimensions())
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/security"
+
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
+)
+
+// makeBearerAuthenticator extends the bearer token authentication of the API
+// with mTLS. Requests without a bearer token are authenticated by their
+// verified client certificate, if mTLS is enabled. A bearer token always takes
+// precedence over the certificate.
+func makeBearerAuthenticator(mtlsClient *mtls.Client) func(string, security.ScopedTokenAuthentication) runtime.Authenticator {
+	return func(name string, authenticate security.ScopedTokenAuthentication) runtime.Authenticator {
+		bearer := security.BearerAuth(name, authenticate)
+		return runtime.AuthenticatorFunc(func(params interface{}) (bool, interface{}, error) {
+			applies, principal, err := bearer.Authenticate(params)
+			if applies || !mtlsClient.Config.Enabled {
+				return applies, principal, err
+			}
+
+			req, ok := params.(*security.ScopedAuthRequest)
+			if !ok || req.Request.TLS == nil {
+				return false, nil, nil
+			}
+
+			certPrincipal, err := mtlsClient.ValidateAndExtract(req.Request.TLS)
+			if err != nil {
+				return true, nil, openapierrors.New(401, "unauthorized: %v", err)
+			}
+			if certPrincipal == nil {
+				return false, nil, nil
+			}
+			return true, certPrincipal, nil
+		})
+	}
+}
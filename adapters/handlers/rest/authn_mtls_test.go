@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestBearerAuthenticatorWithMTLS(t *testing.T) {
+	mtlsClient, err := mtls.New(config.Config{Authentication: config.Authentication{
+		MTLS: config.MTLS{Enabled: true, UsernameField: config.MTLSUsernameFieldCommonName},
+	}})
+	require.NoError(t, err)
+
+	authenticator := makeBearerAuthenticator(mtlsClient)("oidc", func(token string, scopes []string) (interface{}, error) {
+		return &models.Principal{Username: token}, nil
+	})
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ingest-service"}}
+
+	t.Run("verified client certificate", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/objects", nil)
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+		applies, principal, err := authenticator.Authenticate(&security.ScopedAuthRequest{Request: r})
+		require.NoError(t, err)
+		assert.True(t, applies)
+		assert.Equal(t, &models.Principal{Username: "mtls:ingest-service", UserType: models.UserTypeInputOidc}, principal)
+	})
+
+	t.Run("bearer token takes precedence", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/objects", nil)
+		r.Header.Set("Authorization", "Bearer token-user")
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+		applies, principal, err := authenticator.Authenticate(&security.ScopedAuthRequest{Request: r})
+		require.NoError(t, err)
+		assert.True(t, applies)
+		assert.Equal(t, &models.Principal{Username: "token-user"}, principal)
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/objects", nil)
+
+		applies, principal, err := authenticator.Authenticate(&security.ScopedAuthRequest{Request: r})
+		require.NoError(t, err)
+		assert.False(t, applies)
+		assert.Nil(t, principal)
+	})
+}
@@ -53,6 +53,7 @@ type authZHandlers struct {
 	metrics        *monitoring.PrometheusMetrics
 	apiKeysConfigs config.StaticAPIKey
 	oidcConfigs    config.OIDC
+	mtlsConfigs    config.MTLS
 	rbacconfig     rbacconf.Config
 }
 
@@ -62,7 +63,7 @@ type ControllerAndGetUsers interface {
 }
 
 func SetupHandlers(api *operations.WeaviateAPI, controller ControllerAndGetUsers, schemaReader schemaUC.SchemaGetter,
-	apiKeysConfigs config.StaticAPIKey, oidcConfigs config.OIDC, rconfig rbacconf.Config, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger,
+	apiKeysConfigs config.StaticAPIKey, oidcConfigs config.OIDC, mtlsConfigs config.MTLS, rconfig rbacconf.Config, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger,
 ) {
 	h := &authZHandlers{
 		controller:     controller,
@@ -70,6 +71,7 @@ func SetupHandlers(api *operations.WeaviateAPI, controller ControllerAndGetUsers
 		schemaReader:   schemaReader,
 		rbacconfig:     rconfig,
 		oidcConfigs:    oidcConfigs,
+		mtlsConfigs:    mtlsConfigs,
 		apiKeysConfigs: apiKeysConfigs,
 		logger:         logger,
 		metrics:        metrics,
@@ -113,12 +115,42 @@ func (h *authZHandlers) authorizeRoleScopes(ctx context.Context, principal *mode
 				errs = errors.Join(errs, err)
 			}
 		}
-		return errs
+		if errs != nil {
+			return errs
+		}
+		return h.authorizeDataRestrictions(ctx, principal, policies)
 	}
 
 	return fmt.Errorf("can only create roles with less or equal permissions as the current user: %w", err)
 }
 
+// authorizeDataRestrictions verifies that read_data permissions restricted by
+// a filter or properties are not granted more broadly than the principal may
+// read itself. Casbin only matches the resource, the restriction is checked
+// separately.
+func (h *authZHandlers) authorizeDataRestrictions(ctx context.Context, principal *models.Principal, policies []authorization.Policy) error {
+	checker, ok := h.authorizer.(authorization.DataRestrictionAuthorizer)
+	if !ok {
+		return nil
+	}
+
+	var errs error
+	for _, policy := range policies {
+		domain, restriction, err := conv.DataRestrictionFromDomain(policy.Domain)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if domain != authorization.DataDomain || policy.Verb != authorization.READ {
+			continue
+		}
+		if err := checker.AuthorizeDataRestriction(ctx, principal, policy.Resource, restriction); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
 func (h *authZHandlers) createRole(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
@@ -471,6 +503,12 @@ func (h *authZHandlers) assignRoleToUser(params authz.AssignRoleToUserParams, pr
 		return authz.NewAssignRoleToUserNotFound().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("one or more of the roles requested doesn't exist")))
 	}
 
+	for _, policies := range existedRoles {
+		if err := h.authorizeDataRestrictions(ctx, principal, policies); err != nil {
+			return authz.NewAssignRoleToUserForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("assigning: %w", err)))
+		}
+	}
+
 	userTypes, err := h.getUserTypesAndValidateExistence(params.ID, params.Body.UserType)
 	if err != nil {
 		return authz.NewAssignRoleToUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("user exists: %w", err)))
@@ -913,7 +951,8 @@ func (h *authZHandlers) revokeRoleFromGroup(params authz.RevokeRoleFromGroupPara
 func (h *authZHandlers) userExists(user string, userType models.UserTypeInput) (bool, error) {
 	switch userType {
 	case models.UserTypeInputOidc:
-		if !h.oidcConfigs.Enabled {
+		// certificate identities of mTLS are externally managed users as well
+		if !h.oidcConfigs.Enabled && !h.mtlsConfigs.Enabled {
 			return false, fmt.Errorf("oidc is not enabled")
 		}
 		return true, nil
@@ -944,7 +983,7 @@ func (h *authZHandlers) userExistsDeprecated(user string) (bool, error) {
 	// We are only able to check if a user is present on the system if APIKeys are the only auth method. For OIDC
 	// users are managed in an external service and there is no general way to check if a user we have not seen yet is
 	// valid.
-	if h.oidcConfigs.Enabled {
+	if h.oidcConfigs.Enabled || h.mtlsConfigs.Enabled {
 		return true, nil
 	}
 
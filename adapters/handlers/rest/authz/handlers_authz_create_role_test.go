@@ -12,6 +12,7 @@
 package authz
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -327,6 +328,82 @@ func TestCreateRoleForbidden(t *testing.T) {
 	}
 }
 
+// dataRestrictedAuthorizer grants read_data only with the given restriction
+type dataRestrictedAuthorizer struct {
+	*authorization.MockAuthorizer
+	restriction *authorization.DataRestriction
+}
+
+func (a *dataRestrictedAuthorizer) AuthorizeDataRestriction(ctx context.Context, principal *models.Principal,
+	resource string, restriction *authorization.DataRestriction,
+) error {
+	if !a.restriction.Covers(restriction) {
+		return fmt.Errorf("forbidden: read_data on %s", resource)
+	}
+	return nil
+}
+
+func TestCreateRoleDataRestrictionEscalation(t *testing.T) {
+	legal := &models.WhereFilter{Operator: "Equal", Path: []string{"department"}, ValueText: String("legal")}
+	principal := &models.Principal{Username: "user1"}
+
+	tests := []struct {
+		name      string
+		filter    *models.WhereFilter
+		forbidden bool
+	}{
+		{name: "unfiltered read_data", filter: nil, forbidden: true},
+		{name: "same filter", filter: legal, forbidden: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := &dataRestrictedAuthorizer{
+				MockAuthorizer: authorization.NewMockAuthorizer(t),
+				restriction:    &authorization.DataRestriction{Filter: legal},
+			}
+			controller := NewMockControllerAndGetUsers(t)
+			logger, _ := test.NewNullLogger()
+
+			params := authz.CreateRoleParams{
+				HTTPRequest: req,
+				Body: &models.Role{
+					Name: String("newRole"),
+					Permissions: []*models.Permission{{
+						Action: String(authorization.ReadData),
+						Data:   &models.PermissionData{Collection: String("Documents"), Filter: tt.filter},
+					}},
+				},
+			}
+			policies, err := conv.RolesToPolicies(params.Body)
+			require.NoError(t, err)
+
+			authorizer.On("Authorize", mock.Anything, principal, authorization.VerbWithScope(authorization.CREATE, authorization.ROLE_SCOPE_ALL), authorization.Roles("newRole")[0]).Return(errors.New("not all roles"))
+			authorizer.On("Authorize", mock.Anything, principal, authorization.VerbWithScope(authorization.CREATE, authorization.ROLE_SCOPE_MATCH), authorization.Roles("newRole")[0]).Return(nil)
+			authorizer.On("AuthorizeSilent", mock.Anything, principal, authorization.READ, mock.Anything).Return(nil)
+			if !tt.forbidden {
+				controller.On("GetRoles", "newRole").Return(map[string][]authorization.Policy{}, nil)
+				controller.On("CreateRolesPermissions", policies).Return(nil)
+			}
+
+			h := &authZHandlers{
+				authorizer: authorizer,
+				controller: controller,
+				logger:     logger,
+			}
+			res := h.createRole(params, principal)
+			if tt.forbidden {
+				parsed, ok := res.(*authz.CreateRoleForbidden)
+				require.True(t, ok)
+				assert.Contains(t, parsed.Payload.Error[0].Message, "read_data")
+			} else {
+				_, ok := res.(*authz.CreateRoleCreated)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
 func TestCreateRoleInternalServerError(t *testing.T) {
 	type testCase struct {
 		name          string
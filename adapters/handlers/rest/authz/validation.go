@@ -14,11 +14,19 @@ package authz
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
+// filterValidationClass is used to check the syntax of data filters, which
+// might apply to several collections. The properties are validated against
+// the schema when the filter is applied.
+const filterValidationClass = "Collection"
+
 func validatePermissions(allowEmpty bool, permissions ...*models.Permission) error {
 	if !allowEmpty && len(permissions) == 0 {
 		return fmt.Errorf("role has to have at least 1 permission")
@@ -61,6 +69,25 @@ func validatePermissions(allowEmpty bool, permissions ...*models.Permission) err
 			if dataInput.Tenant != nil {
 				multiErr = errors.Join(schema.ValidateTenantNameIncludesRegex(*dataInput.Tenant))
 			}
+
+			restricted := dataInput.Filter != nil || len(dataInput.Properties) > 0 || len(dataInput.ExcludedProperties) > 0
+			if restricted && (perm.Action == nil || *perm.Action != authorization.ReadData) {
+				multiErr = errors.Join(multiErr, fmt.Errorf("data filters and properties are only supported for %s", authorization.ReadData))
+			}
+
+			if dataInput.Filter != nil {
+				if _, err := filterext.Parse(dataInput.Filter, filterValidationClass); err != nil {
+					multiErr = errors.Join(multiErr, fmt.Errorf("data filter: %w", err))
+				}
+			}
+
+			for _, path := range append(dataInput.Properties, dataInput.ExcludedProperties...) {
+				for _, name := range strings.Split(path, ".") {
+					if _, err := schema.ValidatePropertyName(name); err != nil {
+						multiErr = errors.Join(multiErr, fmt.Errorf("data properties: %w", err))
+					}
+				}
+			}
 		}
 
 		if backupsInput != nil && backupsInput.Collection != nil {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 func TestValidatePermissions(t *testing.T) {
@@ -153,6 +154,91 @@ func TestValidatePermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid data filter",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.ReadData),
+					Data: &models.PermissionData{
+						Collection: String("ValidCollectionName"),
+						Filter: &models.WhereFilter{
+							Operator:  "Equal",
+							Path:      []string{"department"},
+							ValueText: String("legal"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "data filter on update_data",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.UpdateData),
+					Data: &models.PermissionData{
+						Collection: String("ValidCollectionName"),
+						Filter: &models.WhereFilter{
+							Operator:  "Equal",
+							Path:      []string{"department"},
+							ValueText: String("legal"),
+						},
+					},
+				},
+			},
+			expectedErr: "data filters and properties are only supported for read_data",
+		},
+		{
+			name: "invalid data filter",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.ReadData),
+					Data: &models.PermissionData{
+						Collection: String("ValidCollectionName"),
+						Filter:     &models.WhereFilter{Operator: "NotAnOperator"},
+					},
+				},
+			},
+			expectedErr: "data filter",
+		},
+		{
+			name: "valid data properties",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.ReadData),
+					Data: &models.PermissionData{
+						Collection:         String("ValidCollectionName"),
+						Properties:         []string{"name", "address"},
+						ExcludedProperties: []string{"address.street"},
+					},
+				},
+			},
+		},
+		{
+			name: "data properties on delete_data",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.DeleteData),
+					Data: &models.PermissionData{
+						Collection: String("ValidCollectionName"),
+						Properties: []string{"name"},
+					},
+				},
+			},
+			expectedErr: "data filters and properties are only supported for read_data",
+		},
+		{
+			name: "invalid data property",
+			permissions: []*models.Permission{
+				{
+					Action: String(authorization.ReadData),
+					Data: &models.PermissionData{
+						Collection: String("ValidCollectionName"),
+						Properties: []string{"address..street"},
+					},
+				},
+			},
+			expectedErr: "data properties",
+		},
 	}
 
 	for _, tt := range tests {
@@ -33,6 +33,7 @@ import (
 	reposdb "github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -65,6 +66,7 @@ type indices struct {
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
+	regexpShardChanges        *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShardFileMetadata   *regexp.Regexp
@@ -75,6 +77,9 @@ type indices struct {
 	regexpResumeFileActivity *regexp.Regexp
 	regexpListFiles          *regexp.Regexp
 
+	regexpSplitShardReplica  *regexp.Regexp
+	regexpDropUnownedObjects *regexp.Regexp
+
 	regexpAsyncReplicationTargetNode *regexp.Regexp
 
 	logger logrus.FieldLogger
@@ -108,6 +113,8 @@ const (
 		`\/shards\/(` + sh + `)\/references`
 	urlPatternShardsQueueSize = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/queuesize`
+	urlPatternShardChanges = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/changes`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
@@ -124,6 +131,10 @@ const (
 		`\/shards\/(` + sh + `)\/background:resume`
 	urlPatternListFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/background:list`
+	urlPatternSplitShardReplica = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/background:split\/(` + sh + `)`
+	urlPatternDropUnownedObjects = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/background:drop-unowned`
 	urlPatternAsyncReplicationTargetNode = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/async-replication-target-node`
 )
@@ -159,6 +170,8 @@ type shards interface {
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, indexName, shardName string) (int64, error)
+	GetShardChanges(ctx context.Context, indexName, shardName string,
+		after uint64, since int64, limit int) ([]changes.Change, error)
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
@@ -190,6 +203,10 @@ type shards interface {
 	// GetFile See adapters/clients.RemoteIndex.GetFile
 	GetFile(ctx context.Context, indexName, shardName,
 		relativeFilePath string) (io.ReadCloser, error)
+	// SplitShardReplica See adapters/clients.RemoteIndex.SplitShardReplica
+	SplitShardReplica(ctx context.Context, indexName, shardName, targetShard string, schemaVersion uint64) error
+	// DropUnownedObjects See adapters/clients.RemoteIndex.DropUnownedObjects
+	DropUnownedObjects(ctx context.Context, indexName, shardName string, schemaVersion uint64) error
 	// AddAsyncReplicationTargetNode See adapters/clients.RemoteIndex.AddAsyncReplicationTargetNode
 	AddAsyncReplicationTargetNode(ctx context.Context, indexName, shardName string,
 		targetNodeOverride additional.AsyncReplicationTargetNodeOverride, schemaVersion uint64) error
@@ -216,6 +233,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpObject:                     regexp.MustCompile(urlPatternObject),
 		regexpReferences:                 regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:            regexp.MustCompile(urlPatternShardsQueueSize),
+		regexpShardChanges:               regexp.MustCompile(urlPatternShardChanges),
 		regexpShardsStatus:               regexp.MustCompile(urlPatternShardsStatus),
 		regexpShardFiles:                 regexp.MustCompile(urlPatternShardFiles),
 		regexpShardFileMetadata:          regexp.MustCompile(urlPatternShardFileMetadata),
@@ -224,6 +242,8 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpPauseFileActivity:          regexp.MustCompile(urlPatternPauseFileActivity),
 		regexpResumeFileActivity:         regexp.MustCompile(urlPatternResumeFileActivity),
 		regexpListFiles:                  regexp.MustCompile(urlPatternListFiles),
+		regexpSplitShardReplica:          regexp.MustCompile(urlPatternSplitShardReplica),
+		regexpDropUnownedObjects:         regexp.MustCompile(urlPatternDropUnownedObjects),
 		regexpAsyncReplicationTargetNode: regexp.MustCompile(urlPatternAsyncReplicationTargetNode),
 		shards:                           shards,
 		db:                               db,
@@ -345,6 +365,13 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpShardChanges.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getShardChanges().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 		case i.regexpShardsStatus.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getGetShardStatus().ServeHTTP(w, r)
@@ -412,6 +439,20 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpSplitShardReplica.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postSplitShardReplica().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case i.regexpDropUnownedObjects.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postDropUnownedObjects().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 		case i.regexpAsyncReplicationTargetNode.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.postAddAsyncReplicationTargetNode().ServeHTTP(w, r)
@@ -1284,6 +1325,57 @@ func (i *indices) getGetShardQueueSize() http.Handler {
 	})
 }
 
+func (i *indices) getShardChanges() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardChanges.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		query := r.URL.Query()
+		after, err := strconv.ParseUint(query.Get("after"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid after: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		since, err := strconv.ParseInt(query.Get("since"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid since: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+
+		res, err := i.shards.GetShardChanges(r.Context(), index, shard, after, since, limit)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.GetShardChangesResults.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.GetShardChangesResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) getGetShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -1617,6 +1709,65 @@ func (i *indices) postListFiles() http.Handler {
 	})
 }
 
+func (i *indices) postSplitShardReplica() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpSplitShardReplica.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		indexName, shardName, targetShard := args[1], args[2], args[3]
+
+		schemaVersion, err := extractSchemaVersionFromUrlQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = i.shards.SplitShardReplica(r.Context(), indexName, shardName, targetShard, schemaVersion)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"action":       "replica_movement",
+			"index":        indexName,
+			"shard":        shardName,
+			"target_shard": targetShard,
+		}).Debug("Copied replica into split shard")
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (i *indices) postDropUnownedObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpDropUnownedObjects.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		indexName, shardName := args[1], args[2]
+
+		schemaVersion, err := extractSchemaVersionFromUrlQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = i.shards.DropUnownedObjects(r.Context(), indexName, shardName, schemaVersion)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
 func (i *indices) postAddAsyncReplicationTargetNode() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpAsyncReplicationTargetNode.FindStringSubmatch(r.URL.Path)
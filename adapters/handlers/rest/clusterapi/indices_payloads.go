@@ -31,6 +31,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -59,6 +60,7 @@ type indicesPayloads struct {
 	BatchDeleteResults         batchDeleteResultsPayload
 	GetShardQueueSizeParams    getShardQueueSizeParamsPayload
 	GetShardQueueSizeResults   getShardQueueSizeResultsPayload
+	GetShardChangesResults     getShardChangesResultsPayload
 	GetShardStatusParams       getShardStatusParamsPayload
 	GetShardStatusResults      getShardStatusResultsPayload
 	UpdateShardStatusParams    updateShardStatusParamsPayload
@@ -919,6 +921,31 @@ func (p getShardQueueSizeResultsPayload) CheckContentTypeHeader(r *http.Response
 	return ct, ct == p.MIME()
 }
 
+type getShardChangesResultsPayload struct{}
+
+func (p getShardChangesResultsPayload) Unmarshal(in []byte) ([]changes.Change, error) {
+	var out []changes.Change
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p getShardChangesResultsPayload) Marshal(in []changes.Change) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p getShardChangesResultsPayload) MIME() string {
+	return "application/vnd.weaviate.getshardchangesresults+json"
+}
+
+func (p getShardChangesResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p getShardChangesResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type getShardStatusParamsPayload struct{}
 
 func (p getShardStatusParamsPayload) MIME() string {
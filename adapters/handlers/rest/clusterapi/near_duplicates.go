@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/usecases/dedup"
+)
+
+type NearDuplicates struct {
+	provider *dedup.Provider
+	auth     auth
+}
+
+func NewNearDuplicates(provider *dedup.Provider, auth auth) *NearDuplicates {
+	return &NearDuplicates{provider: provider, auth: auth}
+}
+
+// Pairs returns the pairs of near duplicates the local node found for a
+// task, so that the coordinator of the task can merge them into groups
+func (n *NearDuplicates) Pairs() http.Handler {
+	return n.auth.handleFunc(n.pairsHandler())
+}
+
+func (n *NearDuplicates) pairsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if n.provider == nil {
+			http.Error(w, "distributed tasks are not enabled", http.StatusNotImplemented)
+			return
+		}
+
+		taskID := r.URL.Query().Get("id")
+		if err := dedup.ValidateTaskID(taskID); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		version, err := strconv.ParseUint(r.URL.Query().Get("version"), 10, 64)
+		if err != nil {
+			http.Error(w, "version is required and must be a number", http.StatusBadRequest)
+			return
+		}
+
+		res, err := n.provider.Result(distributedtask.TaskDescriptor{ID: taskID, Version: version})
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				http.Error(w, "result not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(res.Pairs); err != nil {
+			http.Error(w, "/near-duplicates marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+		}
+	}
+}
@@ -49,6 +49,7 @@ func NewServer(appState *state.State) *Server {
 	nodes := NewNodes(appState.RemoteNodeIncoming, auth)
 	backups := NewBackups(appState.BackupManager, auth)
 	dbUsers := NewDbUsers(appState.APIKeyRemote, auth)
+	nearDuplicates := NewNearDuplicates(appState.NearDuplicates, auth)
 
 	mux := http.NewServeMux()
 	mux.Handle("/classifications/transactions/",
@@ -59,6 +60,7 @@ func NewServer(appState *state.State) *Server {
 	mux.Handle("/nodes/", nodes.Nodes())
 	mux.Handle("/indices/", indices.Indices())
 	mux.Handle("/replicas/indices/", replicatedIndices.Indices())
+	mux.Handle("/near-duplicates/pairs", nearDuplicates.Pairs())
 
 	mux.Handle("/backups/can-commit", backups.CanCommit())
 	mux.Handle("/backups/commit", backups.Commit())
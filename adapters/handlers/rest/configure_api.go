@@ -60,6 +60,7 @@ import (
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/replication/copier"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
 	"github.com/weaviate/weaviate/cluster/usage"
 	"github.com/weaviate/weaviate/entities/concurrency"
 	entcfg "github.com/weaviate/weaviate/entities/config"
@@ -135,10 +136,13 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/dedup"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -373,6 +377,23 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig)
 	appState.MemWatch = memwatch.NewMonitor(memwatch.LiveHeapReader, debug.SetMemoryLimit, 0.97)
 
+	var encryptionMasterKey *encryption.MasterKey
+	if persistence := appState.ServerConfig.Config.Persistence; persistence.EncryptionAtRestEnabled {
+		masterKey, err := encryption.LoadMasterKey(persistence.EncryptionAtRestMasterKey,
+			persistence.EncryptionAtRestMasterKeyFile)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("failed to load encryption at rest master key")
+		}
+		encryptionMasterKey = masterKey
+		encryption.SetBlockCacheSize(persistence.EncryptionAtRestBlockCacheSize)
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("master_key_id", masterKey.ID()).
+			Info("encryption at rest enabled")
+	}
+
 	var vectorRepo vectorRepo
 	// var vectorMigrator schema.Migrator
 	// var migrator schema.Migrator
@@ -416,6 +437,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		ForceFullReplicasSearch:             appState.ServerConfig.Config.ForceFullReplicasSearch,
 		TransferInactivityTimeout:           appState.ServerConfig.Config.TransferInactivityTimeout,
 		LSMEnableSegmentsChecksumValidation: appState.ServerConfig.Config.Persistence.LSMEnableSegmentsChecksumValidation,
+		EncryptionMasterKey:                 encryptionMasterKey,
+		ChangeStream:                        appState.ServerConfig.Config.ChangeStream,
+		CrossClusterReplication:             appState.ServerConfig.Config.CrossClusterReplication,
+		ReadOnlyNode:                        appState.ServerConfig.Config.Cluster.ReadOnly,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -528,6 +553,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		TrailingLogs:                    appState.ServerConfig.Config.Raft.TrailingLogs,
 		ConsistencyWaitTimeout:          appState.ServerConfig.Config.Raft.ConsistencyWaitTimeout,
 		MetadataOnlyVoters:              appState.ServerConfig.Config.Raft.MetadataOnlyVoters,
+		ReadOnly:                        appState.ServerConfig.Config.Cluster.ReadOnly,
 		EnableOneNodeRecovery:           appState.ServerConfig.Config.Raft.EnableOneNodeRecovery,
 		ForceOneNodeRecovery:            appState.ServerConfig.Config.Raft.ForceOneNodeRecovery,
 		DB:                              nil,
@@ -555,6 +581,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			break
 		}
 	}
+	if rConfig.Voter && appState.ServerConfig.Config.Cluster.ReadOnly {
+		// read-only nodes are scaled independently and must not be part of the
+		// raft quorum
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("node", rConfig.NodeID).
+			Fatal("read-only node must not be one of the raft voters in raft.join")
+	}
 
 	appState.ClusterService = rCluster.New(rConfig, appState.AuthzController, appState.AuthzSnapshotter, appState.GRPCServerMetrics)
 	migrator.SetCluster(appState.ClusterService.Raft)
@@ -608,6 +642,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		schemaManager, repo, appState.Modules, appState.RBAC, appState.APIKey.Dynamic)
 	appState.BackupManager = backupManager
 
+	if appState.ServerConfig.Config.DistributedTasks.Enabled {
+		// created before the internal server, which serves the pairs found
+		// locally to the coordinator of a task
+		appState.NearDuplicates = dedup.NewProvider(appState.DB, appState.DB, appState.Cluster,
+			clients.NewRemoteNearDuplicates(appState.ClusterHttpClient, appState.Cluster),
+			filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, "near-duplicates"), appState.Logger)
+	}
+
 	internalServer := clusterapi.NewServer(appState)
 	appState.InternalServer = internalServer
 	enterrors.GoWrapper(func() { appState.InternalServer.Serve() }, appState.Logger)
@@ -616,10 +658,13 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	explorer.SetSchemaGetter(schemaManager)
 	appState.Modules.SetSchemaGetter(schemaManager)
 
+	// the quotas are shared, so that module calls of queries and inserts count
+	// towards the same limit
+	quotas := ratelimiter.NewQuotas(appState.ServerConfig.Config.RateLimits)
 	appState.Traverser = traverser.NewTraverser(appState.ServerConfig,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
-		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+		appState.ServerConfig.Config.MaximumConcurrentGetRequests, quotas)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -652,7 +697,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Logger, prometheus.DefaultRegisterer)
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics, appState.AutoSchemaManager)
+		appState.Authorizer, appState.Metrics, appState.AutoSchemaManager, quotas)
 	appState.BatchManager = batchManager
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -712,11 +757,13 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.DistributedTaskScheduler = distributedtask.NewScheduler(distributedtask.SchedulerParams{
 			CompletionRecorder: appState.ClusterService.Raft,
 			TasksLister:        appState.ClusterService.Raft,
-			Providers:          map[string]distributedtask.Provider{},
-			Logger:             appState.Logger,
-			MetricsRegisterer:  metricsRegisterer,
-			LocalNode:          appState.Cluster.LocalName(),
-			TickInterval:       appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
+			Providers: map[string]distributedtask.Provider{
+				dedup.Namespace: appState.NearDuplicates,
+			},
+			Logger:            appState.Logger,
+			MetricsRegisterer: metricsRegisterer,
+			LocalNode:         appState.Cluster.LocalName(),
+			TickInterval:      appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
 
 			// Using a single global value for now to keep it simple. If there is a need
 			// this can be changed to provide a value per provider.
@@ -737,6 +784,51 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		}, appState.Logger)
 	}
 
+	if appState.ServerConfig.Config.ReplicaMovementEnabled {
+		// splits shards of collections whose shard count was raised
+		appState.Resharder = rebalancer.NewResharder(rebalancer.ResharderParams{
+			Replicator:   appState.ClusterService.Raft,
+			SchemaReader: appState.ClusterService.Raft.SchemaReader(),
+			NodeSelector: appState.Cluster,
+			Logger:       appState.Logger,
+		})
+		enterrors.GoWrapper(func() {
+			<-storeReadyCtx.Done()
+			if !errors.Is(context.Cause(storeReadyCtx), metaStoreReadyErr) {
+				return
+			}
+			appState.Resharder.Start(ctx)
+		}, appState.Logger)
+	}
+
+	if cfg := appState.ServerConfig.Config.ReplicaRebalancer; cfg.Enabled {
+		if !appState.ServerConfig.Config.ReplicaMovementEnabled {
+			appState.Logger.WithField("action", "startup").
+				Warn("replica rebalancer is enabled but replica movement is disabled, not starting it")
+		} else {
+			appState.ReplicaRebalancer, err = rebalancer.New(rebalancer.Params{
+				Replicator:   appState.ClusterService.Raft,
+				SchemaReader: appState.ClusterService.Raft.SchemaReader(),
+				NodeSelector: appState.Cluster,
+				NodeStatus:   appState.DB,
+				Logger:       appState.Logger,
+				Config:       cfg,
+			})
+			if err != nil {
+				appState.Logger.WithError(err).WithField("action", "startup").
+					Fatal("failed to create replica rebalancer")
+			}
+			enterrors.GoWrapper(func() {
+				// placement decisions need the full sharding state
+				<-storeReadyCtx.Done()
+				if !errors.Is(context.Cause(storeReadyCtx), metaStoreReadyErr) {
+					return
+				}
+				appState.ReplicaRebalancer.Start(ctx)
+			}, appState.Logger)
+		}
+	}
+
 	return appState
 }
 
@@ -818,6 +910,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
 		appState.APIKey, appState.OIDC)
+	api.BearerAuthenticator = makeBearerAuthenticator(appState.MTLS)
 
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithFields(logrus.Fields{"action": "restapi_management", "version": build.Version}).Infof(msg, args...)
@@ -836,6 +929,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.SchemaManager,
 		appState.ServerConfig.Config.Authentication.APIKey,
 		appState.ServerConfig.Config.Authentication.OIDC,
+		appState.ServerConfig.Config.Authentication.MTLS,
 		appState.ServerConfig.Config.Authorization.Rbac,
 		appState.Metrics,
 		appState.Authorizer,
@@ -863,7 +957,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
 	if appState.ServerConfig.Config.DistributedTasks.Enabled {
-		setupDistributedTasksHandlers(api, appState.Authorizer, appState.ClusterService.Raft)
+		setupDistributedTasksHandlers(api, appState.Authorizer, appState.ClusterService.Raft,
+			dedup.NewHandler(appState.Authorizer, appState.SchemaManager, appState.ClusterService.Raft,
+				appState.Cluster.LocalName()))
 	}
 
 	var grpcInstrument []grpc.ServerOption
@@ -914,6 +1010,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			appState.DistributedTaskScheduler.Close()
 		}
 
+		if appState.ReplicaRebalancer != nil {
+			appState.ReplicaRebalancer.Stop()
+		}
+		if appState.Resharder != nil {
+			appState.Resharder.Stop()
+		}
+
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
@@ -951,6 +1054,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 				WithField("action", "shutdown modules").
 				Errorf("failed to gracefully shutdown")
 		}
+
+		if err := appState.AuditLogger.Close(); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "shutdown audit log").
+				Errorf("failed to gracefully shutdown")
+		}
 	}
 
 	startGrpcServer(grpcServer, appState)
@@ -1028,6 +1138,7 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	appState.OIDC = configureOIDC(appState)
 	appState.APIKey = configureAPIKey(appState)
 	appState.APIKeyRemote = apikey.NewRemoteApiKey(appState.APIKey)
+	appState.MTLS = configureMTLS(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	if err = configureAuthorizer(appState); err != nil {
 		logger.WithField("action", "startup").WithField("error", err).Error("cannot configure authorizer")
@@ -1829,6 +1940,18 @@ func initRuntimeOverrides(appState *state.State) {
 		registered.QuerySlowLogEnabled = appState.ServerConfig.Config.QuerySlowLogEnabled
 		registered.QuerySlowLogThreshold = appState.ServerConfig.Config.QuerySlowLogThreshold
 		registered.InvertedSorterDisabled = appState.ServerConfig.Config.InvertedSorterDisabled
+		registered.RateLimitQueriesPerPrincipal = appState.ServerConfig.Config.RateLimits.QueriesPerPrincipal.Rate
+		registered.RateLimitQueriesPerPrincipalBurst = appState.ServerConfig.Config.RateLimits.QueriesPerPrincipal.Burst
+		registered.RateLimitQueriesPerCollection = appState.ServerConfig.Config.RateLimits.QueriesPerCollection.Rate
+		registered.RateLimitQueriesPerCollectionBurst = appState.ServerConfig.Config.RateLimits.QueriesPerCollection.Burst
+		registered.RateLimitBatchObjectsPerPrincipal = appState.ServerConfig.Config.RateLimits.BatchObjectsPerPrincipal.Rate
+		registered.RateLimitBatchObjectsPerPrincipalBurst = appState.ServerConfig.Config.RateLimits.BatchObjectsPerPrincipal.Burst
+		registered.RateLimitBatchObjectsPerCollection = appState.ServerConfig.Config.RateLimits.BatchObjectsPerCollection.Rate
+		registered.RateLimitBatchObjectsPerCollectionBurst = appState.ServerConfig.Config.RateLimits.BatchObjectsPerCollection.Burst
+		registered.RateLimitModuleCallsPerPrincipal = appState.ServerConfig.Config.RateLimits.ModuleCallsPerPrincipal.Rate
+		registered.RateLimitModuleCallsPerPrincipalBurst = appState.ServerConfig.Config.RateLimits.ModuleCallsPerPrincipal.Burst
+		registered.RateLimitModuleCallsPerCollection = appState.ServerConfig.Config.RateLimits.ModuleCallsPerCollection.Rate
+		registered.RateLimitModuleCallsPerCollectionBurst = appState.ServerConfig.Config.RateLimits.ModuleCallsPerCollection.Burst
 
 		if appState.Modules.UsageEnabled() {
 			registered.UsageGCSBucket = appState.ServerConfig.Config.Usage.GCSBucket
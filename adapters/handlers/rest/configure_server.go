@@ -26,8 +26,10 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/utils"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
@@ -106,6 +108,16 @@ func configureAPIKey(appState *state.State) *apikey.ApiKey {
 	return c
 }
 
+func configureMTLS(appState *state.State) *mtls.Client {
+	c, err := mtls.New(appState.ServerConfig.Config)
+	if err != nil {
+		appState.Logger.WithField("action", "mtls_init").WithError(err).Fatal("mtls client could not start up")
+		os.Exit(1)
+	}
+
+	return c
+}
+
 // configureAnonymousAccess will always be called, even if anonymous access is
 // disabled. In this case the middleware provided by this client will block
 // anonymous requests
@@ -114,6 +126,12 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 }
 
 func configureAuthorizer(appState *state.State) error {
+	auditLogger, err := audit.New(appState.ServerConfig.Config.AuditLog, appState.Logger)
+	if err != nil {
+		return fmt.Errorf("can't init audit log: %w", err)
+	}
+	appState.AuditLogger = auditLogger
+
 	if appState.ServerConfig.Config.Authorization.Rbac.Enabled {
 		// if rbac enforcer enabled, start forcing all requests using the casbin enforcer
 		rbacController, err := rbac.New(
@@ -124,14 +142,17 @@ func configureAuthorizer(appState *state.State) error {
 			return fmt.Errorf("can't init casbin %w", err)
 		}
 
+		rbacController.SetAuditLogger(auditLogger)
+
 		appState.AuthzController = rbacController
 		appState.AuthzSnapshotter = rbacController
 		appState.RBAC = rbacController
 		appState.Authorizer = rbacController
 	} else if appState.ServerConfig.Config.Authorization.AdminList.Enabled {
-		appState.Authorizer = adminlist.New(appState.ServerConfig.Config.Authorization.AdminList)
+		appState.Authorizer = audit.NewAuthorizer(
+			adminlist.New(appState.ServerConfig.Config.Authorization.AdminList), auditLogger)
 	} else {
-		appState.Authorizer = &authorization.DummyAuthorizer{}
+		appState.Authorizer = audit.NewAuthorizer(&authorization.DummyAuthorizer{}, auditLogger)
 	}
 
 	if appState.ServerConfig.Config.Authorization.Rbac.Enabled && appState.RBAC == nil {
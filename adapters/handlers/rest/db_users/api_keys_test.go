@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db_users
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/users"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func TestCreateUserApiKeySuccess(t *testing.T) {
+	principal := &models.Principal{}
+	authorizer := authorization.NewMockAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Users("user")[0]).Return(nil)
+	dynUser := NewMockDbUserAndRolesGetter(t)
+	dynUser.On("GetUsers", "user").Return(map[string]*apikey.User{"user": {Id: "user"}}, nil)
+	dynUser.On("GetRolesForUser", "user", models.UserTypeInputDb).Return(map[string][]authorization.Policy{"reader": {}, "writer": {}}, nil)
+	dynUser.On("CheckUserIdentifierExists", mock.Anything).Return(false, nil)
+
+	expiresAt := time.Now().Add(time.Hour)
+	var createdKey apikey.UserApiKey
+	dynUser.On("CreateUserApiKey", mock.Anything).Run(func(args mock.Arguments) {
+		createdKey = args[0].(apikey.UserApiKey)
+	}).Return(nil)
+
+	h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+	res := h.createUserApiKey(users.CreateUserAPIKeyParams{
+		UserID: "user", HTTPRequest: req,
+		Body: users.CreateUserAPIKeyBody{Roles: []string{"reader"}, Collections: []string{"articles"}, ExpiresAt: strfmt.DateTime(expiresAt)},
+	}, principal)
+	parsed, ok := res.(*users.CreateUserAPIKeyCreated)
+	require.True(t, ok)
+	require.Len(t, *parsed.Payload.Apikey, 88)
+	require.Equal(t, createdKey.Id, parsed.Payload.KeyID)
+
+	require.Equal(t, "user", createdKey.UserId)
+	require.Equal(t, []string{"reader"}, createdKey.Roles)
+	require.Equal(t, []string{"Articles"}, createdKey.Collections)
+	require.True(t, createdKey.ExpiresAt.Equal(expiresAt))
+	require.Equal(t, (*parsed.Payload.Apikey)[:3], createdKey.ApiKeyFirstLetters)
+}
+
+func TestCreateUserApiKeyUnprocessable(t *testing.T) {
+	tests := []struct {
+		name      string
+		roles     []string
+		expiresAt time.Time
+	}{
+		{name: "role not assigned to user", roles: []string{"admin"}},
+		{name: "expiry in the past", expiresAt: time.Now().Add(-time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal := &models.Principal{}
+			authorizer := authorization.NewMockAuthorizer(t)
+			authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Users("user")[0]).Return(nil)
+			dynUser := NewMockDbUserAndRolesGetter(t)
+			dynUser.On("GetUsers", "user").Return(map[string]*apikey.User{"user": {Id: "user"}}, nil)
+			if len(tt.roles) > 0 {
+				dynUser.On("GetRolesForUser", "user", models.UserTypeInputDb).Return(map[string][]authorization.Policy{"reader": {}}, nil)
+			}
+
+			h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+			res := h.createUserApiKey(users.CreateUserAPIKeyParams{
+				UserID: "user", HTTPRequest: req,
+				Body: users.CreateUserAPIKeyBody{Roles: tt.roles, ExpiresAt: strfmt.DateTime(tt.expiresAt)},
+			}, principal)
+			_, ok := res.(*users.CreateUserAPIKeyUnprocessableEntity)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestCreateUserApiKeyNotFound(t *testing.T) {
+	principal := &models.Principal{}
+	authorizer := authorization.NewMockAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Users("user")[0]).Return(nil)
+	dynUser := NewMockDbUserAndRolesGetter(t)
+	dynUser.On("GetUsers", "user").Return(map[string]*apikey.User{}, nil)
+
+	h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+	res := h.createUserApiKey(users.CreateUserAPIKeyParams{UserID: "user", HTTPRequest: req}, principal)
+	_, ok := res.(*users.CreateUserAPIKeyNotFound)
+	assert.True(t, ok)
+}
+
+func TestListUserApiKeys(t *testing.T) {
+	principal := &models.Principal{}
+	authorizer := authorization.NewMockAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Users("user")[0]).Return(nil)
+	dynUser := NewMockDbUserAndRolesGetter(t)
+	dynUser.On("GetUsers", "user").Return(map[string]*apikey.User{"user": {Id: "user"}}, nil)
+	expiresAt := time.Now().Add(time.Hour)
+	dynUser.On("GetUserApiKeys", "user").Return([]*apikey.UserApiKey{
+		{Id: "key1", UserId: "user", ApiKeyFirstLetters: "abc"},
+		{Id: "key2", UserId: "user", ApiKeyFirstLetters: "def", ExpiresAt: expiresAt, Roles: []string{"reader"}},
+	}, nil)
+
+	h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+	res := h.listUserApiKeys(users.ListUserAPIKeysParams{UserID: "user", HTTPRequest: req}, principal)
+	parsed, ok := res.(*users.ListUserAPIKeysOK)
+	require.True(t, ok)
+	require.Len(t, parsed.Payload, 2)
+	require.Equal(t, "key1", *parsed.Payload[0].KeyID)
+	require.True(t, time.Time(parsed.Payload[0].ExpiresAt).IsZero())
+	require.Equal(t, "key2", *parsed.Payload[1].KeyID)
+	require.True(t, time.Time(parsed.Payload[1].ExpiresAt).Equal(expiresAt))
+	require.Equal(t, []string{"reader"}, parsed.Payload[1].Roles)
+	// first letters are only shown to root users
+	require.Empty(t, parsed.Payload[0].APIKeyFirstLetters)
+}
+
+func TestRevokeUserApiKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyId     string
+		revokeErr error
+		expected  interface{}
+	}{
+		{name: "success", keyId: "key1", expected: &users.RevokeUserAPIKeyNoContent{}},
+		{name: "unknown key", keyId: "other", expected: &users.RevokeUserAPIKeyNotFound{}},
+		{name: "revoke error", keyId: "key1", revokeErr: errors.New("some error"), expected: &users.RevokeUserAPIKeyInternalServerError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal := &models.Principal{}
+			authorizer := authorization.NewMockAuthorizer(t)
+			authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Users("user")[0]).Return(nil)
+			dynUser := NewMockDbUserAndRolesGetter(t)
+			dynUser.On("GetUserApiKeys", "user").Return([]*apikey.UserApiKey{{Id: "key1", UserId: "user"}}, nil)
+			if tt.keyId == "key1" {
+				dynUser.On("RevokeUserApiKey", "user", tt.keyId).Return(tt.revokeErr)
+			}
+
+			h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+			res := h.revokeUserApiKey(users.RevokeUserAPIKeyParams{UserID: "user", KeyID: tt.keyId, HTTPRequest: req}, principal)
+			assert.IsType(t, tt.expected, res)
+		})
+	}
+}
+
+func TestRotateKeyExpiryInThePast(t *testing.T) {
+	principal := &models.Principal{}
+	authorizer := authorization.NewMockAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Users("user")[0]).Return(nil)
+	dynUser := NewMockDbUserAndRolesGetter(t)
+	dynUser.On("GetUsers", "user").Return(map[string]*apikey.User{"user": {Id: "user"}}, nil)
+
+	h := dynUserHandler{dbUsers: dynUser, authorizer: authorizer, dbUserEnabled: true}
+
+	res := h.rotateKey(users.RotateUserAPIKeyParams{
+		UserID: "user", HTTPRequest: req,
+		Body: users.RotateUserAPIKeyBody{ExpiresAt: strfmt.DateTime(time.Now().Add(-time.Minute))},
+	}, principal)
+	_, ok := res.(*users.RotateUserAPIKeyUnprocessableEntity)
+	assert.True(t, ok)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/clients"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/users"
 	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey/keys"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -87,6 +89,9 @@ func SetupHandlers(
 	api.UsersDeactivateUserHandler = users.DeactivateUserHandlerFunc(h.deactivateUser)
 	api.UsersActivateUserHandler = users.ActivateUserHandlerFunc(h.activateUser)
 	api.UsersListAllUsersHandler = users.ListAllUsersHandlerFunc(h.listUsers)
+	api.UsersListUserAPIKeysHandler = users.ListUserAPIKeysHandlerFunc(h.listUserApiKeys)
+	api.UsersCreateUserAPIKeyHandler = users.CreateUserAPIKeyHandlerFunc(h.createUserApiKey)
+	api.UsersRevokeUserAPIKeyHandler = users.RevokeUserAPIKeyHandlerFunc(h.revokeUserApiKey)
 }
 
 func (h *dynUserHandler) listUsers(params users.ListAllUsersParams, principal *models.Principal) middleware.Responder {
@@ -216,6 +221,9 @@ func (h *dynUserHandler) getUser(params users.GetUserInfoParams, principal *mode
 		user := existingDbUsers[params.UserID]
 		response.Active = &user.Active
 		response.CreatedAt = strfmt.DateTime(user.CreatedAt)
+		if !user.ApiKeyExpiresAt.IsZero() {
+			response.APIKeyExpiresAt = strfmt.DateTime(user.ApiKeyExpiresAt)
+		}
 		if isRootUser {
 			response.APIKeyFirstLetters = user.ApiKeyFirstLetters
 		}
@@ -362,6 +370,11 @@ func (h *dynUserHandler) createUser(params users.CreateUserParams, principal *mo
 		return users.NewCreateUserUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(errors.New("cannot create db user with admin list name")))
 	}
 
+	expiresAt, err := validateExpiresAt(params.Body.ExpiresAt)
+	if err != nil {
+		return users.NewCreateUserUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
 	existingUser, err := h.dbUsers.GetUsers(params.UserID)
 	if err != nil {
 		return users.NewCreateUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("checking user existence: %w", err)))
@@ -376,7 +389,7 @@ func (h *dynUserHandler) createUser(params users.CreateUserParams, principal *mo
 		return users.NewCreateUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
 	}
 
-	if err := h.dbUsers.CreateUser(params.UserID, hash, userIdentifier, apiKey[:3], time.Now()); err != nil {
+	if err := h.dbUsers.CreateUser(params.UserID, hash, userIdentifier, apiKey[:3], time.Now(), expiresAt); err != nil {
 		return users.NewCreateUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("creating user: %w", err)))
 	}
 
@@ -406,6 +419,11 @@ func (h *dynUserHandler) rotateKey(params users.RotateUserAPIKeyParams, principa
 		return users.NewRotateUserAPIKeyNotFound()
 	}
 
+	expiresAt, err := validateExpiresAt(params.Body.ExpiresAt)
+	if err != nil {
+		return users.NewRotateUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
 	oldUserIdentifier := existingUser[params.UserID].InternalIdentifier
 
 	apiKey, hash, newUserIdentifier, err := h.getApiKey()
@@ -413,13 +431,153 @@ func (h *dynUserHandler) rotateKey(params users.RotateUserAPIKeyParams, principa
 		return users.NewRotateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
 	}
 
-	if err := h.dbUsers.RotateKey(params.UserID, apiKey[:3], hash, oldUserIdentifier, newUserIdentifier); err != nil {
+	if err := h.dbUsers.RotateKey(params.UserID, apiKey[:3], hash, oldUserIdentifier, newUserIdentifier, expiresAt); err != nil {
 		return users.NewRotateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("rotate key: %w", err)))
 	}
 
 	return users.NewRotateUserAPIKeyOK().WithPayload(&models.UserAPIKey{Apikey: &apiKey})
 }
 
+func (h *dynUserHandler) listUserApiKeys(params users.ListUserAPIKeysParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Users(params.UserID)...); err != nil {
+		return users.NewListUserAPIKeysForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	if !h.dbUserEnabled {
+		return users.NewListUserAPIKeysUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(errors.New("db user management is not enabled")))
+	}
+
+	existingUser, err := h.dbUsers.GetUsers(params.UserID)
+	if err != nil {
+		return users.NewListUserAPIKeysInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("checking user existence: %w", err)))
+	}
+	if len(existingUser) == 0 {
+		return users.NewListUserAPIKeysNotFound()
+	}
+
+	apiKeys, err := h.dbUsers.GetUserApiKeys(params.UserID)
+	if err != nil {
+		return users.NewListUserAPIKeysInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("get api keys: %w", err)))
+	}
+
+	isRootUser := h.isRequestFromRootUser(principal)
+	response := make([]*models.DBUserAPIKeyInfo, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		keyId := apiKey.Id
+		info := &models.DBUserAPIKeyInfo{
+			KeyID:       &keyId,
+			CreatedAt:   strfmt.DateTime(apiKey.CreatedAt),
+			Roles:       apiKey.Roles,
+			Collections: apiKey.Collections,
+		}
+		if !apiKey.ExpiresAt.IsZero() {
+			info.ExpiresAt = strfmt.DateTime(apiKey.ExpiresAt)
+		}
+		if isRootUser {
+			info.APIKeyFirstLetters = apiKey.ApiKeyFirstLetters
+		}
+		response = append(response, info)
+	}
+
+	return users.NewListUserAPIKeysOK().WithPayload(response)
+}
+
+func (h *dynUserHandler) createUserApiKey(params users.CreateUserAPIKeyParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.Users(params.UserID)...); err != nil {
+		return users.NewCreateUserAPIKeyForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	if !h.dbUserEnabled {
+		return users.NewCreateUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(errors.New("db user management is not enabled")))
+	}
+
+	existingUser, err := h.dbUsers.GetUsers(params.UserID)
+	if err != nil {
+		return users.NewCreateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("checking user existence: %w", err)))
+	}
+	if len(existingUser) == 0 {
+		if h.staticUserExists(params.UserID) {
+			return users.NewCreateUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("user '%v' is static user", params.UserID)))
+		}
+		return users.NewCreateUserAPIKeyNotFound()
+	}
+
+	expiresAt, err := validateExpiresAt(params.Body.ExpiresAt)
+	if err != nil {
+		return users.NewCreateUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	// a scoped key can only narrow down the permissions of the user, never extend them
+	if len(params.Body.Roles) > 0 {
+		userRoles, err := h.dbUsers.GetRolesForUser(params.UserID, models.UserTypeInputDb)
+		if err != nil {
+			return users.NewCreateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("get roles: %w", err)))
+		}
+		for _, role := range params.Body.Roles {
+			if _, ok := userRoles[role]; !ok {
+				return users.NewCreateUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("role '%v' is not assigned to user '%v'", role, params.UserID)))
+			}
+		}
+	}
+
+	collections := make([]string, 0, len(params.Body.Collections))
+	for _, collection := range params.Body.Collections {
+		collections = append(collections, entschema.UppercaseClassName(collection))
+	}
+
+	apiKey, hash, userIdentifier, err := h.getApiKey()
+	if err != nil {
+		return users.NewCreateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	keyId := uuid.NewString()
+	if err := h.dbUsers.CreateUserApiKey(apikey.UserApiKey{
+		Id:                 keyId,
+		UserId:             params.UserID,
+		Identifier:         userIdentifier,
+		SecureHash:         hash,
+		ApiKeyFirstLetters: apiKey[:3],
+		CreatedAt:          time.Now(),
+		ExpiresAt:          expiresAt,
+		Roles:              params.Body.Roles,
+		Collections:        collections,
+	}); err != nil {
+		return users.NewCreateUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("create api key: %w", err)))
+	}
+
+	return users.NewCreateUserAPIKeyCreated().WithPayload(&models.UserAPIKey{Apikey: &apiKey, KeyID: keyId})
+}
+
+func (h *dynUserHandler) revokeUserApiKey(params users.RevokeUserAPIKeyParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.Users(params.UserID)...); err != nil {
+		return users.NewRevokeUserAPIKeyForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	if !h.dbUserEnabled {
+		return users.NewRevokeUserAPIKeyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(errors.New("db user management is not enabled")))
+	}
+
+	apiKeys, err := h.dbUsers.GetUserApiKeys(params.UserID)
+	if err != nil {
+		return users.NewRevokeUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("get api keys: %w", err)))
+	}
+	if !slices.ContainsFunc(apiKeys, func(key *apikey.UserApiKey) bool { return key.Id == params.KeyID }) {
+		return users.NewRevokeUserAPIKeyNotFound()
+	}
+
+	if err := h.dbUsers.RevokeUserApiKey(params.UserID, params.KeyID); err != nil {
+		return users.NewRevokeUserAPIKeyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("revoke api key: %w", err)))
+	}
+
+	return users.NewRevokeUserAPIKeyNoContent()
+}
+
 func (h *dynUserHandler) getApiKey() (string, string, string, error) {
 	// the user identifier is random, and we need to be sure that there is no reuse. Otherwise, an existing apikey would
 	// become invalid. The chances are minimal, but with a lot of users it can happen (birthday paradox!).
@@ -633,6 +791,18 @@ func (h *dynUserHandler) isRequestFromRootUser(principal *models.Principal) bool
 	return slices.Contains(h.rbacConfig.RootUsers, principal.Username)
 }
 
+// validateExpiresAt returns the expiry time of a new key. A zero time means that
+// the key never expires
+func validateExpiresAt(expiresAt strfmt.DateTime) (time.Time, error) {
+	if time.Time(expiresAt).IsZero() {
+		return time.Time{}, nil
+	}
+	if !time.Time(expiresAt).After(time.Now()) {
+		return time.Time{}, errors.New("expiresAt must be in the future")
+	}
+	return time.Time(expiresAt).UTC(), nil
+}
+
 // validateRoleName validates that this string is a valid role name (format wise)
 func validateUserName(name string) error {
 	if len(name) > apikey.UserNameMaxLength {
@@ -139,17 +139,17 @@ func (_c *MockDbUserAndRolesGetter_CheckUserIdentifierExists_Call) RunAndReturn(
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt
-func (_m *MockDbUserAndRolesGetter) CreateUser(userId string, secureHash string, userIdentifier string, apiKeyFirstLetters string, createdAt time.Time) error {
-	ret := _m.Called(userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt)
+// CreateUser provides a mock function with given fields: userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt, expiresAt
+func (_m *MockDbUserAndRolesGetter) CreateUser(userId string, secureHash string, userIdentifier string, apiKeyFirstLetters string, createdAt time.Time, expiresAt time.Time) error {
+	ret := _m.Called(userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt, expiresAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, string, time.Time) error); ok {
-		r0 = rf(userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, time.Time, time.Time) error); ok {
+		r0 = rf(userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt, expiresAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -168,13 +168,14 @@ type MockDbUserAndRolesGetter_CreateUser_Call struct {
 //   - userIdentifier string
 //   - apiKeyFirstLetters string
 //   - createdAt time.Time
-func (_e *MockDbUserAndRolesGetter_Expecter) CreateUser(userId interface{}, secureHash interface{}, userIdentifier interface{}, apiKeyFirstLetters interface{}, createdAt interface{}) *MockDbUserAndRolesGetter_CreateUser_Call {
-	return &MockDbUserAndRolesGetter_CreateUser_Call{Call: _e.mock.On("CreateUser", userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt)}
+//   - expiresAt time.Time
+func (_e *MockDbUserAndRolesGetter_Expecter) CreateUser(userId interface{}, secureHash interface{}, userIdentifier interface{}, apiKeyFirstLetters interface{}, createdAt interface{}, expiresAt interface{}) *MockDbUserAndRolesGetter_CreateUser_Call {
+	return &MockDbUserAndRolesGetter_CreateUser_Call{Call: _e.mock.On("CreateUser", userId, secureHash, userIdentifier, apiKeyFirstLetters, createdAt, expiresAt)}
 }
 
-func (_c *MockDbUserAndRolesGetter_CreateUser_Call) Run(run func(userId string, secureHash string, userIdentifier string, apiKeyFirstLetters string, createdAt time.Time)) *MockDbUserAndRolesGetter_CreateUser_Call {
+func (_c *MockDbUserAndRolesGetter_CreateUser_Call) Run(run func(userId string, secureHash string, userIdentifier string, apiKeyFirstLetters string, createdAt time.Time, expiresAt time.Time)) *MockDbUserAndRolesGetter_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time), args[5].(time.Time))
 	})
 	return _c
 }
@@ -184,7 +185,53 @@ func (_c *MockDbUserAndRolesGetter_CreateUser_Call) Return(_a0 error) *MockDbUse
 	return _c
 }
 
-func (_c *MockDbUserAndRolesGetter_CreateUser_Call) RunAndReturn(run func(string, string, string, string, time.Time) error) *MockDbUserAndRolesGetter_CreateUser_Call {
+func (_c *MockDbUserAndRolesGetter_CreateUser_Call) RunAndReturn(run func(string, string, string, string, time.Time, time.Time) error) *MockDbUserAndRolesGetter_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserApiKey provides a mock function with given fields: key
+func (_m *MockDbUserAndRolesGetter) CreateUserApiKey(key apikey.UserApiKey) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserApiKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(apikey.UserApiKey) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDbUserAndRolesGetter_CreateUserApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserApiKey'
+type MockDbUserAndRolesGetter_CreateUserApiKey_Call struct {
+	*mock.Call
+}
+
+// CreateUserApiKey is a helper method to define mock.On call
+//   - key apikey.UserApiKey
+func (_e *MockDbUserAndRolesGetter_Expecter) CreateUserApiKey(key interface{}) *MockDbUserAndRolesGetter_CreateUserApiKey_Call {
+	return &MockDbUserAndRolesGetter_CreateUserApiKey_Call{Call: _e.mock.On("CreateUserApiKey", key)}
+}
+
+func (_c *MockDbUserAndRolesGetter_CreateUserApiKey_Call) Run(run func(key apikey.UserApiKey)) *MockDbUserAndRolesGetter_CreateUserApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(apikey.UserApiKey))
+	})
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_CreateUserApiKey_Call) Return(_a0 error) *MockDbUserAndRolesGetter_CreateUserApiKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_CreateUserApiKey_Call) RunAndReturn(run func(apikey.UserApiKey) error) *MockDbUserAndRolesGetter_CreateUserApiKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -390,6 +437,64 @@ func (_c *MockDbUserAndRolesGetter_GetRolesForUser_Call) RunAndReturn(run func(s
 	return _c
 }
 
+// GetUserApiKeys provides a mock function with given fields: userId
+func (_m *MockDbUserAndRolesGetter) GetUserApiKeys(userId string) ([]*apikey.UserApiKey, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserApiKeys")
+	}
+
+	var r0 []*apikey.UserApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*apikey.UserApiKey, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*apikey.UserApiKey); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*apikey.UserApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDbUserAndRolesGetter_GetUserApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserApiKeys'
+type MockDbUserAndRolesGetter_GetUserApiKeys_Call struct {
+	*mock.Call
+}
+
+// GetUserApiKeys is a helper method to define mock.On call
+//   - userId string
+func (_e *MockDbUserAndRolesGetter_Expecter) GetUserApiKeys(userId interface{}) *MockDbUserAndRolesGetter_GetUserApiKeys_Call {
+	return &MockDbUserAndRolesGetter_GetUserApiKeys_Call{Call: _e.mock.On("GetUserApiKeys", userId)}
+}
+
+func (_c *MockDbUserAndRolesGetter_GetUserApiKeys_Call) Run(run func(userId string)) *MockDbUserAndRolesGetter_GetUserApiKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_GetUserApiKeys_Call) Return(_a0 []*apikey.UserApiKey, _a1 error) *MockDbUserAndRolesGetter_GetUserApiKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_GetUserApiKeys_Call) RunAndReturn(run func(string) ([]*apikey.UserApiKey, error)) *MockDbUserAndRolesGetter_GetUserApiKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsers provides a mock function with given fields: userIds
 func (_m *MockDbUserAndRolesGetter) GetUsers(userIds ...string) (map[string]*apikey.User, error) {
 	_va := make([]interface{}, len(userIds))
@@ -522,17 +627,64 @@ func (_c *MockDbUserAndRolesGetter_RevokeRolesForUser_Call) RunAndReturn(run fun
 	return _c
 }
 
-// RotateKey provides a mock function with given fields: userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier
-func (_m *MockDbUserAndRolesGetter) RotateKey(userId string, apiKeyFirstLetters string, secureHash string, oldIdentifier string, newIdentifier string) error {
-	ret := _m.Called(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier)
+// RevokeUserApiKey provides a mock function with given fields: userId, keyId
+func (_m *MockDbUserAndRolesGetter) RevokeUserApiKey(userId string, keyId string) error {
+	ret := _m.Called(userId, keyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserApiKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userId, keyId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDbUserAndRolesGetter_RevokeUserApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserApiKey'
+type MockDbUserAndRolesGetter_RevokeUserApiKey_Call struct {
+	*mock.Call
+}
+
+// RevokeUserApiKey is a helper method to define mock.On call
+//   - userId string
+//   - keyId string
+func (_e *MockDbUserAndRolesGetter_Expecter) RevokeUserApiKey(userId interface{}, keyId interface{}) *MockDbUserAndRolesGetter_RevokeUserApiKey_Call {
+	return &MockDbUserAndRolesGetter_RevokeUserApiKey_Call{Call: _e.mock.On("RevokeUserApiKey", userId, keyId)}
+}
+
+func (_c *MockDbUserAndRolesGetter_RevokeUserApiKey_Call) Run(run func(userId string, keyId string)) *MockDbUserAndRolesGetter_RevokeUserApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_RevokeUserApiKey_Call) Return(_a0 error) *MockDbUserAndRolesGetter_RevokeUserApiKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDbUserAndRolesGetter_RevokeUserApiKey_Call) RunAndReturn(run func(string, string) error) *MockDbUserAndRolesGetter_RevokeUserApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKey provides a mock function with given fields: userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier, expiresAt
+func (_m *MockDbUserAndRolesGetter) RotateKey(userId string, apiKeyFirstLetters string, secureHash string, oldIdentifier string, newIdentifier string, expiresAt time.Time) error {
+	ret := _m.Called(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier, expiresAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RotateKey")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, string, string) error); ok {
-		r0 = rf(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, time.Time) error); ok {
+		r0 = rf(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier, expiresAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -551,13 +703,14 @@ type MockDbUserAndRolesGetter_RotateKey_Call struct {
 //   - secureHash string
 //   - oldIdentifier string
 //   - newIdentifier string
-func (_e *MockDbUserAndRolesGetter_Expecter) RotateKey(userId interface{}, apiKeyFirstLetters interface{}, secureHash interface{}, oldIdentifier interface{}, newIdentifier interface{}) *MockDbUserAndRolesGetter_RotateKey_Call {
-	return &MockDbUserAndRolesGetter_RotateKey_Call{Call: _e.mock.On("RotateKey", userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier)}
+//   - expiresAt time.Time
+func (_e *MockDbUserAndRolesGetter_Expecter) RotateKey(userId interface{}, apiKeyFirstLetters interface{}, secureHash interface{}, oldIdentifier interface{}, newIdentifier interface{}, expiresAt interface{}) *MockDbUserAndRolesGetter_RotateKey_Call {
+	return &MockDbUserAndRolesGetter_RotateKey_Call{Call: _e.mock.On("RotateKey", userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier, expiresAt)}
 }
 
-func (_c *MockDbUserAndRolesGetter_RotateKey_Call) Run(run func(userId string, apiKeyFirstLetters string, secureHash string, oldIdentifier string, newIdentifier string)) *MockDbUserAndRolesGetter_RotateKey_Call {
+func (_c *MockDbUserAndRolesGetter_RotateKey_Call) Run(run func(userId string, apiKeyFirstLetters string, secureHash string, oldIdentifier string, newIdentifier string, expiresAt time.Time)) *MockDbUserAndRolesGetter_RotateKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(time.Time))
 	})
 	return _c
 }
@@ -567,7 +720,7 @@ func (_c *MockDbUserAndRolesGetter_RotateKey_Call) Return(_a0 error) *MockDbUser
 	return _c
 }
 
-func (_c *MockDbUserAndRolesGetter_RotateKey_Call) RunAndReturn(run func(string, string, string, string, string) error) *MockDbUserAndRolesGetter_RotateKey_Call {
+func (_c *MockDbUserAndRolesGetter_RotateKey_Call) RunAndReturn(run func(string, string, string, string, string, time.Time) error) *MockDbUserAndRolesGetter_RotateKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
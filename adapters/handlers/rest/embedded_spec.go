@@ -5032,6 +5032,70 @@ func init() {
         ]
       }
     },
+    "/tasks/near-duplicates": {
+      "post": {
+        "description": "Starts a task which searches a collection for groups of near duplicate objects on all nodes of the cluster.",
+        "tags": [
+          "distributedTasks"
+        ],
+        "summary": "Start a near duplicates task",
+        "operationId": "distributedTasks.nearDuplicatesStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/NearDuplicatesTask"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Near duplicates task started successfully. The ID of the task is returned.",
+            "schema": {
+              "$ref": "#/definitions/NearDuplicatesTask"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Distributed tasks are disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.distributedTasks.nearDuplicatesStart"
+        ]
+      }
+    },
     "/users/db": {
       "get": {
         "tags": [
@@ -5163,6 +5227,11 @@ func init() {
                   "type": "string",
                   "format": "date-time"
                 },
+                "expiresAt": {
+                  "description": "Date and time after which the API-key of the user is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                },
                 "import": {
                   "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - import api key from static user",
                   "type": "boolean",
@@ -5410,6 +5479,204 @@ func init() {
         ]
       }
     },
+    "/users/db/{user_id}/keys": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "list the additional api keys of a user",
+        "operationId": "listUserApiKeys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Info about the api keys of the user",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DBUserApiKeyInfo"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.listApiKeys"
+        ]
+      },
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "create an additional api key for a user",
+        "operationId": "createUserApiKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "collections": {
+                  "description": "restrict the key to these collections. The key can access all collections if not set",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "expiresAt": {
+                  "description": "Date and time after which the key is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                },
+                "roles": {
+                  "description": "restrict the key to a subset of the roles of the user. The key has all roles of the user if not set",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "ApiKey successfully created",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.createApiKey"
+        ]
+      }
+    },
+    "/users/db/{user_id}/keys/{key_id}": {
+      "delete": {
+        "tags": [
+          "users"
+        ],
+        "summary": "revoke an additional api key of a user",
+        "operationId": "revokeUserApiKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "key id",
+            "name": "key_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "ApiKey successfully revoked"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user or key not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.revokeApiKey"
+        ]
+      }
+    },
     "/users/db/{user_id}/rotate-key": {
       "post": {
         "tags": [
@@ -5424,6 +5691,20 @@ func init() {
             "name": "user_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "expiresAt": {
+                  "description": "Date and time after which the new API-key is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
           }
         ],
         "responses": {
@@ -6393,6 +6674,50 @@ func init() {
         }
       }
     },
+    "DBUserApiKeyInfo": {
+      "type": "object",
+      "required": [
+        "keyId"
+      ],
+      "properties": {
+        "apiKeyFirstLetters": {
+          "description": "First 3 letters of the API-key",
+          "type": "string",
+          "maxLength": 3
+        },
+        "collections": {
+          "description": "The collections the key is restricted to. The key can access all collections if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "createdAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ) after which the key is no longer valid",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
+        },
+        "keyId": {
+          "description": "The id of the key",
+          "type": "string"
+        },
+        "roles": {
+          "description": "The roles the key is restricted to. The key has all roles of the user if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "DBUserInfo": {
       "type": "object",
       "required": [
@@ -6406,6 +6731,14 @@ func init() {
           "description": "activity status of the returned user",
           "type": "boolean"
         },
+        "apiKeyExpiresAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ) after which the API-key of the user is no longer valid",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
+        },
         "apiKeyFirstLetters": {
           "description": "First 3 letters of the associated API-key",
           "type": [
@@ -6784,6 +7117,37 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NearDuplicatesTask": {
+      "description": "Searches a collection for groups of objects with near-identical vectors on all nodes of the cluster.",
+      "type": "object",
+      "required": [
+        "collection",
+        "maxDistance"
+      ],
+      "properties": {
+        "collection": {
+          "description": "The name of the collection to search.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the task. A random ID is assigned if it is not set.",
+          "type": "string"
+        },
+        "markProperty": {
+          "description": "Optional text or uuid property which is set to the ID of the group representative on every member of a group.",
+          "type": "string"
+        },
+        "maxDistance": {
+          "description": "The maximum distance between the vectors of two objects to be considered near duplicates.",
+          "type": "number",
+          "format": "float"
+        },
+        "targetVector": {
+          "description": "The named vector to compare. Required if the collection has named vectors.",
+          "type": "string"
+        }
+      }
+    },
     "NestedProperty": {
       "type": "object",
       "properties": {
@@ -7278,11 +7642,30 @@ func init() {
               "type": "string",
               "default": "*"
             },
+            "excludedProperties": {
+              "description": "property names which are hidden from the role, nested properties are separated by dots. Only supported for read_data",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "filter": {
+              "description": "restricts the objects the permission applies to. Only supported for read_data, objects not matching the filter are hidden from the role",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
             "object": {
               "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
               "type": "string",
               "default": "*"
             },
+            "properties": {
+              "description": "property names which are visible to the role, nested properties are separated by dots. If left empty all properties are visible. Only supported for read_data",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
             "tenant": {
               "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
               "type": "string",
@@ -7418,6 +7801,31 @@ func init() {
             "type": "string"
           }
         },
+        "roles": {
+          "description": "The roles assigned to the principal by the OIDC role mapping rules, in addition to the roles assigned via RBAC",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "scopedCollections": {
+          "description": "The collections the principal is restricted to when authenticated with a scoped API key, empty means all collections",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "scopedRoles": {
+          "description": "The roles the principal is restricted to when authenticated with a scoped API key, empty means all roles of the user",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "The tenant the principal is bound to. A principal that is bound to a tenant can only access this tenant",
+          "type": "string"
+        },
         "userType": {
           "$ref": "#/definitions/UserTypeInput"
         },
@@ -7740,12 +8148,17 @@ func init() {
           "description": "The identifier of the node to which the replica is being moved or copied (the target node).",
           "type": "string"
         },
+        "targetShard": {
+          "description": "The name of the shard created by a 'SPLIT' operation.",
+          "type": "string"
+        },
         "type": {
-          "description": "Indicates whether the operation is a 'COPY' (source replica remains) or a 'MOVE' (source replica is removed after successful transfer).",
+          "description": "Indicates whether the operation is a 'COPY' (source replica remains), a 'MOVE' (source replica is removed after successful transfer) or a 'SPLIT' (half of the data of the shard is moved to a new shard).",
           "type": "string",
           "enum": [
             "COPY",
-            "MOVE"
+            "MOVE",
+            "SPLIT"
           ]
         },
         "uncancelable": {
@@ -7852,12 +8265,13 @@ func init() {
           "type": "string"
         },
         "type": {
-          "description": "Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. Defaults to 'COPY' if omitted.",
+          "description": "Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. 'SPLIT' moves half of the data of the shard to a new shard on the target node. Defaults to 'COPY' if omitted.",
           "type": "string",
           "default": "COPY",
           "enum": [
             "COPY",
-            "MOVE"
+            "MOVE",
+            "SPLIT"
           ]
         }
       }
@@ -8210,6 +8624,33 @@ func init() {
         "name": {
           "description": "The name of the tenant (required).",
           "type": "string"
+        },
+        "quotas": {
+          "$ref": "#/definitions/TenantQuotas"
+        }
+      }
+    },
+    "TenantQuotas": {
+      "description": "limits of a single tenant. A limit of 0 or an omitted limit means unlimited.",
+      "type": "object",
+      "properties": {
+        "maxBytes": {
+          "description": "Maximum size of the tenant's files on disk in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxObjects": {
+          "description": "Maximum number of objects stored in the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxVectorDimensions": {
+          "description": "Maximum number of vector dimensions stored in the tenant, summed up over all objects and all of their vectors. Requires vector dimension tracking (` + "`" + `TRACK_VECTOR_DIMENSIONS` + "`" + `) to be enabled.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
@@ -8222,6 +8663,10 @@ func init() {
         "apikey": {
           "description": "The apikey",
           "type": "string"
+        },
+        "keyId": {
+          "description": "The id of the key. Only set for additional keys of a user",
+          "type": "string"
         }
       }
     },
@@ -13715,6 +14160,70 @@ func init() {
         ]
       }
     },
+    "/tasks/near-duplicates": {
+      "post": {
+        "description": "Starts a task which searches a collection for groups of near duplicate objects on all nodes of the cluster.",
+        "tags": [
+          "distributedTasks"
+        ],
+        "summary": "Start a near duplicates task",
+        "operationId": "distributedTasks.nearDuplicatesStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/NearDuplicatesTask"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Near duplicates task started successfully. The ID of the task is returned.",
+            "schema": {
+              "$ref": "#/definitions/NearDuplicatesTask"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Distributed tasks are disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.distributedTasks.nearDuplicatesStart"
+        ]
+      }
+    },
     "/users/db": {
       "get": {
         "tags": [
@@ -13846,6 +14355,11 @@ func init() {
                   "type": "string",
                   "format": "date-time"
                 },
+                "expiresAt": {
+                  "description": "Date and time after which the API-key of the user is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                },
                 "import": {
                   "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - import api key from static user",
                   "type": "boolean",
@@ -13856,11 +14370,203 @@ func init() {
           }
         ],
         "responses": {
-          "201": {
-            "description": "User created successfully",
-            "schema": {
-              "$ref": "#/definitions/UserApiKey"
-            }
+          "201": {
+            "description": "User created successfully",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "User already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.create"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Delete User",
+        "operationId": "deleteUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.delete"
+        ]
+      }
+    },
+    "/users/db/{user_id}/activate": {
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "activate a deactivated user",
+        "operationId": "activateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully activated"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "409": {
+            "description": "user already activated"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.activateUser"
+        ]
+      }
+    },
+    "/users/db/{user_id}/deactivate": {
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "deactivate a user",
+        "operationId": "deactivateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "revoke_key": {
+                  "description": "if the key should be revoked when deactivating the user",
+                  "type": "boolean",
+                  "default": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "users successfully deactivated"
           },
           "400": {
             "description": "Malformed request.",
@@ -13878,19 +14584,13 @@ func init() {
             }
           },
           "404": {
-            "description": "user not found",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "user not found"
           },
           "409": {
-            "description": "User already exists",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "user already deactivated"
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -13903,32 +14603,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.create"
+          "weaviate.users.db.deactivateUser"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/users/db/{user_id}/keys": {
+      "get": {
         "tags": [
           "users"
         ],
-        "summary": "Delete User",
-        "operationId": "deleteUser",
+        "summary": "list the additional api keys of a user",
+        "operationId": "listUserApiKeys",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "user id",
             "name": "user_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Info about the api keys of the user",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DBUserApiKeyInfo"
+              }
             }
           },
           "401": {
@@ -13957,17 +14659,15 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.delete"
+          "weaviate.users.db.listApiKeys"
         ]
-      }
-    },
-    "/users/db/{user_id}/activate": {
+      },
       "post": {
         "tags": [
           "users"
         ],
-        "summary": "activate a deactivated user",
-        "operationId": "activateUser",
+        "summary": "create an additional api key for a user",
+        "operationId": "createUserApiKey",
         "parameters": [
           {
             "type": "string",
@@ -13975,11 +14675,42 @@ func init() {
             "name": "user_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "collections": {
+                  "description": "restrict the key to these collections. The key can access all collections if not set",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "expiresAt": {
+                  "description": "Date and time after which the key is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                },
+                "roles": {
+                  "description": "restrict the key to a subset of the roles of the user. The key has all roles of the user if not set",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "User successfully activated"
+          "201": {
+            "description": "ApiKey successfully created",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -13999,9 +14730,6 @@ func init() {
           "404": {
             "description": "user not found"
           },
-          "409": {
-            "description": "user already activated"
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
@@ -14016,17 +14744,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.activateUser"
+          "weaviate.users.db.createApiKey"
         ]
       }
     },
-    "/users/db/{user_id}/deactivate": {
-      "post": {
+    "/users/db/{user_id}/keys/{key_id}": {
+      "delete": {
         "tags": [
           "users"
         ],
-        "summary": "deactivate a user",
-        "operationId": "deactivateUser",
+        "summary": "revoke an additional api key of a user",
+        "operationId": "revokeUserApiKey",
         "parameters": [
           {
             "type": "string",
@@ -14036,29 +14764,16 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "revoke_key": {
-                  "description": "if the key should be revoked when deactivating the user",
-                  "type": "boolean",
-                  "default": false
-                }
-              }
-            }
+            "type": "string",
+            "description": "key id",
+            "name": "key_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "users successfully deactivated"
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "ApiKey successfully revoked"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -14070,13 +14785,10 @@ func init() {
             }
           },
           "404": {
-            "description": "user not found"
-          },
-          "409": {
-            "description": "user already deactivated"
+            "description": "user or key not found"
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -14089,7 +14801,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.deactivateUser"
+          "weaviate.users.db.revokeApiKey"
         ]
       }
     },
@@ -14107,6 +14819,20 @@ func init() {
             "name": "user_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "expiresAt": {
+                  "description": "Date and time after which the new API-key is no longer valid. The key never expires if not set",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
           }
         ],
         "responses": {
@@ -15231,6 +15957,50 @@ func init() {
         }
       }
     },
+    "DBUserApiKeyInfo": {
+      "type": "object",
+      "required": [
+        "keyId"
+      ],
+      "properties": {
+        "apiKeyFirstLetters": {
+          "description": "First 3 letters of the API-key",
+          "type": "string",
+          "maxLength": 3
+        },
+        "collections": {
+          "description": "The collections the key is restricted to. The key can access all collections if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "createdAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ) after which the key is no longer valid",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
+        },
+        "keyId": {
+          "description": "The id of the key",
+          "type": "string"
+        },
+        "roles": {
+          "description": "The roles the key is restricted to. The key has all roles of the user if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "DBUserInfo": {
       "type": "object",
       "required": [
@@ -15244,6 +16014,14 @@ func init() {
           "description": "activity status of the returned user",
           "type": "boolean"
         },
+        "apiKeyExpiresAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ) after which the API-key of the user is no longer valid",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
+        },
         "apiKeyFirstLetters": {
           "description": "First 3 letters of the associated API-key",
           "type": [
@@ -15643,6 +16421,37 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NearDuplicatesTask": {
+      "description": "Searches a collection for groups of objects with near-identical vectors on all nodes of the cluster.",
+      "type": "object",
+      "required": [
+        "collection",
+        "maxDistance"
+      ],
+      "properties": {
+        "collection": {
+          "description": "The name of the collection to search.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the task. A random ID is assigned if it is not set.",
+          "type": "string"
+        },
+        "markProperty": {
+          "description": "Optional text or uuid property which is set to the ID of the group representative on every member of a group.",
+          "type": "string"
+        },
+        "maxDistance": {
+          "description": "The maximum distance between the vectors of two objects to be considered near duplicates.",
+          "type": "number",
+          "format": "float"
+        },
+        "targetVector": {
+          "description": "The named vector to compare. Required if the collection has named vectors.",
+          "type": "string"
+        }
+      }
+    },
     "NestedProperty": {
       "type": "object",
       "properties": {
@@ -16154,11 +16963,30 @@ func init() {
               "type": "string",
               "default": "*"
             },
+            "excludedProperties": {
+              "description": "property names which are hidden from the role, nested properties are separated by dots. Only supported for read_data",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "filter": {
+              "description": "restricts the objects the permission applies to. Only supported for read_data, objects not matching the filter are hidden from the role",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
             "object": {
               "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
               "type": "string",
               "default": "*"
             },
+            "properties": {
+              "description": "property names which are visible to the role, nested properties are separated by dots. If left empty all properties are visible. Only supported for read_data",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
             "tenant": {
               "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
               "type": "string",
@@ -16298,11 +17126,30 @@ func init() {
           "type": "string",
           "default": "*"
         },
+        "excludedProperties": {
+          "description": "property names which are hidden from the role, nested properties are separated by dots. Only supported for read_data",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "filter": {
+          "description": "restricts the objects the permission applies to. Only supported for read_data, objects not matching the filter are hidden from the role",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
         "object": {
           "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
           "type": "string",
           "default": "*"
         },
+        "properties": {
+          "description": "property names which are visible to the role, nested properties are separated by dots. If left empty all properties are visible. Only supported for read_data",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tenant": {
           "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
           "type": "string",
@@ -16436,6 +17283,31 @@ func init() {
             "type": "string"
           }
         },
+        "roles": {
+          "description": "The roles assigned to the principal by the OIDC role mapping rules, in addition to the roles assigned via RBAC",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "scopedCollections": {
+          "description": "The collections the principal is restricted to when authenticated with a scoped API key, empty means all collections",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "scopedRoles": {
+          "description": "The roles the principal is restricted to when authenticated with a scoped API key, empty means all roles of the user",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenant": {
+          "description": "The tenant the principal is bound to. A principal that is bound to a tenant can only access this tenant",
+          "type": "string"
+        },
         "userType": {
           "$ref": "#/definitions/UserTypeInput"
         },
@@ -16758,12 +17630,17 @@ func init() {
           "description": "The identifier of the node to which the replica is being moved or copied (the target node).",
           "type": "string"
         },
+        "targetShard": {
+          "description": "The name of the shard created by a 'SPLIT' operation.",
+          "type": "string"
+        },
         "type": {
-          "description": "Indicates whether the operation is a 'COPY' (source replica remains) or a 'MOVE' (source replica is removed after successful transfer).",
+          "description": "Indicates whether the operation is a 'COPY' (source replica remains), a 'MOVE' (source replica is removed after successful transfer) or a 'SPLIT' (half of the data of the shard is moved to a new shard).",
           "type": "string",
           "enum": [
             "COPY",
-            "MOVE"
+            "MOVE",
+            "SPLIT"
           ]
         },
         "uncancelable": {
@@ -16870,12 +17747,13 @@ func init() {
           "type": "string"
         },
         "type": {
-          "description": "Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. Defaults to 'COPY' if omitted.",
+          "description": "Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. 'SPLIT' moves half of the data of the shard to a new shard on the target node. Defaults to 'COPY' if omitted.",
           "type": "string",
           "default": "COPY",
           "enum": [
             "COPY",
-            "MOVE"
+            "MOVE",
+            "SPLIT"
           ]
         }
       }
@@ -17228,6 +18106,33 @@ func init() {
         "name": {
           "description": "The name of the tenant (required).",
           "type": "string"
+        },
+        "quotas": {
+          "$ref": "#/definitions/TenantQuotas"
+        }
+      }
+    },
+    "TenantQuotas": {
+      "description": "limits of a single tenant. A limit of 0 or an omitted limit means unlimited.",
+      "type": "object",
+      "properties": {
+        "maxBytes": {
+          "description": "Maximum size of the tenant's files on disk in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxObjects": {
+          "description": "Maximum number of objects stored in the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxVectorDimensions": {
+          "description": "Maximum number of vector dimensions stored in the tenant, summed up over all objects and all of their vectors. Requires vector dimension tracking (` + "`" + `TRACK_VECTOR_DIMENSIONS` + "`" + `) to be enabled.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
@@ -17240,6 +18145,10 @@ func init() {
         "apikey": {
           "description": "The apikey",
           "type": "string"
+        },
+        "keyId": {
+          "description": "The id of the key. Only set for additional keys of a user",
+          "type": "string"
         }
       }
     },
@@ -48,6 +48,22 @@ func (h *authNHandlers) getOwnInfo(_ users.GetOwnInfoParams, principal *models.P
 		if err != nil {
 			return users.NewGetOwnInfoInternalServerError()
 		}
+		// roles that were mapped to the principal during authentication are not assigned to the user
+		var mappedRoles []string
+		for _, roleName := range principal.Roles {
+			if _, ok := existingRoles[roleName]; !ok {
+				mappedRoles = append(mappedRoles, roleName)
+			}
+		}
+		if len(mappedRoles) > 0 {
+			mapped, err := h.authzController.GetRoles(mappedRoles...)
+			if err != nil {
+				return users.NewGetOwnInfoInternalServerError()
+			}
+			for roleName, policies := range mapped {
+				existingRoles[roleName] = policies
+			}
+		}
 		for roleName, policies := range existingRoles {
 			perms, err := authzConv.PoliciesToPermission(policies...)
 			if err != nil {
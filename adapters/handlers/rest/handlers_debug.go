@@ -14,6 +14,7 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -23,9 +24,11 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/dedup"
 )
 
 func setupDebugHandlers(appState *state.State) {
@@ -741,6 +744,47 @@ func setupDebugHandlers(appState *state.State) {
 			w.Write(bytesToWrite)
 		}
 	}))
+
+	// Returns the result of a near duplicates task on the local node, e.g.
+	// curl "localhost:6060/debug/near-duplicates/result?id=<id>&version=<version>"
+	// Tasks are started with POST /v1/tasks/near-duplicates, which also lists
+	// their version. The groups of the whole cluster are only returned by the
+	// node which accepted the task, all other nodes return their pairs.
+	http.HandleFunc("/debug/near-duplicates/result", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if appState.NearDuplicates == nil {
+			http.Error(w, "distributed tasks are not enabled", http.StatusNotImplemented)
+			return
+		}
+
+		taskID := r.URL.Query().Get("id")
+		if err := dedup.ValidateTaskID(taskID); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		version, err := strconv.ParseUint(r.URL.Query().Get("version"), 10, 64)
+		if err != nil {
+			http.Error(w, "version is required and must be a number", http.StatusBadRequest)
+			return
+		}
+
+		res, err := appState.NearDuplicates.Result(distributedtask.TaskDescriptor{ID: taskID, Version: version})
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				http.Error(w, "result not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
 }
 
 type MaintenanceMode struct {
@@ -22,20 +22,26 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/dedup"
 
 	distributedtaskUC "github.com/weaviate/weaviate/usecases/distributedtask"
 )
 
-func setupDistributedTasksHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer, tasksLister distributedtask.TasksLister) {
+func setupDistributedTasksHandlers(api *operations.WeaviateAPI, authorizer authorization.Authorizer,
+	tasksLister distributedtask.TasksLister, nearDuplicates *dedup.Handler,
+) {
 	h := distributedTasksHandlers{
-		handler: distributedtaskUC.NewHandler(authorizer, tasksLister),
+		handler:        distributedtaskUC.NewHandler(authorizer, tasksLister),
+		nearDuplicates: nearDuplicates,
 	}
 
 	api.DistributedTasksDistributedTasksGetHandler = distributed_tasks.DistributedTasksGetHandlerFunc(h.getTasks)
+	api.DistributedTasksDistributedTasksNearDuplicatesStartHandler = distributed_tasks.DistributedTasksNearDuplicatesStartHandlerFunc(h.startNearDuplicates)
 }
 
 type distributedTasksHandlers struct {
-	handler *distributedtaskUC.Handler
+	handler        *distributedtaskUC.Handler
+	nearDuplicates *dedup.Handler
 }
 
 func (h *distributedTasksHandlers) getTasks(params distributed_tasks.DistributedTasksGetParams, principal *models.Principal) middleware.Responder {
@@ -50,3 +56,32 @@ func (h *distributedTasksHandlers) getTasks(params distributed_tasks.Distributed
 
 	return distributed_tasks.NewDistributedTasksGetOK().WithPayload(tasks)
 }
+
+func (h *distributedTasksHandlers) startNearDuplicates(params distributed_tasks.DistributedTasksNearDuplicatesStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	payload := dedup.Payload{
+		Collection:   *params.Body.Collection,
+		TargetVector: params.Body.TargetVector,
+		MaxDistance:  *params.Body.MaxDistance,
+		MarkProperty: params.Body.MarkProperty,
+	}
+	taskID, err := h.nearDuplicates.StartTask(params.HTTPRequest.Context(), principal, params.Body.ID, payload)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return distributed_tasks.NewDistributedTasksNearDuplicatesStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, dedup.ErrInvalidTask):
+			return distributed_tasks.NewDistributedTasksNearDuplicatesStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return distributed_tasks.NewDistributedTasksNearDuplicatesStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	res := *params.Body
+	res.ID = taskID
+	return distributed_tasks.NewDistributedTasksNearDuplicatesStartOK().WithPayload(&res)
+}
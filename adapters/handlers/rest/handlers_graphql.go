@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -121,6 +122,11 @@ func setupGraphQLHandlers(
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
 
+		if errRateLimited := rateLimitError(result); errRateLimited != nil {
+			metricRequestsTotal.logUserError()
+			return rateLimitedResponse(errRateLimited)
+		}
+
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
 		if jsonErr != nil {
@@ -336,6 +342,28 @@ func (e *graphqlRequestsTotal) getErrGraphQLUser(gqlError gqlerrors.FormattedErr
 	return false, nil
 }
 
+// rateLimitError returns the rate limit error of a GraphQL result, if a
+// resolver was rejected by a rate limit
+func rateLimitError(result *tailorincgraphql.Result) *ratelimiter.ErrRateLimited {
+	for _, gqlErr := range result.Errors {
+		err := gqlErr.OriginalError()
+		var gqlOriginalErr *gqlerrors.Error
+		if errors.As(err, &gqlOriginalErr) && gqlOriginalErr.OriginalError != nil {
+			err = gqlOriginalErr.OriginalError
+		}
+		var errGraphQLUser enterrors.ErrGraphQLUser
+		if errors.As(err, &errGraphQLUser) {
+			err = errGraphQLUser.OriginalError()
+		}
+
+		var errRateLimited *ratelimiter.ErrRateLimited
+		if errors.As(err, &errRateLimited) {
+			return errRateLimited
+		}
+	}
+	return nil
+}
+
 func (e *graphqlRequestsTotal) isSyntaxRelatedError(gqlError gqlerrors.FormattedError) bool {
 	for _, prefix := range []string{"Syntax Error ", "Cannot query field"} {
 		if strings.HasPrefix(gqlError.Message, prefix) {
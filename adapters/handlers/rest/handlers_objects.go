@@ -911,11 +911,12 @@ func getReplicationProperties(consistencyLvl, nodeName *string) (*additional.Rep
 func getConsistencyLevel(lvl *string) (string, error) {
 	if lvl != nil {
 		switch types.ConsistencyLevel(*lvl) {
-		case types.ConsistencyLevelOne, types.ConsistencyLevelQuorum, types.ConsistencyLevelAll:
+		case types.ConsistencyLevelOne, types.ConsistencyLevelQuorum, types.ConsistencyLevelAll,
+			types.ConsistencyLevelSession:
 			return *lvl, nil
 		default:
 			return "", fmt.Errorf("unrecognized consistency level '%v', "+
-				"try one of the following: ['ONE', 'QUORUM', 'ALL']", *lvl)
+				"try one of the following: ['ONE', 'QUORUM', 'ALL', 'SESSION']", *lvl)
 		}
 	}
 
@@ -13,8 +13,15 @@ package rest
 
 import (
 	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // createErrorResponseObject is a common function to create an error response
@@ -37,3 +44,16 @@ func errPayloadFromSingleErr(err error) *models.ErrorResponse {
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// rateLimitedResponse answers a request that exceeded a rate limit with 429
+// and the number of seconds after which the client can retry
+func rateLimitedResponse(err *ratelimiter.ErrRateLimited) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		retryAfter := int(math.Max(1, math.Ceil(err.RetryAfter.Seconds())))
+		rw.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		rw.WriteHeader(http.StatusTooManyRequests)
+		if err := producer.Produce(rw, errPayloadFromSingleErr(err)); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
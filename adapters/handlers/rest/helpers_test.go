@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tailorincgraphql "github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/gqlerrors"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+func TestRateLimitedResponses(t *testing.T) {
+	errRateLimited := &ratelimiter.ErrRateLimited{
+		Kind:       ratelimiter.KindQuery,
+		Scope:      "principal",
+		RetryAfter: 1500 * time.Millisecond,
+	}
+
+	t.Run("rate limit error of a resolver", func(t *testing.T) {
+		result := &tailorincgraphql.Result{Errors: gqlerrors.FormatErrors(
+			errors.New("unrelated"),
+			&gqlerrors.Error{OriginalError: enterrors.NewErrGraphQLUser(errRateLimited, "Get", "Documents")},
+		)}
+		assert.Same(t, errRateLimited, rateLimitError(result))
+	})
+
+	t.Run("no rate limit error", func(t *testing.T) {
+		result := &tailorincgraphql.Result{Errors: gqlerrors.FormatErrors(
+			&gqlerrors.Error{OriginalError: errors.New("other")},
+		)}
+		assert.Nil(t, rateLimitError(result))
+	})
+
+	t.Run("429 with retry after", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		rateLimitedResponse(errRateLimited).WriteResponse(rw, runtime.JSONProducer())
+
+		require.Equal(t, 429, rw.Code)
+		assert.Equal(t, "2", rw.Header().Get("Retry-After"))
+		assert.Contains(t, rw.Body.String(), "query rate limit for principal exceeded")
+	})
+}
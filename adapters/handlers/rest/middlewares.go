@@ -130,7 +130,11 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 		handler = addInjectHeadersIntoContext(handler)
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
+		handler = makeAddAuditOutcome(appState.AuditLogger)(handler)
 		handler = addSourceIpToContext(handler)
+		handler = addRequestIDToContext(handler)
+		handler = addSessionToContext(handler)
+		handler = addShardingKeyHintToContext(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = monitoring.InstrumentHTTP(
 				handler,
@@ -95,6 +95,10 @@ type CreateUserBody struct {
 	// Format: date-time
 	CreateTime strfmt.DateTime `json:"createTime,omitempty" yaml:"createTime,omitempty"`
 
+	// Date and time after which the API-key of the user is no longer valid. The key never expires if not set
+	// Format: date-time
+	ExpiresAt strfmt.DateTime `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+
 	// EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - import api key from static user
 	Import *bool `json:"import,omitempty" yaml:"import,omitempty"`
 }
@@ -107,6 +111,10 @@ func (o *CreateUserBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -125,6 +133,18 @@ func (o *CreateUserBody) validateCreateTime(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *CreateUserBody) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(o.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("body"+"."+"expiresAt", "body", "date-time", o.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this create user body based on context it is used
 func (o *CreateUserBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
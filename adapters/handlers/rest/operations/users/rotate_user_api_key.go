@@ -17,9 +17,14 @@ package users
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -80,3 +85,62 @@ func (o *RotateUserAPIKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
+
+// RotateUserAPIKeyBody rotate user API key body
+//
+// swagger:model RotateUserAPIKeyBody
+type RotateUserAPIKeyBody struct {
+
+	// Date and time after which the new API-key is no longer valid. The key never expires if not set
+	// Format: date-time
+	ExpiresAt strfmt.DateTime `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+}
+
+// Validate validates this rotate user API key body
+func (o *RotateUserAPIKeyBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RotateUserAPIKeyBody) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(o.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("body"+"."+"expiresAt", "body", "date-time", o.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rotate user API key body based on context it is used
+func (o *RotateUserAPIKeyBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RotateUserAPIKeyBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RotateUserAPIKeyBody) UnmarshalBinary(b []byte) error {
+	var res RotateUserAPIKeyBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
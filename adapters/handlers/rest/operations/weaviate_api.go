@@ -146,6 +146,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		UsersCreateUserHandler: users.CreateUserHandlerFunc(func(params users.CreateUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation users.CreateUser has not yet been implemented")
 		}),
+		UsersCreateUserAPIKeyHandler: users.CreateUserAPIKeyHandlerFunc(func(params users.CreateUserAPIKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation users.CreateUserAPIKey has not yet been implemented")
+		}),
 		UsersDeactivateUserHandler: users.DeactivateUserHandlerFunc(func(params users.DeactivateUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation users.DeactivateUser has not yet been implemented")
 		}),
@@ -164,6 +167,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		DistributedTasksDistributedTasksGetHandler: distributed_tasks.DistributedTasksGetHandlerFunc(func(params distributed_tasks.DistributedTasksGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation distributed_tasks.DistributedTasksGet has not yet been implemented")
 		}),
+		DistributedTasksDistributedTasksNearDuplicatesStartHandler: distributed_tasks.DistributedTasksNearDuplicatesStartHandlerFunc(func(params distributed_tasks.DistributedTasksNearDuplicatesStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation distributed_tasks.DistributedTasksNearDuplicatesStart has not yet been implemented")
+		}),
 		ReplicationForceDeleteReplicationsHandler: replication.ForceDeleteReplicationsHandlerFunc(func(params replication.ForceDeleteReplicationsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation replication.ForceDeleteReplications has not yet been implemented")
 		}),
@@ -209,6 +215,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ReplicationListReplicationHandler: replication.ListReplicationHandlerFunc(func(params replication.ListReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation replication.ListReplication has not yet been implemented")
 		}),
+		UsersListUserAPIKeysHandler: users.ListUserAPIKeysHandlerFunc(func(params users.ListUserAPIKeysParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation users.ListUserAPIKeys has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
@@ -290,6 +299,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		AuthzRevokeRoleFromUserHandler: authz.RevokeRoleFromUserHandlerFunc(func(params authz.RevokeRoleFromUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RevokeRoleFromUser has not yet been implemented")
 		}),
+		UsersRevokeUserAPIKeyHandler: users.RevokeUserAPIKeyHandlerFunc(func(params users.RevokeUserAPIKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation users.RevokeUserAPIKey has not yet been implemented")
+		}),
 		UsersRotateUserAPIKeyHandler: users.RotateUserAPIKeyHandlerFunc(func(params users.RotateUserAPIKeyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation users.RotateUserAPIKey has not yet been implemented")
 		}),
@@ -461,6 +473,8 @@ type WeaviateAPI struct {
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// UsersCreateUserHandler sets the operation handler for the create user operation
 	UsersCreateUserHandler users.CreateUserHandler
+	// UsersCreateUserAPIKeyHandler sets the operation handler for the create user Api key operation
+	UsersCreateUserAPIKeyHandler users.CreateUserAPIKeyHandler
 	// UsersDeactivateUserHandler sets the operation handler for the deactivate user operation
 	UsersDeactivateUserHandler users.DeactivateUserHandler
 	// ReplicationDeleteAllReplicationsHandler sets the operation handler for the delete all replications operation
@@ -473,6 +487,8 @@ type WeaviateAPI struct {
 	UsersDeleteUserHandler users.DeleteUserHandler
 	// DistributedTasksDistributedTasksGetHandler sets the operation handler for the distributed tasks get operation
 	DistributedTasksDistributedTasksGetHandler distributed_tasks.DistributedTasksGetHandler
+	// DistributedTasksDistributedTasksNearDuplicatesStartHandler sets the operation handler for the distributed tasks near duplicates start operation
+	DistributedTasksDistributedTasksNearDuplicatesStartHandler distributed_tasks.DistributedTasksNearDuplicatesStartHandler
 	// ReplicationForceDeleteReplicationsHandler sets the operation handler for the force delete replications operation
 	ReplicationForceDeleteReplicationsHandler replication.ForceDeleteReplicationsHandler
 	// ReplicationGetCollectionShardingStateHandler sets the operation handler for the get collection sharding state operation
@@ -503,6 +519,8 @@ type WeaviateAPI struct {
 	UsersListAllUsersHandler users.ListAllUsersHandler
 	// ReplicationListReplicationHandler sets the operation handler for the list replication operation
 	ReplicationListReplicationHandler replication.ListReplicationHandler
+	// UsersListUserAPIKeysHandler sets the operation handler for the list user Api keys operation
+	UsersListUserAPIKeysHandler users.ListUserAPIKeysHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
@@ -557,6 +575,8 @@ type WeaviateAPI struct {
 	AuthzRevokeRoleFromGroupHandler authz.RevokeRoleFromGroupHandler
 	// AuthzRevokeRoleFromUserHandler sets the operation handler for the revoke role from user operation
 	AuthzRevokeRoleFromUserHandler authz.RevokeRoleFromUserHandler
+	// UsersRevokeUserAPIKeyHandler sets the operation handler for the revoke user Api key operation
+	UsersRevokeUserAPIKeyHandler users.RevokeUserAPIKeyHandler
 	// UsersRotateUserAPIKeyHandler sets the operation handler for the rotate user Api key operation
 	UsersRotateUserAPIKeyHandler users.RotateUserAPIKeyHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
@@ -752,6 +772,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.UsersCreateUserHandler == nil {
 		unregistered = append(unregistered, "users.CreateUserHandler")
 	}
+	if o.UsersCreateUserAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.CreateUserAPIKeyHandler")
+	}
 	if o.UsersDeactivateUserHandler == nil {
 		unregistered = append(unregistered, "users.DeactivateUserHandler")
 	}
@@ -770,6 +793,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.DistributedTasksDistributedTasksGetHandler == nil {
 		unregistered = append(unregistered, "distributed_tasks.DistributedTasksGetHandler")
 	}
+	if o.DistributedTasksDistributedTasksNearDuplicatesStartHandler == nil {
+		unregistered = append(unregistered, "distributed_tasks.DistributedTasksNearDuplicatesStartHandler")
+	}
 	if o.ReplicationForceDeleteReplicationsHandler == nil {
 		unregistered = append(unregistered, "replication.ForceDeleteReplicationsHandler")
 	}
@@ -815,6 +841,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ReplicationListReplicationHandler == nil {
 		unregistered = append(unregistered, "replication.ListReplicationHandler")
 	}
+	if o.UsersListUserAPIKeysHandler == nil {
+		unregistered = append(unregistered, "users.ListUserAPIKeysHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
@@ -896,6 +925,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.AuthzRevokeRoleFromUserHandler == nil {
 		unregistered = append(unregistered, "authz.RevokeRoleFromUserHandler")
 	}
+	if o.UsersRevokeUserAPIKeyHandler == nil {
+		unregistered = append(unregistered, "users.RevokeUserAPIKeyHandler")
+	}
 	if o.UsersRotateUserAPIKeyHandler == nil {
 		unregistered = append(unregistered, "users.RotateUserAPIKeyHandler")
 	}
@@ -1153,6 +1185,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/users/db/{user_id}/keys"] = users.NewCreateUserAPIKey(o.context, o.UsersCreateUserAPIKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/users/db/{user_id}/deactivate"] = users.NewDeactivateUser(o.context, o.UsersDeactivateUserHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1177,6 +1213,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/tasks/near-duplicates"] = distributed_tasks.NewDistributedTasksNearDuplicatesStart(o.context, o.DistributedTasksDistributedTasksNearDuplicatesStartHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/replication/replicate/force-delete"] = replication.NewForceDeleteReplications(o.context, o.ReplicationForceDeleteReplicationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1237,6 +1277,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/db/{user_id}/keys"] = users.NewListUserAPIKeys(o.context, o.UsersListUserAPIKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1342,6 +1386,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/authz/users/{id}/revoke"] = authz.NewRevokeRoleFromUser(o.context, o.AuthzRevokeRoleFromUserHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/db/{user_id}/keys/{key_id}"] = users.NewRevokeUserAPIKey(o.context, o.UsersRevokeUserAPIKeyHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -118,6 +118,7 @@ func (h *replicationHandler) generateReplicationDetailsResponse(withHistory bool
 		Shard:              &response.ShardId,
 		SourceNode:         &response.SourceNodeId,
 		TargetNode:         &response.TargetNodeId,
+		TargetShard:        response.TargetShardId,
 		Uncancelable:       response.Uncancelable,
 		ScheduledForCancel: response.ScheduledForCancel,
 		ScheduledForDelete: response.ScheduledForDelete,
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/usecases/audit"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+// addRequestIDToContext attaches the request id passed by the client, or a
+// new one, to the context so that it shows up in the audit log. The id is
+// returned to the client in the response header.
+func addRequestIDToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		r = r.WithContext(audit.WithRequestID(r.Context(), requestID))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// addSessionToContext attaches the replication session passed by the client,
+// or a new one, to the context. Writes record the replicas which applied them
+// in the session and reads at the SESSION consistency level are served by
+// these replicas. The updated session token is returned in the response
+// header.
+func addSessionToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session := replica.NewSession()
+		if token := r.Header.Get(replica.SessionHeader); token != "" {
+			var err error
+			if session, err = replica.ParseSessionToken(token); err != nil {
+				http.Error(w, "invalid "+replica.SessionHeader+" header: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		r = r.WithContext(replica.ContextWithSession(r.Context(), session))
+		next.ServeHTTP(&sessionResponseWriter{ResponseWriter: w, session: session}, r)
+	})
+}
+
+// sessionResponseWriter sets the session token header before the response
+// header is written
+type sessionResponseWriter struct {
+	http.ResponseWriter
+	session     *replica.Session
+	wroteHeader bool
+}
+
+func (w *sessionResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if token := w.session.Token(); token != "" {
+			w.Header().Set(replica.SessionHeader, token)
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *sessionResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *sessionResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *sessionResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
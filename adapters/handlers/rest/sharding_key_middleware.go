@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// addShardingKeyHintToContext attaches the sharding key hint passed by the
+// client to the context. Requests by id to collections sharded by a property
+// are routed to the shard the hint points to instead of asking every shard.
+func addShardingKeyHintToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hint := r.Header.Get(sharding.KeyHintHeader); hint != "" {
+			r = r.WithContext(sharding.ContextWithKeyHint(r.Context(), hint))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
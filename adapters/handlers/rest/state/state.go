@@ -28,14 +28,18 @@ import (
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/fsm"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/mtls"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/dedup"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -55,10 +59,12 @@ type State struct {
 	AnonymousAccess  *anonymous.Client
 	APIKey           *apikey.ApiKey
 	APIKeyRemote     *apikey.RemoteApiKey
+	MTLS             *mtls.Client
 	Authorizer       authorization.Authorizer
 	AuthzController  authorization.Controller
 	AuthzSnapshotter fsm.Snapshotter
 	RBAC             *rbac.Manager
+	AuditLogger      *audit.Logger
 
 	ServerConfig          *config.WeaviateConfig
 	LDIntegration         *configRuntime.LDIntegration
@@ -91,6 +97,9 @@ type State struct {
 	InternalServer types.ClusterServer
 
 	DistributedTaskScheduler *distributedtask.Scheduler
+	NearDuplicates           *dedup.Provider
+	ReplicaRebalancer        *rebalancer.Rebalancer
+	Resharder                *rebalancer.Resharder
 	Migrator                 *db.Migrator
 }
 
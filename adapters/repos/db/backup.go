@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type BackupState struct {
@@ -232,9 +233,13 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 		return err
 	}
 
-	if desc.ShardingState, err = i.marshalShardingState(); err != nil {
+	ss := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	if desc.ShardingState, err = i.marshalShardingState(ss); err != nil {
 		return fmt.Errorf("marshal sharding state %w", err)
 	}
+	if desc.TenantKeys, err = i.tenantKeys(ss); err != nil {
+		return fmt.Errorf("tenant keys %w", err)
+	}
 	if desc.Schema, err = i.marshalSchema(); err != nil {
 		return fmt.Errorf("marshal schema %w", err)
 	}
@@ -288,8 +293,8 @@ func (i *Index) resumeMaintenanceCycles(ctx context.Context) (lastErr error) {
 	return lastErr
 }
 
-func (i *Index) marshalShardingState() ([]byte, error) {
-	b, err := i.getSchema.CopyShardingState(i.Config.ClassName.String()).JSON()
+func (i *Index) marshalShardingState(ss *sharding.State) ([]byte, error) {
+	b, err := ss.JSON()
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal sharding state")
 	}
@@ -297,6 +302,28 @@ func (i *Index) marshalShardingState() ([]byte, error) {
 	return b, nil
 }
 
+// tenantKeys returns the wrapped data keys of the tenants of ss which have
+// their own one. They are part of the backup, so that it can be restored
+// after the collection was deleted or into another cluster using the same
+// master key.
+func (i *Index) tenantKeys(ss *sharding.State) (map[string][]byte, error) {
+	var keys map[string][]byte
+	for name, physical := range ss.Physical {
+		if physical.EncryptionKeyID == "" {
+			continue
+		}
+		key, err := i.getSchema.TenantEncryptionKey(i.Config.ClassName.String(), name)
+		if err != nil {
+			return nil, err
+		}
+		if keys == nil {
+			keys = make(map[string][]byte)
+		}
+		keys[physical.EncryptionKeyID] = key
+	}
+	return keys, nil
+}
+
 func (i *Index) marshalSchema() ([]byte, error) {
 	b, err := i.getSchema.ReadOnlyClass(i.Config.ClassName.String()).MarshalBinary()
 	if err != nil {
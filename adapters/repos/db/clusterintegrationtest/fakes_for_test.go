@@ -211,6 +211,14 @@ func (f *fakeSchemaManager) OptimisticTenantStatus(_ context.Context, class stri
 	return res, nil
 }
 
+func (f *fakeSchemaManager) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	return nil
+}
+
+func (f *fakeSchemaManager) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	return nil, nil
+}
+
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
 	ss := f.shardState
 	return ss.Shard("", string(uuid))
@@ -309,6 +309,14 @@ func (f *fakeMigrationSchemaGetter) OptimisticTenantStatus(_ context.Context, cl
 	return nil, nil
 }
 
+func (f *fakeMigrationSchemaGetter) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	return nil
+}
+
+func (f *fakeMigrationSchemaGetter) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	return nil, nil
+}
+
 func (sg *fakeMigrationSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	return ""
 }
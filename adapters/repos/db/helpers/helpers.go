@@ -23,6 +23,7 @@ var (
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
+	ChangesBucketLSM           = "changes"
 )
 
 const ObjectsBucketLSMDocIDSecondaryIndex int = 0
@@ -71,3 +72,9 @@ func BucketSearchableFromPropNameLSM(propName string) string {
 func BucketRangeableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_rangeable")
 }
+
+// BucketSparseVectorFromTargetVectorLSM creates the name of the bucket which
+// holds the inverted index of a sparse target vector
+func BucketSparseVectorFromTargetVectorLSM(targetVector string) string {
+	return fmt.Sprintf("sparse_vectors_%s", targetVector)
+}
@@ -64,6 +64,7 @@ import (
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -180,6 +181,9 @@ type Index struct {
 	vectorIndexUserConfigs    map[string]schemaConfig.VectorIndexConfig
 
 	partitioningEnabled bool
+	// keyRouter routes objects by the value of a property, nil if they are
+	// routed by their id or tenant
+	keyRouter *router.KeyRouter
 
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
@@ -236,6 +240,12 @@ func (i *Index) path() string {
 	return path.Join(i.Config.RootPath, i.ID())
 }
 
+// pathHints is where the hints of hinted handoff are kept, the leading dot
+// prevents collisions with shard directories as it isn't valid in shard names
+func (i *Index) pathHints() string {
+	return path.Join(i.path(), ".hints")
+}
+
 type nodeResolver interface {
 	AllHostnames() []string
 	NodeHostname(nodeName string) (string, bool)
@@ -282,6 +292,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		vectorIndexUserConfigs:  vectorIndexUserConfigs,
 		stopwords:               sd,
 		partitioningEnabled:     shardState.PartitioningEnabled,
+		keyRouter:               newKeyRouter(cfg.ClassName.String(), shardState, sg),
 		remote:                  sharding.NewRemoteIndex(cfg.ClassName.String(), sg, nodeResolver, remoteClient),
 		metrics:                 NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
 		centralJobQueue:         jobQueueCh,
@@ -313,6 +324,13 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		return nil, fmt.Errorf("init index %q: %w", index.ID(), err)
 	}
 
+	if globalReplicationConfig != nil && globalReplicationConfig.HintedHandoff.Enabled {
+		err := index.replicator.StartHintedHandoff(index.closingCtx, index.pathHints(), globalReplicationConfig.HintedHandoff)
+		if err != nil {
+			return nil, fmt.Errorf("init index %q: %w", index.ID(), err)
+		}
+	}
+
 	if err := index.initAndStoreShards(ctx, class, shardState, promMetrics); err != nil {
 		return nil, err
 	}
@@ -619,7 +637,8 @@ func (i *Index) updateReplicationConfig(ctx context.Context, cfg *models.Replica
 	i.Config.AsyncReplicationEnabled = cfg.AsyncEnabled && i.Config.ReplicationFactor > 1 && !i.asyncReplicationGloballyDisabled()
 
 	err := i.ForEachLoadedShard(func(name string, shard ShardLike) error {
-		if err := shard.SetAsyncReplicationEnabled(ctx, i.Config.AsyncReplicationEnabled); err != nil {
+		enabled := i.Config.AsyncReplicationEnabled || i.crossClusterReplicationEnabled()
+		if err := shard.SetAsyncReplicationEnabled(ctx, enabled); err != nil {
 			return fmt.Errorf("updating async replication on shard %q: %w", name, err)
 		}
 		return nil
@@ -685,6 +704,10 @@ type IndexConfig struct {
 	HNSWFlatSearchConcurrency                    int
 	HNSWAcornFilterRatio                         float64
 	VisitedListPoolMaxSize                       int
+	EncryptionMasterKey                          *encryption.MasterKey
+	ChangeStream                                 config.ChangeStream
+	CrossClusterReplication                      config.CrossClusterReplication
+	ReadOnlyNode                                 bool
 
 	QuerySlowLogEnabled    *configRuntime.DynamicValue[bool]
 	QuerySlowLogThreshold  *configRuntime.DynamicValue[time.Duration]
@@ -701,6 +724,10 @@ func (i *Index) determineObjectShard(ctx context.Context, id strfmt.UUID, tenant
 
 func (i *Index) determineObjectShardByStatus(ctx context.Context, id strfmt.UUID, tenant string, shardsStatus map[string]string) (string, error) {
 	if tenant == "" {
+		if i.keyRouter != nil {
+			return i.keyedObjectShard(ctx, id)
+		}
+
 		uuid, err := uuid.Parse(id.String())
 		if err != nil {
 			return "", fmt.Errorf("parse uuid: %q", id.String())
@@ -747,7 +774,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 			object.Class(), i.Config.ClassName)
 	}
 
-	shardName, err := i.determineObjectShard(ctx, object.ID(), object.Object.Tenant)
+	shardName, err := i.determineShardForObject(ctx, object, nil)
 	if err != nil {
 		switch {
 		case errors.As(err, &objects.ErrMultiTenancy{}):
@@ -759,6 +786,12 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 		}
 	}
 
+	if i.keyRouter != nil && object.Object.Tenant == "" {
+		if err := i.validateKeyHint(ctx, object, shardName); err != nil {
+			return err
+		}
+	}
+
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -838,6 +871,33 @@ func (i *Index) asyncReplicationEnabled() bool {
 	return i.Config.ReplicationFactor > 1 && i.Config.AsyncReplicationEnabled && !i.asyncReplicationGloballyDisabled()
 }
 
+// followerHosts returns the addresses of the follower cluster this index is
+// replicated to, nil if cross-cluster replication is not configured for it
+func (i *Index) followerHosts() []string {
+	return i.Config.CrossClusterReplication.FollowerHosts(i.Config.ClassName.String())
+}
+
+// crossClusterReplicationEnabled returns whether the index is replicated to
+// or from another cluster. The shards then need a hashtree even if async
+// replication within the cluster is disabled.
+func (i *Index) crossClusterReplicationEnabled() bool {
+	return len(i.followerHosts()) > 0 ||
+		i.Config.CrossClusterReplication.IsFollowed(i.Config.ClassName.String())
+}
+
+func (i *Index) crossClusterReplicationFrequency() time.Duration {
+	if frequency := i.Config.CrossClusterReplication.Frequency; frequency > 0 {
+		return frequency
+	}
+	return config.DefaultCrossClusterReplicationFrequency
+}
+
+// hashtreeRequired returns whether the shards of the index need to maintain
+// a hashtree
+func (i *Index) hashtreeRequired() bool {
+	return i.asyncReplicationEnabled() || i.crossClusterReplicationEnabled()
+}
+
 // parseDateFieldsInProps checks the schema for the current class for which
 // fields are date fields, then - if they are set - parses them accordingly.
 // Works for both date and date[].
@@ -954,17 +1014,23 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 		}
 	}
 
+	shardNames := make([]string, len(objects))
 	for pos, obj := range objects {
 		if err := i.validateMultiTenancy(obj.Object.Tenant); err != nil {
 			out[pos] = err
 			continue
 		}
-		shardName, err := i.determineObjectShardByStatus(ctx, obj.ID(), obj.Object.Tenant, tenantsStatus)
+		shardNames[pos], err = i.determineShardForObject(ctx, obj, tenantsStatus)
 		if err != nil {
 			out[pos] = err
-			continue
 		}
+	}
 
+	for pos, obj := range objects {
+		if out[pos] != nil {
+			continue
+		}
+		shardName := shardNames[pos]
 		group := byShard[shardName]
 		group.objects = append(group.objects, obj)
 		group.pos = append(group.pos, pos)
@@ -1232,17 +1298,37 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		pos []int
 	}
 
+	// objects routed by a property are located all at once, objects which
+	// don't exist are left out
+	var located map[strfmt.UUID]string
+	if i.keyRouter != nil && tenant == "" {
+		var err error
+		located, _, err = i.locateObjectShards(ctx, extractIDsFromMulti(query))
+		if err != nil {
+			return nil, fmt.Errorf("determine shard: %w", err)
+		}
+	}
+
 	byShard := map[string]idsAndPos{}
 	for pos, id := range query {
-		shardName, err := i.determineObjectShard(ctx, strfmt.UUID(id.ID), tenant)
-		if err != nil {
-			switch {
-			case errors.As(err, &objects.ErrMultiTenancy{}):
-				return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
-			case errors.As(err, &authzerrors.Forbidden{}):
-				return nil, fmt.Errorf("determine shard: %w", err)
-			default:
-				return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+		var shardName string
+		if located != nil {
+			var ok bool
+			if shardName, ok = located[normalizeID(strfmt.UUID(id.ID))]; !ok {
+				continue
+			}
+		} else {
+			var err error
+			shardName, err = i.determineObjectShard(ctx, strfmt.UUID(id.ID), tenant)
+			if err != nil {
+				switch {
+				case errors.As(err, &objects.ErrMultiTenancy{}):
+					return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
+				case errors.As(err, &authzerrors.Forbidden{}):
+					return nil, fmt.Errorf("determine shard: %w", err)
+				default:
+					return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+				}
 			}
 		}
 
@@ -1322,6 +1408,12 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
+	return i.existsInShard(ctx, shardName, id, replProps)
+}
+
+func (i *Index) existsInShard(ctx context.Context, shardName string, id strfmt.UUID,
+	replProps *additional.ReplicationProperties,
+) (bool, error) {
 	var exists bool
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -1382,6 +1474,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
+	shardNames = i.keyRouter.PruneShards(shardNames, filters)
 
 	// If the request is a BM25F with no properties selected, use all possible properties
 	if keywordRanking != nil && keywordRanking.Type == "bm25" && len(keywordRanking.Properties) == 0 {
@@ -1752,6 +1845,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
+	shardNames = i.keyRouter.PruneShards(shardNames, localFilters)
 
 	if len(shardNames) == 1 && !i.Config.ForceFullReplicasSearch {
 		shard, release, err := i.GetShard(ctx, shardNames[0])
@@ -2133,6 +2227,12 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		}
 	}
 
+	if i.keyRouter != nil && tenant == "" {
+		if err := i.validateMergeShardingKey(merge, shardName); err != nil {
+			return err
+		}
+	}
+
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -2194,6 +2294,7 @@ func (i *Index) aggregate(ctx context.Context,
 	if err != nil || len(shardNames) == 0 {
 		return nil, err
 	}
+	shardNames = i.keyRouter.PruneShards(shardNames, params.Filters)
 
 	results := make([]*aggregation.Result, len(shardNames))
 	for j, shardName := range shardNames {
@@ -2583,39 +2684,15 @@ func (i *Index) findUUIDs(ctx context.Context,
 		return nil, err
 	}
 
-	className := i.Config.ClassName.String()
-
 	shardNames, err := i.targetShardNames(ctx, tenant)
 	if err != nil {
 		return nil, err
 	}
+	shardNames = i.keyRouter.PruneShards(shardNames, filters)
 
 	results := make(map[string][]strfmt.UUID)
 	for _, shardName := range shardNames {
-		var shard ShardLike
-		var release func()
-		var err error
-
-		if i.replicationEnabled() {
-			if repl == nil {
-				repl = defaultConsistency()
-			}
-
-			results[shardName], err = i.replicator.FindUUIDs(ctx, className, shardName, filters, types.ConsistencyLevel(repl.ConsistencyLevel))
-		} else {
-			shard, release, err = i.GetShard(ctx, shardName)
-			if err == nil {
-				if shard != nil {
-					func() {
-						defer release()
-						results[shardName], err = shard.FindUUIDs(ctx, filters)
-					}()
-				} else {
-					results[shardName], err = i.remote.FindUUIDs(ctx, shardName, filters)
-				}
-			}
-		}
-
+		results[shardName], err = i.findUUIDsInShard(ctx, shardName, filters, repl)
 		if err != nil {
 			return nil, fmt.Errorf("find matching doc ids in shard %q: %w", shardName, err)
 		}
@@ -2624,6 +2701,28 @@ func (i *Index) findUUIDs(ctx context.Context,
 	return results, nil
 }
 
+func (i *Index) findUUIDsInShard(ctx context.Context, shardName string,
+	filters *filters.LocalFilter, repl *additional.ReplicationProperties,
+) ([]strfmt.UUID, error) {
+	if i.replicationEnabled() {
+		if repl == nil {
+			repl = defaultConsistency()
+		}
+		return i.replicator.FindUUIDs(ctx, i.Config.ClassName.String(), shardName, filters,
+			types.ConsistencyLevel(repl.ConsistencyLevel))
+	}
+
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	if shard != nil {
+		defer release()
+		return shard.FindUUIDs(ctx, filters)
+	}
+	return i.remote.FindUUIDs(ctx, shardName, filters)
+}
+
 func (i *Index) IncomingFindUUIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
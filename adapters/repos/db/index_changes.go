@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/changes"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// changesFailoverLookback is how far before the latest change read a client
+// resumes when the replica it read a shard from no longer holds it. Sequence
+// numbers are assigned by every replica independently, so the new replica's
+// change log is read by time instead. Writes applied on the new replica more
+// than this after their coordination time may be missed in that case, all
+// others are delivered at least once.
+const changesFailoverLookback = time.Minute
+
+var ErrChangeStreamDisabled = errors.New("change stream is not enabled")
+
+// Changes returns up to limit changes of the collection after the offset. The
+// changes of a shard are in the order they were applied on the replica they
+// are read from, changes of different shards are ordered by time. For
+// multi-tenant collections only the changes of the tenant are returned.
+func (db *DB) Changes(ctx context.Context, className, tenant string, after changes.Offset, limit int,
+) ([]changes.Change, error) {
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil, fmt.Errorf("collection %q not found", className)
+	}
+	return index.Changes(ctx, tenant, after, limit)
+}
+
+func (i *Index) Changes(ctx context.Context, tenant string, after changes.Offset, limit int,
+) ([]changes.Change, error) {
+	if !i.Config.ChangeStream.Enabled {
+		return nil, ErrChangeStreamDisabled
+	}
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	state := i.shardState()
+	shardNames := state.AllPhysicalShards()
+	if tenant != "" {
+		shardName, err := i.determineObjectShard(ctx, "", tenant)
+		if err != nil {
+			return nil, err
+		}
+		shardNames = []string{shardName}
+	}
+
+	results := make([][]changes.Change, len(shardNames))
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	eg.SetLimit(_NUMCPU)
+	for j, shardName := range shardNames {
+		eg.Go(func() error {
+			res, err := i.shardChanges(ctx, state, shardName, after[shardName], limit)
+			if err != nil {
+				return fmt.Errorf("shard %s: %w", shardName, err)
+			}
+			results[j] = res
+			return nil
+		}, shardName)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return changes.Merge(limit, results...), nil
+}
+
+// shardChanges reads the changes of a shard from the replica of the offset,
+// or from another replica if it no longer holds the shard
+func (i *Index) shardChanges(ctx context.Context, state *sharding.State, shardName string,
+	offset changes.ShardOffset, limit int,
+) ([]changes.Change, error) {
+	physical, ok := state.Physical[shardName]
+	if !ok {
+		return nil, fmt.Errorf("shard %q not found", shardName)
+	}
+
+	node, seq, since := offset.Node, offset.Seq, int64(0)
+	if node == "" || !slices.Contains(physical.BelongsToNodes, node) {
+		node = i.getSchema.NodeName()
+		if !state.IsLocalShard(shardName) {
+			node = ""
+		}
+		if offset.Node != "" {
+			seq, since = 0, offset.Time-changesFailoverLookback.Milliseconds()
+		}
+	}
+
+	if node != "" && node == i.getSchema.NodeName() {
+		shard, release, err := i.getOrInitShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return shard.Changes(ctx, seq, since, limit)
+	}
+	return i.remote.GetShardChanges(ctx, node, shardName, seq, since, limit)
+}
+
+func (i *Index) IncomingGetShardChanges(ctx context.Context, shardName string,
+	after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if shard.GetStatus() == storagestate.StatusLoading {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	}
+
+	return shard.Changes(ctx, after, since, limit)
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/cluster/router"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// newKeyRouter is called from NewIndex, where the router package is shadowed
+// by the replica router
+func newKeyRouter(className string, state *sharding.State, sg schemaUC.SchemaGetter) *router.KeyRouter {
+	return router.NewKeyRouter(className, state, sg)
+}
+
+// determineShardForObject returns the shard an object is written to. Objects
+// of collections sharded by a property are routed by the value of that
+// property, all others by their id or tenant.
+func (i *Index) determineShardForObject(ctx context.Context, obj *storobj.Object,
+	tenantsStatus map[string]string,
+) (string, error) {
+	if i.keyRouter == nil || obj.Object.Tenant != "" {
+		return i.determineObjectShardByStatus(ctx, obj.ID(), obj.Object.Tenant, tenantsStatus)
+	}
+	return i.keyRouter.ShardForObject(obj.Object.Properties)
+}
+
+// keyedObjectShard returns the shard of the object with the given id in a
+// collection sharded by a property. Requests by id are routed by the sharding
+// key hint of the request, see sharding.ContextWithKeyHint. Without a hint
+// the object has to be located by asking every shard.
+func (i *Index) keyedObjectShard(ctx context.Context, id strfmt.UUID) (string, error) {
+	if hint, ok := sharding.KeyHintFromContext(ctx); ok {
+		return i.keyRouter.ShardForHint(hint)
+	}
+	return i.locateObjectShard(ctx, id)
+}
+
+// locateObjectShard finds the shard holding the object with the given id in
+// a collection sharded by a property. If no shard has it, the first shard is
+// returned and the caller ends up reporting the object as missing.
+func (i *Index) locateObjectShard(ctx context.Context, id strfmt.UUID) (string, error) {
+	located, shardNames, err := i.locateObjectShards(ctx, []strfmt.UUID{id})
+	if err != nil {
+		return "", err
+	}
+	if shardName, ok := located[normalizeID(id)]; ok {
+		return shardName, nil
+	}
+	return shardNames[0], nil
+}
+
+// locateObjectShards finds the shards holding the objects with the given ids
+// in a collection sharded by a property. The ids alone don't tell where the
+// objects live, so every shard is asked, but only once for all ids and all
+// shards in parallel. Objects which don't exist are missing from the result.
+// The shards that were asked are returned as well.
+func (i *Index) locateObjectShards(ctx context.Context, ids []strfmt.UUID,
+) (map[strfmt.UUID]string, []string, error) {
+	shardNames := i.getSchema.CopyShardingState(i.Config.ClassName.String()).AllPhysicalShards()
+	if len(shardNames) == 0 {
+		return nil, nil, fmt.Errorf("no shards found for class %q", i.Config.ClassName)
+	}
+
+	filter := idsFilter(i.Config.ClassName, ids)
+	located := make(map[strfmt.UUID]string, len(ids))
+	var mu sync.Mutex
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	eg.SetLimit(_NUMCPU)
+	for _, shardName := range shardNames {
+		eg.Go(func() error {
+			found, err := i.findUUIDsInShard(ctx, shardName, filter, nil)
+			if err != nil {
+				return fmt.Errorf("locate objects in shard %q: %w", shardName, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, id := range found {
+				located[normalizeID(id)] = shardName
+			}
+			return nil
+		}, shardName)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return located, shardNames, nil
+}
+
+// idsFilter matches the objects with the given ids
+func idsFilter(className schema.ClassName, ids []strfmt.UUID) *filters.LocalFilter {
+	operands := make([]filters.Clause, len(ids))
+	for pos, id := range ids {
+		operands[pos] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: className, Property: filters.InternalPropID},
+			Value:    &filters.Value{Value: id.String(), Type: schema.DataTypeText},
+		}
+	}
+	if len(operands) == 1 {
+		return &filters.LocalFilter{Root: &operands[0]}
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorOr, Operands: operands}}
+}
+
+func normalizeID(id strfmt.UUID) strfmt.UUID {
+	return strfmt.UUID(strings.ToLower(id.String()))
+}
+
+// validateKeyHint makes sure an object written by id is stored in the shard
+// the sharding key hint of the request points to, if there is one. A
+// different shard means the sharding key of the object was changed, moving
+// objects between shards is not supported, they need to be deleted and
+// re-created instead.
+func (i *Index) validateKeyHint(ctx context.Context, obj *storobj.Object, shardName string) error {
+	hint, ok := sharding.KeyHintFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	hinted, err := i.keyRouter.ShardForHint(hint)
+	if err != nil {
+		return objects.NewErrInvalidUserInput("%v", err)
+	}
+	if hinted != shardName {
+		return objects.NewErrInvalidUserInput("the value of sharding key %q of object %s "+
+			"cannot be changed, delete and re-create the object instead", i.keyRouter.Key(), obj.ID())
+	}
+	return nil
+}
+
+// validateMergeShardingKey makes sure a merge does not remove or change the
+// sharding key of an object stored in shardName.
+func (i *Index) validateMergeShardingKey(merge objects.MergeDocument, shardName string) error {
+	key := i.keyRouter.Key()
+	if slices.Contains(merge.PropertiesToDelete, key) {
+		return objects.NewErrInvalidUserInput("sharding key %q cannot be deleted", key)
+	}
+
+	value, ok := merge.PrimitiveSchema[key]
+	if !ok {
+		return nil
+	}
+	target, err := i.keyRouter.ShardForValue(value)
+	if err != nil {
+		return objects.NewErrInvalidUserInput("%v", err)
+	}
+	if target != shardName {
+		return objects.NewErrInvalidUserInput("the value of sharding key %q of object %s "+
+			"cannot be changed, delete and re-create the object instead", key, merge.ID)
+	}
+	return nil
+}
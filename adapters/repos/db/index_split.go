@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/usecases/integrity"
+)
+
+// splitCleanupBatchSize is the number of objects read at once while looking
+// for objects a shard no longer owns after a split
+const splitCleanupBatchSize = 1000
+
+// splitShard is called once shard has been split into shard and targetShard.
+// The files of the local replicas of the target shard are a copy of the source
+// shard, so right after the split both hold every object of the source shard.
+// The objects which belong to the other shard are dropped by the split
+// operation through IncomingDropUnownedObjects, until then searches may find
+// those objects in both shards.
+func (i *Index) splitShard(ctx context.Context, shard, targetShard string) error {
+	state := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	if state == nil {
+		return fmt.Errorf("could not find sharding state of collection %s", i.Config.ClassName)
+	}
+
+	if phys := state.Physical[targetShard]; phys.IsLocalShard(i.getSchema.NodeName()) {
+		if err := i.LoadLocalShard(ctx, targetShard, false); err != nil {
+			return fmt.Errorf("load shard %s: %w", targetShard, err)
+		}
+	}
+	return nil
+}
+
+// IncomingSplitShardReplica copies the local replica of shardName into the
+// folder of the local replica of targetShard, the shard split off shardName.
+// Files which are already up to date are not copied again, so that a copy
+// made while shardName was writable only needs to be topped up once it is
+// read-only.
+func (i *Index) IncomingSplitShardReplica(ctx context.Context, shardName, targetShard string) error {
+	if err := i.IncomingPauseFileActivity(ctx, shardName); err != nil {
+		return err
+	}
+	defer func() {
+		if err := i.IncomingResumeFileActivity(context.Background(), shardName); err != nil {
+			i.logger.WithField("shard", shardName).WithError(err).
+				Error("resume file activity after split copy")
+		}
+	}()
+
+	relativeFilePaths, err := i.IncomingListFiles(ctx, shardName)
+	if err != nil {
+		return err
+	}
+
+	sourcePrefix := path.Join(i.ID(), shardName) + "/"
+	targetPath := shardPath(i.path(), targetShard)
+	copied := make(map[string]struct{}, len(relativeFilePaths))
+	for _, relativeFilePath := range relativeFilePaths {
+		rest, ok := strings.CutPrefix(relativeFilePath, sourcePrefix)
+		if !ok {
+			return fmt.Errorf("file %q is not part of the folder of shard %s", relativeFilePath, shardName)
+		}
+		localFilePath := filepath.Join(targetPath, rest)
+		copied[localFilePath] = struct{}{}
+		if err := i.copySplitFile(ctx, shardName, relativeFilePath, localFilePath); err != nil {
+			return err
+		}
+	}
+
+	// remove what is left over from a previous copy, e.g. compacted segments
+	return filepath.WalkDir(targetPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if _, ok := copied[p]; ok {
+			return nil
+		}
+		return os.Remove(p)
+	})
+}
+
+// copySplitFile copies the file at relativeFilePath of shardName to
+// localFilePath unless its checksum matches already
+func (i *Index) copySplitFile(ctx context.Context, shardName, relativeFilePath, localFilePath string) error {
+	md, err := i.IncomingGetFileMetadata(ctx, shardName, relativeFilePath)
+	if err != nil {
+		return err
+	}
+	if _, checksum, err := integrity.CRC32(localFilePath); err == nil && checksum == md.CRC32 {
+		return nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	reader, err := i.IncomingGetFile(ctx, shardName, relativeFilePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(localFilePath), os.ModePerm); err != nil {
+		return fmt.Errorf("create parent folder for %s: %w", localFilePath, err)
+	}
+	f, err := os.Create(localFilePath + ".tmp")
+	if err != nil {
+		return fmt.Errorf("open file %q for writing: %w", localFilePath, err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return fmt.Errorf("copy file %q: %w", relativeFilePath, err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("fsync file %q: %w", localFilePath, err)
+	}
+	return os.Rename(localFilePath+".tmp", localFilePath)
+}
+
+// IncomingDropUnownedObjects deletes the objects of the local replica of
+// shardName which are owned by a different shard after a split. It is
+// idempotent, so that a split operation interrupted while cleaning up can
+// simply call it again.
+func (i *Index) IncomingDropUnownedObjects(ctx context.Context, shardName string) error {
+	dropped, err := i.dropUnownedObjects(ctx, shardName)
+	if err != nil {
+		return fmt.Errorf("drop objects not owned by shard %s: %w", shardName, err)
+	}
+	i.logger.WithFields(logrus.Fields{
+		"action":  "split_shard_cleanup",
+		"class":   i.Config.ClassName,
+		"shard":   shardName,
+		"dropped": dropped,
+	}).Info("dropped objects owned by other shard")
+	return nil
+}
+
+// dropUnownedObjects deletes all objects of the local shard shardName which
+// are routed to a different shard according to the current sharding state.
+// It returns the number of objects deleted.
+func (i *Index) dropUnownedObjects(ctx context.Context, shardName string) (int, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	dropped := 0
+	cursor := &filters.Cursor{Limit: splitCleanupBatchSize}
+	for {
+		objs, err := shard.ObjectList(ctx, splitCleanupBatchSize, nil, cursor,
+			additional.Properties{}, i.Config.ClassName)
+		if err != nil {
+			return dropped, fmt.Errorf("list objects: %w", err)
+		}
+		if len(objs) == 0 {
+			return dropped, nil
+		}
+
+		var ids []strfmt.UUID
+		for _, obj := range objs {
+			owner, err := i.determineShardForObject(ctx, obj, nil)
+			if err != nil {
+				return dropped, fmt.Errorf("determine shard of object %s: %w", obj.ID(), err)
+			}
+			if owner != shardName {
+				ids = append(ids, obj.ID())
+			}
+		}
+		cursor.After = objs[len(objs)-1].ID().String()
+
+		if len(ids) == 0 {
+			continue
+		}
+		for _, res := range shard.DeleteObjectBatch(ctx, ids, time.Now(), false) {
+			if res.Err != nil {
+				return dropped, fmt.Errorf("delete object %s: %w", res.UUID, res.Err)
+			}
+			dropped++
+		}
+	}
+}
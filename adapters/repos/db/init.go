@@ -129,6 +129,10 @@ func (db *DB) init(ctx context.Context) error {
 				HNSWFlatSearchConcurrency:                    db.config.HNSWFlatSearchConcurrency,
 				HNSWAcornFilterRatio:                         db.config.HNSWAcornFilterRatio,
 				VisitedListPoolMaxSize:                       db.config.VisitedListPoolMaxSize,
+				EncryptionMasterKey:                          db.config.EncryptionMasterKey,
+				ChangeStream:                                 db.config.ChangeStream,
+				CrossClusterReplication:                      db.config.CrossClusterReplication,
+				ReadOnlyNode:                                 db.config.ReadOnlyNode,
 				QuerySlowLogEnabled:                          db.config.QuerySlowLogEnabled,
 				QuerySlowLogThreshold:                        db.config.QuerySlowLogThreshold,
 				InvertedSorterDisabled:                       db.config.InvertedSorterDisabled,
@@ -98,13 +98,20 @@ func (r *refFilterExtractor) paramsForNestedRequest() (dto.GetParams, error) {
 }
 
 func (r *refFilterExtractor) innerFilter() *filters.LocalFilter {
-	return &filters.LocalFilter{
-		Root: &filters.Clause{
-			Operator: r.filter.Operator,
-			On:       r.filter.On.Child,
-			Value:    r.filter.Value,
-		},
+	inner := filters.Clause{
+		Operator: r.filter.Operator,
+		On:       r.filter.On.Child,
+		Value:    r.filter.Value,
+	}
+	if restriction := r.filter.On.Child.Restriction; restriction != nil {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{inner, *restriction},
+			},
+		}
 	}
+	return &filters.LocalFilter{Root: &inner}
 }
 
 type classUUIDPair struct {
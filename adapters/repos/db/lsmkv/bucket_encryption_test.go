@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/encryption"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestEncryptedBucket(t *testing.T) {
+	tests := bucketTests{
+		{
+			name: "encryptedBucketRoundTrip",
+			f:    encryptedBucketRoundTrip,
+			opts: []BucketOption{WithStrategy(StrategyReplace)},
+		},
+		{
+			name: "encryptedBucketWithoutMemory",
+			f:    encryptedBucketWithoutMemory,
+			opts: []BucketOption{WithStrategy(StrategyReplace)},
+		},
+		{
+			name: "encryptedRoaringSetBucketWithoutMemory",
+			f:    encryptedRoaringSetBucketWithoutMemory,
+			opts: []BucketOption{WithStrategy(StrategyRoaringSet)},
+		},
+	}
+	tests.run(context.Background(), t)
+}
+
+func encryptedBucketRoundTrip(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	encryption.RegisterDataKey(dirName, encryption.GenerateDataKey())
+	defer encryption.UnregisterDataKey(dirName)
+	logger, _ := test.NewNullLogger()
+
+	value := []byte("a value which must not be stored in plaintext")
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+
+	require.Nil(t, b.Put([]byte("flushed"), value))
+	require.Nil(t, b.FlushMemtable())
+	// stays in the WAL only
+	require.Nil(t, b.Put([]byte("unflushed"), value))
+	require.Nil(t, b.WriteWAL())
+
+	err = filepath.WalkDir(dirName, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		require.Nil(t, err)
+		assert.False(t, bytes.Contains(contents, value), "plaintext found in %s", path)
+		return nil
+	})
+	require.Nil(t, err)
+	require.Nil(t, b.Shutdown(ctx))
+
+	b2, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b2.Shutdown(ctx)
+
+	for _, key := range []string{"flushed", "unflushed"} {
+		got, err := b2.Get([]byte(key))
+		require.Nil(t, err)
+		assert.Equal(t, value, got, key)
+	}
+}
+
+func encryptedBucketWithoutMemory(ctx context.Context, t *testing.T, opts []BucketOption) {
+	logger, _ := test.NewNullLogger()
+	newBucket := func(dirName string, opts ...BucketOption) (*Bucket, error) {
+		return NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	}
+	writeSegment := func(dirName string, keys ...string) {
+		b, err := newBucket(dirName, opts...)
+		require.Nil(t, err)
+		for _, key := range keys {
+			require.Nil(t, b.Put([]byte(key), []byte("value-"+key)))
+		}
+		require.Nil(t, b.FlushMemtable())
+		require.Nil(t, b.Shutdown(ctx))
+	}
+	withoutMemory := append(opts, WithAllocChecker(outOfMemory{}))
+
+	t.Run("encrypted segments are read through the block cache without memory", func(t *testing.T) {
+		dirName := t.TempDir()
+		encryption.RegisterDataKey(dirName, encryption.GenerateDataKey())
+		defer encryption.UnregisterDataKey(dirName)
+
+		// enough keys for the segments to span several encrypted blocks
+		var keys []string
+		for i := 0; i < 2000; i++ {
+			keys = append(keys, fmt.Sprintf("key-%05d", i))
+		}
+		writeSegment(dirName, keys[:1000]...)
+		writeSegment(dirName, keys[1000:]...)
+
+		b, err := newBucket(dirName, withoutMemory...)
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		assertKeys := func(t *testing.T) {
+			for _, key := range keys {
+				got, err := b.Get([]byte(key))
+				require.Nil(t, err)
+				require.Equal(t, []byte("value-"+key), got)
+			}
+
+			c := b.Cursor()
+			defer c.Close()
+			i := 0
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				require.Equal(t, keys[i], string(k))
+				require.Equal(t, []byte("value-"+keys[i]), v)
+				i++
+			}
+			assert.Equal(t, len(keys), i)
+		}
+
+		t.Run("before compaction", assertKeys)
+
+		// compactions are skipped under memory pressure regardless of encryption,
+		// without an alloc checker the compacted segment is paged in as well
+		b.disk.allocChecker = nil
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+
+		t.Run("after compaction", assertKeys)
+	})
+
+	t.Run("plaintext segments are mmapped instead", func(t *testing.T) {
+		dirName := t.TempDir()
+		writeSegment(dirName, "key")
+
+		b, err := newBucket(dirName, withoutMemory...)
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+		got, err := b.Get([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value-key"), got)
+	})
+}
+
+func encryptedRoaringSetBucketWithoutMemory(ctx context.Context, t *testing.T, opts []BucketOption) {
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+	encryption.RegisterDataKey(dirName, encryption.GenerateDataKey())
+	defer encryption.UnregisterDataKey(dirName)
+	newBucket := func(opts ...BucketOption) (*Bucket, error) {
+		return NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	}
+
+	for segment := uint64(0); segment < 2; segment++ {
+		b, err := newBucket(opts...)
+		require.Nil(t, err)
+		for i := 0; i < 1000; i++ {
+			require.Nil(t, b.RoaringSetAddOne([]byte(fmt.Sprintf("key-%04d", i)), segment))
+		}
+		require.Nil(t, b.FlushMemtable())
+		require.Nil(t, b.Shutdown(ctx))
+	}
+
+	b, err := newBucket(append(opts, WithAllocChecker(outOfMemory{}))...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	assertSets := func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			bm, err := b.RoaringSetGet([]byte(fmt.Sprintf("key-%04d", i)))
+			require.Nil(t, err)
+			require.ElementsMatch(t, []uint64{0, 1}, bm.ToArray())
+		}
+
+		c := b.CursorRoaringSet()
+		defer c.Close()
+		i := 0
+		for k, bm := c.First(); k != nil; k, bm = c.Next() {
+			require.Equal(t, fmt.Sprintf("key-%04d", i), string(k))
+			require.ElementsMatch(t, []uint64{0, 1}, bm.ToArray())
+			i++
+		}
+		assert.Equal(t, 1000, i)
+	}
+
+	t.Run("before compaction", assertSets)
+
+	// compactions are skipped under memory pressure regardless of encryption,
+	// without an alloc checker the compacted segment is paged in as well
+	b.disk.allocChecker = nil
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+
+	t.Run("after compaction", assertSets)
+}
+
+type outOfMemory struct{}
+
+func (outOfMemory) CheckAlloc(int64) error                  { return memwatch.ErrNotEnoughMemory }
+func (outOfMemory) CheckMappingAndReserve(int64, int) error { return nil }
+func (outOfMemory) Refresh(bool)                            {}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// CreateSparseTerms returns the posting lists of keys in all memtables and
+// segments of an inverted bucket, where each posting is scored by its
+// frequency multiplied by the weight of its key. This turns
+// DoBlockMaxWand into a top-k dot product search, which is used for sparse
+// vectors.
+//
+// Unlike CreateDiskTerm, all terms are returned in a single list, as the
+// postings of a document are spread over memtables and segments when it was
+// updated. Postings hidden by a newer memtable or segment are skipped using
+// its tombstones, so the scores of all terms of a document add up to the dot
+// product of its latest version.
+//
+// The returned release func must be called once the terms are not used
+// anymore.
+func (b *Bucket) CreateSparseTerms(filterDocIds helpers.AllowList, keys [][]byte, weights []float64,
+) (Terms, func(), error) {
+	if b.strategy != StrategyInverted {
+		return nil, func() {}, fmt.Errorf("sparse terms are only supported for strategy %q", StrategyInverted)
+	}
+	if len(keys) != len(weights) {
+		return nil, func() {}, fmt.Errorf("got %d keys but %d weights", len(keys), len(weights))
+	}
+
+	release := func() {}
+
+	defer func() {
+		if !entcfg.Enabled(os.Getenv("DISABLE_RECOVERY_ON_PANIC")) {
+			if r := recover(); r != nil {
+				b.logger.Errorf("Recovered from panic in CreateSparseTerms: %v", r)
+				debug.PrintStack()
+				release()
+			}
+		}
+	}()
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	// The segments are read from during the search, which is run outside of
+	// this function, so the lock is handed over to the caller.
+	segmentsDisk, release := b.disk.getAndLockSegments()
+
+	output := make(Terms, 0, len(keys)*(len(segmentsDisk)+2))
+	// tombstones of all memtables and segments newer than the current one
+	tombstones := sroar.NewBitmap()
+
+	for _, memtable := range []*Memtable{b.active, b.flushing} {
+		if memtable == nil {
+			continue
+		}
+
+		for i, key := range keys {
+			term := NewSegmentBlockMaxDecoded(key, i, 1, filterDocIds, 1, schema.BM25Config{})
+			if _, err := fillTerm(memtable, key, term, filterDocIds); err != nil {
+				release()
+				return nil, func() {}, err
+			}
+			if term.Exhausted() {
+				continue
+			}
+
+			if !tombstones.IsEmpty() {
+				term.tombstones = tombstones.Clone()
+			}
+			term.advanceOnTombstoneOrFilter()
+			if term.Exhausted() {
+				continue
+			}
+			term.setLinearScoring(weights[i])
+			output = append(output, term)
+		}
+
+		memTombstones, err := memtable.ReadOnlyTombstones()
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			release()
+			return nil, func() {}, err
+		}
+		if memTombstones != nil {
+			tombstones.Or(memTombstones)
+		}
+	}
+
+	for j := len(segmentsDisk) - 1; j >= 0; j-- {
+		segment := segmentsDisk[j]
+
+		// the segment only holds on to the tombstones, so they need to be
+		// copied before adding those of older segments
+		segmentTombstones := tombstones.Clone()
+		for i, key := range keys {
+			term := NewSegmentBlockMax(segment.getSegment(), key, i, 0, 1, segmentTombstones,
+				filterDocIds, 1, schema.BM25Config{})
+			if term == nil || term.Exhausted() {
+				continue
+			}
+			term.setLinearScoring(weights[i])
+			output = append(output, term)
+		}
+
+		if j > 0 {
+			segTombstones, err := segment.ReadOnlyTombstones()
+			if err != nil {
+				release()
+				return nil, func() {}, err
+			}
+			tombstones.Or(segTombstones)
+		}
+	}
+
+	return output, release, nil
+}
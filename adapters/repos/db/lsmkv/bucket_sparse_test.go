@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketCreateSparseTerms(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyInverted))
+	require.Nil(t, err)
+	t.Cleanup(func() { require.Nil(t, b.Shutdown(ctx)) })
+
+	docKey := func(docID uint64) []byte {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, docID)
+		return key
+	}
+	// expected holds the current frequency of every key per doc
+	expected := map[string]map[uint64]float32{"a": {}, "b": {}, "c": {}}
+	put := func(key string, docID uint64, tf float32) {
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(tf))
+		binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(1))
+		require.Nil(t, b.MapSet([]byte(key), MapPair{Key: docKey(docID), Value: value}))
+		expected[key][docID] = tf
+	}
+	del := func(docID uint64) {
+		for key := range expected {
+			require.Nil(t, b.MapDeleteKey([]byte(key), docKey(docID)))
+			delete(expected[key], docID)
+		}
+	}
+
+	// spread the postings over two segments and the memtable, with enough
+	// documents to span multiple blocks
+	for docID := uint64(0); docID < 300; docID++ {
+		put("a", docID, float32(docID%17+1))
+		if docID%3 == 0 {
+			put("b", docID, float32(docID%5+1))
+		}
+	}
+	put("c", 7, 40)
+	require.Nil(t, b.FlushAndSwitch())
+
+	// update docs while preserving their doc ids, the postings in the older
+	// segment have to be hidden
+	for _, docID := range []uint64{3, 150, 299} {
+		del(docID)
+		put("a", docID, 30)
+	}
+	del(7)
+	require.Nil(t, b.FlushAndSwitch())
+
+	del(150)
+	put("b", 150, 25)
+	del(12)
+	put("b", 301, 9)
+
+	weights := map[string]float64{"a": 1, "b": 2, "c": 3}
+	search := func(t *testing.T, limit int, filter helpers.AllowList) map[uint64]float64 {
+		keys := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+		terms, release, err := b.CreateSparseTerms(filter, keys, []float64{weights["a"], weights["b"], weights["c"]})
+		require.Nil(t, err)
+		defer release()
+
+		heap := DoBlockMaxWand(limit, terms, 1, false, len(keys), 0)
+		results := map[uint64]float64{}
+		for heap.Len() > 0 {
+			item := heap.Pop()
+			results[item.ID] = float64(item.Dist)
+		}
+		return results
+	}
+	scores := func(filter func(uint64) bool) map[uint64]float64 {
+		out := map[uint64]float64{}
+		for key, docs := range expected {
+			for docID, tf := range docs {
+				if filter == nil || filter(docID) {
+					out[docID] += float64(tf) * weights[key]
+				}
+			}
+		}
+		return out
+	}
+
+	t.Run("all results", func(t *testing.T) {
+		assert.Equal(t, scores(nil), search(t, 1000, nil))
+	})
+
+	t.Run("top k", func(t *testing.T) {
+		// doc 150 was updated in the memtable, docs 3 and 299 in the second
+		// segment, all other docs score below 30
+		results := search(t, 3, nil)
+		assert.Equal(t, map[uint64]float64{150: 2 * 25, 3: 30, 299: 30}, results)
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		allow := helpers.NewAllowList(3, 4, 5, 7, 301)
+		expected := scores(func(docID uint64) bool { return allow.Contains(docID) })
+		assert.Equal(t, expected, search(t, 1000, allow))
+	})
+}
@@ -18,18 +18,17 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
-	"io/fs"
 	"os"
 	"sync"
 	"sync/atomic"
 
-	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/usecases/byteops"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/integrity"
 )
 
@@ -175,7 +174,7 @@ func (cl *lazyCommitLogger) sync() error {
 }
 
 type commitLogger struct {
-	file   *os.File
+	file   encryption.File
 	writer *bufio.Writer
 	n      atomic.Int64
 	path   string
@@ -251,20 +250,19 @@ func newCommitLogger(path, strategy string) (*commitLogger, error) {
 		path: walPath(path),
 	}
 
-	fileInfo, err := os.Stat(out.path)
+	f, err := encryption.OpenFile(out.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o666)
 	if err != nil {
-		if !errors.Is(err, fs.ErrNotExist) {
-			return nil, err
-		}
-		// empty wal
-	} else {
-		out.n.Swap(fileInfo.Size())
+		return nil, err
 	}
 
-	f, err := os.OpenFile(out.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o666)
+	// stat the opened file rather than the path, an encrypted wal is larger
+	// on disk than what was written to it
+	fileInfo, err := f.Stat()
 	if err != nil {
+		f.Close()
 		return nil, err
 	}
+	out.n.Swap(fileInfo.Size())
 
 	observeWrite := monitoring.GetMetrics().FileIOWrites.With(prometheus.Labels{
 		"strategy":  strategy,
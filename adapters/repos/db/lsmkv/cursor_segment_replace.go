@@ -164,8 +164,8 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 
 	s.currOffset = node.Start
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End},
-		s.segment.contents[node.Start:node.End])
+	offset := nodeOffset{start: node.Start, end: node.End}
+	err = s.parseReplaceNodeInto(offset, s.segment.contentsAt(offset))
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -185,8 +185,8 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 	s.currOffset = nextOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	offset := nodeOffset{start: s.currOffset}
+	err = s.parseReplaceNodeInto(offset, s.segment.contentsAt(offset))
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -202,8 +202,8 @@ func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 
 	s.currOffset = firstOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	offset := nodeOffset{start: s.currOffset}
+	err = s.parseReplaceNodeInto(offset, s.segment.contentsAt(offset))
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -12,11 +12,20 @@
 package lsmkv
 
 import (
+	"io"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 )
 
 func (s *segment) newRoaringSetCursor() *roaringset.SegmentCursor {
+	if !s.hasContents() {
+		size := s.dataEndPos - s.dataStartPos
+		return roaringset.NewSegmentCursorReader(
+			io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(size)),
+			size, &roaringSetSeeker{s.index})
+	}
+
 	return roaringset.NewSegmentCursor(s.contents[s.dataStartPos:s.dataEndPos],
 		&roaringSetSeeker{s.index})
 }
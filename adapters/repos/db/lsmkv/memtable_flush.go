@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func (m *Memtable) flushWAL() error {
@@ -73,7 +74,7 @@ func (m *Memtable) flush() (rerr error) {
 
 	tmpSegmentPath := m.path + ".db.tmp"
 
-	f, err := os.OpenFile(tmpSegmentPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	f, err := encryption.OpenFile(tmpSegmentPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
 	if err != nil {
 		return err
 	}
@@ -81,6 +81,11 @@ func DoBlockMaxWand(limit int, results Terms, averagePropLength float64, additio
 				score := 0.0
 				termsMatched := 0
 				for _, term := range results {
+					// terms exhausted by the shallow advance above are not
+					// sorted to the end yet
+					if term.exhausted {
+						continue
+					}
 					if term.idPointer != pivotID {
 						break
 					}
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/mmap"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -110,7 +111,7 @@ type segment struct {
 	dataStartPos        uint64
 	dataEndPos          uint64
 	contents            []byte
-	contentFile         *os.File
+	contentFile         encryption.File
 	strategy            segmentindex.Strategy
 	index               diskIndex
 	secondaryIndices    []diskIndex
@@ -155,6 +156,33 @@ type diskIndex interface {
 	QuantileKeys(q int) [][]byte
 }
 
+// segmentRange returns the part of a segment from start to end. It is sliced
+// from contents if the segment is read from memory or mmapped. Otherwise, i.e.
+// for encrypted segments, it is read from the file, which decrypts the blocks
+// the part is made of.
+func segmentRange(contents []byte, file encryption.File, start, end uint64) ([]byte, error) {
+	if contents != nil {
+		return contents[start:end], nil
+	}
+
+	buf := make([]byte, end-start)
+	if _, err := file.ReadAt(buf, int64(start)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// readSegmentContents reads a segment of a known size into a single buffer,
+// sparing the reallocations of io.ReadAll which would at least double the
+// peak memory of large (decrypted) segments.
+func readSegmentContents(r io.Reader, size int64) ([]byte, error) {
+	contents := make([]byte, size)
+	if _, err := io.ReadFull(r, contents); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
 type segmentConfig struct {
 	mmapContents             bool
 	useBloomFilter           bool
@@ -184,7 +212,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		rerr = fmt.Errorf("unexpected error loading segment %q: %v", path, p)
 	}()
 
-	file, err := os.Open(path)
+	file, err := encryption.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("open file: %w", err)
 	}
@@ -226,8 +254,14 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	useBloomFilter := cfg.useBloomFilter
 	readFromMemory := cfg.mmapContents
-	if size > cfg.MinMMapSize || cfg.allocChecker == nil || allocCheckerErr != nil { // mmap the file if it's too large or if we have memory pressure
-		contents2, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
+	mapContents := size > cfg.MinMMapSize || cfg.allocChecker == nil || allocCheckerErr != nil
+	if mapContents && encryption.IsEncrypted(file) {
+		// encrypted segments can't be mmapped. Their blocks are decrypted on
+		// demand through the block cache of the file instead, only the indexes
+		// are kept in memory, see segmentRange.
+		readFromMemory = false
+	} else if mapContents { // mmap the file if it's too large or if we have memory pressure
+		contents2, err := mmap.MapRegion(file.(*os.File), int(fileInfo.Size()), mmap.RDONLY, 0, 0)
 		if err != nil {
 			return nil, fmt.Errorf("mmap file: %w", err)
 		}
@@ -236,7 +270,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	} else { // read the file into memory if it's small enough and we have enough memory
 		meteredF := diskio.NewMeteredReader(file, diskio.MeteredReaderCallback(metrics.ReadObserver("readSegmentFile")))
 		bufio.NewReader(meteredF)
-		contents, err = io.ReadAll(meteredF)
+		contents, err = readSegmentContents(meteredF, size)
 		if err != nil {
 			return nil, fmt.Errorf("read file: %w", err)
 		}
@@ -244,7 +278,11 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		readFromMemory = true
 		useBloomFilter = false
 	}
-	header, err := segmentindex.ParseHeader(contents[:segmentindex.HeaderSize])
+	headerBytes, err := segmentRange(contents, file, 0, segmentindex.HeaderSize)
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	header, err := segmentindex.ParseHeader(headerBytes)
 	if err != nil {
 		return nil, fmt.Errorf("parse header: %w", err)
 	}
@@ -261,7 +299,11 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		}
 	}
 
-	primaryIndex, err := header.PrimaryIndex(contents)
+	indexes, err := segmentRange(contents, file, header.IndexStart, uint64(size))
+	if err != nil {
+		return nil, fmt.Errorf("read indexes: %w", err)
+	}
+	primaryIndex, err := header.PrimaryIndexOfIndexes(indexes)
 	if err != nil {
 		return nil, fmt.Errorf("extract primary index position: %w", err)
 	}
@@ -273,7 +315,12 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	var invertedHeader *segmentindex.HeaderInverted
 	if header.Strategy == segmentindex.StrategyInverted {
-		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize : segmentindex.HeaderSize+segmentindex.HeaderInvertedSize])
+		invertedHeaderBytes, err := segmentRange(contents, file, segmentindex.HeaderSize,
+			segmentindex.HeaderSize+segmentindex.HeaderInvertedSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "read inverted header")
+		}
+		invertedHeader, err = segmentindex.LoadHeaderInverted(invertedHeaderBytes)
 		if err != nil {
 			return nil, errors.Wrap(err, "load inverted header")
 		}
@@ -331,7 +378,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	if seg.secondaryIndexCount > 0 {
 		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
 		for i := range seg.secondaryIndices {
-			secondary, err := header.SecondaryIndex(contents, uint16(i))
+			secondary, err := header.SecondaryIndexOfIndexes(indexes, uint16(i))
 			if err != nil {
 				return nil, fmt.Errorf("get position for secondary index at %d: %w", i, err)
 			}
@@ -577,6 +624,25 @@ func (s *segment) newNodeReader(offset nodeOffset, operation string) (*nodeReade
 	return &nodeReader{r: r}, nil
 }
 
+// hasContents tells whether the contents of the segment are read from memory
+// or mmapped. Otherwise, i.e. for large encrypted segments, everything but the
+// indexes is read through contentFile.
+func (s *segment) hasContents() bool {
+	return s.contents != nil
+}
+
+// contentsAt returns the contents of the segment at offset if it is read from
+// memory, nil otherwise
+func (s *segment) contentsAt(offset nodeOffset) []byte {
+	if !s.readFromMemory {
+		return nil
+	}
+	if offset.end == 0 {
+		return s.contents[offset.start:]
+	}
+	return s.contents[offset.start:offset.end]
+}
+
 func (s *segment) copyNode(b []byte, offset nodeOffset) error {
 	if s.readFromMemory {
 		copy(b, s.contents[offset.start:offset.end])
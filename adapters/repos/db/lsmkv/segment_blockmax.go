@@ -161,6 +161,11 @@ type SegmentBlockMax struct {
 	blockDatasTest []*terms.BlockData
 
 	sectionReader *io.SectionReader
+
+	// linear scoring is used for sparse vectors, where the frequencies are
+	// weights and the score is the frequency multiplied by queryWeight
+	linear      bool
+	queryWeight float64
 }
 
 func generateSingleFilter(tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) (*sroar.Bitmap, *sroar.Bitmap) {
@@ -524,7 +529,6 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 
 	freq := float64(s.blockDataDecoded.Tfs[s.blockDataIdx])
 	propLength := s.propLengths[s.idPointer]
-	tf := freq / (freq + s.k1*((1-s.b)+s.b*(float64(propLength)/s.averagePropLength)))
 	s.Metrics.DocCountScored++
 	if s.blockEntryIdx != s.Metrics.LastAddedBlock {
 		s.Metrics.BlockCountDecodedFreqs++
@@ -539,6 +543,11 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 			PropLength: float32(propLength),
 		}
 	}
+	if s.linear {
+		return s.idPointer, freq * s.queryWeight, doc
+	}
+
+	tf := freq / (freq + s.k1*((1-s.b)+s.b*(float64(propLength)/s.averagePropLength)))
 	score := tf * s.idf * s.propertyBoost
 	return s.idPointer, score, doc
 }
@@ -574,6 +583,14 @@ func (s *SegmentBlockMax) computeCurrentBlockImpact() float32 {
 	if len(s.blockEntries) == 0 {
 		return float32(s.idf)
 	}
+	if s.linear {
+		// the idf holds the max score of the whole term, which is exact for
+		// fully decoded terms
+		if s.fullyDecoded() {
+			return float32(s.idf)
+		}
+		return float32(float64(s.blockEntries[s.blockEntryIdx].MaxImpactTf) * s.queryWeight)
+	}
 	freq := float64(s.blockEntries[s.blockEntryIdx].MaxImpactTf)
 	propLength := float64(s.blockEntries[s.blockEntryIdx].MaxImpactPropLength)
 	return float32(s.idf * (freq / (freq + s.k1*(1-s.b+s.b*(propLength/s.averagePropLength)))) * s.propertyBoost)
@@ -599,3 +616,45 @@ func (s *SegmentBlockMax) SetIdf(idf float64) {
 	s.idf = idf
 	s.currentBlockImpact = s.computeCurrentBlockImpact()
 }
+
+// fullyDecoded is true for terms whose postings are all held in memory, i.e.
+// memtable terms and posting lists short enough to be stored unencoded
+func (s *SegmentBlockMax) fullyDecoded() bool {
+	return s.docCount <= uint64(terms.ENCODE_AS_FULL_BYTES) ||
+		(s.segment == nil && s.blockDatasTest == nil)
+}
+
+// setLinearScoring switches the term from BM25 to scoring each document by
+// its frequency multiplied by weight. As the block-max WAND search uses the
+// idf as upper bound of the whole term, it is set to the max frequency of the
+// term multiplied by weight.
+func (s *SegmentBlockMax) setLinearScoring(weight float64) {
+	s.linear = true
+	s.queryWeight = weight
+	if s.exhausted {
+		return
+	}
+
+	var maxTf uint64
+	if s.fullyDecoded() {
+		tfs := s.blockDataDecoded.Tfs
+		if uint64(len(tfs)) > s.docCount {
+			tfs = tfs[:s.docCount]
+		}
+		for _, tf := range tfs {
+			if tf > maxTf {
+				maxTf = tf
+			}
+		}
+		if len(s.blockEntries) > 0 {
+			s.currentBlockMaxId = s.blockEntries[len(s.blockEntries)-1].MaxId
+		}
+	} else {
+		for _, entry := range s.blockEntries {
+			if uint64(entry.MaxImpactTf) > maxTf {
+				maxTf = uint64(entry.MaxImpactTf)
+			}
+		}
+	}
+	s.SetIdf(float64(maxTf) * weight)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func (s *segment) bloomFilterPath() string {
@@ -329,7 +330,7 @@ func writeWithChecksum(bufWriter byteops.ReadWriter, path string, observeFileWri
 	chksm := crc32.ChecksumIEEE(bufWriter.Buffer[byteops.Uint32Len:])
 	bufWriter.MoveBufferToAbsolutePosition(0)
 	bufWriter.WriteUint32(chksm)
-	f, err := os.Create(path)
+	f, err := encryption.Create(path)
 	if err != nil {
 		return fmt.Errorf("open file for writing: %w", err)
 	}
@@ -354,7 +355,7 @@ func writeWithChecksum(bufWriter byteops.ReadWriter, path string, observeFileWri
 // use negative length check to indicate that no length check should be
 // performed
 func loadWithChecksum(path string, lengthCheck int, observeFileReader BytesReadObserver) ([]byte, error) {
-	f, err := os.Open(path)
+	f, err := encryption.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -14,7 +14,6 @@ package lsmkv
 import (
 	"encoding/binary"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -22,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -490,7 +490,7 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		}
 	}()
 
-	file, err := os.Create(tmpSegmentPath)
+	file, err := encryption.Create(tmpSegmentPath)
 	if err != nil {
 		return false, err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringsetrange"
 	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 // findCompactionCandidates looks for pair of segments eligible for compaction
@@ -226,7 +227,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 
 	path := filepath.Join(sg.dir, "segment-"+segmentID(leftSegment.path)+"_"+segmentID(rightSegment.path)+".db.tmp")
 
-	f, err := os.Create(path)
+	f, err := encryption.Create(path)
 	if err != nil {
 		return false, err
 	}
@@ -13,6 +13,9 @@ package lsmkv
 
 import (
 	"encoding/binary"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 // bufferedKeyAndTombstoneExtractor is a tool to build up the count stats for
@@ -157,3 +160,57 @@ func (e *bufferedKeyAndTombstoneExtractor) flushAndCallback() {
 
 	e.callbackCycle++
 }
+
+// scanKeysAndTombstones is the counterpart of the
+// bufferedKeyAndTombstoneExtractor for segments which are neither in memory
+// nor mmapped. It reads the nodes one after the other at their offsets, so
+// that the values which are skipped don't need to be read at all.
+func (s *segment) scanKeysAndTombstones(callback keyAndTombstoneCallbackFn) error {
+	r := diskio.NewMeteredReader(s.contentFile,
+		diskio.MeteredReaderCallback(s.metrics.ReadObserver("scanKeysAndTombstones")))
+	readAt := func(b []byte, offset uint64) error {
+		if _, err := r.ReadAt(b, int64(offset)); err != nil {
+			return fmt.Errorf("read node at %d: %w", offset, err)
+		}
+		return nil
+	}
+
+	var (
+		head [9]byte // tombstone and value length
+		key  []byte
+	)
+	for offset := s.dataStartPos; offset < s.dataEndPos; {
+		if err := readAt(head[:], offset); err != nil {
+			return err
+		}
+		tombstone := head[0] == 0x01
+		valueLen := binary.LittleEndian.Uint64(head[1:])
+		offset += 9 + valueLen
+
+		if err := readAt(head[:4], offset); err != nil {
+			return err
+		}
+		primaryKeyLen := uint64(binary.LittleEndian.Uint32(head[:4]))
+		offset += 4
+		// the key is only valid during the callback, like the output buffer
+		// of the extractor
+		if uint64(cap(key)) < primaryKeyLen {
+			key = make([]byte, primaryKeyLen)
+		}
+		key = key[:primaryKeyLen]
+		if err := readAt(key, offset); err != nil {
+			return err
+		}
+		offset += primaryKeyLen
+
+		for i := uint16(0); i < s.secondaryIndexCount; i++ {
+			if err := readAt(head[:4], offset); err != nil {
+				return err
+			}
+			offset += 4 + uint64(binary.LittleEndian.Uint32(head[:4]))
+		}
+
+		callback(key, tombstone)
+	}
+	return nil
+}
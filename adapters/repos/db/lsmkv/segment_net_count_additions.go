@@ -96,10 +96,14 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.hasContents() {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	} else if err := s.scanKeysAndTombstones(cb); err != nil {
+		return fmt.Errorf("scan keys and tombstones: %w", err)
+	}
 
 	s.countNetAdditions = countNet
 
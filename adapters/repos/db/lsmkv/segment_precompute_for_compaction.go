@@ -23,6 +23,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/mmap"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -50,7 +51,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		return nil, fmt.Errorf("pre computing a segment expects a .tmp segment path")
 	}
 
-	file, err := os.Open(path)
+	file, err := encryption.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("open file: %w", err)
 	}
@@ -82,13 +83,16 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}
 	}
 
-	if size > minMMapSize || allocChecker == nil || allocCheckerErr != nil { // mmap the file if it's too large or if we have memory pressure
+	// encrypted segments can't be mmapped, their blocks are decrypted on
+	// demand instead, see segmentRange
+	mapContents := size > minMMapSize || allocChecker == nil || allocCheckerErr != nil
+	if mapContents && !encryption.IsEncrypted(file) { // mmap the file if it's too large or if we have memory pressure
 		monitoring.GetMetrics().MmapOperations.With(prometheus.Labels{
 			"operation": "mmap-compaction",
 			"strategy":  stratLabel,
 		}).Inc()
 
-		contents2, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
+		contents2, err := mmap.MapRegion(file.(*os.File), int(fileInfo.Size()), mmap.RDONLY, 0, 0)
 		if err != nil {
 			return nil, fmt.Errorf("mmap file: %w", err)
 		}
@@ -100,17 +104,21 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}).Inc()
 
 		defer contents2.Unmap()
-	} else { // read the file into memory if it's small enough and we have enough memory
+	} else if !mapContents { // read the file into memory if it's small enough and we have enough memory
 		meteredF := diskio.NewMeteredReader(file, diskio.MeteredReaderCallback(metrics.ReadObserver("readSegmentFileCompaction")))
 
-		contents, err = io.ReadAll(meteredF)
+		contents, err = readSegmentContents(meteredF, size)
 		if err != nil {
 			return nil, fmt.Errorf("read file: %w", err)
 		}
 		useBloomFilter = false // we don't read bloom filters if we are below the MMAP threshold so there is no point in precomputing them
 	}
 
-	header, err := segmentindex.ParseHeader(contents[:segmentindex.HeaderSize])
+	headerBytes, err := segmentRange(contents, file, 0, segmentindex.HeaderSize)
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	header, err := segmentindex.ParseHeader(headerBytes)
 	if err != nil {
 		return nil, fmt.Errorf("parse header: %w", err)
 	}
@@ -127,7 +135,11 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}
 	}
 
-	primaryIndex, err := header.PrimaryIndex(contents)
+	indexes, err := segmentRange(contents, file, header.IndexStart, uint64(size))
+	if err != nil {
+		return nil, fmt.Errorf("read indexes: %w", err)
+	}
+	primaryIndex, err := header.PrimaryIndexOfIndexes(indexes)
 	if err != nil {
 		return nil, fmt.Errorf("extract primary index position: %w", err)
 	}
@@ -139,7 +151,12 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 
 	var invertedHeader *segmentindex.HeaderInverted
 	if header.Strategy == segmentindex.StrategyInverted {
-		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize : segmentindex.HeaderSize+segmentindex.HeaderInvertedSize])
+		invertedHeaderBytes, err := segmentRange(contents, file, segmentindex.HeaderSize,
+			segmentindex.HeaderSize+segmentindex.HeaderInvertedSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "read inverted header")
+		}
+		invertedHeader, err = segmentindex.LoadHeaderInverted(invertedHeaderBytes)
 		if err != nil {
 			return nil, errors.Wrap(err, "load inverted header")
 		}
@@ -182,7 +199,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	if seg.secondaryIndexCount > 0 {
 		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
 		for i := range seg.secondaryIndices {
-			secondary, err := header.SecondaryIndex(contents, uint16(i))
+			secondary, err := header.SecondaryIndexOfIndexes(indexes, uint16(i))
 			if err != nil {
 				return nil, errors.Wrapf(err, "get position for secondary index at %d", i)
 			}
@@ -62,19 +62,32 @@ func (h *Header) WriteTo(w io.Writer) (int64, error) {
 }
 
 func (h *Header) PrimaryIndex(source []byte) ([]byte, error) {
+	return h.primaryIndex(source, 0)
+}
+
+// PrimaryIndexOfIndexes is like PrimaryIndex, but indexes only holds the
+// segment starting at IndexStart, e.g. if the segment isn't read into memory
+// as a whole.
+func (h *Header) PrimaryIndexOfIndexes(indexes []byte) ([]byte, error) {
+	return h.primaryIndex(indexes, h.IndexStart)
+}
+
+// primaryIndex returns the primary index of source, which holds the segment
+// starting at base
+func (h *Header) primaryIndex(source []byte, base uint64) ([]byte, error) {
 	if h.SecondaryIndices == 0 {
-		return source[h.IndexStart:], nil
+		return source[h.IndexStart-base:], nil
 	}
 
 	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.IndexStart:h.secondaryIndexOffsetsEnd()])
+		source[h.IndexStart-base : h.secondaryIndexOffsetsEnd()-base])
 	if err != nil {
 		return nil, err
 	}
 
 	// the beginning of the first secondary is also the end of the primary
 	end := offsets[0]
-	return source[h.secondaryIndexOffsetsEnd():end], nil
+	return source[h.secondaryIndexOffsetsEnd()-base : end-base], nil
 }
 
 func (h *Header) secondaryIndexOffsetsEnd() uint64 {
@@ -93,24 +106,36 @@ func (h *Header) parseSecondaryIndexOffsets(source []byte) ([]uint64, error) {
 }
 
 func (h *Header) SecondaryIndex(source []byte, indexID uint16) ([]byte, error) {
+	return h.secondaryIndex(source, 0, indexID)
+}
+
+// SecondaryIndexOfIndexes is like SecondaryIndex, but indexes only holds the
+// segment starting at IndexStart, see PrimaryIndexOfIndexes
+func (h *Header) SecondaryIndexOfIndexes(indexes []byte, indexID uint16) ([]byte, error) {
+	return h.secondaryIndex(indexes, h.IndexStart, indexID)
+}
+
+// secondaryIndex returns a secondary index of source, which holds the
+// segment starting at base
+func (h *Header) secondaryIndex(source []byte, base uint64, indexID uint16) ([]byte, error) {
 	if indexID >= h.SecondaryIndices {
 		return nil, fmt.Errorf("retrieve index %d with len %d",
 			indexID, h.SecondaryIndices)
 	}
 
 	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.IndexStart:h.secondaryIndexOffsetsEnd()])
+		source[h.IndexStart-base : h.secondaryIndexOffsetsEnd()-base])
 	if err != nil {
 		return nil, err
 	}
 
-	start := offsets[indexID]
+	start := offsets[indexID] - base
 	if indexID == h.SecondaryIndices-1 {
 		// this is the last index, return until EOF
 		return source[start:], nil
 	}
 
-	end := offsets[indexID+1]
+	end := offsets[indexID+1] - base
 	return source[start:end], nil
 }
 
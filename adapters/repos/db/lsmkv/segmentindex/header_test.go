@@ -12,12 +12,44 @@
 package segmentindex
 
 import (
+	"encoding/binary"
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func TestHeaderIndexesOfIndexes(t *testing.T) {
+	header := Header{SecondaryIndices: 2, IndexStart: 20}
+	source := make([]byte, 60)
+	for i := range source {
+		source[i] = byte(i)
+	}
+	// the primary index ends where the first secondary one starts
+	binary.LittleEndian.PutUint64(source[20:28], 40)
+	binary.LittleEndian.PutUint64(source[28:36], 50)
+	indexes := source[header.IndexStart:]
+
+	primary, err := header.PrimaryIndexOfIndexes(indexes)
+	require.NoError(t, err)
+	expected, err := header.PrimaryIndex(source)
+	require.NoError(t, err)
+	assert.Equal(t, source[36:40], primary)
+	assert.Equal(t, expected, primary)
+
+	for i := uint16(0); i < header.SecondaryIndices; i++ {
+		secondary, err := header.SecondaryIndexOfIndexes(indexes, i)
+		require.NoError(t, err)
+		expected, err := header.SecondaryIndex(source, i)
+		require.NoError(t, err)
+		assert.Equal(t, expected, secondary)
+	}
+	secondary, err := header.SecondaryIndexOfIndexes(indexes, 1)
+	require.NoError(t, err)
+	assert.Equal(t, source[50:], secondary)
+}
+
 func BenchmarkParseHeader(b *testing.B) {
 	data := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 	require.Len(b, data, HeaderSize)
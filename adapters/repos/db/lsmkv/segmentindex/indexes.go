@@ -23,6 +23,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/encryption"
 
 	"github.com/pkg/errors"
 )
@@ -77,7 +78,7 @@ func (s *Indexes) WriteTo(w io.Writer) (int64, error) {
 	}
 
 	primaryFileName := filepath.Join(s.ScratchSpacePath, "primary")
-	primaryFD, err := os.Create(primaryFileName)
+	primaryFD, err := encryption.Create(primaryFileName)
 	if err != nil {
 		return written, err
 	}
@@ -104,7 +105,7 @@ func (s *Indexes) WriteTo(w io.Writer) (int64, error) {
 		uint64(s.SecondaryIndexCount)*8
 
 	secondaryFileName := filepath.Join(s.ScratchSpacePath, "secondary")
-	secondaryFD, err := os.Create(secondaryFileName)
+	secondaryFD, err := encryption.Create(secondaryFileName)
 	if err != nil {
 		return written, err
 	}
@@ -38,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	esync "github.com/weaviate/weaviate/entities/sync"
 	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -156,6 +157,10 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			HNSWFlatSearchConcurrency:                    m.db.config.HNSWFlatSearchConcurrency,
 			HNSWAcornFilterRatio:                         m.db.config.HNSWAcornFilterRatio,
 			VisitedListPoolMaxSize:                       m.db.config.VisitedListPoolMaxSize,
+			EncryptionMasterKey:                          m.db.config.EncryptionMasterKey,
+			ChangeStream:                                 m.db.config.ChangeStream,
+			CrossClusterReplication:                      m.db.config.CrossClusterReplication,
+			ReadOnlyNode:                                 m.db.config.ReadOnlyNode,
 			QuerySlowLogEnabled:                          m.db.config.QuerySlowLogEnabled,
 			QuerySlowLogThreshold:                        m.db.config.QuerySlowLogThreshold,
 			InvertedSorterDisabled:                       m.db.config.InvertedSorterDisabled,
@@ -221,6 +226,14 @@ func (m *Migrator) DropShard(ctx context.Context, class, shard string) error {
 	return idx.dropShards([]string{shard})
 }
 
+func (m *Migrator) SplitShard(ctx context.Context, class, shard, targetShard string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.splitShard(ctx, shard, targetShard)
+}
+
 func (m *Migrator) ShutdownShard(ctx context.Context, class, shard string) error {
 	idx := m.db.GetIndex(schema.ClassName(class))
 	if idx == nil {
@@ -731,6 +744,8 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeSPARSE:
+		return sparse.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("invalid index type: %s", old.IndexType())
 }
@@ -287,6 +287,7 @@ func TestListAndGetFilesWithIntegrityChecking(t *testing.T) {
 	mockSchemaGetter.On("ReadOnlyClass", "TestClass").Return(class).Maybe()
 
 	mockSchemaGetter.On("ShardOwner", "TestClass", "shard1").Return("node1", nil)
+	mockSchemaGetter.On("TenantQuotas", "TestClass", "shard1").Return(nil).Maybe()
 
 	logger := logrus.New()
 	scheduler := queue.NewScheduler(queue.SchedulerOptions{
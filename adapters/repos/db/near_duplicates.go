@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// NearDuplicates calls fn for every pair of objects of the class whose vectors
+// for targetVector are at most maxDist apart.
+//
+// Only shards for which the local node is the first replica are scanned, so
+// that running this on every node of the cluster covers every shard exactly
+// once. For multi-tenant classes the tenant of both objects is passed to fn,
+// objects of different tenants are never compared. For single-tenant classes
+// every scanned shard is also searched against all shards sorting after it,
+// no matter which node they live on, so that pairs spanning two shards are
+// found exactly once as well.
+func (db *DB) NearDuplicates(ctx context.Context, className, targetVector string,
+	maxDist float32, fn func(tenant string, a, b strfmt.UUID, dist float32) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("index for class %s not found locally", className)
+	}
+
+	return idx.nearDuplicates(ctx, targetVector, maxDist, fn)
+}
+
+func (i *Index) nearDuplicates(ctx context.Context, targetVector string,
+	maxDist float32, fn func(tenant string, a, b strfmt.UUID, dist float32) error,
+) error {
+	shardNames, err := i.locallyOwnedShardNames()
+	if err != nil {
+		return err
+	}
+
+	for _, shardName := range shardNames {
+		tenant := ""
+		if i.partitioningEnabled {
+			tenant = shardName
+		}
+
+		err := i.withLocalShard(ctx, shardName, func(shard ShardLike) error {
+			return shard.NearDuplicates(ctx, targetVector, maxDist, func(a, b strfmt.UUID, dist float32) error {
+				return fn(tenant, a, b, dist)
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("near duplicates in shard %q: %w", shardName, err)
+		}
+	}
+
+	if i.partitioningEnabled {
+		return nil
+	}
+
+	allShardNames := i.shardState().AllPhysicalShards()
+	sort.Strings(allShardNames)
+	for _, source := range shardNames {
+		for _, target := range allShardNames {
+			if target <= source {
+				continue
+			}
+			if err := i.nearDuplicatesAcrossShards(ctx, source, target, targetVector, maxDist, fn); err != nil {
+				return fmt.Errorf("near duplicates across shards %q and %q: %w", source, target, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// nearDuplicatesAcrossShards searches the target shard with every vector of
+// the local source shard. Unlike pairs within a shard, these can not be taken
+// from the neighbor lists of the vector index. The target shard is searched
+// remotely if it is not loaded on this node.
+func (i *Index) nearDuplicatesAcrossShards(ctx context.Context, source, target, targetVector string,
+	maxDist float32, fn func(tenant string, a, b strfmt.UUID, dist float32) error,
+) error {
+	targetShard, release, err := i.GetShard(ctx, target)
+	if err != nil {
+		return err
+	}
+	if targetShard != nil {
+		defer release()
+	}
+
+	return i.withLocalShard(ctx, source, func(sourceShard ShardLike) error {
+		return sourceShard.ForEachVector(ctx, targetVector, func(id strfmt.UUID, vector []float32) error {
+			var (
+				objs  []*storobj.Object
+				dists []float32
+				err   error
+			)
+			if targetShard != nil {
+				objs, dists, err = targetShard.ObjectVectorSearch(ctx, []models.Vector{vector},
+					[]string{targetVector}, maxDist, -1, nil, nil, nil, additional.Properties{}, nil, nil)
+			} else {
+				objs, dists, _, err = i.remote.SearchShard(ctx, target, []models.Vector{vector},
+					[]string{targetVector}, maxDist, -1, nil, nil, nil, nil, nil,
+					additional.Properties{}, i.replicationEnabled(), nil, nil)
+			}
+			if err != nil {
+				return fmt.Errorf("search near duplicates of %s: %w", id, err)
+			}
+			for k, obj := range objs {
+				if err := fn("", id, obj.ID(), dists[k]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// locallyOwnedShardNames returns the sorted names of all local shards for
+// which this node is the first replica.
+func (i *Index) locallyOwnedShardNames() ([]string, error) {
+	className := i.Config.ClassName.String()
+	localNode := i.getSchema.NodeName()
+
+	var names []string
+	err := i.ForEachShard(func(name string, _ ShardLike) error {
+		replicas, err := i.getSchema.ShardReplicas(className, name)
+		if err != nil {
+			return fmt.Errorf("replicas of shard %q: %w", name, err)
+		}
+		if len(replicas) > 0 && replicas[0] == localNode {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+func (i *Index) withLocalShard(ctx context.Context, shardName string, fn func(shard ShardLike) error) error {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard == nil {
+		return fmt.Errorf("shard %q is not loaded locally", shardName)
+	}
+	defer release()
+
+	return fn(shard)
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIndex_NearDuplicates(t *testing.T) {
+	vectors := [][]float32{
+		{1, 0, 0},
+		{1, 0.001, 0}, // duplicate of 0
+		{0, 1, 0},
+		{0, 0, 1},
+		{0, 0.001, 1}, // duplicate of 3
+	}
+
+	for _, tt := range []struct {
+		name      string
+		indexType string
+		config    schemaConfig.VectorIndexConfig
+	}{
+		{name: "hnsw neighbor lists", indexType: "hnsw", config: enthnsw.NewDefaultUserConfig()},
+		{name: "flat search by distance", indexType: "flat", config: flat.NewDefaultUserConfig()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			className := "NearDuplicatesTest"
+			shard, idx := testShardWithSettings(t, ctx,
+				&models.Class{Class: className, VectorIndexType: tt.indexType}, tt.config, false, false)
+			defer func() { require.Nil(t, idx.drop()) }()
+
+			objs := make([]*storobj.Object, len(vectors))
+			for i, vec := range vectors {
+				objs[i] = testObject(className)
+				objs[i].Vector = vec
+			}
+			for _, err := range shard.PutObjectBatch(ctx, objs) {
+				require.Nil(t, err)
+			}
+
+			var pairs [][2]strfmt.UUID
+			err := idx.nearDuplicates(ctx, "", 0.01, func(tenant string, a, b strfmt.UUID, dist float32) error {
+				assert.Empty(t, tenant)
+				if b < a {
+					a, b = b, a
+				}
+				pairs = append(pairs, [2]strfmt.UUID{a, b})
+				return nil
+			})
+			require.Nil(t, err)
+
+			expected := [][2]strfmt.UUID{}
+			for _, p := range [][2]int{{0, 1}, {3, 4}} {
+				a, b := objs[p[0]].ID(), objs[p[1]].ID()
+				if b < a {
+					a, b = b, a
+				}
+				expected = append(expected, [2]strfmt.UUID{a, b})
+			}
+			assert.ElementsMatch(t, expected, pairs)
+		})
+	}
+}
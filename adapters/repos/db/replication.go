@@ -670,7 +670,11 @@ func (idx *Index) OverwriteObjects(ctx context.Context,
 			continue
 		}
 
-		err = s.PutObject(ctx, storobj.FromObject(incomingObj, u.Vector, u.Vectors, u.MultiVectors))
+		obj := storobj.FromObject(incomingObj, u.Vector, u.Vectors, u.MultiVectors)
+		if len(u.SparseVectors) > 0 {
+			obj.SparseVectors = u.SparseVectors
+		}
+		err = s.PutObject(ctx, obj)
 		if err != nil {
 			r := types.RepairResponse{
 				ID:  id.String(),
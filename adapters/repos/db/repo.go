@@ -40,6 +40,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -245,6 +246,14 @@ type Config struct {
 	HNSWAcornFilterRatio                         float64
 	VisitedListPoolMaxSize                       int
 
+	// EncryptionMasterKey enables encryption at rest, nil if disabled
+	EncryptionMasterKey *encryption.MasterKey
+
+	ChangeStream            config.ChangeStream
+	CrossClusterReplication config.CrossClusterReplication
+	// ReadOnlyNode is set if this node only hosts read-only replicas
+	ReadOnlyNode bool
+
 	TenantActivityReadLogLevel  *configRuntime.DynamicValue[string]
 	TenantActivityWriteLogLevel *configRuntime.DynamicValue[string]
 	QuerySlowLogEnabled         *configRuntime.DynamicValue[bool]
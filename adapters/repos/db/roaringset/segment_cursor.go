@@ -12,6 +12,10 @@
 package roaringset
 
 import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 )
 
@@ -26,6 +30,10 @@ type SegmentCursor struct {
 	index      Seeker
 	data       []byte
 	nextOffset uint64
+
+	// reader and size replace data for segments which aren't in memory
+	reader io.ReaderAt
+	size   uint64
 }
 
 // NewSegmentCursor creates a cursor for a single disk segment. Make sure that
@@ -40,12 +48,30 @@ func NewSegmentCursor(data []byte, index Seeker) *SegmentCursor {
 	return &SegmentCursor{index: index, data: data, nextOffset: 0}
 }
 
+// NewSegmentCursorReader is like [NewSegmentCursor], but reads the payload of
+// the given size from r, e.g. for segments which are neither in memory nor
+// mmapped. Each node is read into its own buffer.
+func NewSegmentCursorReader(r io.ReaderAt, size uint64, index Seeker) *SegmentCursor {
+	return &SegmentCursor{index: index, reader: r, size: size, nextOffset: 0}
+}
+
 func (c *SegmentCursor) Next() ([]byte, BitmapLayer, error) {
-	if c.nextOffset >= uint64(len(c.data)) {
-		return nil, BitmapLayer{}, nil
+	var sn *SegmentNode
+	if c.reader != nil {
+		if c.nextOffset >= c.size {
+			return nil, BitmapLayer{}, nil
+		}
+		node, err := c.readNode()
+		if err != nil {
+			return nil, BitmapLayer{}, err
+		}
+		sn = node
+	} else {
+		if c.nextOffset >= uint64(len(c.data)) {
+			return nil, BitmapLayer{}, nil
+		}
+		sn = NewSegmentNodeFromBuffer(c.data[c.nextOffset:])
 	}
-
-	sn := NewSegmentNodeFromBuffer(c.data[c.nextOffset:])
 	c.nextOffset += sn.Len()
 	layer := BitmapLayer{
 		Additions: sn.Additions(),
@@ -54,6 +80,21 @@ func (c *SegmentCursor) Next() ([]byte, BitmapLayer, error) {
 	return sn.PrimaryKey(), layer, nil
 }
 
+// readNode reads the node at the next offset from the reader, nodes start
+// with their length
+func (c *SegmentCursor) readNode() (*SegmentNode, error) {
+	var length [8]byte
+	if _, err := c.reader.ReadAt(length[:], int64(c.nextOffset)); err != nil {
+		return nil, fmt.Errorf("read length of node at %d: %w", c.nextOffset, err)
+	}
+
+	data := make([]byte, binary.LittleEndian.Uint64(length[:]))
+	if _, err := c.reader.ReadAt(data, int64(c.nextOffset)); err != nil {
+		return nil, fmt.Errorf("read node at %d: %w", c.nextOffset, err)
+	}
+	return NewSegmentNodeFromBuffer(data), nil
+}
+
 func (c *SegmentCursor) First() ([]byte, BitmapLayer, error) {
 	c.nextOffset = 0
 	return c.Next()
@@ -12,6 +12,7 @@
 package roaringset
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -80,6 +81,52 @@ func TestSegmentCursor(t *testing.T) {
 	})
 }
 
+func TestSegmentCursorReader(t *testing.T) {
+	seg, offsets := createDummySegment(t, 5)
+	newCursor := func(seeker Seeker) *SegmentCursor {
+		return NewSegmentCursorReader(bytes.NewReader(seg), uint64(len(seg)), seeker)
+	}
+
+	t.Run("starting from beginning, page through all", func(t *testing.T) {
+		c := newCursor(nil)
+		it := uint64(0)
+		for key, layer, err := c.First(); key != nil; key, layer, err = c.Next() {
+			require.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("%05d", it)), key)
+			assert.True(t, layer.Additions.Contains(it*4))
+			assert.True(t, layer.Additions.Contains(it*4+1))
+			assert.True(t, layer.Deletions.Contains(it*4+2))
+			assert.True(t, layer.Deletions.Contains(it*4+3))
+			it++
+		}
+
+		assert.Equal(t, uint64(5), it)
+	})
+
+	t.Run("seek and iterate from there", func(t *testing.T) {
+		c := newCursor(createDummySeeker(t, offsets, 3))
+
+		it := uint64(3)
+		for key, layer, err := c.Seek([]byte("dummyseeker")); key != nil; key, layer, err = c.Next() {
+			require.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("%05d", it)), key)
+			assert.True(t, layer.Additions.Contains(it*4))
+			assert.True(t, layer.Deletions.Contains(it*4+3))
+			it++
+		}
+
+		assert.Equal(t, uint64(5), it)
+	})
+
+	t.Run("truncated segment", func(t *testing.T) {
+		c := NewSegmentCursorReader(bytes.NewReader(seg[:offsets[1]+10]), uint64(len(seg)), nil)
+		_, _, err := c.First()
+		require.Nil(t, err)
+		_, _, err = c.Next()
+		require.NotNil(t, err)
+	})
+}
+
 func createDummySegment(t *testing.T, count uint64) ([]byte, []uint64) {
 	out := []byte{}
 	offsets := []uint64{}
@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -94,6 +95,7 @@ type ShardLike interface {
 	DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error                                           // Delete object by id
 	MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error)
 	ObjectDigestsInRange(ctx context.Context, initialUUID, finalUUID strfmt.UUID, limit int) (objs []types.RepairResponse, err error)
+	Changes(ctx context.Context, after uint64, since int64, limit int) ([]changes.Change, error)
 	ID() string // Get the shard id
 	drop() error
 	HaltForTransfer(ctx context.Context, offloading bool, inactivityTimeout time.Duration) error
@@ -121,6 +123,8 @@ type ShardLike interface {
 	GetVectorIndex(targetVector string) (VectorIndex, bool)
 	ForEachVectorIndex(f func(targetVector string, index VectorIndex) error) error
 	ForEachVectorQueue(f func(targetVector string, queue *VectorIndexQueue) error) error
+	ForEachVector(ctx context.Context, targetVector string, fn func(id strfmt.UUID, vector []float32) error) error
+	NearDuplicates(ctx context.Context, targetVector string, maxDist float32, fn func(a, b strfmt.UUID, dist float32) error) error
 	// TODO tests only
 	Versioner() *shardVersioner // Get the shard versioner
 
@@ -246,6 +250,15 @@ type Shard struct {
 	stopDimensionTracking        chan struct{}
 	dimensionTrackingInitialized atomic.Bool
 
+	// sequence number of the last change recorded in the change log and the
+	// end of the block of sequence numbers reserved on disk
+	changesLock        sync.Mutex
+	changesSeq         uint64
+	changesSeqReserved uint64
+
+	// quotas of the tenant and the usage they are checked against
+	tenantQuotas tenantQuotaChecker
+
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
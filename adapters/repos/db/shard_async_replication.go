@@ -524,7 +524,7 @@ func (s *Shard) removeTargetNodeOverride(ctx context.Context, targetNodeOverride
 	// if there are no overrides left, return the async replication config to what it
 	// was before overrides were added
 	if targetNodeOverrideLen == 0 {
-		return s.SetAsyncReplicationEnabled(ctx, s.index.Config.AsyncReplicationEnabled)
+		return s.SetAsyncReplicationEnabled(ctx, s.index.Config.AsyncReplicationEnabled || s.index.crossClusterReplicationEnabled())
 	}
 	return nil
 }
@@ -536,7 +536,7 @@ func (s *Shard) removeAllTargetNodeOverrides(ctx context.Context) error {
 		defer s.asyncReplicationRWMux.Unlock()
 		s.asyncReplicationConfig.targetNodeOverrides = make([]additional.AsyncReplicationTargetNodeOverride, 0)
 	}()
-	return s.SetAsyncReplicationEnabled(ctx, s.index.Config.AsyncReplicationEnabled)
+	return s.SetAsyncReplicationEnabled(ctx, s.index.Config.AsyncReplicationEnabled || s.index.crossClusterReplicationEnabled())
 }
 
 func (s *Shard) getAsyncReplicationStats(ctx context.Context) []*models.AsyncReplicationStatus {
@@ -615,6 +615,8 @@ func (s *Shard) HashTreeLevel(ctx context.Context, level int, discriminant *hash
 }
 
 func (s *Shard) initHashBeater(ctx context.Context, config asyncReplicationConfig) {
+	s.initFollowerBeater(ctx, config)
+
 	propagationRequired := make(chan struct{})
 
 	var lastHashbeat time.Time
@@ -652,8 +654,9 @@ func (s *Shard) initHashBeater(ctx context.Context, config asyncReplicationConfi
 					config.targetNodeOverrides = s.asyncReplicationConfig.targetNodeOverrides
 				}()
 
-				if !s.index.asyncReplicationEnabled() && len(config.targetNodeOverrides) == 0 {
-					// skip hashbeat iteration when async replication is disabled and no target node overrides are set
+				if (!s.index.asyncReplicationEnabled() || s.index.Config.ReadOnlyNode) && len(config.targetNodeOverrides) == 0 {
+					// skip hashbeat iteration when async replication is disabled and no target node overrides are set.
+					// Read-only replicas don't propagate objects, they are kept up to date by the writable replicas.
 					backoffTimer.Reset()
 					lastHashbeatMux.Lock()
 					lastHashbeat = time.Now()
@@ -863,22 +866,92 @@ func (s *Shard) hashBeat(ctx context.Context, config asyncReplicationConfig) ([]
 
 	diffCalculationTook := time.Since(diffCalculationStart)
 
-	rangeReader := shardDiffReader.RangeReader
-
 	objectProgationStart := time.Now()
 
-	localObjectsCount := 0
-	remoteObjectsCount := 0
+	candidates, err := s.collectPropagationCandidates(ctx, config, shardDiffReader, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates.objects) > 0 {
+		propagationCtx, cancel := context.WithTimeout(ctx, config.propagationTimeout)
+		defer cancel()
+
+		resp, err := s.propagateObjects(propagationCtx, config, shardDiffReader.TargetNodeAddress, candidates.objects, candidates.remoteStaleUpdateTime)
+		if err != nil {
+			return nil, fmt.Errorf("propagating local objects: %w", err)
+		}
+
+		for _, r := range resp {
+			// NOTE: deleted objects are not propagated but locally deleted when conflict is detected
+
+			deletionStrategy := s.index.DeletionStrategy()
+
+			if !r.Deleted ||
+				deletionStrategy == models.ReplicationConfigDeletionStrategyNoAutomatedResolution {
+				continue
+			}
+
+			if deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict ||
+				(deletionStrategy == models.ReplicationConfigDeletionStrategyTimeBasedResolution &&
+					r.UpdateTime > candidates.localUpdateTime[strfmt.UUID(r.ID)]) {
+
+				err := s.DeleteObject(propagationCtx, strfmt.UUID(r.ID), time.UnixMilli(r.UpdateTime))
+				if err != nil {
+					return nil, fmt.Errorf("deleting local objects: %w", err)
+				}
+			}
+		}
+	}
+
+	return []*hashBeatHostStats{
+		{
+			targetNodeName:      shardDiffReader.TargetNodeName,
+			diffStartTime:       diffCalculationStart,
+			diffCalculationTook: diffCalculationTook,
+			localObjects:        candidates.localObjects,
+			remoteObjects:       candidates.remoteObjects,
+			objectsPropagated:   len(candidates.objects),
+			objectProgationTook: time.Since(objectProgationStart),
+		},
+	}, nil
+}
+
+// propagationCandidates are the differences a hashbeat found within the
+// ranges of a shard diff
+type propagationCandidates struct {
+	localObjects          int
+	remoteObjects         int
+	objects               []strfmt.UUID
+	localUpdateTime       map[strfmt.UUID]int64
+	remoteStaleUpdateTime map[strfmt.UUID]int64
+	// deletions are only collected for follower clusters, within the cluster
+	// objects missing locally are propagated by the other replica instead
+	deletions []*objects.VObject
+}
 
-	objectsToPropagate := make([]strfmt.UUID, 0, config.propagationLimit)
-	localUpdateTimeByUUID := make(map[strfmt.UUID]int64, config.propagationLimit)
-	remoteStaleUpdateTimeByUUID := make(map[strfmt.UUID]int64, config.propagationLimit)
+func (c *propagationCandidates) count() int {
+	return len(c.objects) + len(c.deletions)
+}
+
+// collectPropagationCandidates reads the ranges of diff until
+// config.propagationLimit local objects newer than the target's are found.
+// With withDeletions objects which only exist on the target count towards
+// the limit as well.
+func (s *Shard) collectPropagationCandidates(ctx context.Context, config asyncReplicationConfig,
+	diff *replica.ShardDifferenceReader, withDeletions bool,
+) (*propagationCandidates, error) {
+	candidates := &propagationCandidates{
+		objects:               make([]strfmt.UUID, 0, config.propagationLimit),
+		localUpdateTime:       make(map[strfmt.UUID]int64, config.propagationLimit),
+		remoteStaleUpdateTime: make(map[strfmt.UUID]int64, config.propagationLimit),
+	}
 
 	prepropagationCtx, cancel := context.WithTimeout(ctx, config.prePropagationTimeout)
 	defer cancel()
 
-	for len(objectsToPropagate) < config.propagationLimit {
-		initialLeaf, finalLeaf, err := rangeReader.Next()
+	for candidates.count() < config.propagationLimit {
+		initialLeaf, finalLeaf, err := diff.RangeReader.Next()
 		if err != nil {
 			if errors.Is(err, hashtree.ErrNoMoreRanges) {
 				break
@@ -889,11 +962,11 @@ func (s *Shard) hashBeat(ctx context.Context, config asyncReplicationConfig) ([]
 		localObjsCountWithinRange, remoteObjsCountWithinRange, objsToPropagateWithinRange, err := s.objectsToPropagateWithinRange(
 			prepropagationCtx,
 			config,
-			shardDiffReader.TargetNodeAddress,
-			shardDiffReader.TargetNodeName,
+			diff.TargetNodeAddress,
+			diff.TargetNodeName,
 			initialLeaf,
 			finalLeaf,
-			config.propagationLimit-len(objectsToPropagate),
+			config.propagationLimit-candidates.count(),
 		)
 		if err != nil {
 			if prepropagationCtx.Err() != nil {
@@ -905,58 +978,106 @@ func (s *Shard) hashBeat(ctx context.Context, config asyncReplicationConfig) ([]
 			return nil, fmt.Errorf("collecting local objects to be propagated: %w", err)
 		}
 
-		localObjectsCount += localObjsCountWithinRange
-		remoteObjectsCount += remoteObjsCountWithinRange
+		candidates.localObjects += localObjsCountWithinRange
+		candidates.remoteObjects += remoteObjsCountWithinRange
 
 		for _, obj := range objsToPropagateWithinRange {
-			objectsToPropagate = append(objectsToPropagate, obj.uuid)
-			localUpdateTimeByUUID[obj.uuid] = obj.lastUpdateTime
-			remoteStaleUpdateTimeByUUID[obj.uuid] = obj.remoteStaleUpdateTime
+			candidates.objects = append(candidates.objects, obj.uuid)
+			candidates.localUpdateTime[obj.uuid] = obj.lastUpdateTime
+			candidates.remoteStaleUpdateTime[obj.uuid] = obj.remoteStaleUpdateTime
 		}
+
+		if !withDeletions {
+			continue
+		}
+
+		deletionsWithinRange, err := s.followerDeletionsWithinRange(
+			prepropagationCtx,
+			config,
+			diff.TargetNodeAddress,
+			initialLeaf,
+			finalLeaf,
+			config.propagationLimit-candidates.count(),
+		)
+		if err != nil {
+			if prepropagationCtx.Err() != nil {
+				break
+			}
+			return nil, fmt.Errorf("collecting deletions to be propagated: %w", err)
+		}
+
+		candidates.deletions = append(candidates.deletions, deletionsWithinRange...)
 	}
 
-	if len(objectsToPropagate) > 0 {
-		propagationCtx, cancel := context.WithTimeout(ctx, config.propagationTimeout)
-		defer cancel()
+	return candidates, nil
+}
+
+// leafRangeBounds returns the first and the last uuid of the range of
+// hashtree leaves
+func leafRangeBounds(initialLeaf, finalLeaf uint64, hashtreeHeight int) (first, final []byte) {
+	final = make([]byte, 16)
+	binary.BigEndian.PutUint64(final, finalLeaf<<(64-hashtreeHeight)|((1<<(64-hashtreeHeight))-1))
+	copy(final[8:], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	first = make([]byte, 16)
+	binary.BigEndian.PutUint64(first, initialLeaf<<(64-hashtreeHeight))
+
+	return first, final
+}
+
+// forEachRemoteDigest pages through the digests of the objects the replica on
+// host stores from the uuid from up to to, until fn returns false
+func (s *Shard) forEachRemoteDigest(ctx context.Context, config asyncReplicationConfig,
+	host string, from []byte, to strfmt.UUID, fn func(digests []types.RepairResponse) (bool, error),
+) error {
+	toBytes, err := bytesFromUUID(to)
+	if err != nil {
+		return err
+	}
 
-		resp, err := s.propagateObjects(propagationCtx, config, shardDiffReader.TargetNodeAddress, objectsToPropagate, remoteStaleUpdateTimeByUUID)
+	for curr := from; bytes.Compare(curr, toBytes) < 1; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		currUUID, err := uuidFromBytes(curr)
 		if err != nil {
-			return nil, fmt.Errorf("propagating local objects: %w", err)
+			return err
 		}
 
-		for _, r := range resp {
-			// NOTE: deleted objects are not propagated but locally deleted when conflict is detected
+		digests, err := s.index.replicator.DigestObjectsInRange(ctx,
+			s.name, host, currUUID, to, config.diffBatchSize)
+		if err != nil {
+			return fmt.Errorf("fetching remote object digests: %w", err)
+		}
 
-			deletionStrategy := s.index.DeletionStrategy()
+		if len(digests) == 0 {
+			// no more digests in remote host
+			return nil
+		}
 
-			if !r.Deleted ||
-				deletionStrategy == models.ReplicationConfigDeletionStrategyNoAutomatedResolution {
-				continue
-			}
+		if more, err := fn(digests); err != nil || !more {
+			return err
+		}
 
-			if deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict ||
-				(deletionStrategy == models.ReplicationConfigDeletionStrategyTimeBasedResolution &&
-					r.UpdateTime > localUpdateTimeByUUID[strfmt.UUID(r.ID)]) {
+		if len(digests) < config.diffBatchSize {
+			return nil
+		}
 
-				err := s.DeleteObject(propagationCtx, strfmt.UUID(r.ID), time.UnixMilli(r.UpdateTime))
-				if err != nil {
-					return nil, fmt.Errorf("deleting local objects: %w", err)
-				}
-			}
+		last, err := bytesFromUUID(strfmt.UUID(digests[len(digests)-1].ID))
+		if err != nil {
+			return err
 		}
+
+		if overflow := incToNextLexValue(last); overflow {
+			// no more remote digests need to be fetched
+			return nil
+		}
+
+		curr = last
 	}
 
-	return []*hashBeatHostStats{
-		{
-			targetNodeName:      shardDiffReader.TargetNodeName,
-			diffStartTime:       diffCalculationStart,
-			diffCalculationTook: diffCalculationTook,
-			localObjects:        localObjectsCount,
-			remoteObjects:       remoteObjectsCount,
-			objectsPropagated:   len(objectsToPropagate),
-			objectProgationTook: time.Since(objectProgationStart),
-		},
-	}, nil
+	return nil
 }
 
 func uuidFromBytes(uuidBytes []byte) (id strfmt.UUID, err error) {
@@ -997,20 +1118,13 @@ func (s *Shard) objectsToPropagateWithinRange(ctx context.Context, config asyncR
 ) (localObjectsCount int, remoteObjectsCount int, objectsToPropagate []objectToPropagate, err error) {
 	objectsToPropagate = make([]objectToPropagate, 0, limit)
 
-	hashtreeHeight := config.hashtreeHeight
-
-	finalUUIDBytes := make([]byte, 16)
-	binary.BigEndian.PutUint64(finalUUIDBytes, finalLeaf<<(64-hashtreeHeight)|((1<<(64-hashtreeHeight))-1))
-	copy(finalUUIDBytes[8:], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	currLocalUUIDBytes, finalUUIDBytes := leafRangeBounds(initialLeaf, finalLeaf, config.hashtreeHeight)
 
 	finalUUID, err := uuidFromBytes(finalUUIDBytes)
 	if err != nil {
 		return localObjectsCount, remoteObjectsCount, objectsToPropagate, err
 	}
 
-	currLocalUUIDBytes := make([]byte, 16)
-	binary.BigEndian.PutUint64(currLocalUUIDBytes, initialLeaf<<(64-hashtreeHeight))
-
 	for limit > 0 && bytes.Compare(currLocalUUIDBytes, finalUUIDBytes) < 1 {
 		if ctx.Err() != nil {
 			return localObjectsCount, remoteObjectsCount, objectsToPropagate, ctx.Err()
@@ -1062,74 +1176,32 @@ func (s *Shard) objectsToPropagateWithinRange(ctx context.Context, config asyncR
 
 		remoteStaleUpdateTime := make(map[string]int64, len(localDigestsByUUID))
 
-		if len(localDigestsByUUID) > 0 {
-			// fetch digests from remote host in order to avoid sending unnecessary objects
-			for currRemoteUUIDBytes := currLocalUUIDBytes; bytes.Compare(currRemoteUUIDBytes, lastLocalUUIDBytes) < 1; {
-				if ctx.Err() != nil {
-					return localObjectsCount, remoteObjectsCount, objectsToPropagate, ctx.Err()
-				}
-
-				currRemoteUUID, err := uuidFromBytes(currRemoteUUIDBytes)
-				if err != nil {
-					return localObjectsCount, remoteObjectsCount, objectsToPropagate, err
-				}
-
-				// TODO could speed up by passing through the target node override upper time bound here
-				remoteDigests, err := s.index.replicator.DigestObjectsInRange(ctx,
-					s.name, targetNodeAddress, currRemoteUUID, lastLocalUUID, config.diffBatchSize)
-				if err != nil {
-					return localObjectsCount, remoteObjectsCount, objectsToPropagate, fmt.Errorf("fetching remote object digests: %w", err)
-				}
-
-				if len(remoteDigests) == 0 {
-					// no more digests in remote host
-					break
-				}
-
+		// fetch digests from remote host in order to avoid sending unnecessary objects
+		// TODO could speed up by passing through the target node override upper time bound here
+		err = s.forEachRemoteDigest(ctx, config, targetNodeAddress, currLocalUUIDBytes, lastLocalUUID,
+			func(remoteDigests []types.RepairResponse) (bool, error) {
 				remoteObjectsCount += len(remoteDigests)
 
 				for _, d := range remoteDigests {
 					localDigest, ok := localDigestsByUUID[d.ID]
-					if ok {
-						if localDigest.UpdateTime <= d.UpdateTime {
-							// older or up to date objects are not propagated
-							delete(localDigestsByUUID, d.ID)
-
-							if len(localDigestsByUUID) == 0 {
-								// no more local objects need to be propagated in this iteration
-								break
-							}
-						} else {
-							// older object is subject to be overwriten
-							remoteStaleUpdateTime[d.ID] = d.UpdateTime
-						}
+					if !ok {
+						continue
+					}
+					if localDigest.UpdateTime <= d.UpdateTime {
+						// older or up to date objects are not propagated
+						delete(localDigestsByUUID, d.ID)
+					} else {
+						// older object is subject to be overwriten
+						remoteStaleUpdateTime[d.ID] = d.UpdateTime
 					}
 				}
 
-				if len(localDigestsByUUID) == 0 {
-					// no more local objects need to be propagated in this iteration
-					break
-				}
-
-				if len(remoteDigests) < config.diffBatchSize {
-					break
-				}
-
-				lastRemoteUUID := strfmt.UUID(remoteDigests[len(remoteDigests)-1].ID)
-
-				lastRemoteUUIDBytes, err := bytesFromUUID(lastRemoteUUID)
-				if err != nil {
-					return localObjectsCount, remoteObjectsCount, objectsToPropagate, err
-				}
-
-				overflow := incToNextLexValue(lastRemoteUUIDBytes)
-				if overflow {
-					// no more remote digests need to be fetched
-					break
-				}
-
-				currRemoteUUIDBytes = lastRemoteUUIDBytes
-			}
+				// no more local objects need to be propagated in this iteration
+				// once all of them are up to date
+				return len(localDigestsByUUID) > 0, nil
+			})
+		if err != nil {
+			return localObjectsCount, remoteObjectsCount, objectsToPropagate, err
 		}
 
 		for _, obj := range localDigestsByUUID {
@@ -1216,6 +1288,7 @@ func (s *Shard) propagateObjects(ctx context.Context, config asyncReplicationCon
 
 					var vectors map[string][]float32
 					var multiVectors map[string][][]float32
+					var sparseVectors map[string]models.SparseVector
 
 					if obj.Vectors != nil {
 						vectors = make(map[string][]float32, len(obj.Vectors))
@@ -1229,6 +1302,12 @@ func (s *Shard) propagateObjects(ctx context.Context, config asyncReplicationCon
 							multiVectors[targetVector] = v
 						}
 					}
+					if obj.SparseVectors != nil {
+						sparseVectors = make(map[string]models.SparseVector, len(obj.SparseVectors))
+						for targetVector, v := range obj.SparseVectors {
+							sparseVectors[targetVector] = v
+						}
+					}
 
 					obj := &objects.VObject{
 						ID:                      obj.ID(),
@@ -1237,6 +1316,7 @@ func (s *Shard) propagateObjects(ctx context.Context, config asyncReplicationCon
 						Vector:                  obj.Vector,
 						Vectors:                 vectors,
 						MultiVectors:            multiVectors,
+						SparseVectors:           sparseVectors,
 						StaleUpdateTime:         remoteStaleUpdateTime[obj.ID()],
 					}
 
@@ -166,6 +166,14 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 
+	dataKeyFile, err := s.dataKeyBackupFile()
+	if err != nil {
+		return err
+	}
+	if dataKeyFile != "" {
+		ret.Files = append(ret.Files, dataKeyFile)
+	}
+
 	return s.ForEachVectorIndex(func(targetVector string, idx VectorIndex) error {
 		files, err := idx.ListFiles(ctx, s.index.Config.RootPath)
 		if err != nil {
@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	// changesPruneInterval is how often changes older than the retention
+	// window are removed from the change log
+	changesPruneInterval = time.Minute
+	// changesPruneBatchSize bounds the number of changes removed per cycle
+	changesPruneBatchSize = 10_000
+	// changesSeqBlockSize is the number of sequence numbers reserved at once.
+	// Only the end of the reserved block is persisted, so a restart skips the
+	// rest of the block, but sequence numbers are never reused.
+	changesSeqBlockSize = 1000
+	// changesValueHeaderSize is the size of the operation, time and id
+	// preceding the object in a recorded change
+	changesValueHeaderSize = 1 + 8 + 16
+)
+
+// changesSeqKey holds the end of the reserved block of sequence numbers. It
+// is shorter than the keys of changes, so it is skipped when reading them.
+var changesSeqKey = []byte{0xff}
+
+// initChangesBucket creates the change log of the shard. Every mutation of an
+// object is recorded in it, keyed by a sequence number increasing in the
+// order the mutations are applied on this replica, so that the changes of a
+// shard can be read in order and resumed from any position within the
+// retention window, including writes coordinated late or received through
+// replication.
+func (s *Shard) initChangesBucket(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx, helpers.ChangesBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.dynamicMemtableSizing(),
+		s.memtableDirtyConfig(),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		lsmkv.WithSegmentsChecksumValidationEnabled(s.index.Config.LSMEnableSegmentsChecksumValidation),
+		s.segmentCleanupConfig(),
+		lsmkv.WithMinMMapSize(s.index.Config.MinMMapSize),
+		lsmkv.WithMinWalThreshold(s.index.Config.MaxReuseWalSize),
+	)
+	if err != nil {
+		return fmt.Errorf("create changes bucket: %w", err)
+	}
+
+	reserved, err := s.store.Bucket(helpers.ChangesBucketLSM).Get(changesSeqKey)
+	if err != nil {
+		return fmt.Errorf("read change sequence: %w", err)
+	}
+	if len(reserved) == 8 {
+		s.changesSeq = binary.BigEndian.Uint64(reserved)
+		s.changesSeqReserved = s.changesSeq
+	}
+
+	// unregistered together with the compaction callbacks of the shard on shutdown
+	id := strings.Join([]string{"shard", s.index.ID(), s.name, "changes", "prune"}, "/")
+	s.cycleCallbacks.compactionCallbacks.Register(id, s.pruneChanges,
+		cyclemanager.WithIntervals(cyclemanager.NewFixedIntervals(changesPruneInterval)))
+
+	return nil
+}
+
+// mayRecordChange adds a mutation to the change log if the change stream is
+// enabled. updateTime is the time of the mutation in unix milliseconds as
+// assigned by the coordinator, object is the binary object after the
+// mutation and nil for deletions.
+func (s *Shard) mayRecordChange(op changes.Operation, idBytes []byte, updateTime int64, object []byte) error {
+	if !s.index.Config.ChangeStream.Enabled {
+		return nil
+	}
+
+	bucket := s.store.Bucket(helpers.ChangesBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	if updateTime < 1 {
+		updateTime = time.Now().UnixMilli()
+	}
+
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return fmt.Errorf("invalid object uuid: %w", err)
+	}
+
+	value := make([]byte, changesValueHeaderSize+len(object))
+	value[0] = byte(op)
+	binary.BigEndian.PutUint64(value[1:9], uint64(updateTime))
+	copy(value[9:25], id[:])
+	copy(value[changesValueHeaderSize:], object)
+
+	// sequence numbers are assigned and written under the lock, so that a
+	// reader never sees a change before all changes with lower numbers
+	s.changesLock.Lock()
+	defer s.changesLock.Unlock()
+
+	if s.changesSeq == s.changesSeqReserved {
+		reserved := make([]byte, 8)
+		binary.BigEndian.PutUint64(reserved, s.changesSeqReserved+changesSeqBlockSize)
+		if err := bucket.Put(changesSeqKey, reserved); err != nil {
+			return fmt.Errorf("reserve change sequence: %w", err)
+		}
+		s.changesSeqReserved += changesSeqBlockSize
+	}
+
+	if err := bucket.Put(changes.Key(s.changesSeq+1), value); err != nil {
+		return fmt.Errorf("record %s of object %s: %w", op, id, err)
+	}
+	s.changesSeq++
+	return nil
+}
+
+// Changes returns up to limit changes of the shard replica after the sequence
+// number. Changes older than since, in unix milliseconds, are skipped.
+func (s *Shard) Changes(ctx context.Context, after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	bucket := s.store.Bucket(helpers.ChangesBucketLSM)
+	if bucket == nil {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf("change stream is not enabled on shard %q", s.name))
+	}
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	nodeName := s.index.getSchema.NodeName()
+	var out []changes.Change
+	for k, v := cursor.Seek(changes.Key(after + 1)); k != nil && len(out) < limit; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if len(k) != changes.KeySize || len(v) < changesValueHeaderSize {
+			continue
+		}
+
+		seq, err := changes.SeqFromKey(k)
+		if err != nil {
+			return nil, err
+		}
+		change := changes.Change{
+			Seq:       seq,
+			Node:      nodeName,
+			Time:      int64(binary.BigEndian.Uint64(v[1:9])),
+			Operation: changes.Operation(v[0]),
+			Shard:     s.name,
+		}
+		if change.Time < since {
+			continue
+		}
+		copy(change.ID[:], v[9:25])
+		if len(v) > changesValueHeaderSize {
+			// values may point into memory owned by the bucket
+			change.Object = bytes.Clone(v[changesValueHeaderSize:])
+		}
+		out = append(out, change)
+	}
+
+	return out, nil
+}
+
+// pruneChanges removes changes older than the retention window. Changes are
+// removed in the order of their sequence numbers up to the first one within
+// the window.
+func (s *Shard) pruneChanges(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	bucket := s.store.Bucket(helpers.ChangesBucketLSM)
+	if bucket == nil {
+		return false
+	}
+
+	expired := time.Now().Add(-s.index.Config.ChangeStream.Retention).UnixMilli()
+
+	var keys [][]byte
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil && len(keys) < changesPruneBatchSize; k, v = cursor.Next() {
+		if len(k) != changes.KeySize || len(v) < changesValueHeaderSize {
+			break
+		}
+		if int64(binary.BigEndian.Uint64(v[1:9])) >= expired {
+			break
+		}
+		keys = append(keys, bytes.Clone(k))
+	}
+	cursor.Close()
+
+	for _, key := range keys {
+		if shouldAbort() {
+			return true
+		}
+		if err := bucket.Delete(key); err != nil {
+			s.index.logger.WithField("action", "prune_changes").
+				WithField("shard", s.name).
+				WithError(err).Error("failed to remove expired change")
+			return true
+		}
+	}
+
+	return len(keys) > 0
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestShard_Changes(t *testing.T) {
+	ctx := context.Background()
+	className := "ChangesTest"
+
+	shard, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeStream = config.ChangeStream{
+			Enabled:   true,
+			Retention: config.DefaultChangeStreamRetention,
+		}
+	})
+	defer func() { require.Nil(t, idx.drop()) }()
+
+	start := time.Now().UnixMilli()
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"name": "first"}
+	obj.Object.LastUpdateTimeUnix = start
+	require.NoError(t, shard.PutObject(ctx, obj))
+
+	obj.Object.Properties = map[string]interface{}{"name": "second"}
+	obj.Object.LastUpdateTimeUnix = start + 1
+	require.NoError(t, shard.PutObject(ctx, obj))
+
+	require.NoError(t, shard.DeleteObject(ctx, obj.ID(), time.UnixMilli(start+2)))
+
+	t.Run("all changes in order", func(t *testing.T) {
+		res, err := shard.Changes(ctx, 0, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+
+		assert.Equal(t, changes.OperationInsert, res[0].Operation)
+		assert.Equal(t, changes.OperationUpdate, res[1].Operation)
+		assert.Equal(t, changes.OperationDelete, res[2].Operation)
+		for i, change := range res {
+			assert.Equal(t, uint64(i+1), change.Seq)
+			assert.Equal(t, start+int64(i), change.Time)
+			assert.Equal(t, obj.ID().String(), change.ID.String())
+			assert.Equal(t, shard.Name(), change.Shard)
+		}
+
+		updated, err := storobj.FromBinary(res[1].Object)
+		require.NoError(t, err)
+		assert.Equal(t, "second", updated.Properties().(map[string]interface{})["name"])
+		assert.Empty(t, res[2].Object)
+	})
+
+	t.Run("resume after sequence", func(t *testing.T) {
+		first, err := shard.Changes(ctx, 0, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		rest, err := shard.Changes(ctx, first[0].Seq, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, rest, 2)
+		assert.Equal(t, changes.OperationUpdate, rest[0].Operation)
+	})
+
+	t.Run("late write is not skipped", func(t *testing.T) {
+		read, err := shard.Changes(ctx, 0, 0, 10)
+		require.NoError(t, err)
+		last := read[len(read)-1].Seq
+
+		// a write coordinated before the changes already read, e.g. received
+		// through replication
+		late := testObject(className)
+		late.Object.LastUpdateTimeUnix = start - time.Hour.Milliseconds()
+		require.NoError(t, shard.PutObject(ctx, late))
+
+		res, err := shard.Changes(ctx, last, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, late.ID().String(), res[0].ID.String())
+		assert.Equal(t, late.Object.LastUpdateTimeUnix, res[0].Time)
+	})
+
+	t.Run("since skips older changes", func(t *testing.T) {
+		res, err := shard.Changes(ctx, 0, start+1, 10)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, changes.OperationUpdate, res[0].Operation)
+	})
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// compactVectors sets the compact form of all vectors whose target vector is
+// configured with the int8, uint8 or binary data type. The float32 vectors
+// are the source of truth, so objects are handled the same way no matter if
+// they were sent over REST or gRPC, merged or replicated.
+func (s *Shard) compactVectors(obj *storobj.Object) error {
+	obj.CompactVectors = nil
+	for targetVector, vector := range obj.Vectors {
+		dataType := vectorindex.DataType(s.index.GetVectorIndexConfig(targetVector))
+		if !entcommon.IsCompactDataType(dataType) {
+			continue
+		}
+
+		compact, err := compactVector(dataType, vector)
+		if err != nil {
+			return fmt.Errorf("target vector %s of %s: %w", targetVector, obj.ID(), err)
+		}
+		if obj.CompactVectors == nil {
+			obj.CompactVectors = make(map[string]models.Vector)
+		}
+		obj.CompactVectors[targetVector] = compact
+	}
+	return nil
+}
+
+// compactVector converts the float32 form of a vector to the given data type.
+// Binary vectors are given as one 0 or 1 per dimension.
+func compactVector(dataType string, vector []float32) (models.Vector, error) {
+	switch dataType {
+	case entcommon.VectorDataTypeInt8:
+		out := make(models.Int8Vector, len(vector))
+		for i, v := range vector {
+			if !isIntegerInRange(v, math.MinInt8, math.MaxInt8) {
+				return nil, fmt.Errorf("dimension %d: %v is not a valid int8", i, v)
+			}
+			out[i] = int8(v)
+		}
+		return out, nil
+	case entcommon.VectorDataTypeUint8:
+		out := make(models.Uint8Vector, len(vector))
+		for i, v := range vector {
+			if !isIntegerInRange(v, 0, math.MaxUint8) {
+				return nil, fmt.Errorf("dimension %d: %v is not a valid uint8", i, v)
+			}
+			out[i] = uint8(v)
+		}
+		return out, nil
+	case entcommon.VectorDataTypeBinary:
+		if len(vector)%8 != 0 {
+			return nil, fmt.Errorf("binary vectors need a multiple of 8 dimensions, got %d", len(vector))
+		}
+		out := make(models.BinaryVector, len(vector)/8)
+		for i, v := range vector {
+			switch v {
+			case 0:
+			case 1:
+				out[i/8] |= 0x80 >> (i % 8)
+			default:
+				return nil, fmt.Errorf("dimension %d: %v is not a valid bit, expected 0 or 1", i, v)
+			}
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported vector data type %q", dataType)
+	}
+}
+
+func isIntegerInRange(v float32, minValue, maxValue float32) bool {
+	return v >= minValue && v <= maxValue && v == float32(math.Trunc(float64(v)))
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_CompactVectors(t *testing.T) {
+	ctx := context.Background()
+	className := "CompactVectorTest"
+
+	int8Config := enthnsw.NewDefaultUserConfig()
+	int8Config.Distance = common.DistanceL2Squared
+	int8Config.DataType = common.VectorDataTypeInt8
+
+	binaryConfig := flat.NewDefaultUserConfig()
+	binaryConfig.Distance = common.DistanceHamming
+	binaryConfig.DataType = common.VectorDataTypeBinary
+
+	shard, idx := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		enthnsw.UserConfig{Skip: true}, false, false, func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				"int8":   int8Config,
+				"binary": binaryConfig,
+			}
+		})
+	defer func() { require.Nil(t, idx.drop()) }()
+
+	binaryVectors := []models.BinaryVector{
+		{0b11110000},
+		{0b11100000},
+		{0b00001111},
+	}
+	int8Vectors := []models.Int8Vector{
+		{-128, 0, 127},
+		{-100, 10, 100},
+		{0, 0, 0},
+	}
+	objs := make([]*storobj.Object, len(binaryVectors))
+	for i := range objs {
+		objs[i] = testObject(className)
+		objs[i].Vectors = map[string][]float32{
+			"int8":   int8Vectors[i].Float32(),
+			"binary": binaryVectors[i].Float32(),
+		}
+	}
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	t.Run("vectors are returned in their data type", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, objs[0].ID(), nil,
+			additional.Properties{Vectors: []string{"int8", "binary"}})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]models.Vector{
+			"int8":   int8Vectors[0],
+			"binary": binaryVectors[0],
+		}, obj.CompactVectors)
+		assert.Equal(t, objs[0].Vectors, obj.Vectors)
+	})
+
+	t.Run("binary vectors are searched by hamming distance", func(t *testing.T) {
+		vidx, ok := shard.GetVectorIndex("binary")
+		require.True(t, ok)
+
+		ids, dists, err := vidx.SearchByVector(ctx, binaryVectors[0].Float32(), 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{objs[0].DocID, objs[1].DocID, objs[2].DocID}, ids)
+		assert.Equal(t, []float32{0, 1, 8}, dists)
+	})
+
+	t.Run("int8 vectors are searched", func(t *testing.T) {
+		vidx, ok := shard.GetVectorIndex("int8")
+		require.True(t, ok)
+
+		ids, dists, err := vidx.SearchByVector(ctx, []float32{-127, 1, 126}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{objs[0].DocID}, ids)
+		assert.Equal(t, []float32{3}, dists)
+		assert.True(t, vidx.Compressed(), "int8 vectors are indexed in their compact form")
+	})
+
+	t.Run("values outside of the data type are rejected", func(t *testing.T) {
+		for name, vectors := range map[string]map[string][]float32{
+			"int8 out of range":   {"int8": {-129, 0, 0}},
+			"int8 fraction":       {"int8": {0.5, 0, 0}},
+			"binary not a bit":    {"binary": {2, 0, 0, 0, 0, 0, 0, 0}},
+			"binary not packable": {"binary": {1, 0, 1}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				obj := testObject(className)
+				obj.Vectors = vectors
+				assert.ErrorContains(t, shard.PutObject(ctx, obj), "validate vector data type")
+			})
+		}
+	})
+}
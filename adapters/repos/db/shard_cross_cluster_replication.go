@@ -0,0 +1,284 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// initFollowerBeater starts replicating the shard to the follower cluster of
+// the index, if one is configured. It stops together with the hashbeater
+// when ctx is cancelled.
+func (s *Shard) initFollowerBeater(ctx context.Context, config asyncReplicationConfig) {
+	hosts := s.index.followerHosts()
+	if len(hosts) == 0 {
+		return
+	}
+
+	enterrors.GoWrapper(func() {
+		s.index.logger.
+			WithField("action", "cross_cluster_replication").
+			WithField("class_name", s.class.Class).
+			WithField("shard_name", s.name).
+			WithField("hosts", hosts).
+			Info("follower beater started...")
+
+		defer func() {
+			s.index.logger.
+				WithField("action", "cross_cluster_replication").
+				WithField("class_name", s.class.Class).
+				WithField("shard_name", s.name).
+				Info("follower beater stopped")
+		}()
+
+		t := time.NewTicker(s.index.crossClusterReplicationFrequency())
+		defer t.Stop()
+
+		var lastLog time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			// the other replicas hold the same data, it's enough if one of
+			// them keeps the follower up to date
+			if !s.leadsCrossClusterReplication() {
+				continue
+			}
+
+			stats, err := s.followerBeat(ctx, config, hosts)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if time.Since(lastLog) < config.loggingFrequency {
+					continue
+				}
+				lastLog = time.Now()
+
+				if errors.Is(err, replica.ErrNoDiffFound) {
+					s.index.logger.
+						WithField("action", "cross_cluster_replication").
+						WithField("class_name", s.class.Class).
+						WithField("shard_name", s.name).
+						WithField("target_node_name", stats.targetNodeName).
+						Info("follower beat iteration successfully completed: no differences were found")
+					continue
+				}
+
+				s.index.logger.
+					WithField("action", "cross_cluster_replication").
+					WithField("class_name", s.class.Class).
+					WithField("shard_name", s.name).
+					Warnf("follower beat iteration failed: %v", err)
+				continue
+			}
+
+			s.index.logger.
+				WithField("action", "cross_cluster_replication").
+				WithField("class_name", s.class.Class).
+				WithField("shard_name", s.name).
+				WithField("target_node_name", stats.targetNodeName).
+				WithField("diff_calculation_took", stats.diffCalculationTook.String()).
+				WithField("local_objects", stats.localObjects).
+				WithField("remote_objects", stats.remoteObjects).
+				WithField("objects_propagated", stats.objectsPropagated).
+				WithField("object_progation_took", stats.objectProgationTook.String()).
+				Info("follower beat iteration successfully completed")
+		}
+	}, s.index.logger)
+}
+
+// leadsCrossClusterReplication returns whether this node holds the first
+// replica of the shard which is alive, which is the one replicating it to
+// the follower. If that node goes down, the next replica takes over once the
+// cluster noticed it. Two nodes may briefly both lead, which is harmless as
+// the follower only ever accepts newer objects.
+func (s *Shard) leadsCrossClusterReplication() bool {
+	replicas, err := s.index.getSchema.ShardReplicas(s.class.Class, s.name)
+	if err != nil {
+		return false
+	}
+	leader := crossClusterReplicationLeader(replicas, func(node string) bool {
+		_, ok := s.index.replicator.NodeHostname(node)
+		return ok
+	})
+	return leader != "" && leader == s.index.getSchema.NodeName()
+}
+
+// crossClusterReplicationLeader returns the first of the replicas which is
+// alive, empty if none of them is
+func crossClusterReplicationLeader(replicas []string, alive func(node string) bool) string {
+	for _, replica := range replicas {
+		if alive(replica) {
+			return replica
+		}
+	}
+	return ""
+}
+
+// followerBeat compares the shard with its replica in the follower cluster
+// and brings the follower up to date. Unlike the hashbeat within the
+// cluster, this is one-way: objects missing locally are deleted on the
+// follower and conflicts reported by the follower never change local data.
+func (s *Shard) followerBeat(ctx context.Context, config asyncReplicationConfig, hosts []string,
+) (*hashBeatHostStats, error) {
+	var ht hashtree.AggregatedHashTree
+
+	s.asyncReplicationRWMux.RLock()
+	if s.hashtree == nil || !s.hashtreeFullyInitialized {
+		s.asyncReplicationRWMux.RUnlock()
+		return nil, fmt.Errorf("hashtree not initialized on shard %q", s.ID())
+	}
+	ht = s.hashtree
+	s.asyncReplicationRWMux.RUnlock()
+
+	diffCalculationStart := time.Now()
+
+	shardDiffReader, err := s.index.replicator.CollectFollowerShardDifferences(ctx, s.name, ht, config.diffPerNodeTimeout, hosts)
+	if err != nil {
+		if errors.Is(err, replica.ErrNoDiffFound) {
+			return &hashBeatHostStats{
+				targetNodeName: shardDiffReader.TargetNodeName,
+				diffStartTime:  diffCalculationStart,
+			}, err
+		}
+		return nil, fmt.Errorf("collecting differences: %w", err)
+	}
+
+	diffCalculationTook := time.Since(diffCalculationStart)
+	host := shardDiffReader.TargetNodeAddress
+
+	objectProgationStart := time.Now()
+
+	candidates, err := s.collectPropagationCandidates(ctx, config, shardDiffReader, true)
+	if err != nil {
+		return nil, err
+	}
+
+	propagationCtx, cancel := context.WithTimeout(ctx, config.propagationTimeout)
+	defer cancel()
+
+	if len(candidates.objects) > 0 {
+		// conflicts are left for the next iteration, the follower must not
+		// affect the local shard
+		_, err := s.propagateObjects(propagationCtx, config, host, candidates.objects, candidates.remoteStaleUpdateTime)
+		if err != nil {
+			return nil, fmt.Errorf("propagating local objects: %w", err)
+		}
+	}
+
+	for i := 0; i < len(candidates.deletions); i += config.propagationBatchSize {
+		batch := candidates.deletions[i:min(i+config.propagationBatchSize, len(candidates.deletions))]
+		if _, err := s.index.replicator.Overwrite(propagationCtx, host, s.class.Class, s.name, batch); err != nil {
+			return nil, fmt.Errorf("propagating deletions: %w", err)
+		}
+	}
+
+	return &hashBeatHostStats{
+		targetNodeName:      shardDiffReader.TargetNodeName,
+		diffStartTime:       diffCalculationStart,
+		diffCalculationTook: diffCalculationTook,
+		localObjects:        candidates.localObjects,
+		remoteObjects:       candidates.remoteObjects,
+		objectsPropagated:   candidates.count(),
+		objectProgationTook: time.Since(objectProgationStart),
+	}, nil
+}
+
+// followerDeletionsWithinRange returns the deletions required for objects
+// which exist on the follower within the range of leaves but not locally.
+// Objects deleted recently are skipped the same way recent updates are, so
+// that they are not propagated while they may still change.
+func (s *Shard) followerDeletionsWithinRange(ctx context.Context, config asyncReplicationConfig,
+	host string, initialLeaf, finalLeaf uint64, limit int,
+) ([]*objects.VObject, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	deletions := make([]*objects.VObject, 0, limit)
+
+	firstUUIDBytes, finalUUIDBytes := leafRangeBounds(initialLeaf, finalLeaf, config.hashtreeHeight)
+	finalUUID, err := uuidFromBytes(finalUUIDBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	maxUpdateTime := time.Now().Add(-config.propagationDelay).UnixMilli()
+
+	err = s.forEachRemoteDigest(ctx, config, host, firstUUIDBytes, finalUUID,
+		func(remoteDigests []types.RepairResponse) (bool, error) {
+			ids := make([]strfmt.UUID, 0, len(remoteDigests))
+			remoteUpdateTimeByUUID := make(map[strfmt.UUID]int64, len(remoteDigests))
+			for _, d := range remoteDigests {
+				if d.UpdateTime > maxUpdateTime {
+					// the object may not have been propagated to this replica yet
+					continue
+				}
+				ids = append(ids, strfmt.UUID(d.ID))
+				remoteUpdateTimeByUUID[strfmt.UUID(d.ID)] = d.UpdateTime
+			}
+
+			localObjs, err := s.MultiObjectByID(ctx, wrapIDsInMulti(ids))
+			if err != nil {
+				return false, fmt.Errorf("fetching local objects: %w", err)
+			}
+
+			for j, obj := range localObjs {
+				if obj != nil || len(deletions) == limit {
+					continue
+				}
+
+				deleted, deletionTime, err := s.WasDeleted(ctx, ids[j])
+				if err != nil {
+					return false, fmt.Errorf("fetching local deletion: %w", err)
+				}
+
+				deletionTimeUnixMilli := time.Now().UnixMilli()
+				if deleted && !deletionTime.IsZero() {
+					if deletionTime.UnixMilli() > maxUpdateTime {
+						continue
+					}
+					deletionTimeUnixMilli = deletionTime.UnixMilli()
+				}
+
+				deletions = append(deletions, &objects.VObject{
+					ID:                      ids[j],
+					Deleted:                 true,
+					LastUpdateTimeUnixMilli: deletionTimeUnixMilli,
+					StaleUpdateTime:         remoteUpdateTimeByUUID[ids[j]],
+				})
+			}
+
+			return len(deletions) < limit, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletions, nil
+}
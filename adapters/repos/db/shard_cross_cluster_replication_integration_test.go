@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestShard_FollowerDeletionsWithinRange(t *testing.T) {
+	ctx := context.Background()
+	className := "FollowerDeletions"
+	logger, _ := test.NewNullLogger()
+
+	follower := &fakeFollowerClient{}
+	shardLike, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.DisableLazyLoadShards = true
+		i.replicator = replica.NewReplicator(className, fakeFollowerRouter{}, "node1",
+			func() string { return "" }, follower, logger)
+	})
+	defer func() { require.Nil(t, idx.drop()) }()
+	shard := shardLike.(*Shard)
+
+	past := time.Now().Add(-time.Hour).UnixMilli()
+
+	existing := testObject(className)
+	existing.Object.LastUpdateTimeUnix = past
+	require.NoError(t, shard.PutObject(ctx, existing))
+
+	deleted := testObject(className)
+	deleted.Object.LastUpdateTimeUnix = past
+	require.NoError(t, shard.PutObject(ctx, deleted))
+	require.NoError(t, shard.DeleteObject(ctx, deleted.ID(), time.UnixMilli(past+1)))
+
+	unknown := testObject(className).ID()
+	recent := testObject(className).ID()
+
+	follower.digests = []types.RepairResponse{
+		{ID: existing.ID().String(), UpdateTime: past},
+		{ID: deleted.ID().String(), UpdateTime: past},
+		{ID: unknown.String(), UpdateTime: past},
+		// may not have been replicated to this cluster yet
+		{ID: recent.String(), UpdateTime: time.Now().Add(time.Hour).UnixMilli()},
+	}
+	sort.Slice(follower.digests, func(i, j int) bool { return follower.digests[i].ID < follower.digests[j].ID })
+
+	config := asyncReplicationConfig{diffBatchSize: 1, propagationDelay: time.Minute}
+
+	t.Run("objects missing locally are deleted", func(t *testing.T) {
+		deletions, err := shard.followerDeletionsWithinRange(ctx, config, "follower:7001", 0, 0, 10)
+		require.NoError(t, err)
+
+		ids := make([]strfmt.UUID, len(deletions))
+		for i, d := range deletions {
+			assert.True(t, d.Deleted)
+			assert.Equal(t, past, d.StaleUpdateTime)
+			ids[i] = d.ID
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{deleted.ID(), unknown}, ids)
+		for _, d := range deletions {
+			if d.ID == deleted.ID() {
+				assert.Equal(t, past+1, d.LastUpdateTimeUnixMilli)
+			}
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		deletions, err := shard.followerDeletionsWithinRange(ctx, config, "follower:7001", 0, 0, 1)
+		require.NoError(t, err)
+		assert.Len(t, deletions, 1)
+
+		deletions, err = shard.followerDeletionsWithinRange(ctx, config, "follower:7001", 0, 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, deletions)
+	})
+}
+
+// fakeFollowerClient serves the digests of the objects stored on the follower
+type fakeFollowerClient struct {
+	fakeReplicationClient
+	digests []types.RepairResponse
+}
+
+func (c *fakeFollowerClient) DigestObjectsInRange(ctx context.Context, host, index, shard string,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]types.RepairResponse, error) {
+	var res []types.RepairResponse
+	for _, d := range c.digests {
+		if d.ID >= initialUUID.String() && d.ID <= finalUUID.String() && len(res) < limit {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+type fakeFollowerRouter struct{}
+
+func (fakeFollowerRouter) BuildReadRoutingPlan(params types.RoutingPlanBuildOptions) (types.ReadRoutingPlan, error) {
+	return types.ReadRoutingPlan{}, nil
+}
+
+func (fakeFollowerRouter) BuildWriteRoutingPlan(params types.RoutingPlanBuildOptions) (types.WriteRoutingPlan, error) {
+	return types.WriteRoutingPlan{}, nil
+}
+
+func (fakeFollowerRouter) NodeHostname(nodeName string) (string, bool) {
+	return "", false
+}
+
+func (fakeFollowerRouter) AllHostnames() []string {
+	return nil
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossClusterReplicationLeader(t *testing.T) {
+	aliveNodes := func(nodes ...string) func(string) bool {
+		return func(node string) bool {
+			for _, n := range nodes {
+				if n == node {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	replicas := []string{"node1", "node2", "node3"}
+	assert.Equal(t, "node1", crossClusterReplicationLeader(replicas, aliveNodes("node1", "node2", "node3")))
+	// the next replica takes over if the first one is down
+	assert.Equal(t, "node2", crossClusterReplicationLeader(replicas, aliveNodes("node2", "node3")))
+	assert.Equal(t, "node3", crossClusterReplicationLeader(replicas, aliveNodes("node3")))
+	assert.Equal(t, "", crossClusterReplicationLeader(replicas, aliveNodes()))
+	assert.Equal(t, "", crossClusterReplicationLeader(nil, aliveNodes("node1")))
+}
+
+func TestLeafRangeBounds(t *testing.T) {
+	first, final := leafRangeBounds(0, 0, 0)
+	assert.Equal(t, make([]byte, 16), first)
+	assert.Equal(t, []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}, final)
+
+	first, final = leafRangeBounds(1, 2, 4)
+	assert.Equal(t, []byte{0x10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, first)
+	assert.Equal(t, []byte{
+		0x2f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}, final)
+}
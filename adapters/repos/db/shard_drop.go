@@ -131,6 +131,7 @@ func (s *Shard) drop() (err error) {
 	if err := os.RemoveAll(s.path()); err != nil {
 		return fmt.Errorf("delete shard dir: %w", err)
 	}
+	s.releaseEncryption()
 
 	s.metrics.baseMetrics.FinishUnloadingShard()
 
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+// initEncryption registers the data key of the shard if encryption at rest is
+// enabled, so that all files written below the shard's directory from now on
+// are encrypted. It must be called before any of the shard's files are
+// opened.
+func (s *Shard) initEncryption() error {
+	master := s.index.Config.EncryptionMasterKey
+	if master == nil {
+		return nil
+	}
+
+	key, err := s.loadDataKey(master)
+	if err != nil {
+		return err
+	}
+	encryption.RegisterDataKey(s.path(), key)
+	return nil
+}
+
+// loadDataKey returns the data key of the tenant if it has its own one, which
+// is stored in the schema. Otherwise the data key is stored next to the
+// shard's files and created if it doesn't exist yet. That is only the case for
+// tenants added before encryption at rest was enabled, a deleted key or a
+// tenant that is missing from the local schema fails closed and never falls
+// back to a new key.
+func (s *Shard) loadDataKey(master *encryption.MasterKey) (*encryption.DataKey, error) {
+	if s.index.partitioningEnabled {
+		wrapped, err := s.index.getSchema.TenantEncryptionKey(s.index.Config.ClassName.String(), s.name)
+		if err != nil {
+			return nil, err
+		}
+		if wrapped != nil {
+			return master.Unwrap(wrapped)
+		}
+	}
+	return encryption.LoadOrCreateDataKey(s.path(), master)
+}
+
+// releaseEncryption is the inverse of initEncryption, it must only be called
+// once no more files of the shard are written.
+func (s *Shard) releaseEncryption() {
+	if s.index.Config.EncryptionMasterKey == nil {
+		return
+	}
+	encryption.UnregisterDataKey(s.path())
+}
+
+// dataKeyBackupFile returns the path of the shard's wrapped data key relative
+// to the root path, empty if the shard doesn't have one. Without it, an
+// encrypted backup can't be restored.
+func (s *Shard) dataKeyBackupFile() (string, error) {
+	path := filepath.Join(s.path(), encryption.DataKeyFileName)
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("stat data key: %w", err)
+	}
+	return filepath.Rel(s.index.Config.RootPath, path)
+}
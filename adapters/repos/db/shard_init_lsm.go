@@ -59,6 +59,12 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class, lazyLoad
 		return s.initProplenTracker()
 	})
 
+	if s.index.Config.ChangeStream.Enabled {
+		eg.Go(func() error {
+			return s.initChangesBucket(ctx)
+		})
+	}
+
 	// geo props depend on the object bucket and we need to wait for its creation in this case
 	hasGeoProp := false
 	for _, prop := range class.Properties {
@@ -92,7 +98,7 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class, lazyLoad
 	}
 
 	// Object bucket must be available, initAsyncReplication depends on it
-	if s.index.asyncReplicationEnabled() {
+	if s.index.hashtreeRequired() {
 		s.asyncReplicationRWMux.Lock()
 		defer s.asyncReplicationRWMux.Unlock()
 
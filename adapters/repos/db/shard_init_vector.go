@@ -62,10 +62,14 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		distProv = distancer.NewManhattanProvider()
 	case common.DistanceHamming:
 		distProv = distancer.NewHammingProvider()
+	case common.DistanceJaccard:
+		distProv = distancer.NewJaccardProvider()
+	case common.DistanceAngular:
+		distProv = distancer.NewAngularProvider()
 	default:
 		return nil, fmt.Errorf("init vector index: %w",
 			errors.Errorf("unrecognized distance metric %q,"+
-				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", vectorIndexUserConfig.DistanceName()))
+				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\",\"angular\"]", vectorIndexUserConfig.DistanceName()))
 	}
 
 	var vectorIndex VectorIndex
@@ -99,9 +103,9 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				ShardName:                 s.name,
 				ClassName:                 s.index.Config.ClassName.String(),
 				PrometheusMetrics:         s.promMetrics,
-				VectorForIDThunk:          hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
+				VectorForIDThunk:          hnsw.NewVectorForIDThunk(targetVector, s.indexedVectorByIndexID(vectorIndexUserConfig)),
 				MultiVectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.multiVectorByIndexID),
-				TempVectorForIDThunk:      hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
+				TempVectorForIDThunk:      hnsw.NewTempVectorForIDThunk(targetVector, s.readIndexedVectorByIndexIDIntoSlice(vectorIndexUserConfig)),
 				TempMultiVectorForIDThunk: hnsw.NewTempMultiVectorForIDThunk(targetVector, s.readMultiVectorByIndexIDIntoSlice),
 				DistanceProvider:          distProv,
 				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
@@ -222,6 +226,12 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		return nil, fmt.Errorf("unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
 			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
 	}
+	if cfg, ok := matryoshkaConfig(vectorIndexUserConfig); ok {
+		vectorIndex = newMatryoshkaVectorIndex(vectorIndex, cfg, distProv,
+			func(ctx context.Context, id uint64) ([]float32, error) {
+				return s.vectorByIndexID(ctx, id, targetVector)
+			})
+	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
 }
@@ -263,6 +273,15 @@ func (s *Shard) initTargetVector(ctx context.Context, targetVector string, cfg s
 }
 
 func (s *Shard) initTargetVectorWithLock(ctx context.Context, targetVector string, cfg schemaConfig.VectorIndexConfig, lazyLoadSegments bool) error {
+	if isSparseVectorConfig(cfg) {
+		// sparse vectors are stored in an inverted bucket instead of a vector
+		// index, so there is neither an index nor a queue
+		if err := s.initSparseVectorBucket(ctx, targetVector, lazyLoadSegments); err != nil {
+			return fmt.Errorf("cannot create sparse vector bucket for %q: %w", targetVector, err)
+		}
+		return nil
+	}
+
 	vectorIndex, err := s.initVectorIndex(ctx, targetVector, cfg, lazyLoadSegments)
 	if err != nil {
 		return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -343,6 +344,14 @@ func (l *LazyLoadShard) MultiObjectByID(ctx context.Context, query []multi.Ident
 	return l.shard.MultiObjectByID(ctx, query)
 }
 
+func (l *LazyLoadShard) Changes(ctx context.Context, after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.Changes(ctx, after, since, limit)
+}
+
 func (l *LazyLoadShard) ObjectDigestsInRange(ctx context.Context,
 	initialUUID, finalUUID strfmt.UUID, limit int,
 ) (objs []types.RepairResponse, err error) {
@@ -546,6 +555,24 @@ func (l *LazyLoadShard) FillQueue(targetVector string, from uint64) error {
 	return l.shard.FillQueue(targetVector, from)
 }
 
+func (l *LazyLoadShard) ForEachVector(ctx context.Context, targetVector string,
+	fn func(id strfmt.UUID, vector []float32) error,
+) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.ForEachVector(ctx, targetVector, fn)
+}
+
+func (l *LazyLoadShard) NearDuplicates(ctx context.Context, targetVector string, maxDist float32,
+	fn func(a, b strfmt.UUID, dist float32) error,
+) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.NearDuplicates(ctx, targetVector, maxDist, fn)
+}
+
 func (l *LazyLoadShard) RepairIndex(ctx context.Context, targetVector string) error {
 	l.mustLoad()
 	return l.shard.RepairIndex(ctx, targetVector)
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// nearDuplicateFinder is implemented by vector indexes which can find pairs
+// of near-identical vectors from their own graph structure, see
+// hnsw.NearDuplicates. Indexes without this capability fall back to one
+// search by distance per indexed vector.
+type nearDuplicateFinder interface {
+	NearDuplicates(ctx context.Context, maxDist float32,
+		fn func(a, b uint64, dist float32) error) error
+}
+
+// NearDuplicates calls fn for every pair of objects in the shard whose
+// vectors for targetVector are at most maxDist apart. Pairs of objects which
+// are deleted while the shard is scanned are skipped.
+func (s *Shard) NearDuplicates(ctx context.Context, targetVector string, maxDist float32,
+	fn func(a, b strfmt.UUID, dist float32) error,
+) error {
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return fmt.Errorf("index for target vector %q not found", targetVector)
+	}
+	if vidx.Multivector() {
+		return fmt.Errorf("near duplicates are not supported for multi vector %q", targetVector)
+	}
+
+	if finder, ok := vidx.(nearDuplicateFinder); ok {
+		return finder.NearDuplicates(ctx, maxDist, func(a, b uint64, dist float32) error {
+			idA, err := s.uuidFromDocIDIfExists(a)
+			if err != nil || idA == "" {
+				return err
+			}
+			idB, err := s.uuidFromDocIDIfExists(b)
+			if err != nil || idB == "" {
+				return err
+			}
+			return fn(idA, idB, dist)
+		})
+	}
+
+	return s.forEachVector(ctx, vidx, targetVector, func(docID uint64, id strfmt.UUID, vector []float32) error {
+		docIDs, dists, err := vidx.SearchByVectorDistance(ctx, vector, maxDist,
+			s.index.Config.QueryMaximumResults, nil)
+		if err != nil {
+			return fmt.Errorf("search near duplicates of %s: %w", id, err)
+		}
+
+		for j, other := range docIDs {
+			// every pair is found from both sides, only report it once
+			if other <= docID {
+				continue
+			}
+			otherID, err := s.uuidFromDocIDIfExists(other)
+			if err != nil {
+				return err
+			}
+			if otherID == "" {
+				continue
+			}
+			if err := fn(id, otherID, dists[j]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ForEachVector calls fn with the id and the vector of every object indexed
+// for targetVector. Consistency or order is not guaranteed, as the shard may
+// be concurrently modified.
+func (s *Shard) ForEachVector(ctx context.Context, targetVector string,
+	fn func(id strfmt.UUID, vector []float32) error,
+) error {
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return fmt.Errorf("index for target vector %q not found", targetVector)
+	}
+	if vidx.Multivector() {
+		return fmt.Errorf("iterating multi vector %q is not supported", targetVector)
+	}
+
+	return s.forEachVector(ctx, vidx, targetVector, func(_ uint64, id strfmt.UUID, vector []float32) error {
+		return fn(id, vector)
+	})
+}
+
+func (s *Shard) forEachVector(ctx context.Context, vidx VectorIndex, targetVector string,
+	fn func(docID uint64, id strfmt.UUID, vector []float32) error,
+) error {
+	var iterErr error
+	vidx.Iterate(func(docID uint64) bool {
+		if iterErr = ctx.Err(); iterErr != nil {
+			return false
+		}
+
+		id, err := s.uuidFromDocIDIfExists(docID)
+		if err != nil {
+			iterErr = err
+			return false
+		}
+		if id == "" {
+			return true
+		}
+
+		vector, err := s.vectorByIndexID(ctx, docID, targetVector)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				return true
+			}
+			iterErr = fmt.Errorf("get vector of docID %d: %w", docID, err)
+			return false
+		}
+		if len(vector) == 0 {
+			return true
+		}
+
+		iterErr = fn(docID, id, vector)
+		return iterErr == nil
+	})
+
+	return iterErr
+}
+
+// uuidFromDocIDIfExists is like uuidFromDocID, but returns an empty id
+// instead of an error if the object does not exist anymore.
+func (s *Shard) uuidFromDocIDIfExists(docID uint64) (strfmt.UUID, error) {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return "", fmt.Errorf("objects bucket not found")
+	}
+
+	keyBuf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(keyBuf, docID)
+	res, err := bucket.GetBySecondary(0, keyBuf)
+	if err != nil {
+		return "", fmt.Errorf("get object by doc id: %w", err)
+	}
+	if res == nil {
+		return "", nil
+	}
+
+	prop, _, err := storobj.ParseAndExtractProperty(res, "id")
+	if err != nil {
+		return "", fmt.Errorf("parse and extract property: %w", err)
+	}
+
+	return strfmt.UUID(prop[0]), nil
+}
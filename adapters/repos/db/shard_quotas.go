@@ -0,0 +1,262 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// tenantQuotaRefreshInterval is how long quotas and usage of a tenant are
+// cached on the write path. Changed quotas take effect after at most this
+// interval.
+const tenantQuotaRefreshInterval = 10 * time.Second
+
+// tenantUsage is what a tenant stores, as far as it is limited by quotas
+type tenantUsage struct {
+	objects    int64
+	bytes      int64
+	dimensions int64
+}
+
+// tenantUsageSource provides the quotas and the usage of a tenant
+type tenantUsageSource interface {
+	quotas() *models.TenantQuotas
+	// usage calculates the actual usage, but only for limited resources
+	usage(quotas *models.TenantQuotas) (tenantUsage, error)
+	// newObjects returns the usage added by those objects that don't exist yet
+	newObjects(objects []*storobj.Object) (tenantUsage, error)
+}
+
+// tenantQuotaChecker enforces the quotas of a tenant on the write path.
+// Calculating the usage is too expensive to do for every write, so it is
+// refreshed periodically and estimated in between by adding what was written.
+// Objects are only counted if they are new, i.e. updates of existing objects
+// are always possible. As the size of objects on disk isn't known upfront,
+// the bytes quota rejects writes once the tenant reached its limit.
+type tenantQuotaChecker struct {
+	sync.Mutex
+	quotas      *models.TenantQuotas
+	usage       tenantUsage
+	estimated   bool
+	lastRefresh time.Time
+}
+
+func (c *tenantQuotaChecker) check(now time.Time, src tenantUsageSource,
+	objects []*storobj.Object,
+) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if now.Sub(c.lastRefresh) > tenantQuotaRefreshInterval {
+		if err := c.refresh(now, src); err != nil {
+			return err
+		}
+	}
+	if c.quotas == nil {
+		return nil
+	}
+
+	// assume that all objects are new, only if that would exceed the quotas
+	// look up which of them actually are
+	added := incomingUsage(objects)
+	if c.exceeded(added) != nil {
+		var err error
+		if added, err = src.newObjects(objects); err != nil {
+			return fmt.Errorf("check tenant quotas: %w", err)
+		}
+	}
+
+	// the estimate only ever grows, so only if it's too high to accept the
+	// write it's worth checking the actual usage
+	err := c.exceeded(added)
+	if err != nil && c.estimated {
+		if err := c.refresh(now, src); err != nil {
+			return err
+		}
+		err = c.exceeded(added)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.usage.objects += added.objects
+	c.usage.dimensions += added.dimensions
+	c.estimated = true
+	return nil
+}
+
+func (c *tenantQuotaChecker) refresh(now time.Time, src tenantUsageSource) error {
+	c.quotas = src.quotas()
+	c.usage = tenantUsage{}
+	c.estimated = false
+	c.lastRefresh = now
+	if c.quotas == nil {
+		return nil
+	}
+
+	usage, err := src.usage(c.quotas)
+	if err != nil {
+		// retry with the next write
+		c.lastRefresh = time.Time{}
+		return fmt.Errorf("calculate tenant usage: %w", err)
+	}
+	c.usage = usage
+	return nil
+}
+
+func (c *tenantQuotaChecker) exceeded(added tenantUsage) error {
+	q := c.quotas
+	switch {
+	case q.MaxObjects > 0 && c.usage.objects+added.objects > q.MaxObjects:
+		return fmt.Errorf("%w: storing %d more objects would exceed the limit of %d objects",
+			enterrors.ErrTenantQuotaExceeded, added.objects, q.MaxObjects)
+	case q.MaxVectorDimensions > 0 && c.usage.dimensions+added.dimensions > q.MaxVectorDimensions:
+		return fmt.Errorf("%w: storing %d more vector dimensions would exceed the limit of %d vector dimensions",
+			enterrors.ErrTenantQuotaExceeded, added.dimensions, q.MaxVectorDimensions)
+	case q.MaxBytes > 0 && c.usage.bytes >= q.MaxBytes:
+		return fmt.Errorf("%w: the tenant reached the limit of %d bytes",
+			enterrors.ErrTenantQuotaExceeded, q.MaxBytes)
+	default:
+		return nil
+	}
+}
+
+func incomingUsage(objects []*storobj.Object) tenantUsage {
+	usage := tenantUsage{objects: int64(len(objects))}
+	for _, obj := range objects {
+		obj.IterateThroughVectorDimensions(func(_ string, dims int) error {
+			usage.dimensions += int64(dims)
+			return nil
+		})
+	}
+	return usage
+}
+
+// checkTenantQuotas returns an error wrapping ErrTenantQuotaExceeded if
+// storing the objects would exceed the quotas of the tenant. Shards of
+// collections without multi-tenancy have no quotas.
+func (s *Shard) checkTenantQuotas(ctx context.Context, objects []*storobj.Object) error {
+	if !s.index.partitioningEnabled {
+		return nil
+	}
+	return s.tenantQuotas.check(time.Now(), &shardUsageSource{ctx: ctx, shard: s}, objects)
+}
+
+type shardUsageSource struct {
+	ctx   context.Context
+	shard *Shard
+}
+
+func (u *shardUsageSource) quotas() *models.TenantQuotas {
+	return u.shard.index.getSchema.TenantQuotas(u.shard.index.Config.ClassName.String(), u.shard.name)
+}
+
+func (u *shardUsageSource) usage(quotas *models.TenantQuotas) (tenantUsage, error) {
+	var usage tenantUsage
+	if quotas.MaxObjects > 0 {
+		usage.objects = int64(u.shard.ObjectCountAsync())
+	}
+	if quotas.MaxVectorDimensions > 0 {
+		usage.dimensions = u.shard.totalVectorDimensions(u.ctx)
+	}
+	if quotas.MaxBytes > 0 {
+		size, err := dirSize(u.shard.path())
+		if err != nil {
+			return usage, err
+		}
+		usage.bytes = size
+	}
+	return usage, nil
+}
+
+func (u *shardUsageSource) newObjects(objects []*storobj.Object) (tenantUsage, error) {
+	bucket := u.shard.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return incomingUsage(objects), nil
+	}
+
+	var newObjects []*storobj.Object
+	for _, obj := range objects {
+		id, err := parseBytesUUID(obj.ID())
+		if err != nil {
+			return tenantUsage{}, err
+		}
+		existing, err := bucket.Get(id)
+		if err != nil {
+			return tenantUsage{}, err
+		}
+		if existing == nil {
+			newObjects = append(newObjects, obj)
+		}
+	}
+	return incomingUsage(newObjects), nil
+}
+
+// totalVectorDimensions sums up the dimensions of all vectors of all objects
+func (s *Shard) totalVectorDimensions(ctx context.Context) int64 {
+	b := s.store.Bucket(helpers.DimensionsBucketLSM)
+	if b == nil {
+		return 0
+	}
+
+	c := b.MapCursor()
+	defer c.Close()
+
+	var sum int64
+	for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
+		// keys are the name of the target vector followed by the dimensions
+		if len(k) < 4 {
+			continue
+		}
+		dimLength := binary.LittleEndian.Uint32(k[len(k)-4:])
+		sum += int64(dimLength) * int64(len(v))
+	}
+	return sum
+}
+
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files come and go e.g. due to compactions
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+type fakeTenantUsageSource struct {
+	tenantQuotas *models.TenantQuotas
+	actual       tenantUsage
+	existing     map[strfmt.UUID]struct{}
+	usageCalls   int
+}
+
+func (f *fakeTenantUsageSource) quotas() *models.TenantQuotas {
+	return f.tenantQuotas
+}
+
+func (f *fakeTenantUsageSource) usage(*models.TenantQuotas) (tenantUsage, error) {
+	f.usageCalls++
+	return f.actual, nil
+}
+
+func (f *fakeTenantUsageSource) newObjects(objects []*storobj.Object) (tenantUsage, error) {
+	var newObjects []*storobj.Object
+	for _, obj := range objects {
+		if _, ok := f.existing[obj.ID()]; !ok {
+			newObjects = append(newObjects, obj)
+		}
+	}
+	return incomingUsage(newObjects), nil
+}
+
+func quotaTestObject(id strfmt.UUID, dims int) *storobj.Object {
+	obj := storobj.FromObject(&models.Object{ID: id, Class: "Test"}, nil, nil, nil)
+	if dims > 0 {
+		obj.Vector = make([]float32, dims)
+	}
+	return obj
+}
+
+func TestTenantQuotaChecker(t *testing.T) {
+	now := time.Now()
+	id1 := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+	id2 := strfmt.UUID("9a1d3aa2-3c8d-4589-9ae1-3f638f506970")
+
+	t.Run("without quotas everything is accepted", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{actual: tenantUsage{objects: 1000}}
+
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id1, 3)}))
+		assert.Equal(t, 0, src.usageCalls)
+	})
+
+	t.Run("max objects", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{
+			tenantQuotas: &models.TenantQuotas{MaxObjects: 2},
+			actual:       tenantUsage{objects: 1},
+			existing:     map[strfmt.UUID]struct{}{id1: {}},
+		}
+
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id2, 0)}))
+		src.actual.objects = 2
+		src.existing[id2] = struct{}{}
+		err := c.check(now, src, []*storobj.Object{quotaTestObject(strfmt.UUID("7c5a3aa2-3c8d-4589-9ae1-3f638f506970"), 0)})
+		require.ErrorIs(t, err, enterrors.ErrTenantQuotaExceeded)
+
+		// updates of existing objects are always possible
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id1, 0)}))
+	})
+
+	t.Run("estimate is corrected by the actual usage", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{
+			tenantQuotas: &models.TenantQuotas{MaxObjects: 1},
+		}
+
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id1, 0)}))
+		// the object was deleted in the meantime
+		src.actual = tenantUsage{}
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id2, 0)}))
+		assert.Equal(t, 2, src.usageCalls)
+	})
+
+	t.Run("max vector dimensions", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{
+			tenantQuotas: &models.TenantQuotas{MaxVectorDimensions: 10},
+			actual:       tenantUsage{dimensions: 4},
+		}
+
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id1, 6)}))
+		src.actual.dimensions = 10
+		err := c.check(now, src, []*storobj.Object{quotaTestObject(id2, 1)})
+		require.ErrorIs(t, err, enterrors.ErrTenantQuotaExceeded)
+	})
+
+	t.Run("max bytes", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{
+			tenantQuotas: &models.TenantQuotas{MaxBytes: 100},
+			actual:       tenantUsage{bytes: 99},
+		}
+
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id1, 0)}))
+
+		// only picked up after the next refresh
+		src.actual.bytes = 100
+		require.NoError(t, c.check(now, src, []*storobj.Object{quotaTestObject(id2, 0)}))
+		err := c.check(now.Add(2*tenantQuotaRefreshInterval), src, []*storobj.Object{quotaTestObject(id2, 0)})
+		require.ErrorIs(t, err, enterrors.ErrTenantQuotaExceeded)
+	})
+
+	t.Run("changed quotas are picked up after refresh", func(t *testing.T) {
+		var c tenantQuotaChecker
+		src := &fakeTenantUsageSource{
+			tenantQuotas: &models.TenantQuotas{MaxObjects: 1},
+			actual:       tenantUsage{objects: 1},
+		}
+
+		err := c.check(now, src, []*storobj.Object{quotaTestObject(id1, 0)})
+		require.ErrorIs(t, err, enterrors.ErrTenantQuotaExceeded)
+
+		src.tenantQuotas = nil
+		require.NoError(t, c.check(now.Add(2*tenantQuotaRefreshInterval), src,
+			[]*storobj.Object{quotaTestObject(id1, 0)}))
+	})
+}
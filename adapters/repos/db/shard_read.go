@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -326,7 +327,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 	s.activityTrackerRead.Add(1)
 	if keywordRanking != nil {
-		if v := s.versioner.Version(); v < 2 {
+		if v := s.versioner.Version(); v < 2 && keywordRanking.Type != "sparse" {
 			return nil, nil, errors.Errorf(
 				"shard was built with an older version of " +
 					"Weaviate which does not yet support BM25 search")
@@ -352,6 +353,10 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			defer objs.Close()
 		}
 
+		if keywordRanking.Type == "sparse" {
+			return s.sparseVectorSearch(ctx, limit, keywordRanking.SparseVector, filterDocIds, additional)
+		}
+
 		className := s.index.Config.ClassName
 		bm25Config := s.index.GetInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
@@ -751,6 +756,10 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 		return errors.Wrap(err, "delete object from bucket")
 	}
 
+	if err = s.mayRecordChange(changes.OperationDelete, idBytes, deletionTime.UnixMilli(), nil); err != nil {
+		return err
+	}
+
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
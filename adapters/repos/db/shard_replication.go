@@ -82,6 +82,11 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	if err := s.checkTenantQuotas(ctx, []*storobj.Object{object}); err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
 		if err := s.putOne(ctx, uuid, object); err != nil {
@@ -136,6 +141,11 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 }
 
 func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects []*storobj.Object) replica.SimpleResponse {
+	if err := s.checkTenantQuotas(ctx, objects); err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		rawErrs := s.putBatch(ctx, objects)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
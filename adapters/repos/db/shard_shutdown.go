@@ -161,7 +161,12 @@ func (s *Shard) performShutdown(ctx context.Context) (err error) {
 		s.stopDimensionTracking <- struct{}{}
 	}
 
-	return ec.ToError()
+	if err := ec.ToError(); err != nil {
+		return err
+	}
+
+	s.releaseEncryption()
+	return nil
 }
 
 func (s *Shard) preventShutdown() (release func(), err error) {
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// sparseVectorWeightScale is applied to the weights of sparse vectors before
+// they are stored as frequencies in the inverted bucket, as those are
+// truncated to integers when read. Weights smaller than 1/scale are dropped.
+const sparseVectorWeightScale = 1e4
+
+// sparseVectorTerm is the key of the posting list of a sparse vector
+// dimension
+func sparseVectorTerm(index uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, index)
+	return key
+}
+
+// sparseVectorPosting encodes a weight the way the inverted strategy expects
+// term frequencies, the property length is always 1. The second return value
+// is false if the weight is too small to be stored.
+func sparseVectorPosting(docID uint64, weight float32) (lsmkv.MapPair, bool) {
+	tf := float32(math.Round(float64(weight) * sparseVectorWeightScale))
+	if tf < 1 {
+		return lsmkv.MapPair{}, false
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(tf))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(1))
+
+	return lsmkv.MapPair{Key: key, Value: value}, true
+}
+
+func isSparseVectorConfig(cfg interface{}) bool {
+	_, ok := cfg.(sparseent.UserConfig)
+	return ok
+}
+
+func (s *Shard) initSparseVectorBucket(ctx context.Context, targetVector string, lazyLoadSegments bool) error {
+	return s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketSparseVectorFromTargetVectorLSM(targetVector),
+		s.memtableDirtyConfig(),
+		s.dynamicMemtableSizing(),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		lsmkv.WithSegmentsChecksumValidationEnabled(s.index.Config.LSMEnableSegmentsChecksumValidation),
+		lsmkv.WithMinMMapSize(s.index.Config.MinMMapSize),
+		lsmkv.WithMinWalThreshold(s.index.Config.MaxReuseWalSize),
+		lsmkv.WithLazySegmentLoading(lazyLoadSegments),
+		s.segmentCleanupConfig(),
+		lsmkv.WithStrategy(lsmkv.StrategyInverted),
+	)
+}
+
+func (s *Shard) sparseVectorBucket(targetVector string) (*lsmkv.Bucket, error) {
+	if !isSparseVectorConfig(s.index.GetVectorIndexConfig(targetVector)) {
+		return nil, fmt.Errorf("target vector %q is not a sparse vector", targetVector)
+	}
+	bucket := s.store.Bucket(helpers.BucketSparseVectorFromTargetVectorLSM(targetVector))
+	if bucket == nil {
+		return nil, fmt.Errorf("sparse vector bucket for target vector %q not found", targetVector)
+	}
+	return bucket, nil
+}
+
+func (s *Shard) validateSparseVector(targetVector string, vector models.SparseVector) error {
+	if !isSparseVectorConfig(s.index.GetVectorIndexConfig(targetVector)) {
+		return fmt.Errorf("target vector %q is not a sparse vector", targetVector)
+	}
+	return sparseent.ValidateVector(vector.Indices, vector.Values)
+}
+
+func (s *Shard) validateSparseVectors(obj *storobj.Object) error {
+	for targetVector, vector := range obj.SparseVectors {
+		if err := s.validateSparseVector(targetVector, vector); err != nil {
+			return fmt.Errorf("validate sparse vector %s of %s: %w", targetVector, obj.ID(), err)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) extendSparseVectorsLSM(vectors map[string]models.SparseVector, docID uint64) error {
+	for targetVector, vector := range vectors {
+		bucket, err := s.sparseVectorBucket(targetVector)
+		if err != nil {
+			return err
+		}
+		for i, index := range vector.Indices {
+			pair, ok := sparseVectorPosting(docID, vector.Values[i])
+			if !ok {
+				continue
+			}
+			if err := bucket.MapSet(sparseVectorTerm(index), pair); err != nil {
+				return fmt.Errorf("add sparse vector %s: %w", targetVector, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteSparseVectorsLSM(vectors map[string]models.SparseVector, docID uint64) error {
+	docIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(docIDBytes, docID)
+
+	for targetVector, vector := range vectors {
+		bucket := s.store.Bucket(helpers.BucketSparseVectorFromTargetVectorLSM(targetVector))
+		if bucket == nil {
+			// the target vector does not exist (anymore), nothing to clean up
+			continue
+		}
+		for _, index := range vector.Indices {
+			if err := bucket.MapDeleteKey(sparseVectorTerm(index), docIDBytes); err != nil {
+				return fmt.Errorf("delete sparse vector %s: %w", targetVector, err)
+			}
+		}
+	}
+	return nil
+}
+
+func sparseVectorsEqual(prev, next map[string]models.SparseVector) bool {
+	if len(prev) == 0 && len(next) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(prev, next)
+}
+
+// sparseVectorSearch returns the objects with the highest dot product
+// between their sparse vector and the query, together with the dot products.
+// It runs a block-max WAND search over the posting lists of the query's
+// dimensions.
+func (s *Shard) sparseVectorSearch(ctx context.Context, limit int, query *searchparams.NearSparseVector,
+	filterDocIds helpers.AllowList, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	if query == nil {
+		return nil, nil, fmt.Errorf("sparse vector search: no sparse vector given")
+	}
+	if err := sparseent.ValidateVector(query.Indices, query.Values); err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	bucket, err := s.sparseVectorBucket(query.TargetVector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+
+	keys := make([][]byte, 0, len(query.Indices))
+	weights := make([]float64, 0, len(query.Indices))
+	for i, index := range query.Indices {
+		if query.Values[i] == 0 {
+			continue
+		}
+		keys = append(keys, sparseVectorTerm(index))
+		// undo the scaling of the stored weights, see sparseVectorPosting
+		weights = append(weights, float64(query.Values[i])/sparseVectorWeightScale)
+	}
+	if len(keys) == 0 || limit <= 0 {
+		return nil, nil, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	terms, release, err := bucket.CreateSparseTerms(filterDocIds, keys, weights)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	topKHeap := lsmkv.DoBlockMaxWand(limit, terms, 1, false, len(keys), 0)
+	release()
+
+	// the heap pops the lowest score first
+	ids := make([]uint64, topKHeap.Len())
+	scores := make([]float32, topKHeap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := topKHeap.Pop()
+		ids[i] = item.ID
+		scores[i] = item.Dist
+	}
+
+	objs, err := storobj.ObjectsByDocID(s.store.Bucket(helpers.ObjectsBucketLSM), ids,
+		additional, nil, s.index.logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: load objects: %w", err)
+	}
+
+	// objects deleted in the meantime are skipped
+	if len(objs) != len(ids) {
+		j := 0
+		for i := range ids {
+			if j < len(objs) && objs[j].DocID == ids[i] {
+				scores[j] = scores[i]
+				j++
+			}
+		}
+		scores = scores[:j]
+	}
+
+	return objs, scores, nil
+}
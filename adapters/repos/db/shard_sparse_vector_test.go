@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func TestShard_SparseVectors(t *testing.T) {
+	ctx := context.Background()
+	className := "SparseVectorTest"
+	targetVector := "splade"
+
+	shard, idx := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		enthnsw.UserConfig{Skip: true}, false, false, func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				targetVector: sparse.NewDefaultUserConfig(),
+			}
+		})
+	defer func() { require.Nil(t, idx.drop()) }()
+
+	vectors := []models.SparseVector{
+		{Indices: []uint32{1, 2}, Values: []float32{1, 0.5}},
+		{Indices: []uint32{2, 3}, Values: []float32{2, 1}},
+		{Indices: []uint32{3}, Values: []float32{4}},
+	}
+	objs := make([]*storobj.Object, len(vectors))
+	for i, vec := range vectors {
+		objs[i] = testObject(className)
+		objs[i].SparseVectors = map[string]models.SparseVector{targetVector: vec}
+		require.Nil(t, shard.PutObject(ctx, objs[i]))
+	}
+
+	search := func(t *testing.T, indices []uint32, values []float32) ([]*storobj.Object, []float32) {
+		res, scores, err := shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type: "sparse",
+			SparseVector: &searchparams.NearSparseVector{
+				Indices:      indices,
+				Values:       values,
+				TargetVector: targetVector,
+			},
+		}, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		return res, scores
+	}
+
+	ids := func(objs []*storobj.Object) []string {
+		out := make([]string, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID().String()
+		}
+		return out
+	}
+
+	t.Run("search returns dot products in descending order", func(t *testing.T) {
+		res, scores := search(t, []uint32{2, 3}, []float32{1, 1})
+		assert.Equal(t, ids([]*storobj.Object{objs[2], objs[1], objs[0]}), ids(res))
+		assert.InDeltaSlice(t, []float32{4, 3, 0.5}, scores, 1e-3)
+	})
+
+	t.Run("stored sparse vectors are returned with the object", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, objs[0].ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, vectors[0], obj.SparseVectors[targetVector])
+	})
+
+	t.Run("updates replace the previous postings", func(t *testing.T) {
+		updated := testObject(className)
+		updated.Object.ID = objs[2].ID()
+		updated.SparseVectors = map[string]models.SparseVector{
+			targetVector: {Indices: []uint32{1}, Values: []float32{3}},
+		}
+		require.Nil(t, shard.PutObject(ctx, updated))
+
+		res, scores := search(t, []uint32{1, 3}, []float32{1, 1})
+		assert.Equal(t, ids([]*storobj.Object{objs[2], objs[0], objs[1]}), ids(res))
+		assert.InDeltaSlice(t, []float32{3, 1, 1}, scores, 1e-3)
+	})
+
+	t.Run("deleted objects are not found", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, objs[1].ID(), time.Now()))
+
+		res, _ := search(t, []uint32{2, 3}, []float32{1, 1})
+		assert.Equal(t, ids([]*storobj.Object{objs[0]}), ids(res))
+	})
+
+	t.Run("invalid sparse vectors are rejected", func(t *testing.T) {
+		obj := testObject(className)
+		obj.SparseVectors = map[string]models.SparseVector{
+			targetVector: {Indices: []uint32{1, 1}, Values: []float32{1, 2}},
+		}
+		err := shard.PutObject(ctx, obj)
+		assert.ErrorContains(t, err, "more than once")
+	})
+}
@@ -37,6 +37,14 @@ func (s *Shard) PutObjectBatch(ctx context.Context,
 	if err := s.isReadOnly(); err != nil {
 		return []error{err}
 	}
+	// batches are accepted or rejected as a whole
+	if err := s.checkTenantQuotas(ctx, objects); err != nil {
+		errs := make([]error, len(objects))
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
 
 	return s.putBatch(ctx, objects)
 }
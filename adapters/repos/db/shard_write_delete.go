@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -60,6 +61,10 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
 
+	if err = s.mayRecordChange(changes.OperationDelete, idBytes, deletionTime.UnixMilli(), nil); err != nil {
+		return err
+	}
+
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
@@ -116,6 +121,10 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 
+	if err = s.deleteSparseVectorsLSM(previousObject.SparseVectors, docID); err != nil {
+		return fmt.Errorf("delete sparse vectors: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		err = previousObject.IterateThroughVectorDimensions(func(targetVector string, dims int) error {
 			if err = s.removeDimensionsLSM(dims, docID, targetVector); err != nil {
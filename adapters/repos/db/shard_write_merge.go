@@ -18,6 +18,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -33,6 +35,12 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 
 	for targetVector, vector := range merge.Vectors {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
+		if v, ok := vector.(models.SparseVector); ok {
+			if err := s.validateSparseVector(targetVector, v); err != nil {
+				return errors.Wrapf(err, "validate sparse vector for update of %v for target vector %s", merge.ID, targetVector)
+			}
+			continue
+		}
 		vectorIndex, ok := s.GetVectorIndex(targetVector)
 		if !ok {
 			return errors.Errorf("validate vector index for update of %v for target vector %s: vector index not found", merge.ID, targetVector)
@@ -48,6 +56,11 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 			if err != nil {
 				return errors.Wrapf(err, "validate multi vector index for update of %v for target vector %s", merge.ID, targetVector)
 			}
+		case dto.CompactVector:
+			err := vectorIndex.ValidateBeforeInsert(v.Float32())
+			if err != nil {
+				return errors.Wrapf(err, "validate vector index for update of %v for target vector %s", merge.ID, targetVector)
+			}
 		default:
 			return errors.Errorf("validate vector index for update of %v for target vector %s: unrecongnized vector type: %T", merge.ID, targetVector, vector)
 		}
@@ -168,7 +181,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return errors.Wrap(err, "upsert object data")
 		}
 
-		return nil
+		return s.mayRecordChange(changes.OperationUpdate, idBytes, obj.Object.LastUpdateTimeUnix, objBytes)
 	}(); err != nil {
 		return nil, objectInsertStatus{}, err
 	} else if status.skipUpsert {
@@ -247,6 +260,10 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
+	if err := s.mayRecordChange(changes.OperationUpdate, idBytes, obj.Object.LastUpdateTimeUnix, objBytes); err != nil {
+		return out, err
+	}
+
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
 
@@ -271,7 +288,11 @@ func (s *Shard) mergeObjectData(prevObj *storobj.Object,
 		prevObj.SetID(merge.ID)
 	}
 
-	return mergeProps(prevObj, merge), prevObj, nil
+	next := mergeProps(prevObj, merge)
+	if err := s.compactVectors(next); err != nil {
+		return nil, nil, errors.Wrap(err, "validate vector data type")
+	}
+	return next, prevObj, nil
 }
 
 func mergeProps(previous *storobj.Object,
@@ -313,9 +334,11 @@ func mergeProps(previous *storobj.Object,
 	if len(merge.Vectors) == 0 {
 		next.Vectors = previous.Vectors
 		next.MultiVectors = previous.MultiVectors
+		next.SparseVectors = previous.SparseVectors
 	} else {
 		next.Vectors = vectorsAsMap(merge.Vectors)
 		next.MultiVectors = multiVectorsAsMap(merge.Vectors)
+		next.SparseVectors = sparseVectorsAsMap(merge.Vectors)
 	}
 
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
@@ -328,8 +351,11 @@ func vectorsAsMap(in models.Vectors) map[string][]float32 {
 	if len(in) > 0 {
 		out := make(map[string][]float32)
 		for targetVector, vector := range in {
-			if v, ok := vector.([]float32); ok {
+			switch v := vector.(type) {
+			case []float32:
 				out[targetVector] = v
+			case dto.CompactVector:
+				out[targetVector] = v.Float32()
 			}
 		}
 		return out
@@ -349,3 +375,16 @@ func multiVectorsAsMap(in models.Vectors) map[string][][]float32 {
 	}
 	return nil
 }
+
+func sparseVectorsAsMap(in models.Vectors) map[string]models.SparseVector {
+	if len(in) > 0 {
+		out := make(map[string]models.SparseVector)
+		for targetVector, vector := range in {
+			if v, ok := vector.(models.SparseVector); ok {
+				out[targetVector] = v
+			}
+		}
+		return out
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -35,6 +36,9 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 	if err := s.isReadOnly(); err != nil {
 		return err
 	}
+	if err := s.checkTenantQuotas(ctx, []*storobj.Object{object}); err != nil {
+		return err
+	}
 	uid, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -211,6 +215,10 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 	before := time.Now()
 	defer s.metrics.PutObject(before)
 
+	if err := s.compactVectors(obj); err != nil {
+		return status, errors.Wrap(err, "validate vector data type")
+	}
+
 	for targetVector, vector := range obj.Vectors {
 		if vectorIndex, ok := s.GetVectorIndex(targetVector); ok {
 			if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
@@ -236,6 +244,10 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 	}
 
+	if err := s.validateSparseVectors(obj); err != nil {
+		return status, err
+	}
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	var prevObj *storobj.Object
 
@@ -279,7 +291,11 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 		s.metrics.PutObjectUpsertObject(before)
 
-		return nil
+		op := changes.OperationInsert
+		if prevObj != nil {
+			op = changes.OperationUpdate
+		}
+		return s.mayRecordChange(op, idBytes, obj.Object.LastUpdateTimeUnix, objBinary)
 	}(); err != nil {
 		return objectInsertStatus{}, err
 	} else if status.skipUpsert {
@@ -496,6 +512,9 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 		if err := s.deleteFromInvertedIndicesLSM(propsToDel, nilpropsToDel, status.oldDocID); err != nil {
 			return fmt.Errorf("delete inverted indices props: %w", err)
 		}
+		if err := s.deleteSparseVectorsLSM(prevObject.SparseVectors, status.oldDocID); err != nil {
+			return fmt.Errorf("delete sparse vectors: %w", err)
+		}
 		if s.index.Config.TrackVectorDimensions {
 			err = prevObject.IterateThroughVectorDimensions(func(targetVector string, dims int) error {
 				if err = s.removeDimensionsLSM(dims, status.oldDocID, targetVector); err != nil {
@@ -515,6 +534,10 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 	s.metrics.InvertedExtend(before, len(propsToAdd))
 
+	if err := s.extendSparseVectorsLSM(object.SparseVectors, status.docID); err != nil {
+		return fmt.Errorf("put sparse vectors: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		err = object.IterateThroughVectorDimensions(func(targetVector string, dims int) error {
 			if err = s.extendDimensionTrackerLSM(dims, status.docID, targetVector); err != nil {
@@ -551,6 +574,10 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetMultiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
 		return false, false
 	}
+	// sparse vectors live in an inverted bucket, which can be updated in place
+	if !sparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return true, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
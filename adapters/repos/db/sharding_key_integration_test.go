@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestShardingKey(t *testing.T) {
+	ctx := context.Background()
+	repo, logger := setupMultiShardTest(t)
+	defer repo.Shutdown(ctx)
+
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{"key": "region", "desiredCount": 4}, 4)
+	require.Nil(t, err)
+	state, err := sharding.InitState("ShardedByKey", cfg, "node1", []string{"node1"}, 1, false)
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class:               "ShardedByKey",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{Name: "region", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{}},
+		shardState: state,
+	}
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, state))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	// pick two values which end up in different shards
+	shardOf := func(value string) string {
+		key, err := sharding.KeyValue(value)
+		require.Nil(t, err)
+		return schemaGetter.ShardFromUUID(class.Class, key)
+	}
+	eu, us := "eu", ""
+	for _, candidate := range []string{"us", "apac", "latam", "mea", "anz", "emea"} {
+		if shardOf(candidate) != shardOf(eu) {
+			us = candidate
+			break
+		}
+	}
+	require.NotEmpty(t, us)
+
+	object := func(id strfmt.UUID, region string) *models.Object {
+		return &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"region": region},
+		}
+	}
+	first := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+	second := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506971")
+
+	t.Run("objects are routed by the sharding key", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, object(first, eu), []float32{1, 2, 3}, nil, nil, nil, 0))
+
+		res, err := repo.ObjectByID(ctx, first, search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, eu, res.Schema.(map[string]interface{})["region"])
+	})
+
+	t.Run("a put cannot change the sharding key of the hinted object", func(t *testing.T) {
+		err := repo.PutObject(sharding.ContextWithKeyHint(ctx, eu), object(first, us), []float32{1, 2, 3}, nil, nil, nil, 0)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cannot be changed")
+	})
+
+	t.Run("ids are unique per sharding key", func(t *testing.T) {
+		batch := objects.BatchObjects{
+			{OriginalIndex: 0, Object: object(first, us), UUID: first},
+			{OriginalIndex: 1, Object: object(second, us), UUID: second},
+		}
+		res, err := repo.BatchPutObjects(ctx, batch, nil, 0)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+
+		for _, region := range []string{eu, us} {
+			res, err := repo.ObjectByID(sharding.ContextWithKeyHint(ctx, region), first,
+				search.SelectProperties{}, additional.Properties{}, "")
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, region, res.Schema.(map[string]interface{})["region"])
+		}
+	})
+
+	t.Run("requests by id are routed by the hint", func(t *testing.T) {
+		res, err := repo.ObjectByID(sharding.ContextWithKeyHint(ctx, eu), second,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		require.Nil(t, repo.DeleteObject(sharding.ContextWithKeyHint(ctx, us), class.Class, first, time.Now(), nil, "", 0))
+		res, err = repo.ObjectByID(sharding.ContextWithKeyHint(ctx, eu), first,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		res, err = repo.ObjectByID(sharding.ContextWithKeyHint(ctx, us), first,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("objects are located across shards", func(t *testing.T) {
+		missing := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506972")
+		res, err := repo.MultiGet(ctx, []multi.Identifier{
+			{ID: second.String(), ClassName: class.Class},
+			{ID: missing.String(), ClassName: class.Class},
+			{ID: first.String(), ClassName: class.Class},
+		}, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, second, res[0].ID)
+		assert.Empty(t, res[1].ID)
+		assert.Equal(t, first, res[2].ID)
+		assert.Equal(t, eu, res[2].Schema.(map[string]interface{})["region"])
+	})
+}
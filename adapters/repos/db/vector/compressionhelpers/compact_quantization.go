@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// ByteVectorQuantizer indexes int8 and uint8 vectors in their compact form,
+// one byte per dimension. Unlike the other quantizers it is lossless, the
+// codes are the vectors themselves and all distances are exact.
+type ByteVectorQuantizer struct {
+	signed   bool
+	distance func(x, y []byte) (float32, error)
+}
+
+func NewByteVectorQuantizer(dataType string, provider distancer.Provider) (*ByteVectorQuantizer, error) {
+	q := &ByteVectorQuantizer{}
+	switch dataType {
+	case entcommon.VectorDataTypeInt8:
+		q.signed = true
+		switch provider.Type() {
+		case "l2-squared":
+			q.distance = distancer.L2SquaredInt8
+		case "dot":
+			q.distance = distancer.DotProductInt8
+		}
+	case entcommon.VectorDataTypeUint8:
+		switch provider.Type() {
+		case "l2-squared":
+			q.distance = func(x, y []byte) (float32, error) {
+				return float32(l2SquaredByteImpl(x, y)), nil
+			}
+		case "dot":
+			q.distance = func(x, y []byte) (float32, error) {
+				return -float32(dotByteImpl(x, y)), nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("data type %q is not stored as bytes", dataType)
+	}
+	if q.distance == nil {
+		return nil, fmt.Errorf("distance %q is not supported for data type %q", provider.Type(), dataType)
+	}
+	return q, nil
+}
+
+func (q *ByteVectorQuantizer) Encode(vec []float32) []byte {
+	code := make([]byte, len(vec))
+	for i, v := range vec {
+		if q.signed {
+			code[i] = byte(int8(v))
+		} else {
+			code[i] = byte(v)
+		}
+	}
+	return code
+}
+
+func (q *ByteVectorQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, fmt.Errorf("vector lengths don't match: %d vs %d", len(x), len(y))
+	}
+	return q.distance(x, y)
+}
+
+func (q *ByteVectorQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return q.NewCompressedQuantizerDistancer(q.Encode(a))
+}
+
+func (q *ByteVectorQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &compactDistancer[byte]{q: q, compressed: a}
+}
+
+func (q *ByteVectorQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (q *ByteVectorQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *ByteVectorQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *ByteVectorQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+// PersistCompression is a no-op, the quantizer is fully described by the
+// data type and distance of the index config
+func (q *ByteVectorQuantizer) PersistCompression(logger CommitLogger) {}
+
+func (q *ByteVectorQuantizer) Stats() CompressionStats {
+	if q.signed {
+		return CompactStats{DataType: entcommon.VectorDataTypeInt8}
+	}
+	return CompactStats{DataType: entcommon.VectorDataTypeUint8}
+}
+
+// BitVectorQuantizer indexes binary vectors as packed bits, 64 dimensions
+// per word. Like ByteVectorQuantizer it is lossless, distances are computed
+// with popcount.
+type BitVectorQuantizer struct {
+	distance func(x, y []uint64) (float32, error)
+}
+
+func NewBitVectorQuantizer(provider distancer.Provider) (*BitVectorQuantizer, error) {
+	switch provider.Type() {
+	case "hamming":
+		return &BitVectorQuantizer{distance: distancer.HammingBitwise}, nil
+	case "jaccard":
+		return &BitVectorQuantizer{distance: distancer.JaccardBitwise}, nil
+	default:
+		return nil, fmt.Errorf("distance %q is not supported for data type %q",
+			provider.Type(), entcommon.VectorDataTypeBinary)
+	}
+}
+
+// Encode packs a binary vector given as one 0 or 1 per dimension
+func (q *BitVectorQuantizer) Encode(vec []float32) []uint64 {
+	code := make([]uint64, (len(vec)+63)>>6)
+	for i, v := range vec {
+		if v != 0 {
+			code[i>>6] |= 1 << (i & 63)
+		}
+	}
+	return code
+}
+
+func (q *BitVectorQuantizer) DistanceBetweenCompressedVectors(x, y []uint64) (float32, error) {
+	return q.distance(x, y)
+}
+
+func (q *BitVectorQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[uint64] {
+	return q.NewCompressedQuantizerDistancer(q.Encode(a))
+}
+
+func (q *BitVectorQuantizer) NewCompressedQuantizerDistancer(a []uint64) quantizerDistancer[uint64] {
+	return &compactDistancer[uint64]{q: q, compressed: a}
+}
+
+func (q *BitVectorQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[uint64]) {}
+
+func (q *BitVectorQuantizer) CompressedBytes(compressed []uint64) []byte {
+	slice := make([]byte, len(compressed)*8)
+	for i := range compressed {
+		binary.LittleEndian.PutUint64(slice[i*8:], compressed[i])
+	}
+	return slice
+}
+
+func (q *BitVectorQuantizer) FromCompressedBytes(compressed []byte) []uint64 {
+	slice := make([]uint64, len(compressed)/8)
+	for i := range slice {
+		slice[i] = binary.LittleEndian.Uint64(compressed[i*8:])
+	}
+	return slice
+}
+
+func (q *BitVectorQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]uint64) []uint64 {
+	l := len(compressed) / 8
+	if len(*buffer) < l {
+		*buffer = make([]uint64, 1000*l)
+	}
+
+	// take from end so we can address the start of the buffer
+	slice := (*buffer)[len(*buffer)-l:]
+	*buffer = (*buffer)[:len(*buffer)-l]
+
+	for i := range slice {
+		slice[i] = binary.LittleEndian.Uint64(compressed[i*8:])
+	}
+	return slice
+}
+
+// PersistCompression is a no-op, the quantizer is fully described by the
+// data type and distance of the index config
+func (q *BitVectorQuantizer) PersistCompression(logger CommitLogger) {}
+
+func (q *BitVectorQuantizer) Stats() CompressionStats {
+	return CompactStats{DataType: entcommon.VectorDataTypeBinary}
+}
+
+type compactQuantizer[T byte | uint64] interface {
+	Encode(vec []float32) []T
+	DistanceBetweenCompressedVectors(x, y []T) (float32, error)
+}
+
+type compactDistancer[T byte | uint64] struct {
+	q          compactQuantizer[T]
+	compressed []T
+}
+
+func (d *compactDistancer[T]) Distance(x []T) (float32, error) {
+	return d.q.DistanceBetweenCompressedVectors(d.compressed, x)
+}
+
+func (d *compactDistancer[T]) DistanceToFloat(x []float32) (float32, error) {
+	return d.q.DistanceBetweenCompressedVectors(d.compressed, d.q.Encode(x))
+}
+
+type CompactStats struct {
+	DataType string `json:"dataType"`
+}
+
+func (c CompactStats) CompressionType() string {
+	return c.DataType
+}
+
+func (c CompactStats) CompressionRatio(_ int) float64 {
+	if c.DataType == entcommon.VectorDataTypeBinary {
+		return 32.0
+	}
+	return 4.0
+}
+
+// NewCompactCompressor stores the vectors of an index with the int8, uint8
+// or binary data type in their compact form instead of as float32
+func NewCompactCompressor(
+	dataType string,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	if dataType == entcommon.VectorDataTypeBinary {
+		quantizer, err := NewBitVectorQuantizer(distance)
+		if err != nil {
+			return nil, err
+		}
+		compressor := &quantizedVectorsCompressor[uint64]{
+			quantizer:       quantizer,
+			compressedStore: store,
+			storeId:         binary.BigEndian.PutUint64,
+			loadId:          binary.BigEndian.Uint64,
+			logger:          logger,
+		}
+		compressor.initCompressedStore()
+		compressor.cache = cache.NewShardedUInt64LockCache(
+			compressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger, 0,
+			allocChecker)
+		return compressor, nil
+	}
+
+	quantizer, err := NewByteVectorQuantizer(dataType, distance)
+	if err != nil {
+		return nil, err
+	}
+	compressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	compressor.initCompressedStore()
+	compressor.cache = cache.NewShardedByteLockCache(
+		compressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return compressor, nil
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func randomCompactVector(r *rand.Rand, dims int, minValue, maxValue int) []float32 {
+	vec := make([]float32, dims)
+	for i := range vec {
+		vec[i] = float32(minValue + r.Intn(maxValue-minValue+1))
+	}
+	return vec
+}
+
+func TestByteVectorQuantizerIsExact(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	providers := []distancer.Provider{
+		distancer.NewDotProductProvider(),
+		distancer.NewL2SquaredProvider(),
+	}
+	dataTypes := map[string][2]int{
+		entcommon.VectorDataTypeInt8:  {-128, 127},
+		entcommon.VectorDataTypeUint8: {0, 255},
+	}
+
+	for dataType, bounds := range dataTypes {
+		for _, provider := range providers {
+			t.Run(dataType+"/"+provider.Type(), func(t *testing.T) {
+				q, err := compressionhelpers.NewByteVectorQuantizer(dataType, provider)
+				require.Nil(t, err)
+
+				for i := 0; i < 100; i++ {
+					x := randomCompactVector(r, 67, bounds[0], bounds[1])
+					y := randomCompactVector(r, 67, bounds[0], bounds[1])
+					expected, err := provider.SingleDist(x, y)
+					require.Nil(t, err)
+
+					actual, err := q.DistanceBetweenCompressedVectors(q.Encode(x), q.Encode(y))
+					require.Nil(t, err)
+					assert.Equal(t, expected, actual)
+
+					actual, err = q.NewQuantizerDistancer(x).DistanceToFloat(y)
+					require.Nil(t, err)
+					assert.Equal(t, expected, actual)
+				}
+			})
+		}
+	}
+
+	t.Run("cosine is rejected", func(t *testing.T) {
+		_, err := compressionhelpers.NewByteVectorQuantizer(entcommon.VectorDataTypeInt8,
+			distancer.NewCosineDistanceProvider())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBitVectorQuantizerIsExact(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	provider := distancer.NewHammingProvider()
+	q, err := compressionhelpers.NewBitVectorQuantizer(provider)
+	require.Nil(t, err)
+
+	for i := 0; i < 100; i++ {
+		x := randomCompactVector(r, 136, 0, 1)
+		y := randomCompactVector(r, 136, 0, 1)
+		expected, err := provider.SingleDist(x, y)
+		require.Nil(t, err)
+
+		code := q.Encode(x)
+		assert.Len(t, code, 3)
+		assert.Equal(t, code, q.FromCompressedBytes(q.CompressedBytes(code)))
+
+		actual, err := q.DistanceBetweenCompressedVectors(code, q.Encode(y))
+		require.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	}
+
+	t.Run("dot is rejected", func(t *testing.T) {
+		_, err := compressionhelpers.NewBitVectorQuantizer(distancer.NewDotProductProvider())
+		assert.NotNil(t, err)
+	})
+}
@@ -213,6 +213,12 @@ func NewProductQuantizer(cfg ent.PQConfig, distance distancer.Provider, dimensio
 	if dimensions%cfg.Segments != 0 {
 		return nil, errors.New("segments should be an integer divisor of dimensions")
 	}
+	if distance != nil {
+		if t := distance.Type(); t == "jaccard" || t == "angular" {
+			// these distances can not be accumulated over segments
+			return nil, fmt.Errorf("product quantization does not support the %s distance", t)
+		}
+	}
 	encoderType, err := parseEncoder(cfg.Encoder.Type)
 	if err != nil {
 		return nil, errors.New("invalid encoder type")
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// compactCodec is used instead of the float32 encoding for indexes with the
+// int8, uint8 or binary data type. Vectors are stored in their compact form
+// and the distances on it are exact.
+type compactCodec struct {
+	encode   func(vector []float32) []byte
+	distance func(x, y []byte) (float32, error)
+}
+
+func newCompactCodec(dataType string, provider distancer.Provider) (*compactCodec, error) {
+	if dataType == entcommon.VectorDataTypeBinary {
+		q, err := compressionhelpers.NewBitVectorQuantizer(provider)
+		if err != nil {
+			return nil, err
+		}
+		return &compactCodec{
+			encode: func(vector []float32) []byte {
+				return q.CompressedBytes(q.Encode(vector))
+			},
+			distance: func(x, y []byte) (float32, error) {
+				return q.DistanceBetweenCompressedVectors(q.FromCompressedBytes(x),
+					q.FromCompressedBytes(y))
+			},
+		}, nil
+	}
+
+	q, err := compressionhelpers.NewByteVectorQuantizer(dataType, provider)
+	if err != nil {
+		return nil, err
+	}
+	return &compactCodec{
+		encode:   q.Encode,
+		distance: q.DistanceBetweenCompressedVectors,
+	}, nil
+}
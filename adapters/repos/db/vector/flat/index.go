@@ -39,6 +39,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -70,6 +71,7 @@ type flat struct {
 	pool      *pools
 
 	compression          string
+	compact              *compactCodec
 	bqCache              cache.Cache[uint64]
 	count                uint64
 	concurrentCacheReads int
@@ -103,6 +105,13 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		store:                store,
 		concurrentCacheReads: runtime.GOMAXPROCS(0) * 2,
 	}
+	if entcommon.IsCompactDataType(uc.DataType) {
+		compact, err := newCompactCodec(uc.DataType, cfg.DistanceProvider)
+		if err != nil {
+			return nil, err
+		}
+		index.compact = compact
+	}
 	if err := index.initBuckets(context.Background(), cfg.MinMMapSize, cfg.MaxWalReuseSize, cfg.AllocChecker, cfg.LazyLoadSegments); err != nil {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
 	}
@@ -335,16 +344,20 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		return errors.Errorf("insert called with a vector of the wrong size")
 	}
 	vector = index.normalized(vector)
-	slice := make([]byte, len(vector)*4)
-	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
+	if index.compact != nil {
+		index.storeVector(id, index.compact.encode(vector))
+	} else {
+		slice := make([]byte, len(vector)*4)
+		index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
+	}
 
 	if index.isBQ() {
 		vectorBQ := index.bq.Encode(vector)
+		slice := make([]byte, len(vectorBQ)*8)
 		if index.isBQCached() {
 			index.bqCache.Grow(id)
 			index.bqCache.Preload(id, vectorBQ)
 		}
-		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
 	newCount := atomic.LoadUint64(&index.count)
@@ -430,6 +443,12 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 }
 
 func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
+	if index.compact != nil {
+		encoded := index.compact.encode(vector)
+		return func(vecAsBytes []byte) (float32, error) {
+			return index.compact.distance(encoded, vecAsBytes)
+		}
+	}
 	return func(vecAsBytes []byte) (float32, error) {
 		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
 		defer index.pool.float32SlicePool.Put(vecSlice)
@@ -864,6 +883,9 @@ func (index *flat) PostStartup() {
 }
 
 func (index *flat) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	if index.compact != nil {
+		return index.compact.distance(index.compact.encode(x), index.compact.encode(y))
+	}
 	return index.distancerProvider.SingleDist(x, y)
 }
 
@@ -976,6 +998,18 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "matryoshka.dimensions",
+			accessor: func(c flatent.UserConfig) interface{} { return c.Matryoshka.Dimensions },
+		},
+		{
+			name:     "matryoshka.normalize",
+			accessor: func(c flatent.UserConfig) interface{} { return c.Matryoshka.Normalize },
+		},
+		{
+			name:     "dataType",
+			accessor: func(c flatent.UserConfig) interface{} { return c.DataType },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -997,16 +1031,13 @@ func (index *flat) AlreadyIndexed() uint64 {
 func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
 	var distFunc func(nodeID uint64) (float32, error)
 	queryVector = index.normalized(queryVector)
+	distanceCalc := index.createDistanceCalc(queryVector)
 	defaultDistFunc := func(nodeID uint64) (float32, error) {
 		vec, err := index.vectorById(nodeID)
 		if err != nil {
 			return 0, err
 		}
-		dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
-		if err != nil {
-			return 0, err
-		}
-		return dist, nil
+		return distanceCalc(vec)
 	}
 	switch index.compression {
 	case compressionBQ:
@@ -1029,17 +1060,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 		// use uncompressed for now
 		fallthrough
 	default:
-		distFunc = func(nodeID uint64) (float32, error) {
-			vec, err := index.vectorById(nodeID)
-			if err != nil {
-				return 0, err
-			}
-			dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
-			if err != nil {
-				return 0, err
-			}
-			return dist, nil
-		}
+		distFunc = defaultDistFunc
 	}
 	return common.QueryVectorDistancer{DistanceFunc: distFunc}
 }
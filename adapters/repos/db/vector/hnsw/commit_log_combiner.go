@@ -20,6 +20,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 type CommitLogCombiner struct {
@@ -183,18 +185,18 @@ func (c *CommitLogCombiner) combine(left, right string) error {
 }
 
 func (c *CommitLogCombiner) mergeFiles(outName, first, second string) error {
-	out, err := os.Create(outName)
+	out, err := encryption.Create(outName)
 	if err != nil {
 		return errors.Wrapf(err, "open target file %q", outName)
 	}
 
-	source1, err := os.Open(first)
+	source1, err := encryption.Open(first)
 	if err != nil {
 		return errors.Wrapf(err, "open first source file %q", first)
 	}
 	defer source1.Close()
 
-	source2, err := os.Open(second)
+	source2, err := encryption.Open(second)
 	if err != nil {
 		return errors.Wrapf(err, "open second source file %q", second)
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -135,7 +136,7 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
-func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
+func getLatestCommitFileOrCreate(rootPath, name string) (encryption.File, error) {
 	dir := commitLogDirectory(rootPath, name)
 	err := os.MkdirAll(dir, os.ModePerm)
 	if err != nil {
@@ -152,7 +153,7 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 		fileName = fmt.Sprintf("%d", time.Now().Unix())
 	}
 
-	fd, err := os.OpenFile(commitLogFileName(rootPath, name, fileName),
+	fd, err := encryption.OpenFile(commitLogFileName(rootPath, name, fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return nil, errors.Wrap(err, "create commit log file")
@@ -618,7 +619,7 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 			Info("commit log size crossed threshold, switching to new file")
 	}
 
-	fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
+	fd, err := encryption.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return true, errors.Wrap(err, "create commit log file")
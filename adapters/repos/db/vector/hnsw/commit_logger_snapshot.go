@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/diskio"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 const (
@@ -456,7 +457,7 @@ func loadCommitLoggerState(logger logrus.FieldLogger, fileNames []string, state
 		beforeIndividual := time.Now()
 
 		err = func() error {
-			fd, err := os.Open(fileName)
+			fd, err := encryption.Open(fileName)
 			if err != nil {
 				return errors.Wrapf(err, "open commit log %q for reading", fileName)
 			}
@@ -521,7 +522,7 @@ func (l *hnswCommitLogger) writeSnapshot(state *DeserializationResult, filename
 	tmpSnapshotFileName := fmt.Sprintf("%s.tmp", filename)
 	checkPointsFileName := fmt.Sprintf("%s.checkpoints", filename)
 
-	snap, err := os.OpenFile(tmpSnapshotFileName, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+	snap, err := encryption.OpenFile(tmpSnapshotFileName, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return errors.Wrapf(err, "create snapshot file %q", tmpSnapshotFileName)
 	}
@@ -918,7 +919,7 @@ func (l *hnswCommitLogger) readStateFrom(filename string, checkpoints []Checkpoi
 		LinksReplaced:     make(map[uint64]map[uint16]struct{}),
 	}
 
-	f, err := os.Open(filename)
+	f, err := encryption.Open(filename)
 	if err != nil {
 		return nil, errors.Wrapf(err, "open snapshot file %q", filename)
 	}
@@ -1349,7 +1350,7 @@ type Checkpoint struct {
 }
 
 func writeCheckpoints(fileName string, checkpoints []Checkpoint) error {
-	checkpointFile, err := os.OpenFile(fileName, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+	checkpointFile, err := encryption.OpenFile(fileName, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return fmt.Errorf("open new checkpoint file for writing: %w", err)
 	}
@@ -1383,7 +1384,7 @@ func writeCheckpoints(fileName string, checkpoints []Checkpoint) error {
 func readCheckpoints(snapshotFileName string) (checkpoints []Checkpoint, err error) {
 	cpfn := snapshotFileName + ".checkpoints"
 
-	cpFile, err := os.Open(cpfn)
+	cpFile, err := encryption.Open(cpfn)
 	if err != nil {
 		return nil, err
 	}
@@ -15,15 +15,15 @@ import (
 	"bytes"
 	"encoding/binary"
 	"math"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 type Logger struct {
-	file *os.File
+	file encryption.File
 	bufw *bufWriter
 }
 
@@ -50,7 +50,7 @@ const (
 )
 
 func NewLogger(fileName string) *Logger {
-	file, err := os.Create(fileName)
+	file, err := encryption.Create(fileName)
 	if err != nil {
 		panic(err)
 	}
@@ -58,7 +58,7 @@ func NewLogger(fileName string) *Logger {
 	return &Logger{file: file, bufw: NewWriter(file)}
 }
 
-func NewLoggerWithFile(file *os.File) *Logger {
+func NewLoggerWithFile(file encryption.File) *Logger {
 	return &Logger{file: file, bufw: NewWriterSize(file, 32*1024)}
 }
 
@@ -25,10 +25,11 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 type MemoryCondensor struct {
-	newLogFile *os.File
+	newLogFile encryption.File
 	newLog     *bufWriter
 	logger     logrus.FieldLogger
 }
@@ -37,7 +38,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	c.logger.WithField("action", "hnsw_condensing").Infof("start hnsw condensing")
 	defer c.logger.WithField("action", "hnsw_condensing_complete").Infof("completed hnsw condensing")
 
-	fd, err := os.Open(fileName)
+	fd, err := encryption.Open(fileName)
 	if err != nil {
 		return errors.Wrap(err, "open commit log to be condensed")
 	}
@@ -49,7 +50,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		return errors.Wrap(err, "read commit log to be condensed")
 	}
 
-	newLogFile, err := os.OpenFile(fmt.Sprintf("%s.condensed", fileName),
+	newLogFile, err := encryption.OpenFile(fmt.Sprintf("%s.condensed", fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return errors.Wrap(err, "open new commit log file for writing")
@@ -64,6 +64,18 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "muvera enabled",
 			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.MuveraConfig.Enabled },
 		},
+		{
+			name:     "matryoshka.dimensions",
+			accessor: func(c ent.UserConfig) interface{} { return c.Matryoshka.Dimensions },
+		},
+		{
+			name:     "matryoshka.normalize",
+			accessor: func(c ent.UserConfig) interface{} { return c.Matryoshka.Normalize },
+		},
+		{
+			name:     "dataType",
+			accessor: func(c ent.UserConfig) interface{} { return c.DataType },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// angularFromDot returns the angle between two vectors normalized to [0, 1].
+// Unlike the cosine distance it satisfies the triangle inequality. A zero
+// vector has no direction and is treated as orthogonal to everything.
+func angularFromDot(dot, normA, normB float32) float32 {
+	if normA == 0 || normB == 0 {
+		return 0.5
+	}
+	cos := float64(dot) / (math.Sqrt(float64(normA)) * math.Sqrt(float64(normB)))
+	if cos > 1 {
+		cos = 1
+	} else if cos < -1 {
+		cos = -1
+	}
+	return float32(math.Acos(cos) / math.Pi)
+}
+
+// Angular is the angular distance arccos(cos(a, b)) / pi. The vectors do not
+// need to be normalized. It uses the dot product implementation, so it
+// benefits from the same SIMD optimizations.
+type Angular struct {
+	a     []float32
+	normA float32
+}
+
+func (d *Angular) Distance(b []float32) (float32, error) {
+	if len(d.a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(d.a), len(b))
+	}
+
+	return angularFromDot(dotProductImplementation(d.a, b), d.normA,
+		dotProductImplementation(b, b)), nil
+}
+
+type AngularProvider struct{}
+
+func NewAngularProvider() AngularProvider {
+	return AngularProvider{}
+}
+
+func (d AngularProvider) SingleDist(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	return angularFromDot(dotProductImplementation(a, b),
+		dotProductImplementation(a, a), dotProductImplementation(b, b)), nil
+}
+
+func (d AngularProvider) Type() string {
+	return "angular"
+}
+
+func (d AngularProvider) New(a []float32) Distancer {
+	return &Angular{a: a, normA: dotProductImplementation(a, a)}
+}
+
+// Step returns the distance of a segment. The angular distance can not be
+// accumulated over segments, so product quantization does not support it.
+func (d AngularProvider) Step(x, y []float32) float32 {
+	return angularFromDot(dotProductImplementation(x, y),
+		dotProductImplementation(x, x), dotProductImplementation(y, y))
+}
+
+func (d AngularProvider) Wrap(x float32) float32 {
+	return x
+}
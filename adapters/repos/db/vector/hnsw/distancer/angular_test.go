@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAngularDistancer(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []float32
+		expected float32
+	}{
+		{
+			name:     "identical vectors",
+			a:        []float32{3, 4, 5},
+			b:        []float32{3, 4, 5},
+			expected: 0,
+		},
+		{
+			name:     "same angle, different euclidean position",
+			a:        []float32{3, 4, 5},
+			b:        []float32{1.5, 2, 2.5},
+			expected: 0,
+		},
+		{
+			name:     "orthogonal vectors",
+			a:        []float32{1, 0},
+			b:        []float32{0, 2},
+			expected: 0.5,
+		},
+		{
+			name:     "opposite vectors",
+			a:        []float32{1, 1},
+			b:        []float32{-2, -2},
+			expected: 1,
+		},
+		{
+			name:     "45 degrees",
+			a:        []float32{1, 0},
+			b:        []float32{1, 1},
+			expected: 0.25,
+		},
+		{
+			name:     "zero vector",
+			a:        []float32{0, 0},
+			b:        []float32{1, 1},
+			expected: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dist, err := NewAngularProvider().New(tt.a).Distance(tt.b)
+			require.Nil(t, err)
+
+			control, err := NewAngularProvider().SingleDist(tt.a, tt.b)
+			require.Nil(t, err)
+			assert.InDelta(t, control, dist, 1e-6)
+			assert.InDelta(t, tt.expected, dist, 1e-3)
+		})
+	}
+
+	t.Run("triangle inequality", func(t *testing.T) {
+		a := []float32{1, 0}
+		b := []float32{1, 1}
+		c := []float32{0, 1}
+		p := NewAngularProvider()
+
+		ab, err := p.SingleDist(a, b)
+		require.Nil(t, err)
+		bc, err := p.SingleDist(b, c)
+		require.Nil(t, err)
+		ac, err := p.SingleDist(a, c)
+		require.Nil(t, err)
+		assert.LessOrEqual(t, ac, ab+bc+1e-6)
+	})
+
+	t.Run("vectors of different length", func(t *testing.T) {
+		_, err := NewAngularProvider().SingleDist([]float32{1}, []float32{1, 0})
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// MinMaxSum computes the sums of the element-wise minimums and maximums,
+// which is all the weighted Jaccard distance needs.
+func main() {
+	TEXT("MinMaxSum", NOSPLIT, "func(x, y []float32) (float32, float32)")
+	x := Mem{Base: Load(Param("x").Base(), GP64())}
+	y := Mem{Base: Load(Param("y").Base(), GP64())}
+	n := Load(Param("x").Len(), GP64())
+
+	// two independent accumulators each for the minimums and maximums
+	minAcc := []VecVirtual{YMM(), YMM()}
+	maxAcc := []VecVirtual{YMM(), YMM()}
+	for i := range minAcc {
+		VXORPS(minAcc[i], minAcc[i], minAcc[i])
+		VXORPS(maxAcc[i], maxAcc[i], maxAcc[i])
+	}
+
+	Label("blockloop")
+	CMPQ(n, U32(16))
+	JL(LabelRef("tail"))
+
+	xs := []VecVirtual{YMM(), YMM()}
+	mins := []VecVirtual{YMM(), YMM()}
+	for i := range xs {
+		VMOVUPS(x.Offset(32*i), xs[i])
+	}
+	for i := range xs {
+		VMINPS(y.Offset(32*i), xs[i], mins[i])
+	}
+	for i := range xs {
+		VMAXPS(y.Offset(32*i), xs[i], xs[i])
+	}
+	for i := range xs {
+		VADDPS(mins[i], minAcc[i], minAcc[i])
+		VADDPS(xs[i], maxAcc[i], maxAcc[i])
+	}
+
+	ADDQ(U32(64), x.Base)
+	ADDQ(U32(64), y.Base)
+	SUBQ(U32(16), n)
+	JMP(LabelRef("blockloop"))
+
+	// Process any trailing entries.
+	Label("tail")
+	minTail, maxTail := XMM(), XMM()
+	VXORPS(minTail, minTail, minTail)
+	VXORPS(maxTail, maxTail, maxTail)
+
+	Label("tailloop")
+	CMPQ(n, U32(0))
+	JE(LabelRef("reduce"))
+
+	xt, mint := XMM(), XMM()
+	VMOVSS(x, xt)
+	VMINSS(y, xt, mint)
+	VMAXSS(y, xt, xt)
+	VADDSS(mint, minTail, minTail)
+	VADDSS(xt, maxTail, maxTail)
+
+	ADDQ(U32(4), x.Base)
+	ADDQ(U32(4), y.Base)
+	DECQ(n)
+	JMP(LabelRef("tailloop"))
+
+	// Reduce the lanes to one.
+	Label("reduce")
+	VADDPS(minAcc[0], minAcc[1], minAcc[0])
+	VADDPS(maxAcc[0], maxAcc[1], maxAcc[0])
+
+	for i, acc := range []VecVirtual{minAcc[0], maxAcc[0]} {
+		tail := []VecVirtual{minTail, maxTail}[i]
+		result := acc.AsX()
+		top := XMM()
+		VEXTRACTF128(U8(1), acc, top)
+		VADDPS(result, top, result)
+		VHADDPS(result, result, result)
+		VHADDPS(result, result, result)
+		VADDSS(result, tail, result)
+		Store(result, ReturnIndex(i))
+	}
+
+	VZEROUPPER()
+	RET()
+
+	Generate()
+}
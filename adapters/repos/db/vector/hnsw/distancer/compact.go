@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// The distances in this file are computed on vectors in their compact form,
+// as stored for the int8, uint8 and binary vector data types, without
+// widening them to float32 first. int8 vectors are given as bytes in two's
+// complement, binary vectors as packed bits.
+
+// DotProductInt8 is the negative dot product of two int8 vectors, the
+// counterpart of DotProductProvider
+func DotProductInt8(a, b []byte) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	var sum int32
+	for i := range a {
+		sum += int32(int8(a[i])) * int32(int8(b[i]))
+	}
+	return -float32(sum), nil
+}
+
+// L2SquaredInt8 is the squared euclidean distance of two int8 vectors, the
+// counterpart of L2SquaredProvider
+func L2SquaredInt8(a, b []byte) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	var sum int32
+	for i := range a {
+		diff := int32(int8(a[i])) - int32(int8(b[i]))
+		sum += diff * diff
+	}
+	return float32(sum), nil
+}
+
+// JaccardBitwise is the Jaccard distance of two packed binary vectors,
+// 1 - |a AND b| / |a OR b|. Two zero vectors are considered identical.
+func JaccardBitwise(x []uint64, y []uint64) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.New("both vectors should have the same len")
+	}
+
+	var intersection, union int
+	for i := range x {
+		intersection += bits.OnesCount64(x[i] & y[i])
+		union += bits.OnesCount64(x[i] | y[i])
+	}
+	if union == 0 {
+		return 0, nil
+	}
+	return 1 - float32(intersection)/float32(union), nil
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func int8Bytes(in ...int8) []byte {
+	out := make([]byte, len(in))
+	for i, v := range in {
+		out[i] = byte(v)
+	}
+	return out
+}
+
+func TestCompactDistances(t *testing.T) {
+	a := []float32{-128, 3, 0, 127}
+	b := []float32{5, -7, 100, 127}
+	aInt8 := int8Bytes(-128, 3, 0, 127)
+	bInt8 := int8Bytes(5, -7, 100, 127)
+
+	t.Run("int8 dot product matches float32", func(t *testing.T) {
+		expected, err := NewDotProductProvider().SingleDist(a, b)
+		require.Nil(t, err)
+		dist, err := DotProductInt8(aInt8, bInt8)
+		require.Nil(t, err)
+		assert.Equal(t, expected, dist)
+	})
+
+	t.Run("int8 l2-squared matches float32", func(t *testing.T) {
+		expected, err := NewL2SquaredProvider().SingleDist(a, b)
+		require.Nil(t, err)
+		dist, err := L2SquaredInt8(aInt8, bInt8)
+		require.Nil(t, err)
+		assert.Equal(t, expected, dist)
+	})
+
+	t.Run("int8 length mismatch", func(t *testing.T) {
+		_, err := DotProductInt8(aInt8, bInt8[:2])
+		assert.ErrorIs(t, err, ErrVectorLength)
+		_, err = L2SquaredInt8(aInt8, bInt8[:2])
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+
+	t.Run("bitwise jaccard", func(t *testing.T) {
+		dist, err := JaccardBitwise([]uint64{0b1011, 0}, []uint64{0b0011, 1})
+		require.Nil(t, err)
+		// two shared bits out of four set bits
+		assert.Equal(t, float32(0.5), dist)
+
+		dist, err = JaccardBitwise([]uint64{0}, []uint64{0})
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+
+		_, err = JaccardBitwise([]uint64{0}, []uint64{0, 0})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import "github.com/pkg/errors"
+
+// minMaxSumImpl returns the sum of the element-wise minimums and the sum of
+// the element-wise maximums of both vectors. An init function will overwrite
+// it on amd64 if AVX2 is present.
+var minMaxSumImpl func(a, b []float32) (float32, float32) = func(a, b []float32) (float32, float32) {
+	var mins, maxs float32
+	for i := range a {
+		if a[i] < b[i] {
+			mins += a[i]
+			maxs += b[i]
+		} else {
+			mins += b[i]
+			maxs += a[i]
+		}
+	}
+
+	return mins, maxs
+}
+
+// jaccardFromSums turns the sums of minimums and maximums into a distance.
+// Two zero vectors are considered identical.
+func jaccardFromSums(mins, maxs float32) float32 {
+	if maxs == 0 {
+		return 0
+	}
+	dist := 1 - mins/maxs
+	if dist < 0 {
+		return 0
+	}
+	return dist
+}
+
+// Jaccard is the weighted Jaccard (Ruzicka) distance
+// 1 - sum(min(a_i, b_i)) / sum(max(a_i, b_i)). For binary vectors, such as
+// molecular fingerprints, it is the Jaccard or Tanimoto distance. It is only
+// a metric for non-negative vectors.
+type Jaccard struct {
+	a []float32
+}
+
+func (d *Jaccard) Distance(b []float32) (float32, error) {
+	if len(d.a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(d.a), len(b))
+	}
+
+	return jaccardFromSums(minMaxSumImpl(d.a, b)), nil
+}
+
+type JaccardProvider struct{}
+
+func NewJaccardProvider() JaccardProvider {
+	return JaccardProvider{}
+}
+
+func (d JaccardProvider) SingleDist(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	return jaccardFromSums(minMaxSumImpl(a, b)), nil
+}
+
+func (d JaccardProvider) Type() string {
+	return "jaccard"
+}
+
+func (d JaccardProvider) New(a []float32) Distancer {
+	return &Jaccard{a: a}
+}
+
+// Step returns the distance of a segment. The Jaccard distance can not be
+// accumulated over segments, so product quantization does not support it.
+func (d JaccardProvider) Step(x, y []float32) float32 {
+	return jaccardFromSums(minMaxSumImpl(x, y))
+}
+
+func (d JaccardProvider) Wrap(x float32) float32 {
+	return x
+}
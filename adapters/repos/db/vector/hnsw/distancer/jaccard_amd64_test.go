@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+	"golang.org/x/sys/cpu"
+)
+
+func MinMaxSumPureGo(a, b []float32) (float32, float32) {
+	var mins, maxs float32
+	for i := range a {
+		if a[i] < b[i] {
+			mins += a[i]
+			maxs += b[i]
+		} else {
+			mins += b[i]
+			maxs += a[i]
+		}
+	}
+	return mins, maxs
+}
+
+func Test_Jaccard_DistanceImplementation(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("AVX2 is not supported")
+	}
+
+	lengths := []int{1, 4, 15, 16, 17, 31, 32, 35, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777, 2048}
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				x[i] = rand.Float32()
+				y[i] = rand.Float32()
+			}
+
+			controlMins, controlMaxs := MinMaxSumPureGo(x, y)
+
+			mins, maxs := asm.MinMaxSum(x, y)
+			assert.InEpsilon(t, controlMins, mins, 0.01)
+			assert.InEpsilon(t, controlMaxs, maxs, 0.01)
+		})
+	}
+
+	t.Run("binary fingerprints", func(t *testing.T) {
+		x := make([]float32, 2048)
+		y := make([]float32, 2048)
+		for i := range x {
+			x[i] = float32(rand.Intn(2))
+			y[i] = float32(rand.Intn(2))
+		}
+
+		controlMins, controlMaxs := MinMaxSumPureGo(x, y)
+
+		mins, maxs := asm.MinMaxSum(x, y)
+		assert.Equal(t, controlMins, mins)
+		assert.Equal(t, controlMaxs, maxs)
+	})
+}
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJaccardDistancer(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []float32
+		expected float32
+	}{
+		{
+			name:     "identical fingerprints",
+			a:        []float32{1, 0, 1, 1},
+			b:        []float32{1, 0, 1, 1},
+			expected: 0,
+		},
+		{
+			name:     "disjoint fingerprints",
+			a:        []float32{1, 0, 1, 0},
+			b:        []float32{0, 1, 0, 1},
+			expected: 1,
+		},
+		{
+			// intersection of 2 bits, union of 4 bits
+			name:     "overlapping fingerprints",
+			a:        []float32{1, 1, 1, 0, 0},
+			b:        []float32{0, 1, 1, 1, 0},
+			expected: 0.5,
+		},
+		{
+			name:     "weighted vectors",
+			a:        []float32{2, 1, 0},
+			b:        []float32{1, 3, 1},
+			expected: 1 - 2.0/6.0,
+		},
+		{
+			name:     "zero vectors",
+			a:        []float32{0, 0, 0},
+			b:        []float32{0, 0, 0},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dist, err := NewJaccardProvider().New(tt.a).Distance(tt.b)
+			require.Nil(t, err)
+
+			control, err := NewJaccardProvider().SingleDist(tt.a, tt.b)
+			require.Nil(t, err)
+			assert.Equal(t, control, dist)
+			assert.InDelta(t, tt.expected, dist, 1e-6)
+		})
+	}
+
+	t.Run("vectors of different length", func(t *testing.T) {
+		_, err := NewJaccardProvider().SingleDist([]float32{1}, []float32{1, 0})
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+}
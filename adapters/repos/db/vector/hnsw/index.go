@@ -35,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storobj"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -373,6 +374,22 @@ func New(cfg Config, uc ent.UserConfig,
 		index.cache = nil
 	}
 
+	if entcommon.IsCompactDataType(uc.DataType) {
+		// int8, uint8 and binary vectors are kept in their compact form, the
+		// distances on it are exact so there is nothing to rescore
+		var err error
+		index.compressor, err = compressionhelpers.NewCompactCompressor(uc.DataType,
+			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
+			cfg.AllocChecker)
+		if err != nil {
+			return nil, err
+		}
+		index.compressed.Store(true)
+		index.doNotRescore = true
+		index.cache.Drop()
+		index.cache = nil
+	}
+
 	if uc.RQ.Enabled {
 		index.rqActive = true
 	}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// NearDuplicates walks the layer-0 neighbor lists of all indexed nodes and
+// calls fn for every pair of nodes which are at most maxDist apart. Near
+// duplicates are by definition very close to each other, so they will almost
+// always be direct neighbors in the graph. This makes it possible to find
+// them without running a separate search per node.
+//
+// Every pair is reported at most once, with a < b. Distances are always
+// calculated on the uncompressed vectors, so the results are exact even if
+// the index is compressed. The graph can be modified concurrently, in which
+// case pairs involving the modified nodes may be missed.
+//
+// If fn returns an error the iteration stops and the error is returned.
+func (h *hnsw) NearDuplicates(ctx context.Context, maxDist float32,
+	fn func(a, b uint64, dist float32) error,
+) error {
+	if h.multivector.Load() && !h.muvera.Load() {
+		return fmt.Errorf("near duplicates are not supported for multi vector indexes")
+	}
+
+	var id uint64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if h.shutdownCtx.Err() != nil || h.resetCtx.Err() != nil {
+			return nil
+		}
+
+		connections, stop := h.layerZeroConnections(id)
+		if stop {
+			return nil
+		}
+
+		if len(connections) > 0 && !h.hasTombstone(id) {
+			if err := h.nearDuplicatesOf(id, connections, maxDist, fn); err != nil {
+				return err
+			}
+		}
+
+		id++
+	}
+}
+
+func (h *hnsw) nearDuplicatesOf(id uint64, connections []uint64, maxDist float32,
+	fn func(a, b uint64, dist float32) error,
+) error {
+	var vec []float32
+	for _, neighbor := range connections {
+		if neighbor == id || h.hasTombstone(neighbor) {
+			continue
+		}
+
+		if neighbor < id {
+			// the edge might have been reported already when visiting the
+			// neighbor. This is the case if the edge is bidirectional.
+			neighborConnections, _ := h.layerZeroConnections(neighbor)
+			if slices.Contains(neighborConnections, id) {
+				continue
+			}
+		}
+
+		if vec == nil {
+			v, err := h.fullVectorForID(id)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					return nil
+				}
+				return err
+			}
+			vec = v
+		}
+
+		neighborVec, err := h.fullVectorForID(neighbor)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return err
+		}
+
+		dist, err := h.distancerProvider.SingleDist(vec, neighborVec)
+		if err != nil {
+			return errors.Wrapf(err, "distance between nodes %d and %d", id, neighbor)
+		}
+		if dist > maxDist {
+			continue
+		}
+
+		a, b := id, neighbor
+		if b < a {
+			a, b = b, a
+		}
+		if err := fn(a, b, dist); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// layerZeroConnections returns a copy of the layer-0 connections of the node.
+// stop is true if the id is outside of the index.
+func (h *hnsw) layerZeroConnections(id uint64) (connections []uint64, stop bool) {
+	h.RLock()
+	defer h.RUnlock()
+
+	if id >= uint64(len(h.nodes)) {
+		return nil, true
+	}
+
+	h.shardedNodeLocks.RLock(id)
+	node := h.nodes[id]
+	h.shardedNodeLocks.RUnlock(id)
+	if node == nil {
+		return nil, false
+	}
+
+	node.Lock()
+	defer node.Unlock()
+	if len(node.connections) == 0 {
+		return nil, false
+	}
+	return slices.Clone(node.connectionsAtLevelNoLock(0)), false
+}
+
+// fullVectorForID returns the uncompressed vector of the node. If the index
+// is compressed, the vector is read from disk.
+func (h *hnsw) fullVectorForID(id uint64) ([]float32, error) {
+	if !h.compressed.Load() {
+		return h.vectorForID(context.Background(), id)
+	}
+
+	slice := h.pools.tempVectors.Get(int(h.dims))
+	defer h.pools.tempVectors.Put(slice)
+
+	vec, err := h.TempVectorForIDThunk(context.Background(), id, slice)
+	if err != nil {
+		return nil, err
+	}
+	// the slice is returned to the pool, so the vector must not outlive it
+	return slices.Clone(h.normalizeVec(vec)), nil
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestNearDuplicates(t *testing.T) {
+	ctx := context.Background()
+	vectors := [][]float32{
+		{0, 0},
+		{0, 0.01},   // duplicate of 0
+		{10, 10},    // unique
+		{20, 20},    // duplicate of 4 and 5
+		{20, 20.01}, // duplicate of 3 and 5
+		{20.01, 20}, // duplicate of 3 and 4
+		{-10, 10},   // unique
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "near-duplicates",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+	}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	findPairs := func(t *testing.T) [][2]uint64 {
+		var pairs [][2]uint64
+		err := index.NearDuplicates(ctx, 0.01, func(a, b uint64, dist float32) error {
+			assert.Less(t, a, b)
+			assert.LessOrEqual(t, dist, float32(0.01))
+			pairs = append(pairs, [2]uint64{a, b})
+			return nil
+		})
+		require.Nil(t, err)
+		return pairs
+	}
+
+	t.Run("all pairs within the distance are reported once", func(t *testing.T) {
+		pairs := findPairs(t)
+		assert.ElementsMatch(t, [][2]uint64{{0, 1}, {3, 4}, {3, 5}, {4, 5}}, pairs)
+	})
+
+	t.Run("tombstoned nodes are skipped", func(t *testing.T) {
+		require.Nil(t, index.Delete(4))
+		pairs := findPairs(t)
+		assert.ElementsMatch(t, [][2]uint64{{0, 1}, {3, 5}}, pairs)
+	})
+
+	t.Run("callback error stops the iteration", func(t *testing.T) {
+		calls := 0
+		err := index.NearDuplicates(ctx, 0.01, func(a, b uint64, dist float32) error {
+			calls++
+			return errors.New("stop")
+		})
+		require.EqualError(t, err, "stop")
+		assert.Equal(t, 1, calls)
+	})
+}
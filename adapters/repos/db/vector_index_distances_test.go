@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_JaccardAndAngularDistances(t *testing.T) {
+	for _, tt := range []struct {
+		distance string
+		vectors  [][]float32
+		query    []float32
+		expected []float32
+	}{
+		{
+			distance: common.DistanceJaccard,
+			vectors: [][]float32{
+				{1, 1, 0, 0},
+				{1, 1, 1, 0},
+				{0, 0, 1, 1},
+			},
+			query:    []float32{1, 1, 0, 0},
+			expected: []float32{0, 1.0 / 3.0, 1},
+		},
+		{
+			distance: common.DistanceAngular,
+			vectors: [][]float32{
+				{2, 0},
+				{1, 1},
+				{-1, 0},
+			},
+			query:    []float32{1, 0},
+			expected: []float32{0, 0.25, 1},
+		},
+	} {
+		hnswConfig := enthnsw.NewDefaultUserConfig()
+		hnswConfig.Distance = tt.distance
+
+		flatConfig := flat.NewDefaultUserConfig()
+		flatConfig.Distance = tt.distance
+
+		for _, index := range []struct {
+			indexType string
+			config    schemaConfig.VectorIndexConfig
+		}{
+			{indexType: "hnsw", config: hnswConfig},
+			{indexType: "flat", config: flatConfig},
+		} {
+			t.Run(tt.distance+"/"+index.indexType, func(t *testing.T) {
+				ctx := context.Background()
+				className := "DistanceTest"
+				shard, idx := testShardWithSettings(t, ctx,
+					&models.Class{Class: className, VectorIndexType: index.indexType}, index.config, false, false)
+				defer func() { require.Nil(t, idx.drop()) }()
+
+				objs := make([]*storobj.Object, len(tt.vectors))
+				for i, vec := range tt.vectors {
+					objs[i] = testObject(className)
+					objs[i].Vector = vec
+				}
+				for _, err := range shard.PutObjectBatch(ctx, objs) {
+					require.Nil(t, err)
+				}
+
+				vidx, ok := shard.GetVectorIndex("")
+				require.True(t, ok)
+
+				ids, dists, err := vidx.SearchByVector(ctx, tt.query, len(tt.vectors), nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{objs[0].DocID, objs[1].DocID, objs[2].DocID}, ids)
+				assert.InDeltaSlice(t, tt.expected, dists, 1e-5)
+			})
+		}
+	}
+}
@@ -0,0 +1,320 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// defaultMatryoshkaRescoreLimit is used if the user did not set a rescore
+// limit
+const defaultMatryoshkaRescoreLimit = 100
+
+// matryoshkaConfig returns the matryoshka settings of a vector index config,
+// the second return value is false if truncation is not enabled
+func matryoshkaConfig(cfg schemaConfig.VectorIndexConfig) (entcommon.MatryoshkaConfig, bool) {
+	var m entcommon.MatryoshkaConfig
+	switch c := cfg.(type) {
+	case hnswent.UserConfig:
+		m = c.Matryoshka
+	case flatent.UserConfig:
+		m = c.Matryoshka
+	}
+	return m, m.Enabled()
+}
+
+// truncateVector returns the leading dimensions of vec as configured. The
+// result shares memory with vec unless it is normalized.
+func truncateVector(cfg entcommon.MatryoshkaConfig, vec []float32) []float32 {
+	if len(vec) > cfg.Dimensions {
+		vec = vec[:cfg.Dimensions]
+	}
+	if cfg.Normalize {
+		return distancer.Normalize(vec)
+	}
+	return vec
+}
+
+// truncateVectorInPlace is like truncateVector, but normalizes into vec
+// itself. It is used for vectors read into pooled slices.
+func truncateVectorInPlace(cfg entcommon.MatryoshkaConfig, vec []float32) []float32 {
+	if len(vec) > cfg.Dimensions {
+		vec = vec[:cfg.Dimensions]
+	}
+	if !cfg.Normalize {
+		return vec
+	}
+
+	var norm float32
+	for i := range vec {
+		norm += vec[i] * vec[i]
+	}
+	if norm == 0 {
+		return vec
+	}
+	norm = float32(math.Sqrt(float64(norm)))
+	for i := range vec {
+		vec[i] /= norm
+	}
+	return vec
+}
+
+// indexedVectorByIndexID reads vectors the way they are indexed, i.e.
+// truncated if the index is configured to only index leading dimensions
+func (s *Shard) indexedVectorByIndexID(cfg schemaConfig.VectorIndexConfig,
+) func(ctx context.Context, indexID uint64, targetVector string) ([]float32, error) {
+	m, ok := matryoshkaConfig(cfg)
+	if !ok {
+		return s.vectorByIndexID
+	}
+	return func(ctx context.Context, indexID uint64, targetVector string) ([]float32, error) {
+		vec, err := s.vectorByIndexID(ctx, indexID, targetVector)
+		if err != nil {
+			return nil, err
+		}
+		// copy, so the cache does not keep the full vector alive
+		return slices.Clone(truncateVector(m, vec)), nil
+	}
+}
+
+func (s *Shard) readIndexedVectorByIndexIDIntoSlice(cfg schemaConfig.VectorIndexConfig,
+) func(ctx context.Context, indexID uint64, container *common.VectorSlice, targetVector string) ([]float32, error) {
+	m, ok := matryoshkaConfig(cfg)
+	if !ok {
+		return s.readVectorByIndexIDIntoSlice
+	}
+	return func(ctx context.Context, indexID uint64, container *common.VectorSlice, targetVector string) ([]float32, error) {
+		vec, err := s.readVectorByIndexIDIntoSlice(ctx, indexID, container, targetVector)
+		if err != nil {
+			return nil, err
+		}
+		return truncateVectorInPlace(m, vec), nil
+	}
+}
+
+// matryoshkaVectorIndex indexes only the leading dimensions of every vector.
+// Searches retrieve at least rescoreLimit candidates using the truncated
+// query and rescore them on the full vectors stored with the objects, so the
+// returned distances are always full vector distances.
+type matryoshkaVectorIndex struct {
+	VectorIndex
+
+	config       entcommon.MatryoshkaConfig
+	rescoreLimit atomic.Int64
+	distProv     distancer.Provider
+	// fullVector returns the complete vector of an object
+	fullVector func(ctx context.Context, id uint64) ([]float32, error)
+}
+
+func newMatryoshkaVectorIndex(index VectorIndex, cfg entcommon.MatryoshkaConfig,
+	distProv distancer.Provider, fullVector func(ctx context.Context, id uint64) ([]float32, error),
+) *matryoshkaVectorIndex {
+	m := &matryoshkaVectorIndex{
+		VectorIndex: index,
+		config:      cfg,
+		distProv:    distProv,
+		fullVector:  fullVector,
+	}
+	m.setRescoreLimit(cfg.RescoreLimit)
+	return m
+}
+
+func (m *matryoshkaVectorIndex) setRescoreLimit(limit int) {
+	if limit <= 0 {
+		limit = defaultMatryoshkaRescoreLimit
+	}
+	m.rescoreLimit.Store(int64(limit))
+}
+
+func (m *matryoshkaVectorIndex) truncate(vec []float32) []float32 {
+	return truncateVector(m.config, vec)
+}
+
+func (m *matryoshkaVectorIndex) ValidateBeforeInsert(vector []float32) error {
+	if len(vector) < m.config.Dimensions {
+		return fmt.Errorf("vector has %d dimensions, but matryoshka.dimensions is set to %d",
+			len(vector), m.config.Dimensions)
+	}
+	return m.VectorIndex.ValidateBeforeInsert(m.truncate(vector))
+}
+
+func (m *matryoshkaVectorIndex) Add(ctx context.Context, id uint64, vector []float32) error {
+	return m.VectorIndex.Add(ctx, id, m.truncate(vector))
+}
+
+func (m *matryoshkaVectorIndex) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	truncated := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		truncated[i] = m.truncate(vec)
+	}
+	return m.VectorIndex.AddBatch(ctx, ids, truncated)
+}
+
+func (m *matryoshkaVectorIndex) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := max(k, int(m.rescoreLimit.Load()))
+	ids, _, err := m.VectorIndex.SearchByVector(ctx, m.truncate(vector), limit, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists, err := m.rescore(ctx, vector, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) > k {
+		ids, dists = ids[:k], dists[:k]
+	}
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance applies the distance threshold to the truncated
+// vectors first. Objects which are only within the threshold on their full
+// vectors might therefore be missing from the results.
+func (m *matryoshkaVectorIndex) SearchByVectorDistance(ctx context.Context, vector []float32,
+	dist float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	ids, _, err := m.VectorIndex.SearchByVectorDistance(ctx, m.truncate(vector), dist, maxLimit, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists, err := m.rescore(ctx, vector, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	cut := sort.Search(len(dists), func(i int) bool { return dists[i] > dist })
+	return ids[:cut], dists[:cut], nil
+}
+
+// rescore computes the full vector distances of the candidates and returns
+// them sorted by distance. Candidates whose object was deleted in the
+// meantime are skipped.
+func (m *matryoshkaVectorIndex) rescore(ctx context.Context, query []float32,
+	candidates []uint64,
+) ([]uint64, []float32, error) {
+	query = m.normalizeForDistancer(query)
+
+	ids := make([]uint64, 0, len(candidates))
+	dists := make([]float32, 0, len(candidates))
+	for _, id := range candidates {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		dist, err := m.fullDistance(ctx, query, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return nil, nil, err
+		}
+		ids = append(ids, id)
+		dists = append(dists, dist)
+	}
+
+	sort.Sort(&idsByDistance{ids: ids, dists: dists})
+	return ids, dists, nil
+}
+
+func (m *matryoshkaVectorIndex) fullDistance(ctx context.Context, query []float32, id uint64) (float32, error) {
+	vec, err := m.fullVector(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if len(vec) != len(query) {
+		return 0, fmt.Errorf("rescore doc id %d: vector has %d dimensions, query has %d",
+			id, len(vec), len(query))
+	}
+	return m.distProv.SingleDist(query, m.normalizeForDistancer(vec))
+}
+
+// normalizeForDistancer normalizes vectors for the cosine distancer, which
+// expects unit vectors. Vectors stored with the objects are not normalized.
+func (m *matryoshkaVectorIndex) normalizeForDistancer(vec []float32) []float32 {
+	if m.distProv.Type() == "cosine-dot" {
+		return distancer.Normalize(vec)
+	}
+	return vec
+}
+
+func (m *matryoshkaVectorIndex) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	query := m.normalizeForDistancer(queryVector)
+	return common.QueryVectorDistancer{
+		DistanceFunc: func(id uint64) (float32, error) {
+			return m.fullDistance(context.Background(), query, id)
+		},
+	}
+}
+
+func (m *matryoshkaVectorIndex) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	if cfg, ok := matryoshkaConfig(updated); ok {
+		m.setRescoreLimit(cfg.RescoreLimit)
+	}
+	return m.VectorIndex.UpdateUserConfig(updated, callback)
+}
+
+// the wrapped index might support compression upgrades, see
+// VectorIndexQueue.checkCompressionSettings
+
+func (m *matryoshkaVectorIndex) Upgraded() bool {
+	if ui, ok := m.VectorIndex.(upgradableIndexer); ok {
+		return ui.Upgraded()
+	}
+	return false
+}
+
+func (m *matryoshkaVectorIndex) Upgrade(callback func()) error {
+	if ui, ok := m.VectorIndex.(upgradableIndexer); ok {
+		return ui.Upgrade(callback)
+	}
+	callback()
+	return nil
+}
+
+func (m *matryoshkaVectorIndex) ShouldUpgrade() (bool, int) {
+	if ui, ok := m.VectorIndex.(upgradableIndexer); ok {
+		return ui.ShouldUpgrade()
+	}
+	return false, 0
+}
+
+type idsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s *idsByDistance) Len() int { return len(s.ids) }
+
+func (s *idsByDistance) Less(i, j int) bool { return s.dists[i] < s.dists[j] }
+
+func (s *idsByDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
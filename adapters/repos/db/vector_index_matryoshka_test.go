@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_MatryoshkaVectorIndex(t *testing.T) {
+	matryoshka := common.MatryoshkaConfig{Dimensions: 2}
+
+	hnswConfig := enthnsw.NewDefaultUserConfig()
+	hnswConfig.Distance = common.DistanceL2Squared
+	hnswConfig.Matryoshka = matryoshka
+
+	flatConfig := flat.NewDefaultUserConfig()
+	flatConfig.Distance = common.DistanceL2Squared
+	flatConfig.Matryoshka = matryoshka
+
+	// on the leading two dimensions the first vector is the closest to the
+	// query, on the full vectors it is the farthest
+	vectors := [][]float32{
+		{1, 0, 5, 0},
+		{0.9, 0, 0, 0},
+		{0, 1, 0, 0},
+	}
+	query := []float32{1, 0, 0, 0}
+
+	for _, tt := range []struct {
+		indexType string
+		config    schemaConfig.VectorIndexConfig
+	}{
+		{indexType: "hnsw", config: hnswConfig},
+		{indexType: "flat", config: flatConfig},
+	} {
+		t.Run(tt.indexType, func(t *testing.T) {
+			ctx := context.Background()
+			className := "MatryoshkaTest"
+			shard, idx := testShardWithSettings(t, ctx,
+				&models.Class{Class: className, VectorIndexType: tt.indexType}, tt.config, false, false)
+			defer func() { require.Nil(t, idx.drop()) }()
+
+			objs := make([]*storobj.Object, len(vectors))
+			for i, vec := range vectors {
+				objs[i] = testObject(className)
+				objs[i].Vector = vec
+			}
+			for _, err := range shard.PutObjectBatch(ctx, objs) {
+				require.Nil(t, err)
+			}
+
+			vidx, ok := shard.GetVectorIndex("")
+			require.True(t, ok)
+
+			t.Run("search returns full vector distances", func(t *testing.T) {
+				ids, dists, err := vidx.SearchByVector(ctx, query, 2, nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{objs[1].DocID, objs[2].DocID}, ids)
+				assert.InDeltaSlice(t, []float32{0.01, 2}, dists, 1e-6)
+			})
+
+			t.Run("search by distance filters on full vectors", func(t *testing.T) {
+				ids, dists, err := vidx.SearchByVectorDistance(ctx, query, 3, -1, nil)
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{objs[1].DocID, objs[2].DocID}, ids)
+				assert.InDeltaSlice(t, []float32{0.01, 2}, dists, 1e-6)
+			})
+
+			t.Run("vectors shorter than the truncation are rejected", func(t *testing.T) {
+				err := vidx.ValidateBeforeInsert([]float32{1})
+				assert.ErrorContains(t, err, "matryoshka.dimensions is set to 2")
+			})
+		})
+	}
+}
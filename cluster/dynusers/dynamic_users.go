@@ -41,7 +41,7 @@ func (m *Manager) CreateUser(c *cmd.ApplyRequest) error {
 		return fmt.Errorf("%w: %w", ErrBadRequest, err)
 	}
 
-	return m.dynUser.CreateUser(req.UserId, req.SecureHash, req.UserIdentifier, req.ApiKeyFirstLetters, req.CreatedAt)
+	return m.dynUser.CreateUser(req.UserId, req.SecureHash, req.UserIdentifier, req.ApiKeyFirstLetters, req.CreatedAt, req.ExpiresAt)
 }
 
 func (m *Manager) CreateUserWithKeyRequest(c *cmd.ApplyRequest) error {
@@ -101,7 +101,31 @@ func (m *Manager) RotateKey(c *cmd.ApplyRequest) error {
 		return fmt.Errorf("%w: %w", ErrBadRequest, err)
 	}
 
-	return m.dynUser.RotateKey(req.UserId, req.ApiKeyFirstLetters, req.SecureHash, req.OldIdentifier, req.NewIdentifier)
+	return m.dynUser.RotateKey(req.UserId, req.ApiKeyFirstLetters, req.SecureHash, req.OldIdentifier, req.NewIdentifier, req.ExpiresAt)
+}
+
+func (m *Manager) CreateUserApiKey(c *cmd.ApplyRequest) error {
+	if m.dynUser == nil {
+		return nil
+	}
+	req := &cmd.CreateUserApiKeyRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.dynUser.CreateUserApiKey(req.Key)
+}
+
+func (m *Manager) RevokeUserApiKey(c *cmd.ApplyRequest) error {
+	if m.dynUser == nil {
+		return nil
+	}
+	req := &cmd.RevokeUserApiKeyRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.dynUser.RevokeUserApiKey(req.UserId, req.KeyId)
 }
 
 func (m *Manager) GetUsers(req *cmd.QueryRequest) ([]byte, error) {
@@ -127,6 +151,28 @@ func (m *Manager) GetUsers(req *cmd.QueryRequest) ([]byte, error) {
 	return payload, nil
 }
 
+func (m *Manager) GetUserApiKeys(req *cmd.QueryRequest) ([]byte, error) {
+	if m.dynUser == nil {
+		payload, _ := json.Marshal(cmd.QueryGetUserApiKeysResponse{})
+		return payload, nil
+	}
+	subCommand := cmd.QueryGetUserApiKeysRequest{}
+	if err := json.Unmarshal(req.SubCommand, &subCommand); err != nil {
+		return []byte{}, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	keys, err := m.dynUser.GetUserApiKeys(subCommand.UserId)
+	if err != nil {
+		return []byte{}, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	payload, err := json.Marshal(cmd.QueryGetUserApiKeysResponse{Keys: keys})
+	if err != nil {
+		return []byte{}, fmt.Errorf("could not marshal query response: %w", err)
+	}
+	return payload, nil
+}
+
 func (m *Manager) CheckUserIdentifierExists(req *cmd.QueryRequest) ([]byte, error) {
 	if m.dynUser == nil {
 		payload, _ := json.Marshal(cmd.QueryGetUsersRequest{})
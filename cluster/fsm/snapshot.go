@@ -27,6 +27,8 @@ type Snapshot struct {
 	Schema []byte `json:"schema,omitempty"`
 	// Aliases is the collection alias mapping
 	Aliases []byte `json:"aliases,omitempty"`
+	// TenantKeys are the wrapped data keys of tenants by their id
+	TenantKeys []byte `json:"tenant_keys,omitempty"`
 	// RBAC is the rbac that will be used to restore the FSM
 	RBAC []byte `json:"rbac,omitempty"`
 	// DistributedTasks are the tasks that will be used to restore the FSM.
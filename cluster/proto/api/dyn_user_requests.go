@@ -29,6 +29,7 @@ type CreateUsersRequest struct {
 	UserIdentifier     string
 	ApiKeyFirstLetters string
 	CreatedAt          time.Time
+	ExpiresAt          time.Time
 	Version            int
 }
 
@@ -46,9 +47,21 @@ type RotateUserApiKeyRequest struct {
 	SecureHash         string
 	OldIdentifier      string
 	NewIdentifier      string
+	ExpiresAt          time.Time
 	Version            int
 }
 
+type CreateUserApiKeyRequest struct {
+	Key     apikey.UserApiKey
+	Version int
+}
+
+type RevokeUserApiKeyRequest struct {
+	UserId  string
+	KeyId   string
+	Version int
+}
+
 type DeleteUsersRequest struct {
 	UserId  string
 	Version int
@@ -73,6 +86,14 @@ type QueryGetUsersResponse struct {
 	Users map[string]*apikey.User
 }
 
+type QueryGetUserApiKeysRequest struct {
+	UserId string
+}
+
+type QueryGetUserApiKeysResponse struct {
+	Keys []*apikey.UserApiKey
+}
+
 type QueryUserIdentifierExistsRequest struct {
 	UserIdentifier string
 }
@@ -20,6 +20,9 @@ import (
 type AddClassRequest struct {
 	Class *models.Class
 	State *sharding.State
+	// TenantKeys are the wrapped data keys of the tenants of a restored
+	// class by their id, see backup.ClassDescriptor
+	TenantKeys map[string][]byte `json:",omitempty"`
 }
 
 type UpdateClassRequest struct {
@@ -50,6 +53,15 @@ type DeleteReplicaFromShard struct {
 	SchemaVersion            uint64
 }
 
+// AddRangeShardsRequest adds shards for new key ranges to a collection which
+// is sharded by range
+type AddRangeShardsRequest struct {
+	// ClusterNodes are the nodes the new shards can be placed on
+	ClusterNodes  []string
+	Shards        []string
+	SchemaVersion uint64
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
@@ -133,3 +145,28 @@ type QueryGetAliasesRequest struct {
 type QueryGetAliasesResponse struct {
 	Aliases map[string]string
 }
+
+// TenantQuotasFromModel converts the quotas of a tenant to their wire format
+func TenantQuotasFromModel(q *models.TenantQuotas) *TenantQuotas {
+	if q == nil {
+		return nil
+	}
+	return &TenantQuotas{
+		MaxObjects:          q.MaxObjects,
+		MaxBytes:            q.MaxBytes,
+		MaxVectorDimensions: q.MaxVectorDimensions,
+	}
+}
+
+// TenantQuotasToModel is the inverse of TenantQuotasFromModel. Quotas without
+// any limit set are returned as nil.
+func TenantQuotasToModel(q *TenantQuotas) *models.TenantQuotas {
+	if q == nil || (q.MaxObjects == 0 && q.MaxBytes == 0 && q.MaxVectorDimensions == 0) {
+		return nil
+	}
+	return &models.TenantQuotas{
+		MaxObjects:          q.MaxObjects,
+		MaxBytes:            q.MaxBytes,
+		MaxVectorDimensions: q.MaxVectorDimensions,
+	}
+}
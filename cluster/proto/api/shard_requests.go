@@ -41,6 +41,9 @@ func (s ShardReplicationTransferType) String() string {
 const (
 	COPY ShardReplicationTransferType = "COPY"
 	MOVE ShardReplicationTransferType = "MOVE"
+	// SPLIT moves half of the virtual shards of the source shard to a new
+	// shard on the target node
+	SPLIT ShardReplicationTransferType = "SPLIT"
 )
 
 type ReplicationReplicateShardRequest struct {
@@ -53,6 +56,9 @@ type ReplicationReplicateShardRequest struct {
 	SourceCollection string
 	SourceShard      string
 	TargetNode       string
+	// TargetShard is the name of the shard created by a SPLIT, it is empty
+	// for any other transfer type
+	TargetShard string
 
 	TransferType string
 }
@@ -114,6 +120,8 @@ type ReplicationDetailsResponse struct {
 	Collection   string
 	SourceNodeId string
 	TargetNodeId string
+	// TargetShardId is the shard created by a SPLIT
+	TargetShardId string
 
 	Uncancelable       bool
 	ScheduledForCancel bool
@@ -190,6 +198,16 @@ type ReplicationAddReplicaToShard struct {
 	OpId                     uint64
 	Class, Shard, TargetNode string
 	SchemaVersion            uint64
+	// ReadOnly is set if TargetNode is a read-only node
+	ReadOnly bool
+}
+
+type ReplicationSplitShard struct {
+	OpId                                  uint64
+	Class, Shard, TargetShard, TargetNode string
+	// Nodes holds the replicas of the new shard, the first one is TargetNode
+	Nodes         []string
+	SchemaVersion uint64
 }
 
 type ReplicationForceDeleteAllRequest struct{}
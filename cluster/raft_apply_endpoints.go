@@ -72,11 +72,11 @@ func (s *Raft) DeleteClass(ctx context.Context, name string) (uint64, error) {
 	return s.Execute(ctx, command)
 }
 
-func (s *Raft) RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+func (s *Raft) RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State, tenantKeys map[string][]byte) (uint64, error) {
 	if cls == nil || cls.Class == "" {
 		return 0, fmt.Errorf("nil class or empty class name: %w", schema.ErrBadRequest)
 	}
-	req := cmd.AddClassRequest{Class: cls, State: ss}
+	req := cmd.AddClassRequest{Class: cls, State: ss, TenantKeys: tenantKeys}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
 		return 0, fmt.Errorf("marshal request: %w", err)
@@ -129,6 +129,22 @@ func (s *Raft) AddReplicaToShard(ctx context.Context, class, shard, targetNode s
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) AddRangeShards(ctx context.Context, class string, req *cmd.AddRangeShardsRequest) (uint64, error) {
+	if class == "" || len(req.Shards) == 0 {
+		return 0, fmt.Errorf("empty class or shards: %w", schema.ErrBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_ADD_RANGE_SHARDS,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) DeleteReplicaFromShard(ctx context.Context, class, shard, targetNode string) (uint64, error) {
 	if class == "" || shard == "" || targetNode == "" {
 		return 0, fmt.Errorf("empty class or shard or sourceNode or targetNode: %w", schema.ErrBadRequest)
@@ -159,6 +175,9 @@ func (s *Raft) ReplicationAddReplicaToShard(ctx context.Context, class, shard, t
 		Shard:      shard,
 		TargetNode: targetNode,
 		OpId:       opId,
+		// replicas are added by the replication consumer running on the
+		// target node, which knows its own role
+		ReadOnly: targetNode == s.store.cfg.NodeID && s.store.cfg.ReadOnly,
 	}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
@@ -172,6 +191,30 @@ func (s *Raft) ReplicationAddReplicaToShard(ctx context.Context, class, shard, t
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) ReplicationSplitShard(ctx context.Context, class, shard, targetShard string, nodes []string, opId uint64) (uint64, error) {
+	if class == "" || shard == "" || targetShard == "" || len(nodes) == 0 {
+		return 0, fmt.Errorf("empty class or shard or targetShard or nodes: %w", schema.ErrBadRequest)
+	}
+	req := cmd.ReplicationSplitShard{
+		Class:       class,
+		Shard:       shard,
+		TargetShard: targetShard,
+		TargetNode:  nodes[0],
+		Nodes:       nodes,
+		OpId:        opId,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_REPLICATION_REPLICATE_SPLIT_SHARD,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) SyncShard(ctx context.Context, collection, shard, nodeId string) (uint64, error) {
 	if collection == "" || shard == "" || nodeId == "" {
 		return 0, fmt.Errorf("empty class or shard or sourceNode or targetNode: %w", schema.ErrBadRequest)
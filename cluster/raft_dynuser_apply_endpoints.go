@@ -19,14 +19,16 @@ import (
 	"time"
 
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 )
 
-func (s *Raft) CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt time.Time) error {
+func (s *Raft) CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt, expiresAt time.Time) error {
 	req := cmd.CreateUsersRequest{
 		UserId:             userId,
 		SecureHash:         secureHash,
 		UserIdentifier:     userIdentifier,
 		CreatedAt:          createdAt,
+		ExpiresAt:          expiresAt,
 		ApiKeyFirstLetters: apiKeyFirstLetters,
 		Version:            cmd.DynUserLatestCommandPolicyVersion,
 	}
@@ -66,13 +68,14 @@ func (s *Raft) CreateUserWithKey(userId, apiKeyFirstLetters string, weakHash [sh
 	return nil
 }
 
-func (s *Raft) RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string) error {
+func (s *Raft) RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string, expiresAt time.Time) error {
 	req := cmd.RotateUserApiKeyRequest{
 		UserId:             userId,
 		ApiKeyFirstLetters: apiKeyFirstLetters,
 		SecureHash:         secureHash,
 		OldIdentifier:      oldIdentifier,
 		NewIdentifier:      newIdentifier,
+		ExpiresAt:          expiresAt,
 		Version:            cmd.DynUserLatestCommandPolicyVersion,
 	}
 	subCommand, err := json.Marshal(&req)
@@ -146,3 +149,42 @@ func (s *Raft) DeactivateUser(userId string, revokeKey bool) error {
 	}
 	return nil
 }
+
+func (s *Raft) CreateUserApiKey(key apikey.UserApiKey) error {
+	req := cmd.CreateUserApiKeyRequest{
+		Key:     key,
+		Version: cmd.DynUserLatestCommandPolicyVersion,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_CREATE_USER_API_KEY,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) RevokeUserApiKey(userId, keyId string) error {
+	req := cmd.RevokeUserApiKeyRequest{
+		UserId:  userId,
+		KeyId:   keyId,
+		Version: cmd.DynUserLatestCommandPolicyVersion,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_REVOKE_USER_API_KEY,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
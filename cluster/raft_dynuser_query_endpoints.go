@@ -75,3 +75,31 @@ func (s *Raft) CheckUserIdentifierExists(userIdentifier string) (bool, error) {
 
 	return response.Exists, nil
 }
+
+func (s *Raft) GetUserApiKeys(userId string) ([]*apikey.UserApiKey, error) {
+	req := cmd.QueryGetUserApiKeysRequest{
+		UserId: userId,
+	}
+
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	command := &cmd.QueryRequest{
+		Type:       cmd.QueryRequest_TYPE_GET_USER_API_KEYS,
+		SubCommand: subCommand,
+	}
+	queryResp, err := s.Query(context.Background(), command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	response := cmd.QueryGetUserApiKeysResponse{}
+	err = json.Unmarshal(queryResp.Payload, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query result: %w", err)
+	}
+
+	return response.Keys, nil
+}
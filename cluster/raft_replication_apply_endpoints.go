@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/replication"
 	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func (s *Raft) ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID, sourceNode string, sourceCollection string, sourceShard string, targetNode string, transferType string) error {
@@ -33,6 +34,11 @@ func (s *Raft) ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID
 		Uuid:             uuid,
 		TransferType:     transferType,
 	}
+	if api.ShardReplicationTransferType(transferType) == api.SPLIT {
+		// the name of the new shard has to be part of the command, so that
+		// every node ends up with the same sharding state
+		req.TargetShard = sharding.GenerateShardName()
+	}
 
 	if err := replication.ValidateReplicationReplicateShard(s.SchemaReader(), req); err != nil {
 		return fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, err)
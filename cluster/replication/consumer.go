@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
 )
 
 // asyncStatusInterval is the polling interval to check the status of the
@@ -438,6 +439,13 @@ func (c *CopyOpConsumer) cancelOp(op ShardReplicationOpAndStatus, logger *logrus
 			Error(fmt.Errorf("failure when syncing shards for op: %s", op.Op.UUID))
 	}
 
+	// A split makes its source shard read-only while copying it, which must not outlive the op
+	if op.Op.TransferType == api.SPLIT {
+		if _, err := c.leaderClient.UpdateShardStatus(ctx, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, storagestate.StatusReady.String()); err != nil {
+			logger.WithError(err).Error("failure while making split shard writable again")
+		}
+	}
+
 	// If the operation is only being cancelled then notify the FSM so it can update its state
 	if op.Status.OnlyCancellation() {
 		if err := c.leaderClient.ReplicationCancellationComplete(ctx, op.Op.ID); err != nil {
@@ -488,6 +496,10 @@ func (c *CopyOpConsumer) processHydratingOp(ctx context.Context, op ShardReplica
 	logger := getLoggerForOpAndStatus(c.logger, op.Op, op.Status)
 	logger.Info("processing hydrating replication operation")
 
+	if op.Op.TransferType == api.SPLIT {
+		return c.processHydratingSplitOp(ctx, op, logger)
+	}
+
 	if c.schemaReader.MultiTenancy(op.Op.TargetShard.CollectionId).Enabled {
 		schemaVersion, err := c.leaderClient.UpdateTenants(ctx, op.Op.TargetShard.CollectionId, &api.UpdateTenantsRequest{
 			Tenants: []*api.Tenant{
@@ -542,6 +554,10 @@ func (c *CopyOpConsumer) processFinalizingOp(ctx context.Context, op ShardReplic
 		return api.ShardReplicationState(""), ctx.Err()
 	}
 
+	if op.Op.TransferType == api.SPLIT {
+		return c.processFinalizingSplitOp(ctx, op, logger)
+	}
+
 	if err := c.leaderClient.WaitForUpdate(ctx, op.Status.SchemaVersion); err != nil {
 		logger.WithError(err).Error("failure while waiting for schema version to be applied to local node")
 		return api.ShardReplicationState(""), err
@@ -636,11 +652,138 @@ func (c *CopyOpConsumer) processFinalizingOp(ctx context.Context, op ShardReplic
 	}
 }
 
+// processHydratingSplitOp copies the shard to split into the replicas of the new shard while it is still writable,
+// so that the FINALIZING state only has to top up the copies while the shard is read-only. Reads are served by the
+// source shard throughout the split.
+func (c *CopyOpConsumer) processHydratingSplitOp(ctx context.Context, op ShardReplicationOpAndStatus, logger *logrus.Entry) (api.ShardReplicationState, error) {
+	if _, err := c.hydrateSplitReplicas(ctx, op, op.Status.SchemaVersion); err != nil {
+		logger.WithError(err).Error("failure while copying shard to split")
+		return api.ShardReplicationState(""), err
+	}
+
+	if ctx.Err() != nil {
+		logger.WithError(ctx.Err()).Debug("context cancelled, stopping replication operation")
+		return api.ShardReplicationState(""), ctx.Err()
+	}
+
+	return api.FINALIZING, nil
+}
+
+// processFinalizingSplitOp makes the source shard read-only, so that no writes are lost between the copy and the cut
+// over, tops up the copies and cuts over to the new shard by moving half of the virtual shards of the source shard
+// to it. It then makes the source shard writable again and transitions to the DEHYDRATING state, in which both
+// shards drop the objects they no longer own.
+func (c *CopyOpConsumer) processFinalizingSplitOp(ctx context.Context, op ShardReplicationOpAndStatus, logger *logrus.Entry) (api.ShardReplicationState, error) {
+	// Sanity check: if the new shard is already part of the sharding state we are recovering from a previous failure
+	// and the cut over has happened already
+	nodes, err := c.schemaReader.ShardReplicas(op.Op.TargetShard.CollectionId, op.Op.TargetShard.ShardId)
+	if err != nil || !slices.Contains(nodes, op.Op.TargetShard.NodeId) {
+		schemaVersion, err := c.leaderClient.UpdateShardStatus(ctx, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, storagestate.StatusReadOnly.String())
+		if err != nil {
+			logger.WithError(err).Error("failure while making shard read-only for split operation")
+			return api.ShardReplicationState(""), err
+		}
+
+		// the source replicas apply the schema version before their files are listed, so they are read-only by then
+		nodes, err := c.hydrateSplitReplicas(ctx, op, schemaVersion)
+		if err != nil {
+			logger.WithError(err).Error("failure while copying read-only shard to split")
+			return api.ShardReplicationState(""), err
+		}
+
+		if ctx.Err() != nil {
+			logger.WithError(ctx.Err()).Debug("context cancelled, stopping replication operation")
+			return api.ShardReplicationState(""), ctx.Err()
+		}
+
+		schemaVersion, err = c.leaderClient.ReplicationSplitShard(ctx, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, op.Op.TargetShard.ShardId, nodes, op.Op.ID)
+		if err != nil {
+			logger.WithError(err).Error("failure while splitting shard")
+			return api.ShardReplicationState(""), err
+		}
+		if err := c.leaderClient.WaitForUpdate(ctx, schemaVersion); err != nil {
+			logger.WithError(err).Error("failure while waiting for schema version to be applied to local node")
+			return api.ShardReplicationState(""), err
+		}
+	}
+
+	if _, err := c.leaderClient.UpdateShardStatus(ctx, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, storagestate.StatusReady.String()); err != nil {
+		logger.WithError(err).Error("failure while making split shard writable again")
+		return api.ShardReplicationState(""), err
+	}
+	return api.DEHYDRATING, nil
+}
+
+// processDehydratingSplitOp drops the objects every replica of the split shard and of the new shard no longer owns.
+// Dropping them is idempotent, so the whole state is repeated if it fails or the node restarts.
+func (c *CopyOpConsumer) processDehydratingSplitOp(ctx context.Context, op ShardReplicationOpAndStatus, logger *logrus.Entry) (api.ShardReplicationState, error) {
+	// The op is only seen in the DEHYDRATING state once the cut over has been applied locally, the replicas have to
+	// apply it as well before they look for objects owned by the other shard
+	classInfo := c.schemaReader.ClassInfo(op.Op.SourceShard.CollectionId)
+	schemaVersion := classInfo.Version()
+
+	for _, shard := range []string{op.Op.SourceShard.ShardId, op.Op.TargetShard.ShardId} {
+		nodes, err := c.schemaReader.ShardReplicas(op.Op.SourceShard.CollectionId, shard)
+		if err != nil {
+			logger.WithError(err).Error("failure while getting shard replicas")
+			return api.ShardReplicationState(""), err
+		}
+		for _, node := range nodes {
+			if ctx.Err() != nil {
+				logger.WithError(ctx.Err()).Debug("context cancelled, stopping replication operation")
+				return api.ShardReplicationState(""), ctx.Err()
+			}
+			if err := c.replicaCopier.DropUnownedObjects(ctx, node, op.Op.SourceShard.CollectionId, shard, schemaVersion); err != nil {
+				logger.WithError(err).WithFields(logrus.Fields{"node": node, "shard": shard}).
+					Error("failure while dropping objects owned by the other shard")
+				return api.ShardReplicationState(""), err
+			}
+		}
+	}
+	return api.READY, nil
+}
+
+// hydrateSplitReplicas copies the shard to split into every replica of the new shard and returns the nodes of
+// these replicas. Nodes holding a replica of the source shard copy it locally, the target node copies it from
+// the source node otherwise. Files which are up to date already are not copied again.
+func (c *CopyOpConsumer) hydrateSplitReplicas(ctx context.Context, op ShardReplicationOpAndStatus, schemaVersion uint64) ([]string, error) {
+	replicas, err := c.schemaReader.ShardReplicas(op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId)
+	if err != nil {
+		return nil, fmt.Errorf("get replicas of shard %s: %w", op.Op.SourceShard.ShardId, err)
+	}
+	// the new shard only gets writable replicas, replicas on read-only nodes
+	// are placed by the rebalancer once the split is done
+	readOnly, err := c.schemaReader.ShardReadOnlyReplicas(op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId)
+	if err != nil {
+		return nil, fmt.Errorf("get read-only replicas of shard %s: %w", op.Op.SourceShard.ShardId, err)
+	}
+	replicas = slices.DeleteFunc(replicas, func(node string) bool {
+		return slices.Contains(readOnly, node)
+	})
+
+	nodes := sharding.SplitShardNodes(replicas, op.Op.TargetShard.NodeId)
+	for _, node := range nodes {
+		if slices.Contains(replicas, node) {
+			err = c.replicaCopier.SplitReplicaFiles(ctx, node, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, op.Op.TargetShard.ShardId, schemaVersion)
+		} else {
+			err = c.replicaCopier.CopyReplicaFilesTo(ctx, op.Op.SourceShard.NodeId, op.Op.SourceShard.CollectionId, op.Op.SourceShard.ShardId, op.Op.TargetShard.ShardId, schemaVersion)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("copy shard %s on node %s: %w", op.Op.SourceShard.ShardId, node, err)
+		}
+	}
+	return nodes, nil
+}
+
 // processDehydratingOp is the state handler for the DEHYDRATING state.
 func (c *CopyOpConsumer) processDehydratingOp(ctx context.Context, op ShardReplicationOpAndStatus) (api.ShardReplicationState, error) {
 	logger := getLoggerForOpAndStatus(c.logger, op.Op, op.Status)
 	logger.Info("processing dehydrating replication operation")
 
+	if op.Op.TransferType == api.SPLIT {
+		return c.processDehydratingSplitOp(ctx, op, logger)
+	}
+
 	if err := c.leaderClient.WaitForUpdate(ctx, op.Status.SchemaVersion); err != nil {
 		logger.WithError(err).Error("failure while waiting for schema version to be applied to local node")
 		return api.ShardReplicationState(""), err
@@ -726,20 +869,22 @@ func (c *CopyOpConsumer) processCancelledOp(ctx context.Context, op ShardReplica
 		return api.ShardReplicationState(""), fmt.Errorf("replication operation with id %v is not in a state to be deleted", op.Op.ID)
 	}
 
-	// Cancel async replication and revert state if any
-	targetNodeOverride := additional.AsyncReplicationTargetNodeOverride{
-		CollectionID:   op.Op.SourceShard.CollectionId,
-		ShardID:        op.Op.TargetShard.ShardId,
-		TargetNode:     op.Op.TargetShard.NodeId,
-		SourceNode:     op.Op.SourceShard.NodeId,
-		UpperTimeBound: time.Now().UnixMilli(),
-	}
-	if err := c.replicaCopier.RemoveAsyncReplicationTargetNode(ctx, targetNodeOverride); err != nil {
-		logger.WithError(err).Error("failure while removing async replication target node")
-	}
+	// Cancel async replication and revert state if any, splits don't use async replication
+	if op.Op.TransferType != api.SPLIT {
+		targetNodeOverride := additional.AsyncReplicationTargetNodeOverride{
+			CollectionID:   op.Op.SourceShard.CollectionId,
+			ShardID:        op.Op.TargetShard.ShardId,
+			TargetNode:     op.Op.TargetShard.NodeId,
+			SourceNode:     op.Op.SourceShard.NodeId,
+			UpperTimeBound: time.Now().UnixMilli(),
+		}
+		if err := c.replicaCopier.RemoveAsyncReplicationTargetNode(ctx, targetNodeOverride); err != nil {
+			logger.WithError(err).Error("failure while removing async replication target node")
+		}
 
-	if err := c.replicaCopier.RevertAsyncReplicationLocally(ctx, op.Op.TargetShard.CollectionId, op.Op.SourceShard.ShardId); err != nil {
-		logger.WithError(err).Error("failure while reverting async replication locally")
+		if err := c.replicaCopier.RevertAsyncReplicationLocally(ctx, op.Op.TargetShard.CollectionId, op.Op.SourceShard.ShardId); err != nil {
+			logger.WithError(err).Error("failure while reverting async replication locally")
+		}
 	}
 
 	if err := c.leaderClient.ReplicationRemoveReplicaOp(ctx, op.Op.ID); err != nil {
@@ -1492,3 +1492,154 @@ func TestConsumerShutdown(t *testing.T) {
 	mockFSMUpdater.AssertExpectations(t)
 	mockReplicaCopier.AssertExpectations(t)
 }
+
+func TestConsumerSplit(t *testing.T) {
+	logger, _ := logrustest.NewNullLogger()
+	mockFSMUpdater := types.NewMockFSMUpdater(t)
+	mockReplicaCopier := types.NewMockReplicaCopier(t)
+	parser := fakes.NewMockParser()
+	parser.On("ParseClass", mock.Anything).Return(nil)
+	schemaManager := schema.NewSchemaManager("test-node", nil, parser, prometheus.NewPedanticRegistry(), logrus.New())
+	schemaReader := schemaManager.NewSchemaReader()
+	schemaManager.AddClass(
+		buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+			Class: &models.Class{Class: "TestCollection", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+			State: &sharding.State{
+				Physical: map[string]sharding.Physical{"shard1": {BelongsToNodes: []string{"node1", "node3"}, OwnsVirtual: []string{"v1", "v2"}}},
+				Virtual:  []sharding.Virtual{{Name: "v1", Upper: 1, AssignedToPhysical: "shard1"}, {Name: "v2", Upper: 2, AssignedToPhysical: "shard1"}},
+			},
+		}), "node1", true, false)
+
+	opId, err := randInt(t, 100, 200)
+	require.NoError(t, err, "error generating random operation id")
+
+	replicationFSM := replication.NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, replicationFSM.Replicate(uint64(opId), &api.ReplicationReplicateShardRequest{
+		Uuid: uuid4(), SourceCollection: "TestCollection", SourceShard: "shard1", SourceNode: "node1",
+		TargetNode: "node2", TargetShard: "split", TransferType: api.SPLIT.String(),
+	}))
+	schemaManager.SetReplicationFSM(replicationFSM)
+
+	mockFSMUpdater.EXPECT().
+		ReplicationGetReplicaOpStatus(mock.Anything, uint64(opId)).
+		Return(api.REGISTERED, nil).
+		Maybe()
+	mockFSMUpdater.EXPECT().
+		ReplicationUpdateReplicaOpStatus(mock.Anything, uint64(opId), api.HYDRATING).
+		Return(nil)
+
+	// the new shard is placed on the target node and next to the first source replica. The source shard is copied
+	// once while writable and topped up once it is read-only.
+	for _, schemaVersion := range []uint64{0, 5} {
+		mockReplicaCopier.EXPECT().
+			CopyReplicaFilesTo(mock.Anything, "node1", "TestCollection", "shard1", "split", schemaVersion).
+			Return(nil).
+			Once()
+		mockReplicaCopier.EXPECT().
+			SplitReplicaFiles(mock.Anything, "node1", "TestCollection", "shard1", "split", schemaVersion).
+			Return(nil).
+			Once()
+	}
+	mockFSMUpdater.EXPECT().
+		ReplicationUpdateReplicaOpStatus(mock.Anything, uint64(opId), api.FINALIZING).
+		Return(nil)
+	mockFSMUpdater.EXPECT().
+		UpdateShardStatus(mock.Anything, "TestCollection", "shard1", "READONLY").
+		Return(uint64(5), nil).
+		Once()
+	mockFSMUpdater.EXPECT().
+		ReplicationSplitShard(mock.Anything, "TestCollection", "shard1", "split", []string{"node2", "node1"}, uint64(opId)).
+		RunAndReturn(func(ctx context.Context, collection, shard, targetShard string, nodes []string, opId uint64) (uint64, error) {
+			req := buildApplyRequest(collection, api.ApplyRequest_TYPE_REPLICATION_REPLICATE_SPLIT_SHARD,
+				api.ReplicationSplitShard{OpId: opId, Class: collection, Shard: shard, TargetShard: targetShard, Nodes: nodes})
+			req.Version = 6
+			return req.Version, schemaManager.ReplicationSplitShard(req, true)
+		}).
+		Once()
+	mockFSMUpdater.EXPECT().
+		WaitForUpdate(mock.Anything, uint64(6)).
+		Return(nil)
+	mockFSMUpdater.EXPECT().
+		UpdateShardStatus(mock.Anything, "TestCollection", "shard1", "READY").
+		Return(uint64(7), nil).
+		Once()
+	mockFSMUpdater.EXPECT().
+		ReplicationUpdateReplicaOpStatus(mock.Anything, uint64(opId), api.DEHYDRATING).
+		Return(nil)
+
+	// every replica of both shards drops the objects owned by the other one once the cut over is applied, the first
+	// attempt fails and is retried
+	mockReplicaCopier.EXPECT().
+		DropUnownedObjects(mock.Anything, "node1", "TestCollection", "shard1", uint64(6)).
+		Return(errors.New("node restarted")).
+		Once()
+	for _, replica := range []struct{ node, shard string }{
+		{"node1", "shard1"}, {"node3", "shard1"}, {"node2", "split"}, {"node1", "split"},
+	} {
+		mockReplicaCopier.EXPECT().
+			DropUnownedObjects(mock.Anything, replica.node, "TestCollection", replica.shard, uint64(6)).
+			Return(nil).
+			Once()
+	}
+	mockFSMUpdater.EXPECT().
+		ReplicationRegisterError(mock.Anything, uint64(opId), mock.Anything).
+		Return(nil).
+		Once()
+	mockFSMUpdater.EXPECT().
+		ReplicationUpdateReplicaOpStatus(mock.Anything, uint64(opId), api.READY).
+		Return(nil)
+
+	var completionWg sync.WaitGroup
+	completionWg.Add(1)
+	metricsCallbacks := metrics.NewReplicationEngineOpsCallbacksBuilder().
+		WithOpCompleteCallback(func(node string) {
+			completionWg.Done()
+		}).
+		WithOpFailedCallback(func(node string) {
+			t.Error("Failed callback should not be called for successful operation")
+		}).Build()
+
+	consumer := replication.NewCopyOpConsumer(
+		logger,
+		mockFSMUpdater,
+		mockReplicaCopier,
+		"node2",
+		&backoff.ZeroBackOff{},
+		replication.NewOpsCache(),
+		time.Second*10,
+		1,
+		runtime.NewDynamicValue(time.Second*100),
+		metricsCallbacks,
+		schemaReader,
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	opsChan := make(chan replication.ShardReplicationOpAndStatus, 1)
+	doneChan := make(chan error, 1)
+	go func() {
+		doneChan <- consumer.Consume(ctx, opsChan)
+	}()
+
+	opsChan <- replication.NewShardReplicationOpAndStatus(
+		replication.NewShardSplitOp(uint64(opId), "node1", "node2", "TestCollection", "shard1", "split"),
+		replication.NewShardReplicationStatus(api.REGISTERED))
+
+	waitChan := make(chan struct{})
+	go func() {
+		completionWg.Wait()
+		waitChan <- struct{}{}
+	}()
+
+	select {
+	case <-waitChan:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Test timed out waiting for operation completion")
+	}
+
+	close(opsChan)
+	require.NoError(t, <-doneChan, "expected operation completing successfully")
+	mockFSMUpdater.AssertExpectations(t)
+	mockReplicaCopier.AssertExpectations(t)
+}
@@ -68,6 +68,39 @@ func New(t types.RemoteIndex, nodeSelector cluster.NodeSelector, rootPath string
 
 // CopyReplicaFiles copies a shard replica from the source node to this node.
 func (c *Copier) CopyReplicaFiles(ctx context.Context, srcNodeId, collectionName, shardName string, schemaVersion uint64) error {
+	return c.copyShardFiles(ctx, srcNodeId, collectionName, shardName, shardName, schemaVersion)
+}
+
+// CopyReplicaFilesTo copies a shard replica from the source node to a shard
+// named targetShardName on this node. It is used to split a shard, the copy
+// starts out with all the objects of the source shard.
+func (c *Copier) CopyReplicaFilesTo(ctx context.Context, srcNodeId, collectionName, shardName, targetShardName string, schemaVersion uint64) error {
+	return c.copyShardFiles(ctx, srcNodeId, collectionName, shardName, targetShardName, schemaVersion)
+}
+
+// SplitReplicaFiles copies the replica of shardName on the node nodeId to the
+// replica of targetShardName, the shard split off shardName, on the same node.
+// Files which are up to date already are not copied again.
+func (c *Copier) SplitReplicaFiles(ctx context.Context, nodeId, collectionName, shardName, targetShardName string, schemaVersion uint64) error {
+	hostname, ok := c.nodeSelector.NodeHostname(nodeId)
+	if !ok {
+		return fmt.Errorf("node address not found in cluster membership for node %s", nodeId)
+	}
+	return c.remoteIndex.SplitShardReplica(ctx, hostname, collectionName, shardName, targetShardName, schemaVersion)
+}
+
+// DropUnownedObjects deletes the objects of the replica of shardName on the
+// node nodeId which belong to a different shard since a split. The node
+// applies schemaVersion first, which has to contain the split.
+func (c *Copier) DropUnownedObjects(ctx context.Context, nodeId, collectionName, shardName string, schemaVersion uint64) error {
+	hostname, ok := c.nodeSelector.NodeHostname(nodeId)
+	if !ok {
+		return fmt.Errorf("node address not found in cluster membership for node %s", nodeId)
+	}
+	return c.remoteIndex.DropUnownedObjects(ctx, hostname, collectionName, shardName, schemaVersion)
+}
+
+func (c *Copier) copyShardFiles(ctx context.Context, srcNodeId, collectionName, shardName, targetShardName string, schemaVersion uint64) error {
 	sourceNodeHostname, ok := c.nodeSelector.NodeHostname(srcNodeId)
 	if !ok {
 		return fmt.Errorf("source node address not found in cluster membership for node %s", srcNodeId)
@@ -96,17 +129,22 @@ func (c *Copier) CopyReplicaFiles(ctx context.Context, srcNodeId, collectionName
 		time.Sleep(sleepTime)
 	}
 
-	err = c.prepareLocalFolder(collectionName, shardName, relativeFilePaths)
+	localFilePaths, err := c.localFilePaths(collectionName, shardName, targetShardName, relativeFilePaths)
+	if err != nil {
+		return err
+	}
+
+	err = c.prepareLocalFolder(collectionName, targetShardName, localFilePaths)
 	if err != nil {
 		return fmt.Errorf("failed to prepare local folder: %w", err)
 	}
 
 	eg, gctx := enterrors.NewErrorGroupWithContextWrapper(c.logger, ctx)
 	eg.SetLimit(concurrency)
-	for _, relativeFilePath := range relativeFilePaths {
-		relativeFilePath := relativeFilePath
+	for i := range relativeFilePaths {
+		relativeFilePath, localFilePath := relativeFilePaths[i], localFilePaths[i]
 		eg.Go(func() error {
-			return c.syncFile(gctx, sourceNodeHostname, collectionName, shardName, relativeFilePath)
+			return c.syncFile(gctx, sourceNodeHostname, collectionName, shardName, relativeFilePath, localFilePath)
 		})
 	}
 
@@ -115,7 +153,7 @@ func (c *Copier) CopyReplicaFiles(ctx context.Context, srcNodeId, collectionName
 		return fmt.Errorf("failed to sync files: %w", err)
 	}
 
-	err = c.validateLocalFolder(collectionName, shardName, relativeFilePaths)
+	err = c.validateLocalFolder(collectionName, targetShardName, localFilePaths)
 	if err != nil {
 		return fmt.Errorf("failed to validate local folder: %w", err)
 	}
@@ -136,6 +174,27 @@ func (c *Copier) shardPath(collectionName, shardName string) string {
 	return path.Join(c.rootDataPath, strings.ToLower(collectionName), shardName)
 }
 
+// localFilePaths maps the paths of the files of the source shard, relative
+// to the data root, to the paths of the files of the local target shard
+func (c *Copier) localFilePaths(collectionName, shardName, targetShardName string, relativeFilePaths []string) ([]string, error) {
+	if shardName == targetShardName {
+		return relativeFilePaths, nil
+	}
+
+	sourcePrefix := filepath.Join(strings.ToLower(collectionName), shardName) + string(filepath.Separator)
+	targetPrefix := filepath.Join(strings.ToLower(collectionName), targetShardName) + string(filepath.Separator)
+
+	out := make([]string, len(relativeFilePaths))
+	for i, relativeFilePath := range relativeFilePaths {
+		rest, ok := strings.CutPrefix(relativeFilePath, sourcePrefix)
+		if !ok {
+			return nil, fmt.Errorf("file %q is not part of the folder of shard %s", relativeFilePath, shardName)
+		}
+		out[i] = targetPrefix + rest
+	}
+	return out, nil
+}
+
 func (c *Copier) prepareLocalFolder(collectionName, shardName string, fileNames []string) error {
 	fileNamesMap := make(map[string]struct{}, len(fileNames))
 	for _, fileName := range fileNames {
@@ -270,13 +329,13 @@ func depth(path string) int {
 	return strings.Count(filepath.Clean(path), string(filepath.Separator))
 }
 
-func (c *Copier) syncFile(ctx context.Context, sourceNodeHostname, collectionName, shardName, relativeFilePath string) error {
+func (c *Copier) syncFile(ctx context.Context, sourceNodeHostname, collectionName, shardName, relativeFilePath, localFilePath string) error {
 	md, err := c.remoteIndex.GetFileMetadata(ctx, sourceNodeHostname, collectionName, shardName, relativeFilePath)
 	if err != nil {
 		return err
 	}
 
-	finalLocalPath := filepath.Join(c.rootDataPath, relativeFilePath)
+	finalLocalPath := filepath.Join(c.rootDataPath, localFilePath)
 
 	_, checksum, err := integrity.CRC32(finalLocalPath)
 	if err != nil {
@@ -194,3 +194,35 @@ func TestCopierCopyReplicaFiles(t *testing.T) {
 
 	}
 }
+
+func TestCopierCopyReplicaFilesTo(t *testing.T) {
+	localTmpDir := t.TempDir()
+	content := []byte("foo")
+
+	remoteTmpDir := t.TempDir()
+	remoteFile := filepath.Join(remoteTmpDir, "file1")
+	require.NoError(t, os.WriteFile(remoteFile, content, 0o644))
+	_, fileCrc32, err := integrity.CRC32(remoteFile)
+	require.NoError(t, err)
+
+	relativeFilePath := filepath.Join("collection", "shard", "lsm", "file1")
+	mockRemoteIndex := types.NewMockRemoteIndex(t)
+	mockRemoteIndex.EXPECT().PauseFileActivity(mock.Anything, "node1", "collection", "shard", uint64(0)).Return(nil)
+	mockRemoteIndex.EXPECT().ResumeFileActivity(mock.Anything, "node1", "collection", "shard").Return(nil)
+	mockRemoteIndex.EXPECT().ListFiles(mock.Anything, "node1", "collection", "shard").Return([]string{relativeFilePath}, nil)
+	mockRemoteIndex.EXPECT().GetFileMetadata(mock.Anything, "node1", "collection", "shard", relativeFilePath).
+		Return(file.FileMetadata{Name: relativeFilePath, Size: int64(len(content)), CRC32: fileCrc32}, nil)
+	mockRemoteIndex.EXPECT().GetFile(mock.Anything, "node1", "collection", "shard", relativeFilePath).
+		Return(io.NopCloser(bytes.NewReader(content)), nil)
+
+	logger, _ := logrusTest.NewNullLogger()
+	copier := copier.New(mockRemoteIndex, fakes.NewFakeClusterState("node1"), localTmpDir, nil, logger)
+	require.NoError(t, copier.CopyReplicaFilesTo(t.Context(), "node1", "collection", "shard", "split", 0))
+
+	copied, err := os.ReadFile(filepath.Join(localTmpDir, "collection", "split", "lsm", "file1"))
+	require.NoError(t, err)
+	require.Equal(t, content, copied)
+
+	_, err = os.Stat(filepath.Join(localTmpDir, "collection", "shard"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
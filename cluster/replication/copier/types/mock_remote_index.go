@@ -89,6 +89,56 @@ func (_c *MockRemoteIndex_AddAsyncReplicationTargetNode_Call) RunAndReturn(run f
 	return _c
 }
 
+// DropUnownedObjects provides a mock function with given fields: ctx, hostName, indexName, shardName, schemaVersion
+func (_m *MockRemoteIndex) DropUnownedObjects(ctx context.Context, hostName string, indexName string, shardName string, schemaVersion uint64) error {
+	ret := _m.Called(ctx, hostName, indexName, shardName, schemaVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropUnownedObjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, hostName, indexName, shardName, schemaVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRemoteIndex_DropUnownedObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropUnownedObjects'
+type MockRemoteIndex_DropUnownedObjects_Call struct {
+	*mock.Call
+}
+
+// DropUnownedObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hostName string
+//   - indexName string
+//   - shardName string
+//   - schemaVersion uint64
+func (_e *MockRemoteIndex_Expecter) DropUnownedObjects(ctx interface{}, hostName interface{}, indexName interface{}, shardName interface{}, schemaVersion interface{}) *MockRemoteIndex_DropUnownedObjects_Call {
+	return &MockRemoteIndex_DropUnownedObjects_Call{Call: _e.mock.On("DropUnownedObjects", ctx, hostName, indexName, shardName, schemaVersion)}
+}
+
+func (_c *MockRemoteIndex_DropUnownedObjects_Call) Run(run func(ctx context.Context, hostName string, indexName string, shardName string, schemaVersion uint64)) *MockRemoteIndex_DropUnownedObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRemoteIndex_DropUnownedObjects_Call) Return(_a0 error) *MockRemoteIndex_DropUnownedObjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRemoteIndex_DropUnownedObjects_Call) RunAndReturn(run func(context.Context, string, string, string, uint64) error) *MockRemoteIndex_DropUnownedObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFile provides a mock function with given fields: ctx, hostName, indexName, shardName, fileName
 func (_m *MockRemoteIndex) GetFile(ctx context.Context, hostName string, indexName string, shardName string, fileName string) (io.ReadCloser, error) {
 	ret := _m.Called(ctx, hostName, indexName, shardName, fileName)
@@ -421,6 +471,57 @@ func (_c *MockRemoteIndex_ResumeFileActivity_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SplitShardReplica provides a mock function with given fields: ctx, hostName, indexName, shardName, targetShard, schemaVersion
+func (_m *MockRemoteIndex) SplitShardReplica(ctx context.Context, hostName string, indexName string, shardName string, targetShard string, schemaVersion uint64) error {
+	ret := _m.Called(ctx, hostName, indexName, shardName, targetShard, schemaVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SplitShardReplica")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, hostName, indexName, shardName, targetShard, schemaVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRemoteIndex_SplitShardReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SplitShardReplica'
+type MockRemoteIndex_SplitShardReplica_Call struct {
+	*mock.Call
+}
+
+// SplitShardReplica is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hostName string
+//   - indexName string
+//   - shardName string
+//   - targetShard string
+//   - schemaVersion uint64
+func (_e *MockRemoteIndex_Expecter) SplitShardReplica(ctx interface{}, hostName interface{}, indexName interface{}, shardName interface{}, targetShard interface{}, schemaVersion interface{}) *MockRemoteIndex_SplitShardReplica_Call {
+	return &MockRemoteIndex_SplitShardReplica_Call{Call: _e.mock.On("SplitShardReplica", ctx, hostName, indexName, shardName, targetShard, schemaVersion)}
+}
+
+func (_c *MockRemoteIndex_SplitShardReplica_Call) Run(run func(ctx context.Context, hostName string, indexName string, shardName string, targetShard string, schemaVersion uint64)) *MockRemoteIndex_SplitShardReplica_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRemoteIndex_SplitShardReplica_Call) Return(_a0 error) *MockRemoteIndex_SplitShardReplica_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRemoteIndex_SplitShardReplica_Call) RunAndReturn(run func(context.Context, string, string, string, string, uint64) error) *MockRemoteIndex_SplitShardReplica_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRemoteIndex creates a new instance of MockRemoteIndex. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRemoteIndex(t interface {
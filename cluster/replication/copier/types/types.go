@@ -61,4 +61,10 @@ type RemoteIndex interface {
 	// RemoveAsyncReplicationTargetNode See adapters/clients.RemoteIndex.RemoveAsyncReplicationTargetNode
 	RemoveAsyncReplicationTargetNode(ctx context.Context,
 		hostName, indexName, shardName string, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error
+	// SplitShardReplica See adapters/clients.RemoteIndex.SplitShardReplica
+	SplitShardReplica(ctx context.Context,
+		hostName, indexName, shardName, targetShard string, schemaVersion uint64) error
+	// DropUnownedObjects See adapters/clients.RemoteIndex.DropUnownedObjects
+	DropUnownedObjects(ctx context.Context,
+		hostName, indexName, shardName string, schemaVersion uint64) error
 }
@@ -299,6 +299,10 @@ func (m *Manager) QueryShardingStateByCollectionAndShard(c *cmd.QueryRequest) ([
 }
 
 func makeReplicationDetailsResponse(op *ShardReplicationOp, status *ShardReplicationOpStatus) cmd.ReplicationDetailsResponse {
+	var targetShardId string
+	if op.TransferType == cmd.SPLIT {
+		targetShardId = op.TargetShard.ShardId
+	}
 	return cmd.ReplicationDetailsResponse{
 		Uuid:               op.UUID,
 		Id:                 op.ID,
@@ -306,6 +310,7 @@ func makeReplicationDetailsResponse(op *ShardReplicationOp, status *ShardReplica
 		Collection:         op.SourceShard.CollectionId,
 		SourceNodeId:       op.SourceShard.NodeId,
 		TargetNodeId:       op.TargetShard.NodeId,
+		TargetShardId:      targetShardId,
 		TransferType:       op.TransferType.String(),
 		Uncancelable:       status.UnCancellable,
 		ScheduledForCancel: status.ShouldCancel,
@@ -161,6 +161,81 @@ func TestManager_Replicate(t *testing.T) {
 			},
 			expectedError: replication.ErrBadRequest,
 		},
+		{
+			name: "valid split request on the same node",
+			schemaSetup: func(t *testing.T, s *schema.SchemaManager) error {
+				return s.AddClass(
+					buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+						Class: &models.Class{Class: "TestCollection", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+						State: &sharding.State{
+							Physical: map[string]sharding.Physical{
+								"shard1": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v1", "v2"}},
+								"shard2": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v3"}},
+							},
+						},
+					}), "node1", true, false)
+			},
+			request: &api.ReplicationReplicateShardRequest{
+				Uuid:             uuid4(),
+				SourceCollection: "TestCollection",
+				SourceShard:      "shard1",
+				SourceNode:       "node1",
+				TargetNode:       "node1",
+				TargetShard:      "split",
+				TransferType:     api.SPLIT.String(),
+			},
+			expectedError: nil,
+		},
+		{
+			name: "split of a shard owning a single virtual shard",
+			schemaSetup: func(t *testing.T, s *schema.SchemaManager) error {
+				return s.AddClass(
+					buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+						Class: &models.Class{Class: "TestCollection", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+						State: &sharding.State{
+							Physical: map[string]sharding.Physical{
+								"shard1": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v1"}},
+								"shard2": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v3"}},
+							},
+						},
+					}), "node1", true, false)
+			},
+			request: &api.ReplicationReplicateShardRequest{
+				Uuid:             uuid4(),
+				SourceCollection: "TestCollection",
+				SourceShard:      "shard1",
+				SourceNode:       "node1",
+				TargetNode:       "node1",
+				TargetShard:      "split",
+				TransferType:     api.SPLIT.String(),
+			},
+			expectedError: replication.ErrBadRequest,
+		},
+		{
+			name: "split into an existing shard",
+			schemaSetup: func(t *testing.T, s *schema.SchemaManager) error {
+				return s.AddClass(
+					buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+						Class: &models.Class{Class: "TestCollection", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+						State: &sharding.State{
+							Physical: map[string]sharding.Physical{
+								"shard1": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v1", "v2"}},
+								"shard2": {BelongsToNodes: []string{"node1"}, OwnsVirtual: []string{"v3"}},
+							},
+						},
+					}), "node1", true, false)
+			},
+			request: &api.ReplicationReplicateShardRequest{
+				Uuid:             uuid4(),
+				SourceCollection: "TestCollection",
+				SourceShard:      "shard1",
+				SourceNode:       "node1",
+				TargetNode:       "node1",
+				TargetShard:      "shard2",
+				TransferType:     api.SPLIT.String(),
+			},
+			expectedError: replication.ErrAlreadyExists,
+		},
 	}
 
 	for _, tt := range tests {
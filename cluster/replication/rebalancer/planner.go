@@ -0,0 +1,507 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// Node is a storage node which can hold replicas
+type Node struct {
+	Name string
+	// Zone is the failure domain of the node, empty if unknown
+	Zone string
+	// DiskTotal and DiskAvailable are in bytes, a DiskTotal of 0 means the
+	// disk usage of the node is unknown
+	DiskTotal     uint64
+	DiskAvailable uint64
+}
+
+func (n Node) diskUsage() float64 {
+	if n.DiskTotal == 0 {
+		return 0
+	}
+	return float64(n.DiskTotal-n.DiskAvailable) / float64(n.DiskTotal)
+}
+
+// Shard is a shard of a collection together with the nodes holding its
+// replicas. Size is the number of objects in the shard and is used as the
+// load a replica puts on its node.
+type Shard struct {
+	Collection string
+	Name       string
+	Replicas   []string
+	Size       int64
+	// Factor is the replication factor of the collection, the number of
+	// replicas the shard should have
+	Factor int
+	// ReadOnlyReplicas are the replicas on read-only nodes. They are not part
+	// of Replicas and don't count towards Factor.
+	ReadOnlyReplicas []string
+}
+
+func (s *Shard) factor() int {
+	if s.Factor < 1 {
+		return 1
+	}
+	return s.Factor
+}
+
+// Removal is a replica on a lost node which is no longer needed, because the
+// shard has enough replicas on the available nodes
+type Removal struct {
+	Collection string
+	Shard      string
+	Node       string
+}
+
+func (r Removal) String() string {
+	return fmt.Sprintf("remove %s/%s from lost node %s", r.Collection, r.Shard, r.Node)
+}
+
+// Move is a replication operation proposed by the planner
+type Move struct {
+	Collection   string
+	Shard        string
+	SourceNode   string
+	TargetNode   string
+	TransferType api.ShardReplicationTransferType
+	Reason       string
+}
+
+func (m Move) String() string {
+	return fmt.Sprintf("%s %s/%s from %s to %s: %s",
+		m.TransferType, m.Collection, m.Shard, m.SourceNode, m.TargetNode, m.Reason)
+}
+
+// PlannerConfig bounds the moves proposed by PlanMoves
+type PlannerConfig struct {
+	// MaxMoves is the number of moves which may be proposed
+	MaxMoves int
+	// ImbalanceThreshold is how far the load of the most loaded node may be
+	// above the average, relative to the average
+	ImbalanceThreshold float64
+	// MaxDiskUsage is the disk usage above which a node doesn't receive
+	// replicas
+	MaxDiskUsage float64
+}
+
+// PlanMoves proposes at most cfg.MaxMoves replication operations.
+//
+// nodes are the storage nodes currently in the cluster. Replicas on lostNodes
+// are recreated on other nodes first, by copying them from a replica which is
+// still available. Then replicas of a shard which share a zone are moved to
+// zones without a replica of the shard. The remaining budget is used to move
+// replicas from the most to the least loaded nodes until the most loaded node
+// is within cfg.ImbalanceThreshold of the average load. Targets in zones
+// without a replica of the shard are preferred, and no move lowers the
+// number of zones a shard is spread across.
+//
+// Shards in busy, keyed by collection and shard name, are left alone, as are
+// shards with a replica on a node which is neither in nodes nor in lostNodes,
+// since that node might just be restarting. The plan is deterministic for
+// the same input.
+func PlanMoves(cfg PlannerConfig, nodes []Node, lostNodes []string, shards []Shard, busy map[string]struct{}) []Move {
+	if cfg.MaxMoves <= 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	p := planner{
+		cfg:   cfg,
+		nodes: map[string]Node{},
+		load:  map[string]int64{},
+		lost:  map[string]bool{},
+		moved: map[string]bool{},
+	}
+	for _, n := range nodes {
+		p.nodes[n.Name] = n
+		p.load[n.Name] = 0
+	}
+	for _, n := range lostNodes {
+		p.lost[n] = true
+	}
+
+	shards = slices.Clone(shards)
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].Collection != shards[j].Collection {
+			return shards[i].Collection < shards[j].Collection
+		}
+		return shards[i].Name < shards[j].Name
+	})
+
+	var candidates []*Shard
+	for i := range shards {
+		shard := &shards[i]
+		// busy shards still count towards the load of their nodes
+		_, isBusy := busy[shardKey(shard.Collection, shard.Name)]
+		usable := !isBusy
+		for _, replica := range shard.Replicas {
+			if _, ok := p.nodes[replica]; ok {
+				p.load[replica] += shard.Size
+			} else if !p.lost[replica] {
+				usable = false
+			}
+		}
+		if usable {
+			candidates = append(candidates, shard)
+		}
+	}
+
+	p.replaceLostReplicas(candidates)
+	p.spreadAcrossZones(candidates)
+	p.balance(candidates)
+	return p.moves
+}
+
+// PlanRemovals returns the replicas on lostNodes which can be removed from
+// the sharding state, because their shard has at least as many replicas on
+// nodes as its replication factor. Read-only replicas on lostNodes don't need
+// a replacement. Shards in busy are left alone, so that a replica isn't
+// removed while its replacement is still being copied.
+func PlanRemovals(nodes []Node, lostNodes []string, shards []Shard, busy map[string]struct{}) []Removal {
+	present := map[string]bool{}
+	for _, n := range nodes {
+		present[n.Name] = true
+	}
+	lost := map[string]bool{}
+	for _, n := range lostNodes {
+		lost[n] = true
+	}
+
+	var removals []Removal
+	for i := range shards {
+		shard := &shards[i]
+		if _, isBusy := busy[shardKey(shard.Collection, shard.Name)]; isBusy {
+			continue
+		}
+		available := 0
+		for _, replica := range shard.Replicas {
+			if present[replica] {
+				available++
+			}
+		}
+		if available < shard.factor() {
+			continue
+		}
+		for _, replica := range shard.Replicas {
+			if lost[replica] {
+				removals = append(removals, Removal{Collection: shard.Collection, Shard: shard.Name, Node: replica})
+			}
+		}
+		for _, replica := range shard.ReadOnlyReplicas {
+			if lost[replica] {
+				removals = append(removals, Removal{Collection: shard.Collection, Shard: shard.Name, Node: replica})
+			}
+		}
+	}
+	sort.Slice(removals, func(i, j int) bool {
+		return removals[i].String() < removals[j].String()
+	})
+	return removals
+}
+
+// PlanReadOnlyReplicas proposes at most maxMoves copies which place a
+// replica of every shard on every read-only node. The copies are made from
+// the least loaded replica of the shard which is on one of nodes.
+//
+// Read-only replicas only receive objects through async replication, which
+// needs more than one writable replica. Shards of collections with a
+// replication factor of one therefore don't get read-only replicas.
+func PlanReadOnlyReplicas(maxMoves int, nodes []Node, readOnlyNodes []string, shards []Shard, busy map[string]struct{}) []Move {
+	if maxMoves <= 0 || len(readOnlyNodes) == 0 {
+		return nil
+	}
+	present := map[string]bool{}
+	for _, n := range nodes {
+		present[n.Name] = true
+	}
+
+	shards = slices.Clone(shards)
+	sort.Slice(shards, func(i, j int) bool {
+		return shardKey(shards[i].Collection, shards[i].Name) < shardKey(shards[j].Collection, shards[j].Name)
+	})
+
+	var moves []Move
+	for _, shard := range shards {
+		if _, isBusy := busy[shardKey(shard.Collection, shard.Name)]; isBusy || shard.Factor < 2 {
+			continue
+		}
+		source := ""
+		for _, replica := range shard.Replicas {
+			if present[replica] && (source == "" || replica < source) {
+				source = replica
+			}
+		}
+		if source == "" {
+			continue
+		}
+		for _, node := range readOnlyNodes {
+			if slices.Contains(shard.Replicas, node) || slices.Contains(shard.ReadOnlyReplicas, node) {
+				continue
+			}
+			moves = append(moves, Move{
+				Collection:   shard.Collection,
+				Shard:        shard.Name,
+				SourceNode:   source,
+				TargetNode:   node,
+				TransferType: api.COPY,
+				Reason:       fmt.Sprintf("read-only node %s has no replica", node),
+			})
+			// one op per shard at a time, the next tick places the next one
+			break
+		}
+		if len(moves) == maxMoves {
+			break
+		}
+	}
+	return moves
+}
+
+type planner struct {
+	cfg   PlannerConfig
+	nodes map[string]Node
+	load  map[string]int64
+	lost  map[string]bool
+	// moved holds the shards which are already part of the plan
+	moved map[string]bool
+	moves []Move
+}
+
+func (p *planner) budgetLeft() bool {
+	return len(p.moves) < p.cfg.MaxMoves
+}
+
+// replaceLostReplicas copies a replica held by a lost node to the least
+// loaded node which doesn't hold the shard yet. Shards which already have as
+// many available replicas as their replication factor aren't copied again,
+// their lost replicas are removed instead, see PlanRemovals.
+func (p *planner) replaceLostReplicas(shards []*Shard) {
+	for _, shard := range shards {
+		if !p.budgetLeft() {
+			return
+		}
+		var lost []string
+		for _, replica := range shard.Replicas {
+			if p.lost[replica] {
+				lost = append(lost, replica)
+			}
+		}
+		if len(lost) == 0 || len(shard.Replicas)-len(lost) >= shard.factor() {
+			continue
+		}
+		source := p.leastLoaded(shard.Replicas, func(string) bool { return true })
+		if source == "" {
+			// no replica left to copy from
+			continue
+		}
+		target := p.target(shard, "")
+		if target == "" {
+			continue
+		}
+		p.add(shard, source, target, api.COPY, fmt.Sprintf("replica on node %s was lost", lost[0]))
+	}
+}
+
+// spreadAcrossZones moves a replica of shards with several replicas in the
+// same zone to a zone without a replica of the shard
+func (p *planner) spreadAcrossZones(shards []*Shard) {
+	for _, shard := range shards {
+		if !p.budgetLeft() {
+			return
+		}
+		if p.moved[shardKey(shard.Collection, shard.Name)] {
+			continue
+		}
+
+		seen := map[string]bool{}
+		source := ""
+		for _, replica := range shard.Replicas {
+			zone := p.zone(replica)
+			if zone == "" {
+				continue
+			}
+			if seen[zone] && (source == "" || p.load[replica] > p.load[source]) {
+				source = replica
+			}
+			seen[zone] = true
+		}
+		if source == "" {
+			continue
+		}
+		target := p.leastLoaded(p.nodeNames(), func(name string) bool {
+			zone := p.zone(name)
+			return p.canReceive(shard)(name) && zone != "" && !seen[zone]
+		})
+		if target == "" {
+			continue
+		}
+		p.add(shard, source, target, api.MOVE, fmt.Sprintf("zone %s holds several replicas", p.zone(source)))
+	}
+}
+
+// balance moves replicas from the most to the least loaded node as long as
+// this lowers the load of the most loaded node
+func (p *planner) balance(shards []*Shard) {
+	total := int64(0)
+	for _, load := range p.load {
+		total += load
+	}
+	if total == 0 {
+		return
+	}
+	limit := float64(total) / float64(len(p.nodes)) * (1 + p.cfg.ImbalanceThreshold)
+
+	for p.budgetLeft() {
+		source := p.mostLoaded()
+		if float64(p.load[source]) <= limit {
+			return
+		}
+
+		var (
+			best       *Shard
+			bestTarget string
+			bestSpread int64
+		)
+		for _, shard := range shards {
+			if p.moved[shardKey(shard.Collection, shard.Name)] || shard.Size == 0 ||
+				!slices.Contains(shard.Replicas, source) {
+				continue
+			}
+			target := p.target(shard, source)
+			if target == "" {
+				continue
+			}
+			gap := p.load[source] - p.load[target]
+			if shard.Size >= gap {
+				// the target would end up at least as loaded as the source
+				continue
+			}
+			// prefer the shard which brings both nodes closest together
+			spread := abs(gap - 2*shard.Size)
+			if best == nil || spread < bestSpread {
+				best, bestTarget, bestSpread = shard, target, spread
+			}
+		}
+		if best == nil {
+			return
+		}
+		p.add(best, source, bestTarget, api.MOVE, fmt.Sprintf("node %s is above the average load", source))
+	}
+}
+
+func (p *planner) add(shard *Shard, source, target string, transferType api.ShardReplicationTransferType, reason string) {
+	p.moves = append(p.moves, Move{
+		Collection:   shard.Collection,
+		Shard:        shard.Name,
+		SourceNode:   source,
+		TargetNode:   target,
+		TransferType: transferType,
+		Reason:       reason,
+	})
+	p.moved[shardKey(shard.Collection, shard.Name)] = true
+	p.load[target] += shard.Size
+	if transferType == api.MOVE {
+		p.load[source] -= shard.Size
+	}
+}
+
+// target returns the least loaded node which may receive a replica of shard,
+// preferring nodes in zones without a replica of the shard. If source is set,
+// the replica on source is moved away and nodes which would lower the number
+// of zones the shard is spread across are not considered.
+func (p *planner) target(shard *Shard, source string) string {
+	zones := map[string]bool{}
+	for _, replica := range shard.Replicas {
+		if replica != source && p.zone(replica) != "" {
+			zones[p.zone(replica)] = true
+		}
+	}
+	sourceZone := p.zone(source)
+	canReceive := p.canReceive(shard)
+
+	if target := p.leastLoaded(p.nodeNames(), func(name string) bool {
+		zone := p.zone(name)
+		return canReceive(name) && zone != "" && !zones[zone]
+	}); target != "" {
+		return target
+	}
+	return p.leastLoaded(p.nodeNames(), func(name string) bool {
+		if !canReceive(name) {
+			return false
+		}
+		// the source zone is lost by the move unless another replica or the
+		// target is in it
+		return sourceZone == "" || zones[sourceZone] || p.zone(name) == sourceZone
+	})
+}
+
+func (p *planner) zone(name string) string {
+	return p.nodes[name].Zone
+}
+
+// canReceive returns whether a node may receive a replica of shard
+func (p *planner) canReceive(shard *Shard) func(string) bool {
+	return func(name string) bool {
+		node, ok := p.nodes[name]
+		return ok && !slices.Contains(shard.Replicas, name) &&
+			(p.cfg.MaxDiskUsage <= 0 || node.diskUsage() < p.cfg.MaxDiskUsage)
+	}
+}
+
+// leastLoaded returns the least loaded of the available names accepted by
+// filter, ties are broken by name
+func (p *planner) leastLoaded(names []string, filter func(string) bool) string {
+	best := ""
+	for _, name := range names {
+		if _, ok := p.nodes[name]; !ok || !filter(name) {
+			continue
+		}
+		if best == "" || p.load[name] < p.load[best] || (p.load[name] == p.load[best] && name < best) {
+			best = name
+		}
+	}
+	return best
+}
+
+func (p *planner) mostLoaded() string {
+	best := ""
+	for _, name := range p.nodeNames() {
+		if best == "" || p.load[name] > p.load[best] {
+			best = name
+		}
+	}
+	return best
+}
+
+func (p *planner) nodeNames() []string {
+	names := make([]string, 0, len(p.nodes))
+	for name := range p.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func shardKey(collection, shard string) string {
+	return collection + "/" + shard
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func TestPlanMoves(t *testing.T) {
+	cfg := PlannerConfig{MaxMoves: 10, ImbalanceThreshold: 0.2, MaxDiskUsage: 0.8}
+	nodes := func(names ...string) []Node {
+		out := make([]Node, len(names))
+		for i, name := range names {
+			out[i] = Node{Name: name}
+		}
+		return out
+	}
+
+	t.Run("balanced cluster", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node2"}, Size: 100},
+		}
+		assert.Empty(t, PlanMoves(cfg, nodes("node1", "node2"), nil, shards, nil))
+	})
+
+	t.Run("scale up", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s3", Replicas: []string{"node2"}, Size: 100},
+			{Collection: "C", Name: "s4", Replicas: []string{"node2"}, Size: 100},
+		}
+		moves := PlanMoves(cfg, nodes("node1", "node2", "node3"), nil, shards, nil)
+		require.Len(t, moves, 1)
+		assert.Equal(t, api.MOVE, moves[0].TransferType)
+		assert.Equal(t, "node1", moves[0].SourceNode)
+		assert.Equal(t, "node3", moves[0].TargetNode)
+	})
+
+	t.Run("respects max moves", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s3", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s4", Replicas: []string{"node1"}, Size: 100},
+		}
+		limited := cfg
+		limited.MaxMoves = 1
+		assert.Len(t, PlanMoves(limited, nodes("node1", "node2", "node3"), nil, shards, nil), 1)
+		assert.Len(t, PlanMoves(cfg, nodes("node1", "node2", "node3"), nil, shards, nil), 2)
+	})
+
+	t.Run("skips busy shards", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1"}, Size: 100},
+		}
+		busy := map[string]struct{}{"C/s1": {}}
+		moves := PlanMoves(cfg, nodes("node1", "node2"), nil, shards, busy)
+		require.Len(t, moves, 1)
+		assert.Equal(t, "s2", moves[0].Shard)
+	})
+
+	t.Run("replaces lost replicas", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node3"}, Size: 100, Factor: 2},
+		}
+		moves := PlanMoves(cfg, nodes("node1", "node2"), []string{"node3"}, shards, nil)
+		require.Len(t, moves, 1)
+		assert.Equal(t, Move{
+			Collection:   "C",
+			Shard:        "s1",
+			SourceNode:   "node1",
+			TargetNode:   "node2",
+			TransferType: api.COPY,
+			Reason:       "replica on node node3 was lost",
+		}, moves[0])
+	})
+
+	t.Run("doesn't copy replaced lost replicas again", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node3", "node2"}, Size: 100, Factor: 2},
+		}
+		assert.Empty(t, PlanMoves(cfg, nodes("node1", "node2", "node4"), []string{"node3"}, shards, nil))
+	})
+
+	t.Run("leaves shards on missing nodes alone", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node3"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1"}, Size: 100},
+		}
+		moves := PlanMoves(cfg, nodes("node1", "node2"), nil, shards, nil)
+		require.Len(t, moves, 1)
+		assert.Equal(t, "s2", moves[0].Shard)
+	})
+
+	t.Run("skips full nodes", func(t *testing.T) {
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1"}, Size: 100},
+		}
+		full := []Node{{Name: "node1"}, {Name: "node2", DiskTotal: 100, DiskAvailable: 10}}
+		assert.Empty(t, PlanMoves(cfg, full, nil, shards, nil))
+	})
+
+	t.Run("spreads replicas across zones", func(t *testing.T) {
+		zoned := []Node{{Name: "node1", Zone: "a"}, {Name: "node2", Zone: "a"}, {Name: "node3", Zone: "b"}}
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node2"}, Size: 100},
+		}
+		moves := PlanMoves(cfg, zoned, nil, shards, nil)
+		require.Len(t, moves, 1)
+		assert.Equal(t, api.MOVE, moves[0].TransferType)
+		assert.Equal(t, "node3", moves[0].TargetNode)
+	})
+
+	t.Run("replaces lost replicas in a new zone", func(t *testing.T) {
+		zoned := []Node{{Name: "node1", Zone: "a"}, {Name: "node2", Zone: "a"}, {Name: "node4", Zone: "b"}}
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node3"}, Size: 100, Factor: 2},
+			{Collection: "C", Name: "s2", Replicas: []string{"node4"}, Size: 1000},
+		}
+		moves := PlanMoves(cfg, zoned, []string{"node3"}, shards, nil)
+		require.NotEmpty(t, moves)
+		assert.Equal(t, api.COPY, moves[0].TransferType)
+		assert.Equal(t, "node4", moves[0].TargetNode)
+	})
+
+	t.Run("balancing keeps zone spread", func(t *testing.T) {
+		zoned := []Node{{Name: "node1", Zone: "a"}, {Name: "node2", Zone: "b"}, {Name: "node3", Zone: "b"}}
+		shards := []Shard{
+			{Collection: "C", Name: "s1", Replicas: []string{"node1", "node2"}, Size: 100},
+			{Collection: "C", Name: "s2", Replicas: []string{"node1", "node2"}, Size: 100},
+		}
+		for _, move := range PlanMoves(cfg, zoned, nil, shards, nil) {
+			assert.NotEqual(t, "node1", move.SourceNode)
+		}
+	})
+}
+
+func TestPlanRemovals(t *testing.T) {
+	nodes := []Node{{Name: "node1"}, {Name: "node2"}}
+	shards := []Shard{
+		// replaced already
+		{Collection: "C", Name: "s1", Replicas: []string{"node1", "node3", "node2"}, Factor: 2},
+		// still waiting for its replacement
+		{Collection: "C", Name: "s2", Replicas: []string{"node1", "node3"}, Factor: 2},
+		// replaced, but another op is in flight
+		{Collection: "C", Name: "s3", Replicas: []string{"node1", "node3", "node2"}, Factor: 2},
+	}
+	busy := map[string]struct{}{"C/s3": {}}
+
+	assert.Equal(t, []Removal{{Collection: "C", Shard: "s1", Node: "node3"}},
+		PlanRemovals(nodes, []string{"node3"}, shards, busy))
+	assert.Empty(t, PlanRemovals(nodes, nil, shards, busy))
+}
+
+func TestPlanReadOnlyReplicas(t *testing.T) {
+	nodes := []Node{{Name: "node1"}, {Name: "node2"}}
+	shards := []Shard{
+		{Collection: "C", Name: "s1", Replicas: []string{"node2", "node1"}, Factor: 2},
+		{Collection: "C", Name: "s2", Replicas: []string{"node1", "node2"}, Factor: 2, ReadOnlyReplicas: []string{"query1"}},
+		// a single replica has no async replication to keep a read-only one up to date
+		{Collection: "D", Name: "s1", Replicas: []string{"node1"}, Factor: 1},
+		{Collection: "E", Name: "s1", Replicas: []string{"node1", "node2"}, Factor: 2},
+	}
+	busy := map[string]struct{}{"E/s1": {}}
+
+	moves := PlanReadOnlyReplicas(10, nodes, []string{"query1"}, shards, busy)
+	require.Len(t, moves, 1)
+	assert.Equal(t, Move{
+		Collection:   "C",
+		Shard:        "s1",
+		SourceNode:   "node1",
+		TargetNode:   "query1",
+		TransferType: api.COPY,
+		Reason:       "read-only node query1 has no replica",
+	}, moves[0])
+
+	assert.Empty(t, PlanReadOnlyReplicas(0, nodes, []string{"query1"}, shards, busy))
+	assert.Empty(t, PlanReadOnlyReplicas(10, nodes, nil, shards, busy))
+}
@@ -0,0 +1,373 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Replicator starts replication operations and lists the ones known to the
+// cluster
+type Replicator interface {
+	ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID, sourceNode string, sourceCollection string, sourceShard string, targetNode string, transferType string) error
+	GetAllReplicationDetails(ctx context.Context) ([]api.ReplicationDetailsResponse, error)
+	DeleteReplicaFromShard(ctx context.Context, class, shard, targetNode string) (uint64, error)
+	LeaderWithID() (string, string)
+}
+
+// SchemaReader reads the sharding state of the collections
+type SchemaReader interface {
+	ReadOnlySchema() models.Schema
+	Read(class string, reader func(*models.Class, *sharding.State) error) error
+}
+
+// NodeSelector lists the storage nodes with their disk usage and zone
+type NodeSelector interface {
+	LocalName() string
+	StorageCandidates() []string
+	ReadOnlyNodes() []string
+	NodeInfo(node string) (cluster.NodeInfo, bool)
+	NodeZone(node string) string
+}
+
+// NodeStatusGetter returns the shards held by every node together with their
+// object count
+type NodeStatusGetter interface {
+	GetNodeStatus(ctx context.Context, className, shardName, output string) ([]*models.NodeStatus, error)
+}
+
+type Params struct {
+	Replicator   Replicator
+	SchemaReader SchemaReader
+	NodeSelector NodeSelector
+	NodeStatus   NodeStatusGetter
+	Clock        clockwork.Clock
+	Logger       logrus.FieldLogger
+	Config       config.ReplicaRebalancer
+}
+
+// Rebalancer periodically plans replica moves which even out the load of the
+// storage nodes and starts them through the replica movement engine. It only
+// runs on the leader, so that a single node decides on the placement.
+type Rebalancer struct {
+	replicator   Replicator
+	schemaReader SchemaReader
+	nodeSelector NodeSelector
+	nodeStatus   NodeStatusGetter
+	clock        clockwork.Clock
+	logger       logrus.FieldLogger
+
+	config  config.ReplicaRebalancer
+	windows []config.MaintenanceWindow
+
+	// missingSince holds when a node holding replicas was first seen missing
+	// from the storage nodes
+	missingSince map[string]time.Time
+
+	stopCh chan struct{}
+}
+
+func New(params Params) (*Rebalancer, error) {
+	windows, err := config.ParseMaintenanceWindows(params.Config.MaintenanceWindows)
+	if err != nil {
+		return nil, err
+	}
+	if params.Clock == nil {
+		params.Clock = clockwork.NewRealClock()
+	}
+
+	return &Rebalancer{
+		replicator:   params.Replicator,
+		schemaReader: params.SchemaReader,
+		nodeSelector: params.NodeSelector,
+		nodeStatus:   params.NodeStatus,
+		clock:        params.Clock,
+		logger:       params.Logger.WithField("action", "replica_rebalancer"),
+		config:       params.Config,
+		windows:      windows,
+		missingSince: map[string]time.Time{},
+		stopCh:       make(chan struct{}),
+	}, nil
+}
+
+// Start runs the rebalancer in the background until Stop is called or ctx is
+// cancelled
+func (r *Rebalancer) Start(ctx context.Context) {
+	r.logger.WithFields(logrus.Fields{
+		"plan_only":            r.config.PlanOnly,
+		"interval":             r.config.Interval,
+		"max_concurrent_moves": r.config.MaxConcurrentMoves,
+		"maintenance_windows":  r.config.MaintenanceWindows,
+	}).Info("starting replica rebalancer")
+
+	enterrors.GoWrapper(func() {
+		ticker := r.clock.NewTicker(r.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.stopCh:
+				return
+			case <-ticker.Chan():
+				if err := r.Tick(ctx); err != nil {
+					r.logger.WithError(err).Warn("rebalance replicas")
+				}
+			}
+		}
+	}, r.logger)
+}
+
+func (r *Rebalancer) Stop() {
+	close(r.stopCh)
+}
+
+// Tick plans the moves for the current state of the cluster and, unless the
+// rebalancer only plans, starts them
+func (r *Rebalancer) Tick(ctx context.Context) error {
+	if _, leader := r.replicator.LeaderWithID(); leader != r.nodeSelector.LocalName() {
+		// only the leader places replicas, forget what was observed while
+		// this node was the leader
+		r.missingSince = map[string]time.Time{}
+		return nil
+	}
+
+	ops, err := r.replicator.GetAllReplicationDetails(ctx)
+	if err != nil && !errors.Is(err, replicationTypes.ErrReplicationOperationNotFound) {
+		return fmt.Errorf("list replication operations: %w", err)
+	}
+	busy := map[string]struct{}{}
+	for _, op := range ops {
+		if op.Status.State == api.READY.String() || op.Status.State == api.CANCELLED.String() {
+			continue
+		}
+		busy[shardKey(op.Collection, op.ShardId)] = struct{}{}
+	}
+
+	shards, err := r.shards(ctx)
+	if err != nil {
+		return err
+	}
+	nodes := r.nodes()
+	lost := r.lostNodes(nodes, shards)
+
+	moves := PlanMoves(PlannerConfig{
+		MaxMoves:           r.config.MaxConcurrentMoves - len(busy),
+		ImbalanceThreshold: r.config.ImbalanceThreshold,
+		MaxDiskUsage:       r.config.MaxDiskUsage,
+	}, nodes, lost, shards, busy)
+	moves = append(moves, PlanReadOnlyReplicas(r.config.MaxConcurrentMoves-len(busy)-len(moves),
+		nodes, r.nodeSelector.ReadOnlyNodes(), shards, busy)...)
+	removals := PlanRemovals(nodes, lost, shards, busy)
+	if len(moves) == 0 && len(removals) == 0 {
+		return nil
+	}
+
+	if r.config.PlanOnly {
+		for _, move := range moves {
+			r.logger.WithFields(moveFields(move)).Infof("proposed replica move: %s", move)
+		}
+		for _, removal := range removals {
+			r.logger.WithFields(removalFields(removal)).Infof("proposed replica removal: %s", removal)
+		}
+		return nil
+	}
+	if !r.inMaintenanceWindow() {
+		r.logger.WithField("moves", len(moves)).WithField("removals", len(removals)).
+			Debug("outside of maintenance windows, not starting replica moves")
+		return nil
+	}
+
+	// the replacements of these replicas are in place, drop the lost ones
+	// so that they aren't replaced again on the next tick
+	for _, removal := range removals {
+		if _, err := r.replicator.DeleteReplicaFromShard(ctx, removal.Collection, removal.Shard, removal.Node); err != nil {
+			r.logger.WithFields(removalFields(removal)).WithError(err).Warn("remove lost replica")
+			continue
+		}
+		r.logger.WithFields(removalFields(removal)).Infof("removed lost replica: %s", removal)
+	}
+
+	for _, move := range moves {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return fmt.Errorf("generate uuid: %w", err)
+		}
+		if err := r.replicator.ReplicationReplicateReplica(ctx, strfmt.UUID(id.String()), move.SourceNode,
+			move.Collection, move.Shard, move.TargetNode, move.TransferType.String()); err != nil {
+			r.logger.WithFields(moveFields(move)).WithError(err).Warn("start replica move")
+			continue
+		}
+		r.logger.WithFields(moveFields(move)).WithField("op_id", id.String()).Infof("started replica move: %s", move)
+	}
+	return nil
+}
+
+func (r *Rebalancer) inMaintenanceWindow() bool {
+	if len(r.windows) == 0 {
+		return true
+	}
+	now := r.clock.Now()
+	for _, window := range r.windows {
+		if window.Contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodes returns the storage nodes currently in the cluster
+func (r *Rebalancer) nodes() []Node {
+	names := r.nodeSelector.StorageCandidates()
+	nodes := make([]Node, 0, len(names))
+	for _, name := range names {
+		node := Node{Name: name, Zone: r.nodeSelector.NodeZone(name)}
+		if info, ok := r.nodeSelector.NodeInfo(name); ok {
+			node.DiskTotal, node.DiskAvailable = info.Total, info.Available
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// lostNodes returns the nodes which hold replicas but have been missing from
+// the cluster for longer than the grace period
+func (r *Rebalancer) lostNodes(nodes []Node, shards []Shard) []string {
+	present := map[string]bool{}
+	for _, node := range nodes {
+		present[node.Name] = true
+	}
+	// read-only nodes are no storage candidates, but valid replica holders
+	for _, node := range r.nodeSelector.ReadOnlyNodes() {
+		present[node] = true
+	}
+
+	now := r.clock.Now()
+	missing := map[string]time.Time{}
+	var lost []string
+	for _, shard := range shards {
+		for _, replica := range slices.Concat(shard.Replicas, shard.ReadOnlyReplicas) {
+			if _, seen := missing[replica]; seen || present[replica] {
+				continue
+			}
+			since, ok := r.missingSince[replica]
+			if !ok {
+				since = now
+				r.logger.WithField("node", replica).Info("node holding replicas is missing from the cluster")
+			}
+			missing[replica] = since
+			if now.Sub(since) >= r.config.NodeLossGracePeriod {
+				lost = append(lost, replica)
+			}
+		}
+	}
+	r.missingSince = missing
+	sort.Strings(lost)
+	return lost
+}
+
+// shards returns the shards of all collections with their replicas and size.
+// Only shards of single tenant collections and active tenants are returned.
+func (r *Rebalancer) shards(ctx context.Context) ([]Shard, error) {
+	status, err := r.nodeStatus.GetNodeStatus(ctx, "", "", verbosity.OutputVerbose)
+	if err != nil {
+		return nil, fmt.Errorf("get node status: %w", err)
+	}
+	sizes := map[string]int64{}
+	for _, node := range status {
+		if node == nil {
+			continue
+		}
+		for _, shard := range node.Shards {
+			key := shardKey(shard.Class, shard.Name)
+			if shard.ObjectCount > sizes[key] {
+				sizes[key] = shard.ObjectCount
+			}
+		}
+	}
+
+	readOnly := map[string]bool{}
+	for _, node := range r.nodeSelector.ReadOnlyNodes() {
+		readOnly[node] = true
+	}
+
+	var shards []Shard
+	for _, class := range r.schemaReader.ReadOnlySchema().Classes {
+		if err := r.schemaReader.Read(class.Class, func(class *models.Class, state *sharding.State) error {
+			factor := 1
+			if class.ReplicationConfig != nil {
+				factor = int(class.ReplicationConfig.Factor)
+			}
+			for name, physical := range state.Physical {
+				if state.PartitioningEnabled && physical.ActivityStatus() != models.TenantActivityStatusHOT {
+					continue
+				}
+				shard := Shard{
+					Collection: class.Class,
+					Name:       name,
+					Size:       sizes[shardKey(class.Class, name)],
+					Factor:     factor,
+				}
+				// replicas on read-only nodes are neither moved nor balanced
+				for _, replica := range physical.BelongsToNodes {
+					if physical.IsReadOnlyReplica(replica) || readOnly[replica] {
+						shard.ReadOnlyReplicas = append(shard.ReadOnlyReplicas, replica)
+					} else {
+						shard.Replicas = append(shard.Replicas, replica)
+					}
+				}
+				shards = append(shards, shard)
+			}
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("read sharding state of %s: %w", class.Class, err)
+		}
+	}
+	return shards, nil
+}
+
+func moveFields(move Move) logrus.Fields {
+	return logrus.Fields{
+		"collection":    move.Collection,
+		"shard":         move.Shard,
+		"source_node":   move.SourceNode,
+		"target_node":   move.TargetNode,
+		"transfer_type": move.TransferType,
+	}
+}
+
+func removalFields(removal Removal) logrus.Fields {
+	return logrus.Fields{
+		"collection": removal.Collection,
+		"shard":      removal.Shard,
+		"node":       removal.Node,
+	}
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRebalancerReplacesLostReplicaOnce(t *testing.T) {
+	schema := &fakeSchemaReader{
+		factor: 2,
+		states: map[string]*sharding.State{"C": {
+			Physical: map[string]sharding.Physical{
+				"s1": {Name: "s1", BelongsToNodes: []string{"node1", "node3"}},
+			},
+		}},
+	}
+	replicator := &fakeReplicator{leader: "node1", schema: schema}
+	logger, _ := test.NewNullLogger()
+	r, err := New(Params{
+		Replicator:   replicator,
+		SchemaReader: schema,
+		NodeSelector: fakeNodeSelector{local: "node1", nodes: []string{"node1", "node2"}},
+		NodeStatus:   fakeNodeStatus{},
+		Logger:       logger,
+		Config:       config.ReplicaRebalancer{MaxConcurrentMoves: 5},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// node3 is lost, its replica is copied to node2
+	require.NoError(t, r.Tick(ctx))
+	require.Len(t, replicator.started, 1)
+	assert.Equal(t, api.COPY.String(), replicator.started[0].TransferType)
+	assert.Equal(t, "node2", replicator.started[0].TargetNodeId)
+
+	// the copy is in flight, nothing else is scheduled nor removed
+	require.NoError(t, r.Tick(ctx))
+	assert.Len(t, replicator.started, 1)
+	assert.Empty(t, replicator.removed)
+
+	// the copy is done, the lost replica is removed instead of copied again
+	replicator.started[0].Status.State = api.READY.String()
+	physical := schema.states["C"].Physical["s1"]
+	physical.BelongsToNodes = append(physical.BelongsToNodes, "node2")
+	schema.states["C"].Physical["s1"] = physical
+	require.NoError(t, r.Tick(ctx))
+	assert.Len(t, replicator.started, 1)
+	assert.Equal(t, []string{"C/s1/node3"}, replicator.removed)
+	assert.Equal(t, []string{"node1", "node2"}, schema.states["C"].Physical["s1"].BelongsToNodes)
+
+	// the cluster is settled
+	require.NoError(t, r.Tick(ctx))
+	assert.Len(t, replicator.started, 1)
+	assert.Len(t, replicator.removed, 1)
+}
+
+func TestRebalancerKeepsReadOnlyReplicas(t *testing.T) {
+	schema := &fakeSchemaReader{
+		factor: 2,
+		states: map[string]*sharding.State{"C": {
+			Physical: map[string]sharding.Physical{
+				"s1": {Name: "s1", BelongsToNodes: []string{"node1", "node2", "query1"}, ReadOnlyReplicas: []string{"query1"}},
+				"s2": {Name: "s2", BelongsToNodes: []string{"node1", "node2"}},
+			},
+		}},
+	}
+	replicator := &fakeReplicator{leader: "node1", schema: schema}
+	logger, _ := test.NewNullLogger()
+	r, err := New(Params{
+		Replicator:   replicator,
+		SchemaReader: schema,
+		NodeSelector: fakeNodeSelector{local: "node1", nodes: []string{"node1", "node2"}, readOnly: []string{"query1"}},
+		NodeStatus:   fakeNodeStatus{},
+		Logger:       logger,
+		Config:       config.ReplicaRebalancer{MaxConcurrentMoves: 5},
+	})
+	require.NoError(t, err)
+
+	// the replica on query1 is neither lost nor copied elsewhere, s2 gets
+	// one on the read-only node
+	require.NoError(t, r.Tick(context.Background()))
+	assert.Empty(t, replicator.removed)
+	require.Len(t, replicator.started, 1)
+	assert.Equal(t, "s2", replicator.started[0].ShardId)
+	assert.Equal(t, "query1", replicator.started[0].TargetNodeId)
+	assert.Equal(t, api.COPY.String(), replicator.started[0].TransferType)
+}
+
+type fakeReplicator struct {
+	leader  string
+	started []api.ReplicationDetailsResponse
+	removed []string
+	schema  *fakeSchemaReader
+}
+
+func (f *fakeReplicator) ReplicationReplicateReplica(_ context.Context, uuid strfmt.UUID, sourceNode string,
+	sourceCollection string, sourceShard string, targetNode string, transferType string,
+) error {
+	f.started = append(f.started, api.ReplicationDetailsResponse{
+		Uuid:         uuid,
+		ShardId:      sourceShard,
+		Collection:   sourceCollection,
+		SourceNodeId: sourceNode,
+		TargetNodeId: targetNode,
+		Status:       api.ReplicationDetailsState{State: api.REGISTERED.String()},
+		TransferType: transferType,
+	})
+	return nil
+}
+
+func (f *fakeReplicator) GetAllReplicationDetails(context.Context) ([]api.ReplicationDetailsResponse, error) {
+	return f.started, nil
+}
+
+func (f *fakeReplicator) DeleteReplicaFromShard(_ context.Context, class, shard, targetNode string) (uint64, error) {
+	f.removed = append(f.removed, class+"/"+shard+"/"+targetNode)
+	if f.schema != nil {
+		physical := f.schema.states[class].Physical[shard]
+		physical.BelongsToNodes = slices.DeleteFunc(physical.BelongsToNodes, func(node string) bool {
+			return node == targetNode
+		})
+		f.schema.states[class].Physical[shard] = physical
+	}
+	return 0, nil
+}
+
+func (f *fakeReplicator) LeaderWithID() (string, string) {
+	return "", f.leader
+}
+
+type fakeSchemaReader struct {
+	factor int64
+	states map[string]*sharding.State
+}
+
+func (f *fakeSchemaReader) ReadOnlySchema() models.Schema {
+	var s models.Schema
+	for name := range f.states {
+		s.Classes = append(s.Classes, &models.Class{Class: name})
+	}
+	return s
+}
+
+func (f *fakeSchemaReader) Read(class string, reader func(*models.Class, *sharding.State) error) error {
+	return reader(&models.Class{
+		Class:             class,
+		ReplicationConfig: &models.ReplicationConfig{Factor: f.factor},
+	}, f.states[class])
+}
+
+type fakeNodeSelector struct {
+	local    string
+	nodes    []string
+	readOnly []string
+}
+
+func (f fakeNodeSelector) LocalName() string                        { return f.local }
+func (f fakeNodeSelector) StorageCandidates() []string              { return f.nodes }
+func (f fakeNodeSelector) ReadOnlyNodes() []string                  { return f.readOnly }
+func (f fakeNodeSelector) NodeInfo(string) (cluster.NodeInfo, bool) { return cluster.NodeInfo{}, false }
+func (f fakeNodeSelector) NodeZone(string) string                   { return "" }
+
+type fakeNodeStatus struct{}
+
+func (fakeNodeStatus) GetNodeStatus(context.Context, string, string, string) ([]*models.NodeStatus, error) {
+	return nil, nil
+}
@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// DefaultResharderInterval is how often the resharder compares the shard
+// count of the collections with their desired count
+const DefaultResharderInterval = 30 * time.Second
+
+type ResharderParams struct {
+	Replicator   Replicator
+	SchemaReader SchemaReader
+	NodeSelector NodeSelector
+	Clock        clockwork.Clock
+	Logger       logrus.FieldLogger
+	Interval     time.Duration
+}
+
+// Split is a SPLIT of one shard of a collection planned by the resharder
+type Split struct {
+	Collection string
+	Shard      string
+	SourceNode string
+	TargetNode string
+}
+
+func (s Split) String() string {
+	return fmt.Sprintf("split %s/%s onto %s", s.Collection, s.Shard, s.TargetNode)
+}
+
+// Resharder raises the number of physical shards of a collection to its
+// desired shard count by splitting existing shards. Like the rebalancer it
+// only runs on the leader.
+type Resharder struct {
+	replicator   Replicator
+	schemaReader SchemaReader
+	nodeSelector NodeSelector
+	clock        clockwork.Clock
+	logger       logrus.FieldLogger
+	interval     time.Duration
+
+	stopCh chan struct{}
+}
+
+func NewResharder(params ResharderParams) *Resharder {
+	if params.Clock == nil {
+		params.Clock = clockwork.NewRealClock()
+	}
+	if params.Interval <= 0 {
+		params.Interval = DefaultResharderInterval
+	}
+	return &Resharder{
+		replicator:   params.Replicator,
+		schemaReader: params.SchemaReader,
+		nodeSelector: params.NodeSelector,
+		clock:        params.Clock,
+		logger:       params.Logger.WithField("action", "resharder"),
+		interval:     params.Interval,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start runs the resharder in the background until Stop is called or ctx is
+// cancelled
+func (r *Resharder) Start(ctx context.Context) {
+	r.logger.WithField("interval", r.interval).Info("starting resharder")
+
+	enterrors.GoWrapper(func() {
+		ticker := r.clock.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.stopCh:
+				return
+			case <-ticker.Chan():
+				if err := r.Tick(ctx); err != nil {
+					r.logger.WithError(err).Warn("reshard collections")
+				}
+			}
+		}
+	}, r.logger)
+}
+
+func (r *Resharder) Stop() {
+	close(r.stopCh)
+}
+
+// Tick starts the splits needed for every collection to reach its desired
+// shard count. Splits which are still in flight count towards the desired
+// count, so that repeated ticks don't split more shards than needed.
+func (r *Resharder) Tick(ctx context.Context) error {
+	if _, leader := r.replicator.LeaderWithID(); leader != r.nodeSelector.LocalName() {
+		return nil
+	}
+
+	ops, err := r.replicator.GetAllReplicationDetails(ctx)
+	if err != nil && !errors.Is(err, replicationTypes.ErrReplicationOperationNotFound) {
+		return fmt.Errorf("list replication operations: %w", err)
+	}
+	busy := map[string]struct{}{}
+	inFlight := map[string]int{}
+	for _, op := range ops {
+		if op.Status.State == api.READY.String() || op.Status.State == api.CANCELLED.String() {
+			continue
+		}
+		busy[shardKey(op.Collection, op.ShardId)] = struct{}{}
+		if op.TransferType == api.SPLIT.String() {
+			inFlight[op.Collection]++
+		}
+	}
+
+	nodes := r.nodeSelector.StorageCandidates()
+	var splits []Split
+	for _, class := range r.schemaReader.ReadOnlySchema().Classes {
+		if err := r.schemaReader.Read(class.Class, func(_ *models.Class, state *sharding.State) error {
+			splits = append(splits, PlanSplits(class.Class, state, nodes, busy, inFlight[class.Class])...)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("read sharding state of %s: %w", class.Class, err)
+		}
+	}
+
+	for _, split := range splits {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return fmt.Errorf("generate uuid: %w", err)
+		}
+		if err := r.replicator.ReplicationReplicateReplica(ctx, strfmt.UUID(id.String()), split.SourceNode,
+			split.Collection, split.Shard, split.TargetNode, api.SPLIT.String()); err != nil {
+			r.logger.WithField("collection", split.Collection).WithField("shard", split.Shard).
+				WithError(err).Warn("start shard split")
+			continue
+		}
+		r.logger.WithField("op_id", id.String()).Infof("started %s", split)
+	}
+	return nil
+}
+
+// PlanSplits returns the splits needed for a collection to reach its desired
+// shard count. The shards owning the most virtual shards are split first and
+// every new shard is placed on the node holding the fewest shards of the
+// collection.
+func PlanSplits(collection string, state *sharding.State, nodes []string,
+	busy map[string]struct{}, inFlight int,
+) []Split {
+	if state == nil || state.PartitioningEnabled || state.Config.ShardedByRange() || len(nodes) == 0 {
+		return nil
+	}
+	missing := state.Config.DesiredCount - len(state.Physical) - inFlight
+	if missing <= 0 {
+		return nil
+	}
+
+	load := map[string]int{}
+	for _, node := range nodes {
+		load[node] = 0
+	}
+	candidates := make([]sharding.Physical, 0, len(state.Physical))
+	for name, physical := range state.Physical {
+		for _, node := range physical.BelongsToNodes {
+			if _, ok := load[node]; ok {
+				load[node]++
+			}
+		}
+		if _, ok := busy[shardKey(collection, name)]; ok {
+			continue
+		}
+		if len(physical.OwnsVirtual) < 2 || len(physical.BelongsToNodes) == 0 {
+			continue
+		}
+		candidates = append(candidates, physical)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if len(candidates[i].OwnsVirtual) != len(candidates[j].OwnsVirtual) {
+			return len(candidates[i].OwnsVirtual) > len(candidates[j].OwnsVirtual)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	var splits []Split
+	for _, physical := range candidates {
+		if len(splits) == missing {
+			break
+		}
+		target := leastLoaded(nodes, load)
+		load[target]++
+		splits = append(splits, Split{
+			Collection: collection,
+			Shard:      physical.Name,
+			SourceNode: physical.BelongsToNodes[0],
+			TargetNode: target,
+		})
+	}
+	return splits
+}
+
+func leastLoaded(nodes []string, load map[string]int) string {
+	best := nodes[0]
+	for _, node := range nodes[1:] {
+		if load[node] < load[best] || (load[node] == load[best] && node < best) {
+			best = node
+		}
+	}
+	return best
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestPlanSplits(t *testing.T) {
+	state := func(desired int, shards ...sharding.Physical) *sharding.State {
+		s := &sharding.State{Config: shardingConfig.Config{DesiredCount: desired}, Physical: map[string]sharding.Physical{}}
+		for _, shard := range shards {
+			s.Physical[shard.Name] = shard
+		}
+		return s
+	}
+	s1 := sharding.Physical{Name: "s1", OwnsVirtual: []string{"v1", "v2"}, BelongsToNodes: []string{"node1"}}
+	s2 := sharding.Physical{Name: "s2", OwnsVirtual: []string{"v3", "v4", "v5", "v6"}, BelongsToNodes: []string{"node2"}}
+	nodes := []string{"node1", "node2", "node3"}
+
+	t.Run("desired count reached", func(t *testing.T) {
+		assert.Empty(t, PlanSplits("C", state(2, s1, s2), nodes, nil, 0))
+	})
+
+	t.Run("splits the largest shard onto the least loaded node", func(t *testing.T) {
+		splits := PlanSplits("C", state(3, s1, s2), nodes, nil, 0)
+		require.Len(t, splits, 1)
+		assert.Equal(t, Split{Collection: "C", Shard: "s2", SourceNode: "node2", TargetNode: "node3"}, splits[0])
+	})
+
+	t.Run("counts splits in flight", func(t *testing.T) {
+		assert.Empty(t, PlanSplits("C", state(3, s1, s2), nodes, nil, 1))
+	})
+
+	t.Run("skips busy shards and shards with one virtual shard", func(t *testing.T) {
+		single := sharding.Physical{Name: "s3", OwnsVirtual: []string{"v7"}, BelongsToNodes: []string{"node3"}}
+		busy := map[string]struct{}{"C/s2": {}}
+		splits := PlanSplits("C", state(6, s1, s2, single), nodes, busy, 0)
+		require.Len(t, splits, 1)
+		assert.Equal(t, "s1", splits[0].Shard)
+	})
+
+	t.Run("range sharding", func(t *testing.T) {
+		ranged := state(3, s1, s2)
+		ranged.Config.Strategy = shardingConfig.StrategyRange
+		assert.Empty(t, PlanSplits("C", ranged, nodes, nil, 0))
+	})
+}
+
+func TestResharderTick(t *testing.T) {
+	state := &sharding.State{
+		Config: shardingConfig.Config{DesiredCount: 2},
+		Physical: map[string]sharding.Physical{
+			"s1": {Name: "s1", OwnsVirtual: []string{"v1", "v2"}, BelongsToNodes: []string{"node1"}},
+		},
+	}
+	replicator := &fakeReplicator{leader: "node1"}
+	logger, _ := test.NewNullLogger()
+	r := NewResharder(ResharderParams{
+		Replicator:   replicator,
+		SchemaReader: &fakeSchemaReader{states: map[string]*sharding.State{"C": state}},
+		NodeSelector: fakeNodeSelector{local: "node1", nodes: []string{"node1", "node2"}},
+		Logger:       logger,
+	})
+
+	require.NoError(t, r.Tick(context.Background()))
+	require.Len(t, replicator.started, 1)
+	assert.Equal(t, api.SPLIT.String(), replicator.started[0].TransferType)
+	assert.Equal(t, "node2", replicator.started[0].TargetNodeId)
+
+	// the split is still in flight, a second tick must not start another one
+	require.NoError(t, r.Tick(context.Background()))
+	assert.Len(t, replicator.started, 1)
+
+	// only the leader reshards
+	replicator.leader = "node2"
+	replicator.started = nil
+	require.NoError(t, r.Tick(context.Background()))
+	assert.Empty(t, replicator.started)
+}
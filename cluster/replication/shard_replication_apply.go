@@ -28,11 +28,17 @@ func (s *ShardReplicationFSM) Replicate(id uint64, c *api.ReplicationReplicateSh
 	s.opsLock.Lock()
 	defer s.opsLock.Unlock()
 
+	// a split creates a new shard, every other op a replica of the source shard
+	targetShard := c.SourceShard
+	if api.ShardReplicationTransferType(c.TransferType) == api.SPLIT {
+		targetShard = c.TargetShard
+	}
+
 	op := ShardReplicationOp{
 		ID:           id,
 		UUID:         c.Uuid,
 		SourceShard:  newShardFQDN(c.SourceNode, c.SourceCollection, c.SourceShard),
-		TargetShard:  newShardFQDN(c.TargetNode, c.SourceCollection, c.SourceShard),
+		TargetShard:  newShardFQDN(c.TargetNode, c.SourceCollection, targetShard),
 		TransferType: api.ShardReplicationTransferType(c.TransferType),
 	}
 	return s.writeOpIntoFSM(op, NewShardReplicationStatus(api.REGISTERED))
@@ -68,24 +74,24 @@ func (s *ShardReplicationFSM) writeOpIntoFSM(op ShardReplicationOp, status Shard
 	if existingOps, ok := s.opsBySourceFQDN[op.SourceShard]; ok {
 		for _, existingOp := range existingOps {
 			// First check the status of the existing op. If it's READY or CANCELLED we can accept a new op
-			// If it's ongoing we need to check if it's a move, in which case we can't accept any new op
+			// If it's ongoing we need to check if it's a move or a split, in which case we can't accept any new op
 			// Otherwise we can accept a copy if the existing op is also a copy
 			if existingOpStatus, ok := s.statusById[existingOp.ID]; !ok {
 				// This should never happen
 				return fmt.Errorf("could not find op status for op %d", existingOp.ID)
 			} else if existingOpStatus.GetCurrentState() == api.CANCELLED {
 				continue
-			} else if existingOpStatus.GetCurrentState() == api.READY && existingOp.TransferType == api.COPY {
+			} else if existingOpStatus.GetCurrentState() == api.READY && existingOp.TransferType != api.MOVE {
 				continue
 			}
 
-			// If any of the ops we're handling is a move we can't accept any new op
-			if existingOp.TransferType == api.MOVE {
+			// If any of the ops we're handling is a move or a split we can't accept any new op
+			if existingOp.TransferType != api.COPY {
 				return ErrShardAlreadyReplicating
 			}
 
-			// At this point we know the existing op is a copy, if our new op is a move we can't accept it
-			if op.TransferType == api.MOVE {
+			// At this point we know the existing op is a copy, if our new op is a move or a split we can't accept it
+			if op.TransferType != api.COPY {
 				return ErrShardAlreadyReplicating
 			}
 
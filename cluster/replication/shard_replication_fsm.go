@@ -57,6 +57,16 @@ func NewShardReplicationOp(id uint64, sourceNode, targetNode, collectionId, shar
 	}
 }
 
+// NewShardSplitOp returns an op splitting shardId into itself and the new shard targetShardId on targetNode
+func NewShardSplitOp(id uint64, sourceNode, targetNode, collectionId, shardId, targetShardId string) ShardReplicationOp {
+	return ShardReplicationOp{
+		ID:           id,
+		SourceShard:  newShardFQDN(sourceNode, collectionId, shardId),
+		TargetShard:  newShardFQDN(targetNode, collectionId, targetShardId),
+		TransferType: api.SPLIT,
+	}
+}
+
 type ShardReplicationFSM struct {
 	opsLock sync.RWMutex
 
@@ -387,6 +397,10 @@ func (s *ShardReplicationFSM) filterOneReplicaAsSourceReadWrite(node string, col
 	readOk := true
 	writeOk := true
 	for _, op := range ops {
+		if op.TransferType == api.SPLIT {
+			// the source shard of a split keeps serving while the split cleans up
+			continue
+		}
 		opState, ok := s.statusById[op.ID]
 		if !ok {
 			// This should never happen
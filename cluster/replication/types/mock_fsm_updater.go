@@ -352,6 +352,67 @@ func (_c *MockFSMUpdater_ReplicationRemoveReplicaOp_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ReplicationSplitShard provides a mock function with given fields: ctx, collection, shard, targetShard, nodes, opId
+func (_m *MockFSMUpdater) ReplicationSplitShard(ctx context.Context, collection string, shard string, targetShard string, nodes []string, opId uint64) (uint64, error) {
+	ret := _m.Called(ctx, collection, shard, targetShard, nodes, opId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicationSplitShard")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, uint64) (uint64, error)); ok {
+		return rf(ctx, collection, shard, targetShard, nodes, opId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, uint64) uint64); ok {
+		r0 = rf(ctx, collection, shard, targetShard, nodes, opId)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []string, uint64) error); ok {
+		r1 = rf(ctx, collection, shard, targetShard, nodes, opId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFSMUpdater_ReplicationSplitShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicationSplitShard'
+type MockFSMUpdater_ReplicationSplitShard_Call struct {
+	*mock.Call
+}
+
+// ReplicationSplitShard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collection string
+//   - shard string
+//   - targetShard string
+//   - nodes []string
+//   - opId uint64
+func (_e *MockFSMUpdater_Expecter) ReplicationSplitShard(ctx interface{}, collection interface{}, shard interface{}, targetShard interface{}, nodes interface{}, opId interface{}) *MockFSMUpdater_ReplicationSplitShard_Call {
+	return &MockFSMUpdater_ReplicationSplitShard_Call{Call: _e.mock.On("ReplicationSplitShard", ctx, collection, shard, targetShard, nodes, opId)}
+}
+
+func (_c *MockFSMUpdater_ReplicationSplitShard_Call) Run(run func(ctx context.Context, collection string, shard string, targetShard string, nodes []string, opId uint64)) *MockFSMUpdater_ReplicationSplitShard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].([]string), args[5].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockFSMUpdater_ReplicationSplitShard_Call) Return(_a0 uint64, _a1 error) *MockFSMUpdater_ReplicationSplitShard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFSMUpdater_ReplicationSplitShard_Call) RunAndReturn(run func(context.Context, string, string, string, []string, uint64) (uint64, error)) *MockFSMUpdater_ReplicationSplitShard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplicationStoreSchemaVersion provides a mock function with given fields: ctx, id, schemaVersion
 func (_m *MockFSMUpdater) ReplicationStoreSchemaVersion(ctx context.Context, id uint64, schemaVersion uint64) error {
 	ret := _m.Called(ctx, id, schemaVersion)
@@ -507,6 +568,65 @@ func (_c *MockFSMUpdater_SyncShard_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateShardStatus provides a mock function with given fields: ctx, class, shard, status
+func (_m *MockFSMUpdater) UpdateShardStatus(ctx context.Context, class string, shard string, status string) (uint64, error) {
+	ret := _m.Called(ctx, class, shard, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateShardStatus")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (uint64, error)); ok {
+		return rf(ctx, class, shard, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) uint64); ok {
+		r0 = rf(ctx, class, shard, status)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, class, shard, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFSMUpdater_UpdateShardStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateShardStatus'
+type MockFSMUpdater_UpdateShardStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateShardStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - class string
+//   - shard string
+//   - status string
+func (_e *MockFSMUpdater_Expecter) UpdateShardStatus(ctx interface{}, class interface{}, shard interface{}, status interface{}) *MockFSMUpdater_UpdateShardStatus_Call {
+	return &MockFSMUpdater_UpdateShardStatus_Call{Call: _e.mock.On("UpdateShardStatus", ctx, class, shard, status)}
+}
+
+func (_c *MockFSMUpdater_UpdateShardStatus_Call) Run(run func(ctx context.Context, class string, shard string, status string)) *MockFSMUpdater_UpdateShardStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockFSMUpdater_UpdateShardStatus_Call) Return(_a0 uint64, _a1 error) *MockFSMUpdater_UpdateShardStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFSMUpdater_UpdateShardStatus_Call) RunAndReturn(run func(context.Context, string, string, string) (uint64, error)) *MockFSMUpdater_UpdateShardStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTenants provides a mock function with given fields: ctx, class, req
 func (_m *MockFSMUpdater) UpdateTenants(ctx context.Context, class string, req *api.UpdateTenantsRequest) (uint64, error) {
 	ret := _m.Called(ctx, class, req)
@@ -194,6 +194,107 @@ func (_c *MockReplicaCopier_CopyReplicaFiles_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CopyReplicaFilesTo provides a mock function with given fields: ctx, sourceNode, sourceCollection, sourceShard, targetShard, schemaVersion
+func (_m *MockReplicaCopier) CopyReplicaFilesTo(ctx context.Context, sourceNode string, sourceCollection string, sourceShard string, targetShard string, schemaVersion uint64) error {
+	ret := _m.Called(ctx, sourceNode, sourceCollection, sourceShard, targetShard, schemaVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyReplicaFilesTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, sourceNode, sourceCollection, sourceShard, targetShard, schemaVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReplicaCopier_CopyReplicaFilesTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyReplicaFilesTo'
+type MockReplicaCopier_CopyReplicaFilesTo_Call struct {
+	*mock.Call
+}
+
+// CopyReplicaFilesTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceNode string
+//   - sourceCollection string
+//   - sourceShard string
+//   - targetShard string
+//   - schemaVersion uint64
+func (_e *MockReplicaCopier_Expecter) CopyReplicaFilesTo(ctx interface{}, sourceNode interface{}, sourceCollection interface{}, sourceShard interface{}, targetShard interface{}, schemaVersion interface{}) *MockReplicaCopier_CopyReplicaFilesTo_Call {
+	return &MockReplicaCopier_CopyReplicaFilesTo_Call{Call: _e.mock.On("CopyReplicaFilesTo", ctx, sourceNode, sourceCollection, sourceShard, targetShard, schemaVersion)}
+}
+
+func (_c *MockReplicaCopier_CopyReplicaFilesTo_Call) Run(run func(ctx context.Context, sourceNode string, sourceCollection string, sourceShard string, targetShard string, schemaVersion uint64)) *MockReplicaCopier_CopyReplicaFilesTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockReplicaCopier_CopyReplicaFilesTo_Call) Return(_a0 error) *MockReplicaCopier_CopyReplicaFilesTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReplicaCopier_CopyReplicaFilesTo_Call) RunAndReturn(run func(context.Context, string, string, string, string, uint64) error) *MockReplicaCopier_CopyReplicaFilesTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropUnownedObjects provides a mock function with given fields: ctx, nodeId, collectionName, shardName, schemaVersion
+func (_m *MockReplicaCopier) DropUnownedObjects(ctx context.Context, nodeId string, collectionName string, shardName string, schemaVersion uint64) error {
+	ret := _m.Called(ctx, nodeId, collectionName, shardName, schemaVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropUnownedObjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, nodeId, collectionName, shardName, schemaVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReplicaCopier_DropUnownedObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropUnownedObjects'
+type MockReplicaCopier_DropUnownedObjects_Call struct {
+	*mock.Call
+}
+
+// DropUnownedObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeId string
+//   - collectionName string
+//   - shardName string
+//   - schemaVersion uint64
+func (_e *MockReplicaCopier_Expecter) DropUnownedObjects(ctx interface{}, nodeId interface{}, collectionName interface{}, shardName interface{}, schemaVersion interface{}) *MockReplicaCopier_DropUnownedObjects_Call {
+	return &MockReplicaCopier_DropUnownedObjects_Call{Call: _e.mock.On("DropUnownedObjects", ctx, nodeId, collectionName, shardName, schemaVersion)}
+}
+
+func (_c *MockReplicaCopier_DropUnownedObjects_Call) Run(run func(ctx context.Context, nodeId string, collectionName string, shardName string, schemaVersion uint64)) *MockReplicaCopier_DropUnownedObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockReplicaCopier_DropUnownedObjects_Call) Return(_a0 error) *MockReplicaCopier_DropUnownedObjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReplicaCopier_DropUnownedObjects_Call) RunAndReturn(run func(context.Context, string, string, string, uint64) error) *MockReplicaCopier_DropUnownedObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitAsyncReplicationLocally provides a mock function with given fields: ctx, collectionName, shardName
 func (_m *MockReplicaCopier) InitAsyncReplicationLocally(ctx context.Context, collectionName string, shardName string) error {
 	ret := _m.Called(ctx, collectionName, shardName)
@@ -385,6 +486,57 @@ func (_c *MockReplicaCopier_RevertAsyncReplicationLocally_Call) RunAndReturn(run
 	return _c
 }
 
+// SplitReplicaFiles provides a mock function with given fields: ctx, nodeId, collectionName, shardName, targetShard, schemaVersion
+func (_m *MockReplicaCopier) SplitReplicaFiles(ctx context.Context, nodeId string, collectionName string, shardName string, targetShard string, schemaVersion uint64) error {
+	ret := _m.Called(ctx, nodeId, collectionName, shardName, targetShard, schemaVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SplitReplicaFiles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, nodeId, collectionName, shardName, targetShard, schemaVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReplicaCopier_SplitReplicaFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SplitReplicaFiles'
+type MockReplicaCopier_SplitReplicaFiles_Call struct {
+	*mock.Call
+}
+
+// SplitReplicaFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeId string
+//   - collectionName string
+//   - shardName string
+//   - targetShard string
+//   - schemaVersion uint64
+func (_e *MockReplicaCopier_Expecter) SplitReplicaFiles(ctx interface{}, nodeId interface{}, collectionName interface{}, shardName interface{}, targetShard interface{}, schemaVersion interface{}) *MockReplicaCopier_SplitReplicaFiles_Call {
+	return &MockReplicaCopier_SplitReplicaFiles_Call{Call: _e.mock.On("SplitReplicaFiles", ctx, nodeId, collectionName, shardName, targetShard, schemaVersion)}
+}
+
+func (_c *MockReplicaCopier_SplitReplicaFiles_Call) Run(run func(ctx context.Context, nodeId string, collectionName string, shardName string, targetShard string, schemaVersion uint64)) *MockReplicaCopier_SplitReplicaFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockReplicaCopier_SplitReplicaFiles_Call) Return(_a0 error) *MockReplicaCopier_SplitReplicaFiles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReplicaCopier_SplitReplicaFiles_Call) RunAndReturn(run func(context.Context, string, string, string, string, uint64) error) *MockReplicaCopier_SplitReplicaFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockReplicaCopier creates a new instance of MockReplicaCopier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReplicaCopier(t interface {
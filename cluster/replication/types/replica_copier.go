@@ -23,6 +23,15 @@ type ReplicaCopier interface {
 	// CopyReplicaFiles see cluster/replication/copier.Copier.CopyReplicaFiles
 	CopyReplicaFiles(ctx context.Context, sourceNode string, sourceCollection string, sourceShard string, schemaVersion uint64) error
 
+	// CopyReplicaFilesTo see cluster/replication/copier.Copier.CopyReplicaFilesTo
+	CopyReplicaFilesTo(ctx context.Context, sourceNode string, sourceCollection string, sourceShard string, targetShard string, schemaVersion uint64) error
+
+	// SplitReplicaFiles see cluster/replication/copier.Copier.SplitReplicaFiles
+	SplitReplicaFiles(ctx context.Context, nodeId string, collectionName string, shardName string, targetShard string, schemaVersion uint64) error
+
+	// DropUnownedObjects see cluster/replication/copier.Copier.DropUnownedObjects
+	DropUnownedObjects(ctx context.Context, nodeId string, collectionName string, shardName string, schemaVersion uint64) error
+
 	// LoadLocalShard see cluster/replication/copier.Copier.LoadLocalShard
 	LoadLocalShard(ctx context.Context, collectionName, shardName string) error
 
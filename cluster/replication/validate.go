@@ -14,9 +14,12 @@ package replication
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
@@ -31,6 +34,9 @@ func ValidateReplicationReplicateShard(schemaReader schema.SchemaReader, c *api.
 	if c.Uuid == "" {
 		return fmt.Errorf("uuid is required: %w", ErrBadRequest)
 	}
+	if api.ShardReplicationTransferType(c.TransferType) == api.SPLIT {
+		return validateSplitShard(schemaReader, c)
+	}
 	if c.SourceNode == c.TargetNode {
 		return fmt.Errorf("source and target node are the same: %w", ErrBadRequest)
 	}
@@ -65,3 +71,39 @@ func ValidateReplicationReplicateShard(schemaReader schema.SchemaReader, c *api.
 	}
 	return nil
 }
+
+// validateSplitShard validates a SPLIT op. Unlike a copy or a move, a split
+// creates a new shard, which may well live on the node of the source shard.
+func validateSplitShard(schemaReader schema.SchemaReader, c *api.ReplicationReplicateShardRequest) error {
+	if c.TargetShard == "" {
+		return fmt.Errorf("target shard is required to split a shard: %w", ErrBadRequest)
+	}
+
+	classInfo := schemaReader.ClassInfo(c.SourceCollection)
+	if !classInfo.Exists {
+		return fmt.Errorf("collection %s does not exists: %w", c.SourceCollection, ErrClassNotFound)
+	}
+
+	return schemaReader.Read(c.SourceCollection, func(_ *models.Class, state *sharding.State) error {
+		if state.PartitioningEnabled {
+			return fmt.Errorf("shards of multi-tenant collection %s cannot be split: %w", c.SourceCollection, ErrBadRequest)
+		}
+		if state.Config.ShardedByRange() {
+			return fmt.Errorf("collection %s is sharded by range, its shards cannot be split: %w", c.SourceCollection, ErrBadRequest)
+		}
+		physical, ok := state.Physical[c.SourceShard]
+		if !ok {
+			return fmt.Errorf("could not find shard %s for collection %s: %w", c.SourceShard, c.SourceCollection, ErrShardNotFound)
+		}
+		if !slices.Contains(physical.BelongsToNodes, c.SourceNode) {
+			return fmt.Errorf("could not find shard %s for collection %s on source node %s: %w", c.SourceShard, c.SourceCollection, c.SourceNode, ErrNodeNotFound)
+		}
+		if len(physical.OwnsVirtual) < 2 {
+			return fmt.Errorf("shard %s of collection %s owns too few virtual shards to be split: %w", c.SourceShard, c.SourceCollection, ErrBadRequest)
+		}
+		if _, ok := state.Physical[c.TargetShard]; ok {
+			return fmt.Errorf("shard %s already exist for collection %s: %w", c.TargetShard, c.SourceCollection, ErrAlreadyExists)
+		}
+		return nil
+	})
+}
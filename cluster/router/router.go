@@ -22,6 +22,7 @@ package router
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -84,8 +85,9 @@ type Router interface {
 	//
 	// Returns:
 	//   - routingPlan: the routing plan includes replicas ordered with the direct candidate first,
-	//     followed by the remaining replicas in no guaranteed order. If no direct candidate is provided,
-	//     the local node name is used and placed first as the local replica.
+	//     followed by the replicas in the same zone as the local node and then the remaining replicas
+	//     in no guaranteed order. If no direct candidate is provided, the local node name is used and
+	//     placed first as the local replica.
 	//   - error: if validation fails, no suitable replicas are found, or consistency level is invalid.
 	BuildReadRoutingPlan(params types.RoutingPlanBuildOptions) (types.ReadRoutingPlan, error)
 
@@ -348,7 +350,7 @@ func (r *singleTenantRouter) buildReadRoutingPlan(params types.RoutingPlanBuildO
 		return types.ReadRoutingPlan{}, fmt.Errorf("error while checking replica availability for collection %q shard %q", r.collection, params.Shard)
 	}
 
-	orderedReplicas := sort(readReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName())
+	orderedReplicas := sort(readReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName(), r.nodeSelector.NodeZone)
 
 	plan := types.ReadRoutingPlan{
 		Shard: params.Shard,
@@ -371,13 +373,16 @@ func (r *singleTenantRouter) buildWriteRoutingPlan(params types.RoutingPlanBuild
 	if err != nil {
 		return types.WriteRoutingPlan{}, fmt.Errorf("error while getting read replicas for collection %s shard %s: %w", r.collection, params.Shard, err)
 	}
+	readOnly := readOnlyReplica(r.collection, r.schemaReader, r.nodeSelector)
+	writeReplicas.Replicas = writable(writeReplicas.Replicas, readOnly)
+	additionalWriteReplicas.Replicas = writable(additionalWriteReplicas.Replicas, readOnly)
 
 	if len(writeReplicas.Replicas) == 0 {
 		return types.WriteRoutingPlan{}, fmt.Errorf("error while checking replica availability for collection %q shard %q", r.collection, params.Shard)
 	}
 
 	// Order replicas with direct candidate first
-	sortedWriteReplicas := sort(writeReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName())
+	sortedWriteReplicas := sort(writeReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName(), nil)
 
 	plan := types.WriteRoutingPlan{
 		Shard: params.Shard,
@@ -510,7 +515,7 @@ func (r *multiTenantRouter) buildReadRoutingPlan(params types.RoutingPlanBuildOp
 	}
 
 	// Order replicas with direct candidate first
-	orderedReplicas := sort(readReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName())
+	orderedReplicas := sort(readReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName(), r.nodeSelector.NodeZone)
 
 	plan := types.ReadRoutingPlan{
 		Shard: params.Shard,
@@ -539,13 +544,16 @@ func (r *multiTenantRouter) buildWriteRoutingPlan(params types.RoutingPlanBuildO
 	if err != nil {
 		return types.WriteRoutingPlan{}, fmt.Errorf("error while getting write replicas for collection %s shard %s: %w", r.collection, params.Shard, err)
 	}
+	readOnly := readOnlyReplica(r.collection, r.schemaReader, r.nodeSelector)
+	writeReplicas.Replicas = writable(writeReplicas.Replicas, readOnly)
+	additionalWriteReplicas.Replicas = writable(additionalWriteReplicas.Replicas, readOnly)
 
 	if len(writeReplicas.Replicas) == 0 {
 		return types.WriteRoutingPlan{}, fmt.Errorf("error while checking write replica availability for collection %q shard %q", r.collection, params.Shard)
 	}
 
 	// Order replicas with direct candidate first
-	orderedReplicas := sort(writeReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName())
+	orderedReplicas := sort(writeReplicas.Replicas, params.DirectCandidateNode, r.nodeSelector.LocalName(), nil)
 
 	plan := types.WriteRoutingPlan{
 		Shard: params.Shard,
@@ -574,8 +582,38 @@ func (r *multiTenantRouter) validateTenant(tenant string) error {
 	return nil
 }
 
-// sort orders replicas with the direct candidate first, followed by the remaining replicas
-func sort(replicas []types.Replica, directCandidate string, localNodeName string) []types.Replica {
+// writable filters out the replicas hosted on read-only nodes. These are kept
+// up to date by async replication and must not take part in write quorums.
+func writable(replicas []types.Replica, readOnly func(replica types.Replica) bool) []types.Replica {
+	out := make([]types.Replica, 0, len(replicas))
+	for _, replica := range replicas {
+		if !readOnly(replica) {
+			out = append(out, replica)
+		}
+	}
+	return out
+}
+
+// readOnlyReplica returns whether a replica is hosted on a read-only node.
+// The role is persisted in the sharding state when the replica is added, the
+// role a node advertises covers replicas placed before it became read-only.
+func readOnlyReplica(collection string, schemaReader schemaTypes.SchemaReader, nodeSelector cluster.NodeSelector) func(types.Replica) bool {
+	persisted := map[string][]string{}
+	return func(replica types.Replica) bool {
+		nodes, ok := persisted[replica.ShardName]
+		if !ok {
+			// an unknown shard has no read-only replicas
+			nodes, _ = schemaReader.ShardReadOnlyReplicas(collection, replica.ShardName)
+			persisted[replica.ShardName] = nodes
+		}
+		return slices.Contains(nodes, replica.NodeName) || nodeSelector.NodeReadOnly(replica.NodeName)
+	}
+}
+
+// sort orders replicas with the direct candidate first, followed by the remaining replicas.
+// If zoneOf is set, the remaining replicas in the same zone as the local node come before
+// the replicas in other zones to avoid cross-zone traffic.
+func sort(replicas []types.Replica, directCandidate string, localNodeName string, zoneOf func(string) string) []types.Replica {
 	if len(replicas) == 0 {
 		return replicas
 	}
@@ -584,18 +622,27 @@ func sort(replicas []types.Replica, directCandidate string, localNodeName string
 	if preferredNodeName == "" {
 		preferredNodeName = localNodeName
 	}
+	localZone := ""
+	if zoneOf != nil {
+		localZone = zoneOf(localNodeName)
+	}
 
 	var orderedReplicas []types.Replica
+	var sameZoneReplicas []types.Replica
 	var otherReplicas []types.Replica
 
 	for _, replica := range replicas {
-		if replica.NodeName == preferredNodeName {
+		switch {
+		case replica.NodeName == preferredNodeName:
 			orderedReplicas = append(orderedReplicas, replica)
-		} else {
+		case localZone != "" && zoneOf(replica.NodeName) == localZone:
+			sameZoneReplicas = append(sameZoneReplicas, replica)
+		default:
 			otherReplicas = append(otherReplicas, replica)
 		}
 	}
 
+	orderedReplicas = append(orderedReplicas, sameZoneReplicas...)
 	orderedReplicas = append(orderedReplicas, otherReplicas...)
 	return orderedReplicas
 }
@@ -261,6 +261,7 @@ func TestWriteRoutingWithFSM(t *testing.T) {
 			shardReplicationFSM := replication.NewShardReplicationFSM(reg)
 			clusterState := clusterMocks.NewMockNodeSelector(testCase.allShardNodes...)
 			schemaReaderMock := schemaTypes.NewMockSchemaReader(t)
+			schemaReaderMock.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 			schemaGetterMock := schema.NewMockSchemaGetter(t)
 			schemaGetterMock.EXPECT().OptimisticTenantStatus(mock.Anything, "collection1", "shard1").Return(
 				map[string]string{
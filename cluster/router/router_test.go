@@ -814,6 +814,7 @@ func TestSingleTenantRouter_BuildRoutingPlan_WithDirectCandidate(t *testing.T) {
 	state := createShardingStateWithShards([]string{"shard1"})
 	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeZone(mock.Anything).Return("").Maybe()
 
 	directCandidateNode := "node2"
 	mockSchemaReader.EXPECT().
@@ -858,6 +859,89 @@ func TestSingleTenantRouter_BuildRoutingPlan_WithDirectCandidate(t *testing.T) {
 	require.Equal(t, expectedReplicas, plan.Replicas())
 }
 
+func TestSingleTenantRouter_BuildReadRoutingPlan_PrefersSameZone(t *testing.T) {
+	mockSchemaGetter := schema.NewMockSchemaGetter(t)
+	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
+	mockNodeSelector := mocks.NewMockNodeSelector("node1", "node2", "node3", "node4").WithZones(map[string]string{
+		"node1": "a", "node2": "b", "node3": "b", "node4": "a",
+	})
+
+	state := createShardingStateWithShards([]string{"shard1"})
+	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
+	mockSchemaReader.EXPECT().
+		ShardReplicas("TestClass", "shard1").
+		Return([]string{"node2", "node3", "node4"}, nil)
+	mockReplicationFSM.EXPECT().
+		FilterOneShardReplicasRead("TestClass", "shard1", []string{"node2", "node3", "node4"}).
+		Return([]string{"node2", "node3", "node4"})
+	mockReplicationFSM.EXPECT().
+		FilterOneShardReplicasWrite("TestClass", "shard1", []string{"node2", "node3", "node4"}).
+		Return([]string{"node2", "node3", "node4"}, []string{})
+
+	r := router.NewBuilder(
+		"TestClass",
+		false,
+		mockNodeSelector,
+		mockSchemaGetter,
+		mockSchemaReader,
+		mockReplicationFSM,
+	).Build()
+
+	plan, err := r.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
+		Shard:            "shard1",
+		ConsistencyLevel: types.ConsistencyLevelOne,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"node4", "node2", "node3"}, plan.NodeNames())
+}
+
+func TestSingleTenantRouter_ReadOnlyReplicas(t *testing.T) {
+	mockSchemaGetter := schema.NewMockSchemaGetter(t)
+	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	// node2 was recorded as read-only when its replica was added, node3
+	// advertises to be read-only
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas("TestClass", "shard1").Return([]string{"node2"}, nil)
+	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
+	mockNodeSelector := mocks.NewMockNodeSelector("node1", "node2", "node3").WithReadOnly("node3")
+
+	state := createShardingStateWithShards([]string{"shard1"})
+	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
+	mockSchemaReader.EXPECT().
+		ShardReplicas("TestClass", "shard1").
+		Return([]string{"node1", "node2", "node3"}, nil)
+	mockReplicationFSM.EXPECT().
+		FilterOneShardReplicasRead("TestClass", "shard1", []string{"node1", "node2", "node3"}).
+		Return([]string{"node1", "node2", "node3"})
+	mockReplicationFSM.EXPECT().
+		FilterOneShardReplicasWrite("TestClass", "shard1", []string{"node1", "node2", "node3"}).
+		Return([]string{"node1", "node2", "node3"}, []string{})
+
+	r := router.NewBuilder(
+		"TestClass",
+		false,
+		mockNodeSelector,
+		mockSchemaGetter,
+		mockSchemaReader,
+		mockReplicationFSM,
+	).Build()
+
+	readPlan, err := r.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
+		Shard:            "shard1",
+		ConsistencyLevel: types.ConsistencyLevelOne,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"node1", "node2", "node3"}, readPlan.NodeNames())
+
+	writePlan, err := r.BuildWriteRoutingPlan(types.RoutingPlanBuildOptions{
+		Shard:            "shard1",
+		ConsistencyLevel: types.ConsistencyLevelAll,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"node1"}, writePlan.ReplicaSet.NodeNames())
+	require.Equal(t, 1, writePlan.IntConsistencyLevel)
+}
+
 func TestRouter_NodeHostname(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1155,8 +1239,10 @@ func TestMultiTenantRouter_RoutingPlanConstruction_DirectCandidate(t *testing.T)
 
 			if testCase.directCandidate != "" {
 				mockNodeSelector.EXPECT().LocalName().Return(testCase.directCandidate)
+				mockNodeSelector.EXPECT().NodeZone(mock.Anything).Return("").Maybe()
 			} else {
 				mockNodeSelector.EXPECT().LocalName().Return("node1")
+				mockNodeSelector.EXPECT().NodeZone(mock.Anything).Return("").Maybe()
 			}
 
 			r := router.NewBuilder("TestClass", true, mockNodeSelector,
@@ -1302,6 +1388,7 @@ func TestSingleTenantRouter_BroadcastVsTargeted(t *testing.T) {
 			state := createShardingStateWithShards(allShards)
 			mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 			mockNodeSelector.EXPECT().LocalName().Return("node1")
+			mockNodeSelector.EXPECT().NodeZone(mock.Anything).Return("").Maybe()
 
 			for _, shard := range testCase.expectShards {
 				mockSchemaReader.EXPECT().ShardReplicas("TestClass", shard).Return([]string{"node1"}, nil)
@@ -1340,12 +1427,14 @@ func TestSingleTenantRouter_BroadcastVsTargeted(t *testing.T) {
 func TestSingleTenantRouter_BuildWriteRoutingPlan_Success(t *testing.T) {
 	mockSchemaGetter := schema.NewMockSchemaGetter(t)
 	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 	mockNodeSelector := cluster.NewMockNodeSelector(t)
 
 	state := createShardingStateWithShards([]string{"shard1"})
 	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "shard1").Return([]string{"node1", "node2", "node3"}, nil)
 	mockReplicationFSM.EXPECT().FilterOneShardReplicasRead("TestClass", "shard1", []string{"node1", "node2", "node3"}).
 		Return([]string{"node1", "node2", "node3"})
@@ -1420,12 +1509,14 @@ func TestSingleTenantRouter_BuildWriteRoutingPlan_NoWriteReplicas(t *testing.T)
 func TestSingleTenantRouter_BuildWriteRoutingPlan_WithDirectCandidate(t *testing.T) {
 	mockSchemaGetter := schema.NewMockSchemaGetter(t)
 	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 	mockNodeSelector := cluster.NewMockNodeSelector(t)
 
 	state := createShardingStateWithShards([]string{"shard1"})
 	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 
 	directCandidateNode := "node3"
 	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "shard1").
@@ -1470,6 +1561,7 @@ func TestSingleTenantRouter_BuildWriteRoutingPlan_WithDirectCandidate(t *testing
 func TestSingleTenantRouter_BuildWriteRoutingPlan_MultipleShards(t *testing.T) {
 	mockSchemaGetter := schema.NewMockSchemaGetter(t)
 	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 	mockNodeSelector := cluster.NewMockNodeSelector(t)
 
@@ -1477,6 +1569,7 @@ func TestSingleTenantRouter_BuildWriteRoutingPlan_MultipleShards(t *testing.T) {
 	state := createShardingStateWithShards(shards)
 	mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 
 	// Setup expectations for all shards
 	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "shard1").Return([]string{"node1", "node2"}, nil)
@@ -1540,6 +1633,7 @@ func TestMultiTenantRouter_BuildWriteRoutingPlan_Success(t *testing.T) {
 	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 	mockNodeSelector := cluster.NewMockNodeSelector(t)
 	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "alice").Return([]string{"node1", "node2"}, nil)
 
@@ -1557,6 +1651,7 @@ func TestMultiTenantRouter_BuildWriteRoutingPlan_Success(t *testing.T) {
 	mockNodeSelector.EXPECT().NodeHostname("node1").Return("host1.example.com", true)
 	mockNodeSelector.EXPECT().NodeHostname("node2").Return("host2.example.com", true)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 
 	r := router.NewBuilder(
 		"TestClass",
@@ -1677,6 +1772,7 @@ func TestMultiTenantRouter_BuildWriteRoutingPlan_WithDirectCandidate(t *testing.
 	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 	mockNodeSelector := cluster.NewMockNodeSelector(t)
 	mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+	mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 	directCandidateNode := "node3"
 	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "alice").
@@ -1697,6 +1793,7 @@ func TestMultiTenantRouter_BuildWriteRoutingPlan_WithDirectCandidate(t *testing.
 	mockNodeSelector.EXPECT().NodeHostname("node2").Return("host2.example.com", true)
 	mockNodeSelector.EXPECT().NodeHostname(directCandidateNode).Return("host3.example.com", true)
 	mockNodeSelector.EXPECT().LocalName().Return("node1")
+	mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 
 	r := router.NewBuilder(
 		"TestClass",
@@ -1758,12 +1855,14 @@ func TestWriteRoutingPlan_ConsistencyLevelValidation(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			mockSchemaGetter := schema.NewMockSchemaGetter(t)
 			mockSchemaReader := schemaTypes.NewMockSchemaReader(t)
+			mockSchemaReader.EXPECT().ShardReadOnlyReplicas(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 			mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
 			mockNodeSelector := cluster.NewMockNodeSelector(t)
 
 			state := createShardingStateWithShards([]string{"shard1"})
 			mockSchemaReader.EXPECT().CopyShardingState("TestClass").Return(state)
 			mockNodeSelector.EXPECT().LocalName().Return("node1")
+			mockNodeSelector.EXPECT().NodeReadOnly(mock.Anything).Return(false).Maybe()
 
 			mockSchemaReader.EXPECT().ShardReplicas("TestClass", "shard1").Return([]string{"node1", "node2", "node3"}, nil)
 			mockReplicationFSM.EXPECT().FilterOneShardReplicasRead("TestClass", "shard1", []string{"node1", "node2", "node3"}).
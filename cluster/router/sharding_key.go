@@ -0,0 +1,266 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// KeyRouter routes the objects of a single-tenant collection which is sharded
+// by the value of a property instead of the object id. It picks the shard an
+// object is written to and narrows down the shards a search has to be sent to
+// if the filter pins the sharding key.
+type KeyRouter struct {
+	collection   string
+	key          string
+	rangeWidth   int64
+	schemaGetter schema.SchemaGetter
+}
+
+// NewKeyRouter returns the key router of a collection, nil if the objects of
+// the collection are routed by their id or tenant.
+func NewKeyRouter(collection string, state *sharding.State, schemaGetter schema.SchemaGetter) *KeyRouter {
+	if state == nil || state.PartitioningEnabled || !state.Config.ShardedByProperty() {
+		return nil
+	}
+
+	r := &KeyRouter{
+		collection:   collection,
+		key:          state.Config.Key,
+		schemaGetter: schemaGetter,
+	}
+	if state.Config.ShardedByRange() {
+		r.rangeWidth = state.Config.RangeWidth
+	}
+	return r
+}
+
+// Key returns the property objects are routed by, an empty string if they are
+// routed by their id
+func (r *KeyRouter) Key() string {
+	if r == nil {
+		return ""
+	}
+	return r.key
+}
+
+// RangeWidth returns the width of the key ranges shards own, zero unless the
+// collection is sharded by range
+func (r *KeyRouter) RangeWidth() int64 {
+	if r == nil {
+		return 0
+	}
+	return r.rangeWidth
+}
+
+// ShardForObject returns the shard an object with the given properties is
+// written to
+func (r *KeyRouter) ShardForObject(properties any) (string, error) {
+	props, _ := properties.(map[string]interface{})
+	return r.ShardForValue(props[r.key])
+}
+
+// ShardForValue returns the shard owning objects whose sharding key has the
+// given value
+func (r *KeyRouter) ShardForValue(value any) (string, error) {
+	if r.rangeWidth > 0 {
+		rangeValue, err := shardingConfig.RangeValue(value)
+		if err != nil {
+			return "", fmt.Errorf("sharding key %q: %w", r.key, err)
+		}
+		return shardingConfig.RangeShardName(shardingConfig.RangeStart(rangeValue, r.rangeWidth)), nil
+	}
+
+	key, err := sharding.KeyValue(value)
+	if err != nil {
+		return "", fmt.Errorf("sharding key %q: %w", r.key, err)
+	}
+	// the virtual shard ring hashes arbitrary bytes, ids are just the default
+	return r.schemaGetter.ShardFromUUID(r.collection, key), nil
+}
+
+// ShardForHint returns the shard owning objects whose sharding key has the
+// value of a hint, see sharding.ContextWithKeyHint. Hints are passed as
+// strings, integers are recognized so that they are routed like numeric keys.
+func (r *KeyRouter) ShardForHint(hint string) (string, error) {
+	if _, err := strconv.ParseInt(hint, 10, 64); err == nil {
+		return r.ShardForValue(json.Number(hint))
+	}
+	return r.ShardForValue(hint)
+}
+
+// PruneShards narrows down the shards a search has to be sent to if the
+// filter pins the sharding key to one or more values. Any filter it cannot
+// reason about leaves the shards untouched.
+func (r *KeyRouter) PruneShards(shardNames []string, filter *filters.LocalFilter) []string {
+	if r == nil || filter == nil || filter.Root == nil || len(shardNames) < 2 {
+		return shardNames
+	}
+
+	class := r.schemaGetter.ReadOnlyClass(r.collection)
+	if class == nil {
+		return shardNames
+	}
+	prop, err := entschema.GetPropertyByName(class, r.key)
+	if err != nil {
+		return shardNames
+	}
+	// with any other tokenization an equality filter matches parts of a value,
+	// which may be stored in any shard
+	if entschema.DataType(prop.DataType[0]) == entschema.DataTypeText &&
+		prop.Tokenization != models.PropertyTokenizationField {
+		return shardNames
+	}
+
+	targets, ok := r.shardsForClause(filter.Root, shardNames)
+	if !ok {
+		return shardNames
+	}
+
+	out := make([]string, 0, len(targets))
+	for _, name := range shardNames {
+		if _, ok := targets[name]; ok {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// shardsForClause returns the shards which can hold objects matching the
+// clause. The second return value is false if the clause can match objects
+// in any shard.
+func (r *KeyRouter) shardsForClause(clause *filters.Clause, shardNames []string) (map[string]struct{}, bool) {
+	switch clause.Operator {
+	case filters.OperatorAnd:
+		var out map[string]struct{}
+		for pos := range clause.Operands {
+			shards, ok := r.shardsForClause(&clause.Operands[pos], shardNames)
+			if !ok {
+				continue
+			}
+			if out == nil {
+				out = shards
+				continue
+			}
+			for name := range out {
+				if _, ok := shards[name]; !ok {
+					delete(out, name)
+				}
+			}
+		}
+		return out, out != nil
+	case filters.OperatorOr:
+		out := map[string]struct{}{}
+		for pos := range clause.Operands {
+			shards, ok := r.shardsForClause(&clause.Operands[pos], shardNames)
+			if !ok {
+				return nil, false
+			}
+			for name := range shards {
+				out[name] = struct{}{}
+			}
+		}
+		return out, len(clause.Operands) > 0
+	case filters.OperatorEqual, filters.ContainsAny:
+		if !r.onKey(clause.On) || clause.Value == nil {
+			return nil, false
+		}
+		out := map[string]struct{}{}
+		for _, value := range filterValues(clause.Value.Value) {
+			shard, err := r.ShardForValue(value)
+			if err != nil {
+				return nil, false
+			}
+			out[shard] = struct{}{}
+		}
+		return out, len(out) > 0
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		if r.rangeWidth == 0 || !r.onKey(clause.On) || clause.Value == nil {
+			return nil, false
+		}
+		value, err := shardingConfig.RangeValue(clause.Value.Value)
+		if err != nil {
+			return nil, false
+		}
+		out := map[string]struct{}{}
+		for _, name := range shardNames {
+			start, ok := shardingConfig.ParseRangeShardName(name)
+			if !ok || rangeMatches(clause.Operator, start, start+r.rangeWidth, value) {
+				out[name] = struct{}{}
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// rangeMatches is true if a key in [start, end) can satisfy the comparison
+// with value. Values are truncated to whole seconds for dates, so the bounds
+// are kept inclusive on both sides.
+func rangeMatches(op filters.Operator, start, end, value int64) bool {
+	switch op {
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual:
+		return end > value
+	default:
+		return start <= value
+	}
+}
+
+func (r *KeyRouter) onKey(path *filters.Path) bool {
+	return path != nil && path.Child == nil &&
+		entschema.LowercaseFirstLetter(string(path.Property)) == r.key
+}
+
+// filterValues flattens the value of an Equal or ContainsAny filter
+func filterValues(in any) []any {
+	switch typed := in.(type) {
+	case []string:
+		out := make([]any, len(typed))
+		for pos := range typed {
+			out[pos] = typed[pos]
+		}
+		return out
+	case []int:
+		out := make([]any, len(typed))
+		for pos := range typed {
+			out[pos] = typed[pos]
+		}
+		return out
+	case []int64:
+		out := make([]any, len(typed))
+		for pos := range typed {
+			out[pos] = typed[pos]
+		}
+		return out
+	case []float64:
+		out := make([]any, len(typed))
+		for pos := range typed {
+			out[pos] = typed[pos]
+		}
+		return out
+	case []any:
+		return typed
+	default:
+		return []any{in}
+	}
+}
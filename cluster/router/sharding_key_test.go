@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package router
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestKeyRouterPruneShards(t *testing.T) {
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{"key": "region"}, 4)
+	require.Nil(t, err)
+	state, err := sharding.InitState("Test", cfg, "node1", []string{"node1"}, 1, false)
+	require.Nil(t, err)
+	allShards := state.AllPhysicalShards()
+	require.Len(t, allShards, 4)
+
+	shardOf := func(value string) string {
+		key, err := sharding.KeyValue(value)
+		require.Nil(t, err)
+		return state.PhysicalShard(key)
+	}
+	// pick two values which end up in different shards
+	eu, us := "eu", ""
+	for _, candidate := range []string{"us", "apac", "latam", "mea", "anz", "emea"} {
+		if shardOf(candidate) != shardOf(eu) {
+			us = candidate
+			break
+		}
+	}
+	require.NotEmpty(t, us)
+
+	newRouter := func(tokenization string) *KeyRouter {
+		sg := schemaUC.NewMockSchemaGetter(t)
+		sg.On("ReadOnlyClass", "Test").Return(&models.Class{
+			Class: "Test",
+			Properties: []*models.Property{
+				{Name: "region", DataType: []string{"text"}, Tokenization: tokenization},
+			},
+		}).Maybe()
+		sg.On("ShardFromUUID", "Test", mock.Anything).Return(func(_ string, key []byte) string {
+			return state.PhysicalShard(key)
+		}).Maybe()
+		return NewKeyRouter("Test", state, sg)
+	}
+
+	on := func(prop string) *filters.Path {
+		return &filters.Path{Class: "Test", Property: schema.PropertyName(prop)}
+	}
+	equal := func(prop, value string) filters.Clause {
+		return filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       on(prop),
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}
+	}
+	other := filters.Clause{
+		Operator: filters.OperatorGreaterThan,
+		On:       on("price"),
+		Value:    &filters.Value{Value: 3, Type: schema.DataTypeInt},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *filters.Clause
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			expected: allShards,
+		},
+		{
+			name:     "equal on key",
+			filter:   &filters.Clause{Operator: filters.OperatorEqual, On: on("region"), Value: &filters.Value{Value: eu}},
+			expected: []string{shardOf(eu)},
+		},
+		{
+			name: "contains any on key",
+			filter: &filters.Clause{
+				Operator: filters.ContainsAny,
+				On:       on("region"),
+				Value:    &filters.Value{Value: []string{eu, us}},
+			},
+			expected: pruned(allShards, shardOf(eu), shardOf(us)),
+		},
+		{
+			name:     "and with other clause",
+			filter:   &filters.Clause{Operator: filters.OperatorAnd, Operands: []filters.Clause{other, equal("region", us)}},
+			expected: []string{shardOf(us)},
+		},
+		{
+			name:     "or on key",
+			filter:   &filters.Clause{Operator: filters.OperatorOr, Operands: []filters.Clause{equal("region", eu), equal("region", us)}},
+			expected: pruned(allShards, shardOf(eu), shardOf(us)),
+		},
+		{
+			name:     "or with other clause",
+			filter:   &filters.Clause{Operator: filters.OperatorOr, Operands: []filters.Clause{other, equal("region", us)}},
+			expected: allShards,
+		},
+		{
+			name:     "not equal on key",
+			filter:   &filters.Clause{Operator: filters.OperatorNotEqual, On: on("region"), Value: &filters.Value{Value: eu}},
+			expected: allShards,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var filter *filters.LocalFilter
+			if test.filter != nil {
+				filter = &filters.LocalFilter{Root: test.filter}
+			}
+			got := newRouter(models.PropertyTokenizationField).PruneShards(allShards, filter)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+
+	t.Run("collections routed by id are not pruned", func(t *testing.T) {
+		cfg, err := shardingConfig.ParseConfig(nil, 4)
+		require.Nil(t, err)
+		byID, err := sharding.InitState("Test", cfg, "node1", []string{"node1"}, 1, false)
+		require.Nil(t, err)
+
+		r := NewKeyRouter("Test", byID, nil)
+		require.Nil(t, r)
+		assert.Empty(t, r.Key())
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual, On: on("region"), Value: &filters.Value{Value: eu},
+		}}
+		assert.Equal(t, allShards, r.PruneShards(allShards, filter))
+	})
+
+	t.Run("hints are routed like values", func(t *testing.T) {
+		r := newRouter(models.PropertyTokenizationField)
+		for _, value := range []string{eu, us} {
+			shard, err := r.ShardForHint(value)
+			require.Nil(t, err)
+			assert.Equal(t, shardOf(value), shard)
+		}
+
+		// numeric keys are hashed in their decimal form
+		byValue, err := r.ShardForValue(float64(42))
+		require.Nil(t, err)
+		byHint, err := r.ShardForHint("42")
+		require.Nil(t, err)
+		assert.Equal(t, byValue, byHint)
+	})
+
+	t.Run("word tokenized key is not pruned", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual, On: on("region"), Value: &filters.Value{Value: eu},
+		}}
+		got := newRouter(models.PropertyTokenizationWord).PruneShards(allShards, filter)
+		assert.Equal(t, allShards, got)
+	})
+}
+
+// pruned returns the given shards in the order they appear in all
+func pruned(all []string, shards ...string) []string {
+	var out []string
+	for _, name := range all {
+		for _, shard := range shards {
+			if name == shard {
+				out = append(out, name)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func TestKeyRouterPruneShardsByRange(t *testing.T) {
+	const day = int64(86400)
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{
+		"key": "createdAt", "strategy": "range", "rangeWidth": json.Number("86400"),
+	}, 1)
+	require.Nil(t, err)
+	state, err := sharding.InitState("Test", cfg, "node1", []string{"node1"}, 1, false)
+	require.Nil(t, err)
+	require.Empty(t, state.AllPhysicalShards())
+
+	// shards for 2025-03-01, 2025-03-02 and 2025-03-03
+	first := shardingConfig.RangeStart(1740830400, day)
+	shardNames := []string{
+		shardingConfig.RangeShardName(first),
+		shardingConfig.RangeShardName(first + day),
+		shardingConfig.RangeShardName(first + 2*day),
+	}
+
+	sg := schemaUC.NewMockSchemaGetter(t)
+	sg.On("ReadOnlyClass", "Test").Return(&models.Class{
+		Class:      "Test",
+		Properties: []*models.Property{{Name: "createdAt", DataType: []string{"date"}}},
+	}).Maybe()
+	r := NewKeyRouter("Test", state, sg)
+	require.Equal(t, day, r.RangeWidth())
+
+	compare := func(op filters.Operator, date string) filters.Clause {
+		return filters.Clause{
+			Operator: op,
+			On:       &filters.Path{Class: "Test", Property: "createdAt"},
+			Value:    &filters.Value{Value: date, Type: schema.DataTypeDate},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filter   filters.Clause
+		expected []string
+	}{
+		{
+			name:     "equal",
+			filter:   compare(filters.OperatorEqual, "2025-03-02T10:00:00Z"),
+			expected: shardNames[1:2],
+		},
+		{
+			name:     "greater than",
+			filter:   compare(filters.OperatorGreaterThan, "2025-03-02T10:00:00Z"),
+			expected: shardNames[1:],
+		},
+		{
+			name:     "greater than or equal at range start",
+			filter:   compare(filters.OperatorGreaterThanEqual, "2025-03-03T00:00:00Z"),
+			expected: shardNames[2:],
+		},
+		{
+			name:     "less than",
+			filter:   compare(filters.OperatorLessThan, "2025-03-01T23:00:00Z"),
+			expected: shardNames[:1],
+		},
+		{
+			name: "between",
+			filter: filters.Clause{Operator: filters.OperatorAnd, Operands: []filters.Clause{
+				compare(filters.OperatorGreaterThanEqual, "2025-03-02T00:00:00Z"),
+				compare(filters.OperatorLessThan, "2025-03-02T12:00:00Z"),
+			}},
+			expected: shardNames[1:2],
+		},
+		{
+			name:     "not equal",
+			filter:   compare(filters.OperatorNotEqual, "2025-03-02T10:00:00Z"),
+			expected: shardNames,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := r.PruneShards(shardNames, &filters.LocalFilter{Root: &test.filter})
+			assert.Equal(t, test.expected, got)
+		})
+	}
+
+	t.Run("objects are routed by range", func(t *testing.T) {
+		shard, err := r.ShardForValue("2025-03-03T23:59:59Z")
+		require.Nil(t, err)
+		assert.Equal(t, shardNames[2], shard)
+	})
+
+	t.Run("hints are routed like values", func(t *testing.T) {
+		shard, err := r.ShardForHint("2025-03-03T23:59:59Z")
+		require.Nil(t, err)
+		assert.Equal(t, shardNames[2], shard)
+
+		shard, err = r.ShardForHint(strconv.FormatInt(first+day, 10))
+		require.Nil(t, err)
+		assert.Equal(t, shardNames[1], shard)
+
+		_, err = r.ShardForHint("yesterday")
+		assert.Error(t, err)
+	})
+}
@@ -18,6 +18,10 @@ const (
 	ConsistencyLevelOne    ConsistencyLevel = "ONE"
 	ConsistencyLevelQuorum ConsistencyLevel = "QUORUM"
 	ConsistencyLevelAll    ConsistencyLevel = "ALL"
+	// ConsistencyLevelSession acknowledges writes like ONE and reads from a
+	// replica which applied the writes recorded in the session of the request,
+	// giving read-your-writes consistency
+	ConsistencyLevelSession ConsistencyLevel = "SESSION"
 )
 
 // ToInt returns the minimum number needed to satisfy consistency level l among N
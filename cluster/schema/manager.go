@@ -24,6 +24,7 @@ import (
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 	gproto "google.golang.org/protobuf/proto"
 )
 
@@ -210,8 +211,14 @@ func (s *SchemaManager) RestoreClass(cmd *command.ApplyRequest, nodeID string, s
 
 	return s.apply(
 		applyOp{
-			op:                   cmd.GetType().String(),
-			updateSchema:         func() error { return s.schema.addClass(req.Class, req.State, cmd.Version) },
+			op: cmd.GetType().String(),
+			updateSchema: func() error {
+				if err := s.schema.addClass(req.Class, req.State, cmd.Version); err != nil {
+					return err
+				}
+				s.schema.storeRestoredTenantKeys(req.State, req.TenantKeys)
+				return nil
+			},
 			updateStore:          func() error { return s.db.AddClass(req) },
 			schemaOnly:           schemaOnly,
 			enableSchemaCallback: enableSchemaCallback,
@@ -257,6 +264,16 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		if req.State != nil {
 			meta.Sharding = *req.State
 		}
+		// a raised shard count is only recorded here, the resharder splits
+		// existing shards until the physical count catches up
+		if cfg, ok := u.ShardingConfig.(shardingConfig.Config); ok && !meta.Sharding.PartitioningEnabled &&
+			cfg.DesiredCount > meta.Sharding.Config.DesiredCount {
+			meta.Sharding.Config.DesiredCount = cfg.DesiredCount
+			if current, ok := meta.Class.ShardingConfig.(shardingConfig.Config); ok {
+				current.DesiredCount = cfg.DesiredCount
+				meta.Class.ShardingConfig = current
+			}
+		}
 		return nil
 	}
 
@@ -362,6 +379,43 @@ func (s *SchemaManager) AddReplicaToShard(cmd *command.ApplyRequest, schemaOnly
 	)
 }
 
+func (s *SchemaManager) AddRangeShards(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.AddRangeShardsRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	var dropped []string
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			// updateSchema narrows the request down to the shards stored on this node
+			updateSchema: func() (err error) {
+				dropped, err = s.schema.addRangeShards(cmd.Class, cmd.Version, &req)
+				return err
+			},
+			updateStore: func() error {
+				for _, shard := range req.Shards {
+					s.db.LoadShard(cmd.Class, shard)
+				}
+				if len(dropped) == 0 {
+					return nil
+				}
+				if s.replicationFSM == nil {
+					return fmt.Errorf("replication deleter is not set, this should never happen")
+				} else if err := s.replicationFSM.DeleteReplicationsByTenants(cmd.Class, dropped); err != nil {
+					s.log.WithField("error", err).WithField("class", cmd.Class).WithField("shards", dropped).Error("could not delete replication operations for dropped range shards")
+				}
+				for _, shard := range dropped {
+					s.db.DropShard(cmd.Class, shard)
+				}
+				return nil
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
 func (s *SchemaManager) DeleteReplicaFromShard(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := command.DeleteReplicaFromShard{}
 	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
@@ -550,9 +604,22 @@ func (s *SchemaManager) ReplicationAddReplicaToShard(cmd *command.ApplyRequest,
 				if err != nil {
 					return fmt.Errorf("set un-cancellable: %w", err)
 				}
+				if req.ReadOnly {
+					return s.schema.addReadOnlyReplicaToShard(cmd.Class, cmd.Version, req.Shard, req.TargetNode)
+				}
 				return s.schema.addReplicaToShard(cmd.Class, cmd.Version, req.Shard, req.TargetNode)
 			},
 			updateStore: func() error {
+				if req.ReadOnly {
+					// apply async replication, which may just have been
+					// turned on for the read-only replica
+					class, _ := s.schema.ReadOnlyClass(req.Class)
+					if class != nil {
+						if err := s.db.UpdateClass(command.UpdateClassRequest{Class: class}); err != nil {
+							return err
+						}
+					}
+				}
 				if req.TargetNode == s.schema.nodeID {
 					return s.db.AddReplicaToShard(req.Class, req.Shard, req.TargetNode)
 				}
@@ -563,6 +630,35 @@ func (s *SchemaManager) ReplicationAddReplicaToShard(cmd *command.ApplyRequest,
 	)
 }
 
+func (s *SchemaManager) ReplicationSplitShard(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ReplicationSplitShard{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() error {
+				err := s.replicationFSM.SetUnCancellable(req.OpId)
+				if err != nil {
+					return fmt.Errorf("set un-cancellable: %w", err)
+				}
+				nodes := req.Nodes
+				if len(nodes) == 0 {
+					// commands written before the replicas of the new shard were part of it
+					nodes = []string{req.TargetNode}
+				}
+				return s.schema.splitShard(cmd.Class, cmd.Version, req.Shard, req.TargetShard, nodes)
+			},
+			updateStore: func() error {
+				return s.db.SplitShard(req.Class, req.Shard, req.TargetShard)
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
 type applyOp struct {
 	op                   string
 	updateSchema         func() error
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	entSchema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 type (
@@ -93,6 +94,21 @@ func (m *metaClass) CloneClass() *models.Class {
 	return &cp
 }
 
+// EncryptionKeyIDs returns the ids of the data keys of the given tenants.
+// Tenants without their own data key are omitted.
+func (m *metaClass) EncryptionKeyIDs(tenants []string) map[string]string {
+	m.RLock()
+	defer m.RUnlock()
+
+	ids := make(map[string]string, len(tenants))
+	for _, name := range tenants {
+		if id := m.Sharding.Physical[name].EncryptionKeyID; id != "" {
+			ids[name] = id
+		}
+	}
+	return ids
+}
+
 // ShardOwner returns the node owner of the specified shard
 // will randomize the owner if there is more than one node
 func (m *metaClass) ShardOwner(shard string) (string, uint64, error) {
@@ -132,6 +148,18 @@ func (m *metaClass) ShardReplicas(shard string) ([]string, uint64, error) {
 	return slices.Clone(x.BelongsToNodes), m.version(), nil
 }
 
+// ShardReadOnlyReplicas returns the replicas of a shard hosted on read-only
+// nodes
+func (m *metaClass) ShardReadOnlyReplicas(shard string) ([]string, uint64, error) {
+	m.RLock()
+	defer m.RUnlock()
+	x, ok := m.Sharding.Physical[shard]
+	if !ok {
+		return nil, 0, ErrShardNotFound
+	}
+	return slices.Clone(x.ReadOnlyReplicas), m.version(), nil
+}
+
 // TenantsShards returns shard name for the provided tenant and its activity status
 func (m *metaClass) TenantsShards(class string, tenants ...string) (map[string]string, uint64) {
 	m.RLock()
@@ -182,6 +210,42 @@ func (m *metaClass) AddReplicaToShard(v uint64, shard string, replica string) er
 	return nil
 }
 
+// AddReadOnlyReplicaToShard adds a replica hosted on a read-only node. Such
+// replicas only receive objects through async replication, which is turned
+// on for the class if it isn't already.
+func (m *metaClass) AddReadOnlyReplicaToShard(v uint64, shard string, replica string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.Sharding.AddReadOnlyReplicaToShard(shard, replica); err != nil {
+		return err
+	}
+	if cfg := m.Class.ReplicationConfig; cfg != nil && !cfg.AsyncEnabled {
+		forced := *cfg
+		forced.AsyncEnabled = true
+		m.Class.ReplicationConfig = &forced
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+// SplitShard moves half of the virtual shards of shard to the new shard
+// targetShard placed on nodes
+func (m *metaClass) SplitShard(v uint64, shard, targetShard string, nodes []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.Sharding.Config.ShardedByRange() {
+		return fmt.Errorf("%w: shards of collection %q are sharded by range and cannot be split",
+			ErrBadRequest, m.Class.Class)
+	}
+	if err := m.Sharding.SplitShard(shard, targetShard, nodes); err != nil {
+		return err
+	}
+	m.ShardVersion = v
+	return nil
+}
+
 func (m *metaClass) DeleteReplicaFromShard(v uint64, shard string, replica string) error {
 	m.Lock()
 	defer m.Unlock()
@@ -261,7 +325,14 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 			// TODO-RAFT: Do we want to silently continue here or raise an error ?
 			continue
 		}
-		p := sharding.Physical{Name: t.Name, Status: t.Status, BelongsToNodes: part}
+		p := sharding.Physical{
+			Name:           t.Name,
+			Status:         t.Status,
+			BelongsToNodes: part,
+			Quotas:         command.TenantQuotasToModel(t.Quotas),
+			// the key itself is stored by the schema, see storeTenantKeys
+			EncryptionKeyID: t.EncryptionKeyId,
+		}
 		if m.Sharding.Physical == nil {
 			m.Sharding.Physical = make(map[string]sharding.Physical, 128)
 		}
@@ -277,6 +348,85 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 	return sc, nil
 }
 
+// AddRangeShards adds the shards of the request which don't exist yet. Like
+// AddTenants it narrows the request down to the shards stored on nodeID. It
+// returns the number of shards added and the shards dropped because their
+// ranges fell out of the retention. The request is rejected if the collection
+// would end up with more shards than allowed.
+func (m *metaClass) AddRangeShards(nodeID string, req *command.AddRangeShardsRequest, v uint64) (int, []sharding.Physical, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	cfg := m.Sharding.Config
+	if !cfg.ShardedByRange() {
+		return 0, nil, fmt.Errorf("%w: collection %q is not sharded by range", ErrBadRequest, m.Class.Class)
+	}
+
+	newest, found := int64(0), false
+	for _, name := range append(m.Sharding.AllPhysicalShards(), req.Shards...) {
+		if start, ok := shardingConfig.ParseRangeShardName(name); ok && (!found || start > newest) {
+			newest, found = start, true
+		}
+	}
+
+	missing := make([]string, 0, len(req.Shards))
+	for _, name := range req.Shards {
+		start, ok := shardingConfig.ParseRangeShardName(name)
+		if !ok {
+			return 0, nil, fmt.Errorf("%w: %q is not the name of a range shard", ErrBadRequest, name)
+		}
+		if _, ok := m.Sharding.Physical[name]; ok || slices.Contains(missing, name) ||
+			shardingConfig.RangeExpired(start, newest, cfg.RangeRetention) {
+			continue
+		}
+		missing = append(missing, name)
+	}
+
+	var expired []sharding.Physical
+	for name, physical := range m.Sharding.Physical {
+		if start, ok := shardingConfig.ParseRangeShardName(name); ok &&
+			shardingConfig.RangeExpired(start, newest, cfg.RangeRetention) {
+			physical.Name = name
+			expired = append(expired, physical)
+		}
+	}
+
+	if count := len(m.Sharding.Physical) - len(expired) + len(missing); cfg.RangeMaxShards > 0 && count > cfg.RangeMaxShards {
+		return 0, nil, fmt.Errorf("%w: collection %q would have %d shards, it is limited to %d",
+			ErrBadRequest, m.Class.Class, count, cfg.RangeMaxShards)
+	}
+
+	partitions, err := m.Sharding.GetPartitions(req.ClusterNodes, missing, m.Sharding.ReplicationFactor)
+	if err != nil {
+		return 0, nil, fmt.Errorf("get partitions: %w", err)
+	}
+
+	for _, physical := range expired {
+		if _, _, err := m.Sharding.DeletePartition(physical.Name); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	added := 0
+	local := make([]string, 0, len(missing))
+	for _, name := range missing {
+		nodes, ok := partitions[name]
+		if !ok {
+			continue
+		}
+		if _, err := m.Sharding.AddPartition(name, nodes, ""); err != nil {
+			return added, expired, err
+		}
+		added++
+		if slices.Contains(nodes, nodeID) {
+			local = append(local, name)
+		}
+	}
+	m.ShardVersion = v
+	req.Shards = local
+	return added, expired, nil
+}
+
 // DeleteTenants try to delete the tenants from given request and returns
 // total number of deleted tenants.
 func (m *metaClass) DeleteTenants(req *command.DeleteTenantsRequest, v uint64) (map[string]int, error) {
@@ -375,6 +525,14 @@ func (m *metaClass) UpdateTenants(nodeID string, req *command.UpdateTenantsReque
 			continue
 		}
 
+		// Quotas are independent of the activity status, they are applied even
+		// if the status doesn't change. Quotas without any limit remove them.
+		if requestTenant.Quotas != nil {
+			oldTenant = oldTenant.DeepCopy()
+			oldTenant.Quotas = command.TenantQuotasToModel(requestTenant.Quotas)
+			m.Sharding.Physical[oldTenant.Name] = oldTenant
+		}
+
 		// validate status
 		switch oldTenant.ActivityStatus() {
 		case req.Tenants[i].Status:
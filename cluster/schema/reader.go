@@ -137,6 +137,12 @@ func (rs SchemaReader) Aliases() map[string]string {
 	return rs.schema.getAliases("", "")
 }
 
+// TenantKey returns the wrapped data key of a tenant by its id, false if the
+// key doesn't exist, e.g. because the tenant was deleted
+func (rs SchemaReader) TenantKey(id string) ([]byte, bool) {
+	return rs.schema.tenantKey(id)
+}
+
 // ShardOwner returns the node owner of the specified shard
 func (rs SchemaReader) ShardOwner(class, shard string) (owner string, err error) {
 	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("ShardOwner"))
@@ -164,6 +170,16 @@ func (rs SchemaReader) ShardReplicas(class, shard string) (nodes []string, err e
 	return res, err
 }
 
+// ShardReadOnlyReplicas returns the replicas of a shard hosted on read-only
+// nodes
+func (rs SchemaReader) ShardReadOnlyReplicas(class, shard string) ([]string, error) {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("ShardReadOnlyReplicas"))
+	defer t.ObserveDuration()
+
+	nodes, _, err := rs.schema.ShardReadOnlyReplicas(class, shard)
+	return nodes, err
+}
+
 // TenantsShards returns shard name for the provided tenant and its activity status
 func (rs SchemaReader) TenantsShards(class string, tenants ...string) (map[string]string, error) {
 	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("TenantsShards"))
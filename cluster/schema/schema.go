@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 	"sync"
 
@@ -58,6 +59,8 @@ type schema struct {
 	mu      sync.RWMutex
 	classes map[string]*metaClass
 	aliases map[string]string
+	// tenantKeys maps the ids of tenant data keys to the wrapped keys
+	tenantKeys map[string][]byte
 
 	// metrics
 	// collectionsCount represents the number of collections on this specific node.
@@ -75,6 +78,7 @@ func NewSchema(nodeID string, shardReader shardReader, reg prometheus.Registerer
 		nodeID:      nodeID,
 		classes:     make(map[string]*metaClass, 128),
 		aliases:     make(map[string]string, 128),
+		tenantKeys:  make(map[string][]byte),
 		shardReader: shardReader,
 		collectionsCount: r.NewGauge(prometheus.GaugeOpts{
 			Namespace:   "weaviate",
@@ -243,6 +247,16 @@ func (s *schema) ShardReplicas(class, shard string) ([]string, uint64, error) {
 	return meta.ShardReplicas(shard)
 }
 
+// ShardReadOnlyReplicas returns the replicas of a shard hosted on read-only
+// nodes
+func (s *schema) ShardReadOnlyReplicas(class, shard string) ([]string, uint64, error) {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return nil, 0, ErrClassNotFound
+	}
+	return meta.ShardReadOnlyReplicas(shard)
+}
+
 // TenantsShards returns shard name for the provided tenant and its activity status
 func (s *schema) TenantsShards(class string, tenants ...string) (map[string]string, uint64) {
 	s.mu.RLock()
@@ -417,6 +431,30 @@ func (s *schema) addProperty(class string, v uint64, props ...*models.Property)
 	return meta.AddProperty(v, props...)
 }
 
+// addRangeShards adds the shards of req and drops the ones which fell out of
+// the retention. It returns the dropped shards which are stored on this node.
+func (s *schema) addRangeShards(class string, v uint64, req *command.AddRangeShardsRequest) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return nil, ErrClassNotFound
+	}
+	added, expired, err := meta.AddRangeShards(s.nodeID, req, v)
+	if err != nil {
+		return nil, err
+	}
+	s.shardsCount.WithLabelValues("").Add(float64(added - len(expired)))
+
+	var dropped []string
+	for _, physical := range expired {
+		if slices.Contains(physical.BelongsToNodes, s.nodeID) {
+			dropped = append(dropped, physical.Name)
+		}
+	}
+	return dropped, nil
+}
+
 func (s *schema) addReplicaToShard(class string, v uint64, shard string, replica string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -427,6 +465,30 @@ func (s *schema) addReplicaToShard(class string, v uint64, shard string, replica
 	return meta.AddReplicaToShard(v, shard, replica)
 }
 
+func (s *schema) addReadOnlyReplicaToShard(class string, v uint64, shard string, replica string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.AddReadOnlyReplicaToShard(v, shard, replica)
+}
+
+func (s *schema) splitShard(class string, v uint64, shard, targetShard string, nodes []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	if err := meta.SplitShard(v, shard, targetShard, nodes); err != nil {
+		return err
+	}
+	s.shardsCount.WithLabelValues("").Inc()
+	return nil
+}
+
 func (s *schema) deleteReplicaFromShard(class string, v uint64, shard string, replica string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -445,6 +507,9 @@ func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsReque
 		return err
 	}
 
+	// AddTenants removes tenants which aren't stored on this node from the
+	// request, their keys have to be stored nonetheless
+	tenants := slices.Clone(req.Tenants)
 	sc, err := meta.AddTenants(s.nodeID, req, int64(info.ReplicationFactor), v)
 	if err != nil {
 		return err
@@ -452,6 +517,7 @@ func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsReque
 	for status, count := range sc {
 		s.shardsCount.WithLabelValues(status).Add(float64(count))
 	}
+	s.storeTenantKeys(meta, tenants)
 
 	return nil
 }
@@ -461,6 +527,7 @@ func (s *schema) deleteTenants(class string, v uint64, req *command.DeleteTenant
 	if !ok {
 		return err
 	}
+	keyIDs := meta.EncryptionKeyIDs(req.Tenants)
 	sc, err := meta.DeleteTenants(req, v)
 	if err != nil {
 		return err
@@ -469,6 +536,7 @@ func (s *schema) deleteTenants(class string, v uint64, req *command.DeleteTenant
 	for status, count := range sc {
 		s.shardsCount.WithLabelValues(status).Sub(float64(count))
 	}
+	s.deleteTenantKeys(keyIDs)
 
 	return nil
 }
@@ -524,6 +592,7 @@ func (s *schema) getTenants(class string, tenants []string) ([]*models.Tenant, e
 				res[i] = &models.Tenant{
 					Name:           tenantName,
 					ActivityStatus: entSchema.ActivityStatus(physical.Status),
+					Quotas:         physical.QuotasCopy(),
 				}
 
 				// Increment our result iterator
@@ -539,6 +608,7 @@ func (s *schema) getTenants(class string, tenants []string) ([]*models.Tenant, e
 					res = append(res, &models.Tenant{
 						Name:           tenantName,
 						ActivityStatus: entSchema.ActivityStatus(physical.Status),
+						Quotas:         physical.QuotasCopy(),
 					})
 				}
 			}
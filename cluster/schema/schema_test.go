@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
@@ -190,6 +191,153 @@ func Test_schemaShardMetrics(t *testing.T) {
 	assert.Equal(t, float64(1), testutil.ToFloat64(s.shardsCount.WithLabelValues("")))
 }
 
+func Test_schemaTenantQuotas(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	c := &models.Class{
+		Class:              "collection",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}
+	require.NoError(t, s.addClass(c, &sharding.State{}, 0))
+
+	quotasOf := func(tenant string) *models.TenantQuotas {
+		tenants, err := s.getTenants(c.Class, []string{tenant})
+		require.NoError(t, err)
+		require.Len(t, tenants, 1)
+		return tenants[0].Quotas
+	}
+
+	require.NoError(t, s.addTenants(c.Class, 0, &api.AddTenantsRequest{
+		ClusterNodes: []string{"testNode"},
+		Tenants: []*api.Tenant{
+			{Name: "limited", Status: "HOT", Quotas: &api.TenantQuotas{MaxObjects: 10}},
+			{Name: "unlimited", Status: "HOT"},
+		},
+	}))
+	assert.Equal(t, &models.TenantQuotas{MaxObjects: 10}, quotasOf("limited"))
+	assert.Nil(t, quotasOf("unlimited"))
+
+	fsm := NewMockreplicationFSM(t)
+	fsm.On("HasOngoingReplication", mock.Anything, mock.Anything, mock.Anything).Return(false).Maybe()
+	update := func(tenant *api.Tenant) {
+		require.NoError(t, s.updateTenants(c.Class, 0, &api.UpdateTenantsRequest{
+			Tenants:      []*api.Tenant{tenant},
+			ClusterNodes: []string{"testNode"},
+		}, fsm))
+	}
+
+	// quotas are changed even if the status stays the same
+	update(&api.Tenant{Name: "unlimited", Status: "HOT", Quotas: &api.TenantQuotas{MaxBytes: 1024}})
+	assert.Equal(t, &models.TenantQuotas{MaxBytes: 1024}, quotasOf("unlimited"))
+
+	// updates without quotas keep the existing ones
+	update(&api.Tenant{Name: "limited", Status: "COLD"})
+	assert.Equal(t, &models.TenantQuotas{MaxObjects: 10}, quotasOf("limited"))
+
+	// quotas without limits remove them
+	update(&api.Tenant{Name: "limited", Status: "COLD", Quotas: &api.TenantQuotas{}})
+	assert.Nil(t, quotasOf("limited"))
+}
+
+func Test_schemaTenantKeys(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	c := &models.Class{
+		Class:              "collection",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}
+	require.NoError(t, s.addClass(c, &sharding.State{}, 0))
+
+	keyIDOf := func(tenant string) string {
+		return s.metaClass(c.Class).EncryptionKeyIDs([]string{tenant})[tenant]
+	}
+
+	require.NoError(t, s.addTenants(c.Class, 0, &api.AddTenantsRequest{
+		// the tenants aren't stored on this node, their keys are nonetheless
+		ClusterNodes: []string{"otherNode"},
+		Tenants: []*api.Tenant{
+			{Name: "encrypted", Status: "HOT", EncryptionKeyId: "id1", EncryptionKey: []byte("key1")},
+			{Name: "plain", Status: "HOT"},
+		},
+	}))
+	assert.Equal(t, "id1", keyIDOf("encrypted"))
+	assert.Equal(t, "", keyIDOf("plain"))
+	key, ok := s.tenantKey("id1")
+	require.True(t, ok)
+	assert.Equal(t, []byte("key1"), key)
+
+	// the key of an existing tenant isn't replaced
+	require.NoError(t, s.addTenants(c.Class, 0, &api.AddTenantsRequest{
+		ClusterNodes: []string{"otherNode"},
+		Tenants: []*api.Tenant{
+			{Name: "encrypted", Status: "HOT", EncryptionKeyId: "id2", EncryptionKey: []byte("key2")},
+		},
+	}))
+	assert.Equal(t, "id1", keyIDOf("encrypted"))
+	_, ok = s.tenantKey("id2")
+	assert.False(t, ok)
+
+	t.Run("snapshot", func(t *testing.T) {
+		// keys which are no longer referenced are removed
+		s.mu.Lock()
+		s.tenantKeys["orphan"] = []byte("key3")
+		s.mu.Unlock()
+
+		m := &SchemaManager{schema: s}
+		snap, err := m.TenantKeysSnapshot()
+		require.NoError(t, err)
+		_, ok := s.tenantKey("orphan")
+		assert.False(t, ok)
+
+		restored := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+		require.NoError(t, restored.addClass(c, &sharding.State{Physical: map[string]sharding.Physical{
+			"encrypted": {Name: "encrypted", Status: "HOT", EncryptionKeyID: "id1"},
+		}}, 0))
+		require.NoError(t, (&SchemaManager{schema: restored}).RestoreTenantKeys(snap))
+		key, ok := restored.tenantKey("id1")
+		require.True(t, ok)
+		assert.Equal(t, []byte("key1"), key)
+
+		// restoring without the collection drops its keys
+		empty := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+		require.NoError(t, (&SchemaManager{schema: empty}).RestoreTenantKeys(snap))
+		_, ok = empty.tenantKey("id1")
+		assert.False(t, ok)
+	})
+
+	// deleting a collection doesn't shred the keys of its tenants
+	s.deleteClass(c.Class)
+	_, ok = s.tenantKey("id1")
+	assert.True(t, ok)
+
+	// restoring a collection stores the keys of the backup
+	restored := &sharding.State{Physical: map[string]sharding.Physical{
+		"encrypted": {Name: "encrypted", Status: "HOT", EncryptionKeyID: "id1"},
+		"restored":  {Name: "restored", Status: "HOT", EncryptionKeyID: "id4"},
+	}}
+	require.NoError(t, s.addClass(c, restored, 0))
+	s.storeRestoredTenantKeys(restored, map[string][]byte{
+		"id1":    []byte("other"),
+		"id4":    []byte("key4"),
+		"orphan": []byte("key5"),
+	})
+	key, ok = s.tenantKey("id1")
+	require.True(t, ok)
+	assert.Equal(t, []byte("key1"), key)
+	key, ok = s.tenantKey("id4")
+	require.True(t, ok)
+	assert.Equal(t, []byte("key4"), key)
+	_, ok = s.tenantKey("orphan")
+	assert.False(t, ok)
+
+	// deleting a tenant deletes its key
+	require.NoError(t, s.deleteTenants(c.Class, 0, &api.DeleteTenantsRequest{Tenants: []string{"restored"}}))
+	_, ok = s.tenantKey("id4")
+	assert.False(t, ok)
+	_, ok = s.tenantKey("id1")
+	assert.True(t, ok)
+}
+
 func Test_schemaDeepCopy(t *testing.T) {
 	r := prometheus.NewPedanticRegistry()
 	s := NewSchema("testNode", nil, r)
@@ -502,3 +650,134 @@ func TestGetAlias(t *testing.T) {
 		assert.EqualValues(t, expected, aliases)
 	})
 }
+
+func Test_schemaAddRangeShards(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	c := &models.Class{Class: "collection", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+	ss := &sharding.State{
+		Config:            shardingConfig.Config{Key: "createdAt", Strategy: shardingConfig.StrategyRange, RangeWidth: 10},
+		Physical:          map[string]sharding.Physical{},
+		ReplicationFactor: 1,
+	}
+	require.NoError(t, s.addClass(c, ss, 0))
+
+	req := &api.AddRangeShardsRequest{
+		ClusterNodes: []string{"testNode", "otherNode"},
+		Shards:       []string{"range_0", "range_10"},
+	}
+	_, err := s.addRangeShards(c.Class, 1, req)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"range_0", "range_10"}, s.metaClass(c.Class).Sharding.AllPhysicalShards())
+	// the request only keeps the shards which are stored on this node
+	require.Len(t, req.Shards, 1)
+	assert.Contains(t, s.metaClass(c.Class).Sharding.Physical[req.Shards[0]].BelongsToNodes, "testNode")
+
+	// existing shards are left alone
+	nodes := s.metaClass(c.Class).Sharding.Physical["range_0"].BelongsToNodes
+	req = &api.AddRangeShardsRequest{ClusterNodes: []string{"thirdNode"}, Shards: []string{"range_0", "range_20"}}
+	_, err = s.addRangeShards(c.Class, 2, req)
+	require.NoError(t, err)
+	assert.Equal(t, nodes, s.metaClass(c.Class).Sharding.Physical["range_0"].BelongsToNodes)
+	assert.Equal(t, []string{"thirdNode"}, s.metaClass(c.Class).Sharding.Physical["range_20"].BelongsToNodes)
+	assert.Empty(t, req.Shards)
+
+	// collections which aren't sharded by range are rejected
+	require.NoError(t, s.addClass(&models.Class{Class: "hashed"}, &sharding.State{Physical: map[string]sharding.Physical{}}, 0))
+	_, err = s.addRangeShards("hashed", 3, &api.AddRangeShardsRequest{
+		ClusterNodes: []string{"testNode"}, Shards: []string{"range_0"},
+	})
+	require.ErrorIs(t, err, ErrBadRequest)
+}
+
+func Test_schemaAddRangeShardsRetention(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	c := &models.Class{Class: "collection", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+	ss := &sharding.State{
+		Config: shardingConfig.Config{
+			Key: "createdAt", Strategy: shardingConfig.StrategyRange,
+			RangeWidth: 10, RangeRetention: 30, RangeMaxShards: 4,
+		},
+		Physical:          map[string]sharding.Physical{},
+		ReplicationFactor: 1,
+	}
+	require.NoError(t, s.addClass(c, ss, 0))
+	add := func(v uint64, shards ...string) ([]string, error) {
+		return s.addRangeShards(c.Class, v, &api.AddRangeShardsRequest{ClusterNodes: []string{"testNode"}, Shards: shards})
+	}
+
+	dropped, err := add(1, "range_0", "range_10", "range_20")
+	require.NoError(t, err)
+	assert.Empty(t, dropped)
+
+	// ranges which are more than 30 behind the newest one are dropped
+	dropped, err = add(2, "range_30", "range_40")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"range_0", "range_10"}, dropped)
+	assert.ElementsMatch(t, []string{"range_20", "range_30", "range_40"}, s.metaClass(c.Class).Sharding.AllPhysicalShards())
+
+	// expired ranges aren't added again
+	_, err = add(3, "range_0")
+	require.NoError(t, err)
+	assert.NotContains(t, s.metaClass(c.Class).Sharding.AllPhysicalShards(), "range_0")
+
+	// the number of shards is limited
+	_, err = add(4, "range_41", "range_42")
+	require.ErrorIs(t, err, ErrBadRequest)
+	assert.Len(t, s.metaClass(c.Class).Sharding.AllPhysicalShards(), 3)
+
+	_, err = add(5, "not_a_range")
+	require.ErrorIs(t, err, ErrBadRequest)
+}
+
+func Test_schemaSplitShard(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	require.NoError(t, err)
+	ss, err := sharding.InitState("collection", cfg, "testNode", []string{"testNode"}, 1, false)
+	require.NoError(t, err)
+	c := &models.Class{Class: "collection", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+	require.NoError(t, s.addClass(c, ss, 0))
+
+	source := ss.AllPhysicalShards()[0]
+	require.NoError(t, s.splitShard(c.Class, 3, source, "target", []string{"otherNode", "testNode"}))
+	meta := s.metaClass(c.Class)
+	assert.ElementsMatch(t, []string{source, "target"}, meta.Sharding.AllPhysicalShards())
+	assert.Equal(t, []string{"otherNode", "testNode"}, meta.Sharding.Physical["target"].BelongsToNodes)
+	assert.Equal(t, uint64(3), meta.ShardVersion)
+
+	require.ErrorIs(t, s.splitShard("unknown", 4, source, "target2", []string{"otherNode"}), ErrClassNotFound)
+	require.Error(t, s.splitShard(c.Class, 4, source, "target", []string{"otherNode"}))
+
+	// collections sharded by range are rejected
+	rs := &sharding.State{
+		Config:   shardingConfig.Config{Key: "createdAt", Strategy: shardingConfig.StrategyRange, RangeWidth: 10},
+		Physical: map[string]sharding.Physical{},
+	}
+	require.NoError(t, s.addClass(&models.Class{Class: "ranged"}, rs, 0))
+	require.ErrorIs(t, s.splitShard("ranged", 5, "range_0", "target", []string{"otherNode"}), ErrBadRequest)
+}
+
+func Test_schemaAddReadOnlyReplicaToShard(t *testing.T) {
+	s := NewSchema("testNode", nil, prometheus.NewPedanticRegistry())
+	cfg, err := shardingConfig.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	require.NoError(t, err)
+	ss, err := sharding.InitState("collection", cfg, "testNode", []string{"testNode"}, 1, false)
+	require.NoError(t, err)
+	c := &models.Class{Class: "collection", ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+	require.NoError(t, s.addClass(c, ss, 0))
+
+	shard := ss.AllPhysicalShards()[0]
+	require.NoError(t, s.addReadOnlyReplicaToShard(c.Class, 2, shard, "queryNode"))
+
+	replicas, _, err := s.ShardReplicas(c.Class, shard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"testNode", "queryNode"}, replicas)
+	readOnly, _, err := s.ShardReadOnlyReplicas(c.Class, shard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queryNode"}, readOnly)
+
+	// read-only replicas are kept up to date by async replication
+	class, _ := s.ReadOnlyClass(c.Class)
+	assert.True(t, class.ReplicationConfig.AsyncEnabled)
+	assert.False(t, c.ReplicationConfig.AsyncEnabled, "the original config must not be modified")
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Tenants which are added while encryption at rest is enabled get their own
+// data key. The sharding state only references the key by its id, the wrapped
+// keys are stored apart from the classes:
+//
+//   - Deleting a tenant deletes its key. All remaining copies of the tenant's
+//     data, e.g. offloaded to the cloud, can't be decrypted anymore
+//     (crypto-shredding). Note that the raft log contains the key until it is
+//     compacted by the next snapshot.
+//   - Backups contain the wrapped keys of their tenants, restoring a backup
+//     stores them again. A backup of a deleted tenant can only be shredded by
+//     deleting the backup.
+//   - Deleting a collection doesn't delete any key on its own. Its keys are no
+//     longer referenced by any tenant though, such keys are removed whenever
+//     a snapshot is taken.
+
+// storeTenantKeys stores the data keys of tenants which were added to meta.
+// Keys of tenants which existed before are ignored.
+func (s *schema) storeTenantKeys(meta *metaClass, tenants []*command.Tenant) {
+	names := make([]string, 0, len(tenants))
+	for _, t := range tenants {
+		if t.EncryptionKeyId != "" && len(t.EncryptionKey) > 0 {
+			names = append(names, t.Name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	ids := meta.EncryptionKeyIDs(names)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range tenants {
+		if id, ok := ids[t.Name]; ok && id == t.EncryptionKeyId {
+			s.tenantKeys[id] = bytes.Clone(t.EncryptionKey)
+		}
+	}
+}
+
+// storeRestoredTenantKeys stores the data keys of the tenants of a restored
+// class which are referenced by ss and not stored yet
+func (s *schema) storeRestoredTenantKeys(ss *sharding.State, keys map[string][]byte) {
+	if len(keys) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, physical := range ss.Physical {
+		id := physical.EncryptionKeyID
+		if id == "" {
+			continue
+		}
+		if _, ok := s.tenantKeys[id]; ok {
+			continue
+		}
+		if key, ok := keys[id]; ok {
+			s.tenantKeys[id] = bytes.Clone(key)
+		}
+	}
+}
+
+// deleteTenantKeys deletes the data keys with the given ids, values of ids
+func (s *schema) deleteTenantKeys(ids map[string]string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		delete(s.tenantKeys, id)
+	}
+}
+
+// tenantKey returns the wrapped data key with the given id, false if the key
+// doesn't exist (anymore)
+func (s *schema) tenantKey(id string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.tenantKeys[id]
+	return bytes.Clone(key), ok
+}
+
+func (s *schema) restoreTenantKeys(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tenantKeys = make(map[string][]byte)
+	if err := json.Unmarshal(data, &s.tenantKeys); err != nil {
+		return fmt.Errorf("restore tenant keys: parse json: %w", err)
+	}
+	s.removeUnreferencedTenantKeys()
+	return nil
+}
+
+// removeUnreferencedTenantKeys deletes all keys which no tenant references.
+// The caller must hold the lock of the schema.
+func (s *schema) removeUnreferencedTenantKeys() {
+	if len(s.tenantKeys) == 0 {
+		return
+	}
+
+	referenced := make(map[string]struct{}, len(s.tenantKeys))
+	for _, meta := range s.classes {
+		meta.RLock()
+		for _, physical := range meta.Sharding.Physical {
+			if physical.EncryptionKeyID != "" {
+				referenced[physical.EncryptionKeyID] = struct{}{}
+			}
+		}
+		meta.RUnlock()
+	}
+
+	for id := range s.tenantKeys {
+		if _, ok := referenced[id]; !ok {
+			delete(s.tenantKeys, id)
+		}
+	}
+}
+
+// TenantKeysSnapshot returns the keys which are still referenced by a tenant,
+// others are removed from the local state as well
+func (s *SchemaManager) TenantKeysSnapshot() ([]byte, error) {
+	s.schema.mu.Lock()
+	defer s.schema.mu.Unlock()
+
+	s.schema.removeUnreferencedTenantKeys()
+	return json.Marshal(s.schema.tenantKeys)
+}
+
+func (s *SchemaManager) RestoreTenantKeys(data []byte) error {
+	return s.schema.restoreTenantKeys(data)
+}
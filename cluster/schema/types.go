@@ -40,6 +40,7 @@ type Indexer interface {
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
 	AddReplicaToShard(class, shard, targetNode string) error
 	DeleteReplicaFromShard(class, shard, targetNode string) error
+	SplitShard(class, shard, targetShard string) error
 	LoadShard(class, shard string)     // is a no-op
 	ShutdownShard(class, shard string) // is a no-op
 	DropShard(class, shard string)     // is a no-op
@@ -932,6 +932,65 @@ func (_c *MockSchemaReader_ShardOwnerWithVersion_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ShardReadOnlyReplicas provides a mock function with given fields: class, shard
+func (_m *MockSchemaReader) ShardReadOnlyReplicas(class string, shard string) ([]string, error) {
+	ret := _m.Called(class, shard)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShardReadOnlyReplicas")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return rf(class, shard)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(class, shard)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(class, shard)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchemaReader_ShardReadOnlyReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShardReadOnlyReplicas'
+type MockSchemaReader_ShardReadOnlyReplicas_Call struct {
+	*mock.Call
+}
+
+// ShardReadOnlyReplicas is a helper method to define mock.On call
+//   - class string
+//   - shard string
+func (_e *MockSchemaReader_Expecter) ShardReadOnlyReplicas(class interface{}, shard interface{}) *MockSchemaReader_ShardReadOnlyReplicas_Call {
+	return &MockSchemaReader_ShardReadOnlyReplicas_Call{Call: _e.mock.On("ShardReadOnlyReplicas", class, shard)}
+}
+
+func (_c *MockSchemaReader_ShardReadOnlyReplicas_Call) Run(run func(class string, shard string)) *MockSchemaReader_ShardReadOnlyReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSchemaReader_ShardReadOnlyReplicas_Call) Return(_a0 []string, _a1 error) *MockSchemaReader_ShardReadOnlyReplicas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchemaReader_ShardReadOnlyReplicas_Call) RunAndReturn(run func(string, string) ([]string, error)) *MockSchemaReader_ShardReadOnlyReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShardReplicas provides a mock function with given fields: class, shard
 func (_m *MockSchemaReader) ShardReplicas(class string, shard string) ([]string, error) {
 	ret := _m.Called(class, shard)
@@ -138,6 +138,10 @@ type Config struct {
 	// MetadataOnlyVoters configures the voters to store metadata exclusively, without storing any other data
 	MetadataOnlyVoters bool
 
+	// ReadOnly is set if this node only hosts read-only replicas. Replicas
+	// added to this node are recorded as read-only in the sharding state.
+	ReadOnly bool
+
 	// DB is the interface to the weaviate database. It is necessary so that schema changes are reflected to the DB
 	DB schema.Indexer
 	// Parser parses class field after deserialization
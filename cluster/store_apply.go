@@ -210,6 +210,10 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.schemaManager.AddReplicaToShard(&cmd, schemaOnly)
 		}
+	case api.ApplyRequest_TYPE_ADD_RANGE_SHARDS:
+		f = func() {
+			ret.Error = st.schemaManager.AddRangeShards(&cmd, schemaOnly)
+		}
 	case api.ApplyRequest_TYPE_DELETE_REPLICA_FROM_SHARD:
 		f = func() {
 			ret.Error = st.schemaManager.DeleteReplicaFromShard(&cmd, schemaOnly)
@@ -289,6 +293,14 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.dynUserManager.CreateUserWithKeyRequest(&cmd)
 		}
+	case api.ApplyRequest_TYPE_CREATE_USER_API_KEY:
+		f = func() {
+			ret.Error = st.dynUserManager.CreateUserApiKey(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REVOKE_USER_API_KEY:
+		f = func() {
+			ret.Error = st.dynUserManager.RevokeUserApiKey(&cmd)
+		}
 	case api.ApplyRequest_TYPE_REPLICATION_REPLICATE:
 		f = func() {
 			ret.Error = st.replicationManager.Replicate(l.Index, &cmd)
@@ -337,6 +349,10 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.schemaManager.ReplicationAddReplicaToShard(&cmd, schemaOnly)
 		}
+	case api.ApplyRequest_TYPE_REPLICATION_REPLICATE_SPLIT_SHARD:
+		f = func() {
+			ret.Error = st.schemaManager.ReplicationSplitShard(&cmd, schemaOnly)
+		}
 	case api.ApplyRequest_TYPE_REPLICATION_REPLICATE_FORCE_DELETE_ALL:
 		f = func() {
 			ret.Error = st.replicationManager.ForceDeleteAll(&cmd)
@@ -102,10 +102,15 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not get dynamic user: %w", err)
 		}
 	case cmd.QueryRequest_TYPE_USER_IDENTIFIER_EXISTS:
-		payload, err = st.dynUserManager.GetUsers(req)
+		payload, err = st.dynUserManager.CheckUserIdentifierExists(req)
 		if err != nil {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not check user identifier: %w", err)
 		}
+	case cmd.QueryRequest_TYPE_GET_USER_API_KEYS:
+		payload, err = st.dynUserManager.GetUserApiKeys(req)
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get user api keys: %w", err)
+		}
 	case cmd.QueryRequest_TYPE_GET_REPLICATION_DETAILS:
 		payload, err = st.replicationManager.GetReplicationDetailsByReplicationId(req)
 		if err != nil {
@@ -38,6 +38,11 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		return fmt.Errorf("alias snapshot: %w", err)
 	}
 
+	tenantKeysSnapshot, err := s.schemaManager.TenantKeysSnapshot()
+	if err != nil {
+		return fmt.Errorf("tenant keys snapshot: %w", err)
+	}
+
 	rbacSnapshot, err := s.authZManager.Snapshot()
 	if err != nil {
 		return fmt.Errorf("rbac snapshot: %w", err)
@@ -63,6 +68,7 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		SnapshotID:       sink.ID(),
 		Schema:           schemaSnapshot,
 		Aliases:          aliasSnapshot,
+		TenantKeys:       tenantKeysSnapshot,
 		RBAC:             rbacSnapshot,
 		DbUsers:          dbUserSnapshot,
 		DistributedTasks: tasksSnapshot,
@@ -140,6 +146,12 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}
 
+		if snap.TenantKeys != nil {
+			if err := st.schemaManager.RestoreTenantKeys(snap.TenantKeys); err != nil {
+				return fmt.Errorf("restore tenant keys from snapshot: %w", err)
+			}
+		}
+
 		if snap.RBAC != nil {
 			if err := st.authZManager.Restore(snap.RBAC); err != nil {
 				st.log.WithError(err).Error("restoring rbac from snapshot")
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changes
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type Operation uint8
+
+const (
+	OperationInsert Operation = iota + 1
+	OperationUpdate
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationInsert:
+		return "insert"
+	case OperationUpdate:
+		return "update"
+	case OperationDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("unknown(%d)", o)
+	}
+}
+
+// KeySize is the size of the key of a change in the change log
+const KeySize = 8
+
+// Key is the key of the change with the sequence number in the change log,
+// keys sort in the order of their sequence numbers
+func Key(seq uint64) []byte {
+	key := make([]byte, KeySize)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func SeqFromKey(key []byte) (uint64, error) {
+	if len(key) != KeySize {
+		return 0, fmt.Errorf("invalid change key length %d", len(key))
+	}
+	return binary.BigEndian.Uint64(key), nil
+}
+
+// Change is a mutation of an object as recorded in the change log of a
+// replica of a shard
+type Change struct {
+	// Seq is the position of the change in the change log of the replica.
+	// Every replica assigns sequence numbers in the order it applies changes,
+	// so they are only meaningful for the node which assigned them.
+	Seq  uint64 `json:"seq"`
+	Node string `json:"node"`
+	// Time of the mutation in unix milliseconds as assigned by the coordinator
+	Time      int64     `json:"time"`
+	ID        uuid.UUID `json:"id"`
+	Operation Operation `json:"op"`
+	// Shard the object is stored in, which is the tenant for multi-tenant
+	// collections
+	Shard string `json:"shard"`
+	// Object is the object after the mutation in its storage format, it is
+	// empty for deletions
+	Object []byte `json:"object,omitempty"`
+}
+
+// ShardOffset is the position of a reader in the change log of a shard
+type ShardOffset struct {
+	// Node is the replica whose change log is read
+	Node string `json:"node"`
+	Seq  uint64 `json:"seq"`
+	// Time is the latest time of the changes read. It is used to resume on
+	// another replica if Node no longer holds the shard.
+	Time int64 `json:"time"`
+}
+
+// Offset is the position of a reader in the change stream of a collection,
+// by shard
+type Offset map[string]ShardOffset
+
+// Advance moves the offset past the change
+func (o Offset) Advance(change Change) {
+	prev := o[change.Shard]
+	o[change.Shard] = ShardOffset{
+		Node: change.Node,
+		Seq:  change.Seq,
+		Time: max(prev.Time, change.Time),
+	}
+}
+
+func (o Offset) Clone() Offset {
+	out := make(Offset, len(o))
+	for shard, offset := range o {
+		out[shard] = offset
+	}
+	return out
+}
+
+// String encodes the offset as an opaque token
+func (o Offset) String() string {
+	if len(o) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(o)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func ParseOffset(s string) (Offset, error) {
+	if s == "" {
+		return Offset{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %q: %w", s, err)
+	}
+	var o Offset
+	if err := json.Unmarshal(raw, &o); err != nil {
+		return nil, fmt.Errorf("invalid offset %q: %w", s, err)
+	}
+	if o == nil {
+		o = Offset{}
+	}
+	return o, nil
+}
+
+// Merge combines the changes of shards, each in the order of its change log,
+// into a single list of up to limit changes. Changes of different shards are
+// ordered by time, the order within a shard is kept, so that advancing an
+// offset over the result never skips a change of a shard.
+func Merge(limit int, shards ...[]Change) []Change {
+	var out []Change
+	heads := make([]int, len(shards))
+	for len(out) < limit {
+		next := -1
+		for i, shard := range shards {
+			if heads[i] == len(shard) {
+				continue
+			}
+			if next == -1 || shard[heads[i]].Time < shards[next][heads[next]].Time {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		out = append(out, shards[next][heads[next]])
+		heads[next]++
+	}
+	return out
+}
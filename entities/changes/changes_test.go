@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffset(t *testing.T) {
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	t.Run("string round trip", func(t *testing.T) {
+		o := Offset{
+			"shard1": {Node: "node1", Seq: 42, Time: 1700000000000},
+			"shard2": {Node: "node2", Seq: 7, Time: 1700000000001},
+		}
+		parsed, err := ParseOffset(o.String())
+		require.NoError(t, err)
+		assert.Equal(t, o, parsed)
+
+		parsed, err = ParseOffset("")
+		require.NoError(t, err)
+		assert.Empty(t, parsed)
+		assert.Equal(t, "", parsed.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"123_" + id.String(), "!!", "WzFd"} {
+			_, err := ParseOffset(s)
+			assert.Error(t, err, s)
+		}
+	})
+
+	t.Run("advance", func(t *testing.T) {
+		o := Offset{}
+		o.Advance(Change{Shard: "shard1", Node: "node1", Seq: 1, Time: 200})
+		o.Advance(Change{Shard: "shard1", Node: "node1", Seq: 2, Time: 100})
+		assert.Equal(t, ShardOffset{Node: "node1", Seq: 2, Time: 200}, o["shard1"])
+	})
+
+	t.Run("key order matches sequence order", func(t *testing.T) {
+		seqs := []uint64{1, 2, 255, 256, 1 << 40}
+		for i := 1; i < len(seqs); i++ {
+			assert.Negative(t, bytes.Compare(Key(seqs[i-1]), Key(seqs[i])))
+		}
+
+		decoded, err := SeqFromKey(Key(seqs[4]))
+		require.NoError(t, err)
+		assert.Equal(t, seqs[4], decoded)
+
+		_, err = SeqFromKey([]byte{1})
+		assert.Error(t, err)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	change := func(shard string, seq uint64, ts int64) Change {
+		return Change{Shard: shard, Seq: seq, Time: ts}
+	}
+
+	t.Run("ordered by time across shards", func(t *testing.T) {
+		merged := Merge(3,
+			[]Change{change("a", 1, 1), change("a", 2, 4), change("a", 3, 5)},
+			[]Change{change("b", 1, 2), change("b", 2, 3)},
+			nil,
+		)
+		assert.Equal(t, []Change{change("a", 1, 1), change("b", 1, 2), change("b", 2, 3)}, merged)
+	})
+
+	t.Run("order within a shard is kept", func(t *testing.T) {
+		// a write applied late on a replica has an older time but a later
+		// sequence number, it must not overtake earlier changes of its shard
+		merged := Merge(2,
+			[]Change{change("a", 1, 10), change("a", 2, 1)},
+			[]Change{change("b", 1, 5)},
+		)
+		assert.Equal(t, []Change{change("b", 1, 5), change("a", 1, 10)}, merged)
+	})
+}
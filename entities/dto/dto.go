@@ -72,6 +72,12 @@ type Embedding interface {
 	[]float32 | [][]float32
 }
 
+// CompactVector is implemented by the int8, uint8 and binary vector types.
+// They are stored in their compact form, but indexed as float32.
+type CompactVector interface {
+	Float32() []float32
+}
+
 func IsVectorEmpty(vector models.Vector) (bool, error) {
 	switch v := vector.(type) {
 	case nil:
@@ -82,6 +88,14 @@ func IsVectorEmpty(vector models.Vector) (bool, error) {
 		return len(v) == 0, nil
 	case [][]float32:
 		return len(v) == 0, nil
+	case models.SparseVector:
+		return len(v.Indices) == 0, nil
+	case models.Int8Vector:
+		return len(v) == 0, nil
+	case models.Uint8Vector:
+		return len(v) == 0, nil
+	case models.BinaryVector:
+		return len(v) == 0, nil
 	default:
 		return false, fmt.Errorf("unrecognized vector type: %T", vector)
 	}
@@ -150,6 +164,13 @@ func GetVectors(in models.Vectors) (map[string][]float32, map[string][][]float32
 					multiVectors = make(map[string][][]float32)
 				}
 				multiVectors[targetVector] = vec
+			case models.SparseVector:
+				// sparse vectors are extracted separately, see GetSparseVectors
+			case CompactVector:
+				if vectors == nil {
+					vectors = make(map[string][]float32)
+				}
+				vectors[targetVector] = vec.Float32()
 			default:
 				return nil, nil, fmt.Errorf("unrecognized vector type: %T for target vector: %s", vector, targetVector)
 			}
@@ -157,3 +178,18 @@ func GetVectors(in models.Vectors) (map[string][]float32, map[string][][]float32
 	}
 	return vectors, multiVectors, nil
 }
+
+// GetSparseVectors returns the sparse vectors contained in in, other vector
+// types are ignored
+func GetSparseVectors(in models.Vectors) map[string]models.SparseVector {
+	var sparseVectors map[string]models.SparseVector
+	for targetVector, vector := range in {
+		if vec, ok := vector.(models.SparseVector); ok {
+			if sparseVectors == nil {
+				sparseVectors = make(map[string]models.SparseVector)
+			}
+			sparseVectors[targetVector] = vec
+		}
+	}
+	return sparseVectors
+}
@@ -12,6 +12,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -80,3 +81,29 @@ func TestVectors(t *testing.T) {
 		assert.Len(t, multiVector[1], 3)
 	})
 }
+
+func TestSparseVectors(t *testing.T) {
+	var vectors models.Vectors
+	err := json.Unmarshal([]byte(`{
+		"dense": [0.1, 0.2],
+		"multi": [[0.1, 0.2], [0.3, 0.4]],
+		"sparse": {"indices": [3, 17], "values": [0.5, 1.25]}
+	}`), &vectors)
+	require.NoError(t, err)
+
+	sparse := models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}}
+	assert.Equal(t, sparse, vectors["sparse"])
+
+	dense, multi, err := GetVectors(vectors)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]float32{"dense": {0.1, 0.2}}, dense)
+	assert.Equal(t, map[string][][]float32{"multi": {{0.1, 0.2}, {0.3, 0.4}}}, multi)
+	assert.Equal(t, map[string]models.SparseVector{"sparse": sparse}, GetSparseVectors(vectors))
+
+	isEmpty, err := IsVectorEmpty(vectors["sparse"])
+	require.NoError(t, err)
+	assert.False(t, isEmpty)
+	isEmpty, err = IsVectorEmpty(models.SparseVector{})
+	require.NoError(t, err)
+	assert.True(t, isEmpty)
+}
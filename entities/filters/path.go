@@ -27,6 +27,11 @@ type Path struct {
 	// If nil, then this is the property we're interested in.
 	// If a pointer to another Path, the constraint applies to that one.
 	Child *Path `json:"child"`
+
+	// Restriction is a filter the objects of Class have to match in addition
+	// when the path is entered through a reference. It carries the read_data
+	// restrictions of the principal on referenced classes.
+	Restriction *Clause `json:"restriction,omitempty"`
 }
 
 // GetInnerMost recursively searches for child paths, only when no more
@@ -35,6 +35,10 @@ type DBUserInfo struct {
 	// Required: true
 	Active *bool `json:"active"`
 
+	// Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ) after which the API-key of the user is no longer valid
+	// Format: date-time
+	APIKeyExpiresAt strfmt.DateTime `json:"apiKeyExpiresAt,omitempty"`
+
 	// First 3 letters of the associated API-key
 	// Max Length: 3
 	APIKeyFirstLetters string `json:"apiKeyFirstLetters,omitempty"`
@@ -69,6 +73,10 @@ func (m *DBUserInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAPIKeyExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateAPIKeyFirstLetters(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +116,18 @@ func (m *DBUserInfo) validateActive(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DBUserInfo) validateAPIKeyExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.APIKeyExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("apiKeyExpiresAt", "body", "date-time", m.APIKeyExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *DBUserInfo) validateAPIKeyFirstLetters(formats strfmt.Registry) error {
 	if swag.IsZero(m.APIKeyFirstLetters) { // not required
 		return nil
@@ -748,20 +748,82 @@ type PermissionData struct {
 	// string or regex. if a specific collection name, if left empty it will be ALL or *
 	Collection *string `json:"collection,omitempty"`
 
+	// property names which are hidden from the role, nested properties are separated by dots. Only supported for read_data
+	ExcludedProperties []string `json:"excludedProperties"`
+
+	// restricts the objects the permission applies to. Only supported for read_data, objects not matching the filter are hidden from the role
+	Filter *WhereFilter `json:"filter,omitempty"`
+
 	// string or regex. if a specific object ID, if left empty it will be ALL or *
 	Object *string `json:"object,omitempty"`
 
+	// property names which are visible to the role, nested properties are separated by dots. If left empty all properties are visible. Only supported for read_data
+	Properties []string `json:"properties"`
+
 	// string or regex. if a specific tenant name, if left empty it will be ALL or *
 	Tenant *string `json:"tenant,omitempty"`
 }
 
 // Validate validates this permission data
 func (m *PermissionData) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFilter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this permission data based on context it is used
+func (m *PermissionData) validateFilter(formats strfmt.Registry) error {
+	if swag.IsZero(m.Filter) { // not required
+		return nil
+	}
+
+	if m.Filter != nil {
+		if err := m.Filter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data" + "." + "filter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("data" + "." + "filter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this permission data based on the context it is used
 func (m *PermissionData) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFilter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PermissionData) contextValidateFilter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Filter != nil {
+		if err := m.Filter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data" + "." + "filter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("data" + "." + "filter")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -32,6 +32,18 @@ type Principal struct {
 	// groups
 	Groups []string `json:"groups"`
 
+	// The roles assigned to the principal by the OIDC role mapping rules, in addition to the roles assigned via RBAC
+	Roles []string `json:"roles"`
+
+	// The collections the principal is restricted to when authenticated with a scoped API key, empty means all collections
+	ScopedCollections []string `json:"scopedCollections"`
+
+	// The roles the principal is restricted to when authenticated with a scoped API key, empty means all roles of the user
+	ScopedRoles []string `json:"scopedRoles"`
+
+	// The tenant the principal is bound to. A principal that is bound to a tenant can only access this tenant
+	Tenant string `json:"tenant,omitempty"`
+
 	// user type
 	UserType UserTypeInput `json:"userType,omitempty"`
 
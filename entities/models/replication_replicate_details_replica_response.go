@@ -66,9 +66,12 @@ type ReplicationReplicateDetailsReplicaResponse struct {
 	// Required: true
 	TargetNode *string `json:"targetNode"`
 
-	// Indicates whether the operation is a 'COPY' (source replica remains) or a 'MOVE' (source replica is removed after successful transfer).
+	// The name of the shard created by a 'SPLIT' operation.
+	TargetShard string `json:"targetShard,omitempty"`
+
+	// Indicates whether the operation is a 'COPY' (source replica remains), a 'MOVE' (source replica is removed after successful transfer) or a 'SPLIT' (half of the data of the shard is moved to a new shard).
 	// Required: true
-	// Enum: [COPY MOVE]
+	// Enum: [COPY MOVE SPLIT]
 	Type *string `json:"type"`
 
 	// Whether the replica operation is uncancelable.
@@ -216,7 +219,7 @@ var replicationReplicateDetailsReplicaResponseTypeTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["COPY","MOVE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["COPY","MOVE","SPLIT"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -231,6 +234,9 @@ const (
 
 	// ReplicationReplicateDetailsReplicaResponseTypeMOVE captures enum value "MOVE"
 	ReplicationReplicateDetailsReplicaResponseTypeMOVE string = "MOVE"
+
+	// ReplicationReplicateDetailsReplicaResponseTypeSPLIT captures enum value "SPLIT"
+	ReplicationReplicateDetailsReplicaResponseTypeSPLIT string = "SPLIT"
 )
 
 // prop value enum
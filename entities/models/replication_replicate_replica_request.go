@@ -47,8 +47,8 @@ type ReplicationReplicateReplicaRequest struct {
 	// Required: true
 	TargetNode *string `json:"targetNode"`
 
-	// Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. Defaults to 'COPY' if omitted.
-	// Enum: [COPY MOVE]
+	// Specifies the type of replication operation to perform. 'COPY' creates a new replica on the target node while keeping the source replica. 'MOVE' creates a new replica on the target node and then removes the source replica upon successful completion. 'SPLIT' moves half of the data of the shard to a new shard on the target node. Defaults to 'COPY' if omitted.
+	// Enum: [COPY MOVE SPLIT]
 	Type *string `json:"type,omitempty"`
 }
 
@@ -122,7 +122,7 @@ var replicationReplicateReplicaRequestTypeTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["COPY","MOVE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["COPY","MOVE","SPLIT"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -137,6 +137,9 @@ const (
 
 	// ReplicationReplicateReplicaRequestTypeMOVE captures enum value "MOVE"
 	ReplicationReplicateReplicaRequestTypeMOVE string = "MOVE"
+
+	// ReplicationReplicateReplicaRequestTypeSPLIT captures enum value "SPLIT"
+	ReplicationReplicateReplicaRequestTypeSPLIT string = "SPLIT"
 )
 
 // prop value enum
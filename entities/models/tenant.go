@@ -37,6 +37,9 @@ type Tenant struct {
 
 	// The name of the tenant (required).
 	Name string `json:"name,omitempty"`
+
+	// quotas
+	Quotas *TenantQuotas `json:"quotas,omitempty"`
 }
 
 // Validate validates this tenant
@@ -47,6 +50,10 @@ func (m *Tenant) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateQuotas(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -119,8 +126,52 @@ func (m *Tenant) validateActivityStatus(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this tenant based on context it is used
+func (m *Tenant) validateQuotas(formats strfmt.Registry) error {
+	if swag.IsZero(m.Quotas) { // not required
+		return nil
+	}
+
+	if m.Quotas != nil {
+		if err := m.Quotas.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotas")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this tenant based on the context it is used
 func (m *Tenant) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateQuotas(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Tenant) contextValidateQuotas(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Quotas != nil {
+		if err := m.Quotas.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotas")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -33,6 +33,9 @@ type UserAPIKey struct {
 	// The apikey
 	// Required: true
 	Apikey *string `json:"apikey"`
+
+	// The id of the key. Only set for additional keys of a user
+	KeyID string `json:"keyId,omitempty"`
 }
 
 // Validate validates this user Api key
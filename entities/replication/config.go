@@ -11,7 +11,16 @@
 
 package replication
 
-import "github.com/weaviate/weaviate/usecases/config/runtime"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+const (
+	DefaultHintedHandoffMaxAge          = 3 * time.Hour
+	DefaultHintedHandoffReplayFrequency = 10 * time.Second
+)
 
 // GlobalConfig represents system-wide config that may restrict settings of an
 // individual class
@@ -23,4 +32,19 @@ type GlobalConfig struct {
 	MinimumFactor int `json:"minimum_factor" yaml:"minimum_factor"`
 
 	DeletionStrategy string `json:"deletion_strategy" yaml:"deletion_strategy"`
+
+	HintedHandoff HintedHandoff `json:"hinted_handoff" yaml:"hinted_handoff"`
+}
+
+// HintedHandoff lets the coordinator of a write keep hints on disk for the
+// replicas which missed it, e.g. because they were restarting, and replay
+// them once the replicas are reachable again.
+type HintedHandoff struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// MaxAge is how long hints are kept, older ones are dropped and left to
+	// async replication and read repair
+	MaxAge time.Duration `json:"max_age" yaml:"max_age"`
+	// ReplayFrequency is how often the hints of unreachable replicas are
+	// attempted to be replayed
+	ReplayFrequency time.Duration `json:"replay_frequency" yaml:"replay_frequency"`
 }
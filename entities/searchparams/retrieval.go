@@ -29,6 +29,14 @@ type NearVector struct {
 	TargetVectors []string        `json:"targetVectors"`
 }
 
+// NearSparseVector searches a sparse vector index. The results are ranked by
+// the dot product of the query and the stored sparse vectors.
+type NearSparseVector struct {
+	Indices      []uint32  `json:"indices"`
+	Values       []float32 `json:"values"`
+	TargetVector string    `json:"targetVector"`
+}
+
 type KeywordRanking struct {
 	Type                   string   `json:"type"`
 	Properties             []string `json:"properties"`
@@ -36,6 +44,9 @@ type KeywordRanking struct {
 	AdditionalExplanations bool     `json:"additionalExplanations"`
 	MinimumOrTokensMatch   int      `json:"minimumOrTokensMatch"`
 	SearchOperator         string   `json:"searchOperator"`
+	// SparseVector is set for Type "sparse", which ranks by a sparse vector
+	// index instead of BM25
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
 }
 
 // Indicates whether property should be indexed
@@ -114,6 +125,8 @@ type HybridSearch struct {
 	SearchOperator       string        `json:"searchOperator"`
 	NearTextParams       *NearTextParams
 	NearVectorParams     *NearVector
+	// NearSparseVectorParams replaces BM25 as the sparse part of the search
+	NearSparseVectorParams *NearSparseVector
 }
 
 type NearObject struct {
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// data types of the vectors in the compact vectors segment
+const (
+	compactVectorTypeInt8   uint8 = 1
+	compactVectorTypeUint8  uint8 = 2
+	compactVectorTypeBinary uint8 = 3
+)
+
+func compactVectorType(vec models.Vector) uint8 {
+	switch vec.(type) {
+	case models.Int8Vector:
+		return compactVectorTypeInt8
+	case models.Uint8Vector:
+		return compactVectorTypeUint8
+	case models.BinaryVector:
+		return compactVectorTypeBinary
+	default:
+		return 0
+	}
+}
+
+func compactVectorBytes(vec models.Vector) ([]byte, error) {
+	switch v := vec.(type) {
+	case models.Int8Vector:
+		out := make([]byte, len(v))
+		for i := range v {
+			out[i] = byte(v[i])
+		}
+		return out, nil
+	case models.Uint8Vector:
+		return v, nil
+	case models.BinaryVector:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported compact vector type %T", vec)
+	}
+}
+
+func compactVectorFromBytes(dataType uint8, data []byte) (models.Vector, error) {
+	switch dataType {
+	case compactVectorTypeInt8:
+		out := make(models.Int8Vector, len(data))
+		for i := range data {
+			out[i] = int8(data[i])
+		}
+		return out, nil
+	case compactVectorTypeUint8:
+		out := make(models.Uint8Vector, len(data))
+		copy(out, data)
+		return out, nil
+	case compactVectorTypeBinary:
+		out := make(models.BinaryVector, len(data))
+		copy(out, data)
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported compact vector data type %d", dataType)
+	}
+}
+
+// widenCompactVector returns the float32 form of a compact vector, which is
+// the form it is indexed in
+func widenCompactVector(vec models.Vector) []float32 {
+	switch v := vec.(type) {
+	case models.Int8Vector:
+		return v.Float32()
+	case models.Uint8Vector:
+		return v.Float32()
+	case models.BinaryVector:
+		return v.Float32()
+	default:
+		return nil
+	}
+}
+
+// setCompactVectors sets the compact vectors read from disk and adds their
+// float32 form to the target vectors
+func (ko *Object) setCompactVectors(compactVectors map[string]models.Vector) {
+	ko.CompactVectors = compactVectors
+	for name, vec := range compactVectors {
+		if ko.Vectors == nil {
+			ko.Vectors = make(map[string][]float32, len(compactVectors))
+		}
+		ko.Vectors[name] = widenCompactVector(vec)
+	}
+}
+
+func deepCopyCompactVectorsMap(orig map[string]models.Vector) map[string]models.Vector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]models.Vector, len(orig))
+	for key, vec := range orig {
+		out[key] = deepCopyCompactVector(vec)
+	}
+	return out
+}
+
+func deepCopyCompactVector(orig models.Vector) models.Vector {
+	switch v := orig.(type) {
+	case models.Int8Vector:
+		out := make(models.Int8Vector, len(v))
+		copy(out, v)
+		return out
+	case models.Uint8Vector:
+		out := make(models.Uint8Vector, len(v))
+		copy(out, v)
+		return out
+	case models.BinaryVector:
+		out := make(models.BinaryVector, len(v))
+		copy(out, v)
+		return out
+	default:
+		return nil
+	}
+}
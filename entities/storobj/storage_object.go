@@ -55,8 +55,12 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 	DocID             uint64
-	Vectors           map[string][]float32   `json:"vectors"`
-	MultiVectors      map[string][][]float32 `json:"multivectors"`
+	Vectors           map[string][]float32           `json:"vectors"`
+	MultiVectors      map[string][][]float32         `json:"multivectors"`
+	SparseVectors     map[string]models.SparseVector `json:"sparseVectors"`
+	// CompactVectors holds the int8, uint8 and binary vectors in their stored
+	// form. The same vectors are also present, widened to float32, in Vectors.
+	CompactVectors map[string]models.Vector `json:"-"`
 }
 
 func New(docID uint64) *Object {
@@ -96,6 +100,18 @@ func FromObject(object *models.Object, vector []float32, vectors map[string][]fl
 		}
 	}
 
+	// sparse vectors are not extracted by the callers, they are only ever
+	// provided by the user as part of the object
+	var sparseVectors map[string]models.SparseVector
+	for targetVector, vector := range object.Vectors {
+		if sparseVector, ok := vector.(models.SparseVector); ok {
+			if sparseVectors == nil {
+				sparseVectors = make(map[string]models.SparseVector)
+			}
+			sparseVectors[targetVector] = sparseVector
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
@@ -103,6 +119,7 @@ func FromObject(object *models.Object, vector []float32, vectors map[string][]fl
 		VectorLen:         len(vector),
 		Vectors:           vecs,
 		MultiVectors:      multiVectors,
+		SparseVectors:     sparseVectors,
 	}
 }
 
@@ -250,6 +267,24 @@ func FromBinaryOptional(data []byte,
 				ko.Object.Vectors[vecName] = vec
 			}
 		}
+
+		sparseVectors, err := unmarshalSparseVectors(&rw, vectorNamesToUnmarshal)
+		if err != nil {
+			return nil, err
+		}
+		ko.SparseVectors = sparseVectors
+
+		compactVectors, err := unmarshalCompactVectors(&rw, vectorNamesToUnmarshal)
+		if err != nil {
+			return nil, err
+		}
+		ko.setCompactVectors(compactVectors)
+		for vecName, vec := range compactVectors {
+			if ko.Object.Vectors == nil {
+				ko.Object.Vectors = make(models.Vectors)
+			}
+			ko.Object.Vectors[vecName] = vec
+		}
 	}
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
@@ -572,14 +607,22 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 }
 
 func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[string][][]float32) models.Vectors {
-	if (len(vectors) + len(multiVectors)) > 0 {
+	if (len(vectors) + len(multiVectors) + len(ko.SparseVectors)) > 0 {
 		out := make(models.Vectors)
 		for targetVector, vector := range vectors {
+			if compact, ok := ko.CompactVectors[targetVector]; ok {
+				// return the vector in the form it was given in
+				out[targetVector] = compact
+				continue
+			}
 			out[targetVector] = vector
 		}
 		for targetVector, vector := range multiVectors {
 			out[targetVector] = vector
 		}
+		for targetVector, vector := range ko.SparseVectors {
+			out[targetVector] = vector
+		}
 		return out
 	}
 	return nil
@@ -747,18 +790,30 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n             | []byte                   | packed multivector offsets map { name : offset_in_bytes }
 // 4             | uint32                   | length of multivectors segment (in bytes)
 // 4 + (2 + n*4) | uint32 + (uint16+[]byte) | multivectors segment: num vecs + (vec length + vec floats), ...
+// 4             | uint32                   | length of packed sparse vector offsets (in bytes), only present if the object has sparse vectors
+// n             | []byte                   | packed sparse vector offsets map { name : offset_in_bytes }
+// 4             | uint32                   | length of sparse vectors segment (in bytes)
+// 4 + n*4 + n*4 | uint32 + []byte + []byte | sparse vectors segment: num entries + indices (uint32) + values (float32), ...
+// 4             | uint32                   | length of packed compact vector offsets (in bytes), only present if the object has int8, uint8 or binary vectors
+// n             | []byte                   | packed compact vector offsets map { name : offset_in_bytes }
+// 4             | uint32                   | length of compact vectors segment (in bytes)
+// 1 + 4 + n     | uint8 + uint32 + []byte  | compact vectors segment: data type + vec length (in bytes) + vec, ...
 // TODO vec lengths immediately following num vecs so you can jump straight to specific vec?
 
 const (
-	maxVectorLength               int = math.MaxUint16
-	maxClassNameLength            int = math.MaxUint16
-	maxSchemaLength               int = math.MaxUint32
-	maxMetaLength                 int = math.MaxUint32
-	maxVectorWeightsLength        int = math.MaxUint32
-	maxTargetVectorsSegmentLength int = math.MaxUint32
-	maxTargetVectorsOffsetsLength int = math.MaxUint32
-	maxMultiVectorsSegmentLength  int = math.MaxUint32
-	maxMultiVectorsOffsetsLength  int = math.MaxUint32
+	maxVectorLength                int = math.MaxUint16
+	maxClassNameLength             int = math.MaxUint16
+	maxSchemaLength                int = math.MaxUint32
+	maxMetaLength                  int = math.MaxUint32
+	maxVectorWeightsLength         int = math.MaxUint32
+	maxTargetVectorsSegmentLength  int = math.MaxUint32
+	maxTargetVectorsOffsetsLength  int = math.MaxUint32
+	maxMultiVectorsSegmentLength   int = math.MaxUint32
+	maxMultiVectorsOffsetsLength   int = math.MaxUint32
+	maxSparseVectorsSegmentLength  int = math.MaxUint32
+	maxSparseVectorsOffsetsLength  int = math.MaxUint32
+	maxCompactVectorsSegmentLength int = math.MaxUint32
+	maxCompactVectorsOffsetsLength int = math.MaxUint32
 )
 
 func (ko *Object) MarshalBinary() ([]byte, error) {
@@ -825,6 +880,10 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	if len(ko.Vectors) > 0 {
 		offsetsMap := map[string]uint32{}
 		for name, vec := range ko.Vectors {
+			if _, ok := ko.CompactVectors[name]; ok {
+				// stored in the compact vectors segment instead
+				continue
+			}
 			if len(vec) > maxVectorLength {
 				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", len(vec), maxVectorLength)
 			}
@@ -888,6 +947,74 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		multiVectorsOffsetsLength = uint32(len(multiVectorsOffsets))
 	}
 
+	var sparseVectorsOffsets []byte
+	var sparseVectorsSegmentLength int
+
+	sparseVectorsOffsetOrder := make([]string, 0, len(ko.SparseVectors))
+	if len(ko.SparseVectors) > 0 {
+		offsetsMap := map[string]uint32{}
+		for name, vec := range ko.SparseVectors {
+			if len(vec.Indices) != len(vec.Values) {
+				return nil, fmt.Errorf("could not marshal sparse vector %q: %d indices but %d values",
+					name, len(vec.Indices), len(vec.Values))
+			}
+
+			offsetsMap[name] = uint32(sparseVectorsSegmentLength)
+			// 4 bytes for the number of entries, 4 bytes per index and per value
+			sparseVectorsSegmentLength += 4 + 8*len(vec.Indices)
+
+			if sparseVectorsSegmentLength > maxSparseVectorsSegmentLength {
+				return nil,
+					fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)",
+						"sparseVectorsSegmentLength", sparseVectorsSegmentLength, maxSparseVectorsSegmentLength)
+			}
+			sparseVectorsOffsetOrder = append(sparseVectorsOffsetOrder, name)
+		}
+
+		sparseVectorsOffsets, err = msgpack.Marshal(offsetsMap)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal sparse vectors offsets: %w", err)
+		}
+		if len(sparseVectorsOffsets) > maxSparseVectorsOffsetsLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectorsOffsets", len(sparseVectorsOffsets), maxSparseVectorsOffsetsLength)
+		}
+	}
+
+	var compactVectorsOffsets []byte
+	var compactVectorsSegmentLength int
+
+	compactVectorsOffsetOrder := make([]string, 0, len(ko.CompactVectors))
+	compactVectorsData := make(map[string][]byte, len(ko.CompactVectors))
+	if len(ko.CompactVectors) > 0 {
+		offsetsMap := map[string]uint32{}
+		for name, vec := range ko.CompactVectors {
+			data, err := compactVectorBytes(vec)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal compact vector %q: %w", name, err)
+			}
+			compactVectorsData[name] = data
+
+			offsetsMap[name] = uint32(compactVectorsSegmentLength)
+			// 1 byte for the data type, 4 bytes for the vec length
+			compactVectorsSegmentLength += 1 + 4 + len(data)
+
+			if compactVectorsSegmentLength > maxCompactVectorsSegmentLength {
+				return nil,
+					fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)",
+						"compactVectorsSegmentLength", compactVectorsSegmentLength, maxCompactVectorsSegmentLength)
+			}
+			compactVectorsOffsetOrder = append(compactVectorsOffsetOrder, name)
+		}
+
+		compactVectorsOffsets, err = msgpack.Marshal(offsetsMap)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal compact vectors offsets: %w", err)
+		}
+		if len(compactVectorsOffsets) > maxCompactVectorsOffsetsLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "compactVectorsOffsets", len(compactVectorsOffsets), maxCompactVectorsOffsetsLength)
+		}
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -898,6 +1025,19 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + uint32(targetVectorsSegmentLength) +
 		4 + multiVectorsOffsetsLength +
 		4 + uint32(multiVectorsSegmentLength)
+	// the sparse and compact vectors segments are only appended if needed, so
+	// objects without such vectors are stored exactly as before. The compact
+	// vectors segment follows the sparse vectors segment, which is then
+	// written even if it is empty.
+	writeSparseVectors := len(sparseVectorsOffsets) > 0 || len(compactVectorsOffsets) > 0
+	if writeSparseVectors {
+		totalBufferLength += 4 + uint32(len(sparseVectorsOffsets)) +
+			4 + uint32(sparseVectorsSegmentLength)
+	}
+	if len(compactVectorsOffsets) > 0 {
+		totalBufferLength += 4 + uint32(len(compactVectorsOffsets)) +
+			4 + uint32(compactVectorsSegmentLength)
+	}
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -979,6 +1119,45 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	if writeSparseVectors {
+		rw.WriteUint32(uint32(len(sparseVectorsOffsets)))
+		err = rw.CopyBytesToBuffer(sparseVectorsOffsets)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparseVectorsOffsets")
+		}
+
+		rw.WriteUint32(uint32(sparseVectorsSegmentLength))
+		for _, name := range sparseVectorsOffsetOrder {
+			vec := ko.SparseVectors[name]
+			rw.WriteUint32(uint32(len(vec.Indices)))
+			for _, index := range vec.Indices {
+				rw.WriteUint32(index)
+			}
+			for _, value := range vec.Values {
+				rw.WriteUint32(math.Float32bits(value))
+			}
+		}
+	}
+
+	if len(compactVectorsOffsets) > 0 {
+		rw.WriteUint32(uint32(len(compactVectorsOffsets)))
+		err = rw.CopyBytesToBuffer(compactVectorsOffsets)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy compactVectorsOffsets")
+		}
+
+		rw.WriteUint32(uint32(compactVectorsSegmentLength))
+		for _, name := range compactVectorsOffsetOrder {
+			data := compactVectorsData[name]
+			rw.WriteByte(compactVectorType(ko.CompactVectors[name]))
+			rw.WriteUint32(uint32(len(data)))
+			err = rw.CopyBytesToBuffer(data)
+			if err != nil {
+				return byteBuffer, errors.Wrap(err, "Could not copy compact vector")
+			}
+		}
+	}
+
 	return byteBuffer, nil
 }
 
@@ -1176,6 +1355,18 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	}
 	ko.MultiVectors = multiVectors
 
+	sparseVectors, err := unmarshalSparseVectors(&rw, nil)
+	if err != nil {
+		return err
+	}
+	ko.SparseVectors = sparseVectors
+
+	compactVectors, err := unmarshalCompactVectors(&rw, nil)
+	if err != nil {
+		return err
+	}
+	ko.setCompactVectors(compactVectors)
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -1272,6 +1463,95 @@ func unmarshalMultiVectors(
 	return nil, nil
 }
 
+// unmarshalSparseVectors unmarshals the sparse vectors from the buffer. If onlyUnmarshalNames is set and non-empty,
+// then only the sparse vectors which names specified as the map's keys will be unmarshaled.
+func unmarshalSparseVectors(
+	rw *byteops.ReadWriter,
+	onlyUnmarshalNames map[string]interface{},
+) (map[string]models.SparseVector, error) {
+	// the sparse vectors segment is only present for objects with sparse vectors
+	if rw.Position < uint64(len(rw.Buffer)) {
+		sparseVectorsOffsets := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+		sparseVectorsSegmentLength := rw.ReadUint32()
+		pos := rw.Position
+
+		if len(sparseVectorsOffsets) > 0 {
+			var svOffsets map[string]uint32
+			if err := msgpack.Unmarshal(sparseVectorsOffsets, &svOffsets); err != nil {
+				return nil, fmt.Errorf("could not unmarshal sparse vectors offset: %w", err)
+			}
+
+			sparseVectors := map[string]models.SparseVector{}
+			for name, offset := range svOffsets {
+				if len(onlyUnmarshalNames) > 0 {
+					if _, ok := onlyUnmarshalNames[name]; !ok {
+						continue
+					}
+				}
+				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
+				numEntries := rw.ReadUint32()
+				vec := models.SparseVector{
+					Indices: make([]uint32, numEntries),
+					Values:  make([]float32, numEntries),
+				}
+				for i := range vec.Indices {
+					vec.Indices[i] = rw.ReadUint32()
+				}
+				for i := range vec.Values {
+					vec.Values[i] = math.Float32frombits(rw.ReadUint32())
+				}
+				sparseVectors[name] = vec
+			}
+
+			rw.MoveBufferToAbsolutePosition(pos + uint64(sparseVectorsSegmentLength))
+			return sparseVectors, nil
+		}
+	}
+	return nil, nil
+}
+
+// unmarshalCompactVectors unmarshals the int8, uint8 and binary vectors from the buffer. If onlyUnmarshalNames
+// is set and non-empty, then only the vectors which names specified as the map's keys will be unmarshaled.
+func unmarshalCompactVectors(
+	rw *byteops.ReadWriter,
+	onlyUnmarshalNames map[string]interface{},
+) (map[string]models.Vector, error) {
+	// the compact vectors segment is only present for objects with compact vectors
+	if rw.Position < uint64(len(rw.Buffer)) {
+		compactVectorsOffsets := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+		compactVectorsSegmentLength := rw.ReadUint32()
+		pos := rw.Position
+
+		if len(compactVectorsOffsets) > 0 {
+			var cvOffsets map[string]uint32
+			if err := msgpack.Unmarshal(compactVectorsOffsets, &cvOffsets); err != nil {
+				return nil, fmt.Errorf("could not unmarshal compact vectors offset: %w", err)
+			}
+
+			compactVectors := map[string]models.Vector{}
+			for name, offset := range cvOffsets {
+				if len(onlyUnmarshalNames) > 0 {
+					if _, ok := onlyUnmarshalNames[name]; !ok {
+						continue
+					}
+				}
+				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
+				dataType := rw.ReadUint8()
+				data := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+				vec, err := compactVectorFromBytes(dataType, data)
+				if err != nil {
+					return nil, fmt.Errorf("could not unmarshal compact vector %q: %w", name, err)
+				}
+				compactVectors[name] = vec
+			}
+
+			rw.MoveBufferToAbsolutePosition(pos + uint64(compactVectorsSegmentLength))
+			return compactVectors, nil
+		}
+	}
+	return nil, nil
+}
+
 func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
 	if len(in) == 0 {
 		return nil, nil
@@ -1306,10 +1586,28 @@ func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float
 			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
 		}
 		vector, ok := targetVectors[targetVector]
+		if ok {
+			return vector, nil
+		}
+
+		// int8, uint8 and binary vectors are stored after the multi and
+		// sparse vectors segments
+		onlyTargetVector := map[string]interface{}{targetVector: nil}
+		if _, err := unmarshalMultiVectors(&rw, onlyTargetVector); err != nil {
+			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
+		}
+		if _, err := unmarshalSparseVectors(&rw, onlyTargetVector); err != nil {
+			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
+		}
+		compactVectors, err := unmarshalCompactVectors(&rw, onlyTargetVector)
+		if err != nil {
+			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
+		}
+		compact, ok := compactVectors[targetVector]
 		if !ok {
 			return nil, errors.Errorf("vector not found for target vector: %s", targetVector)
 		}
-		return vector, nil
+		return widenCompactVector(compact), nil
 	}
 
 	// since we know the version and know that the blob is not len(0), we can
@@ -1518,6 +1816,8 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectorsMap(ko.Vectors),
 		MultiVectors:      deepCopyMultiVectorsMap(ko.MultiVectors),
+		SparseVectors:     deepCopySparseVectorsMap(ko.SparseVectors),
+		CompactVectors:    deepCopyCompactVectorsMap(ko.CompactVectors),
 	}
 
 	return o
@@ -1550,6 +1850,10 @@ func deepCopyVectors(orig models.Vectors) models.Vectors {
 			out[key] = deepCopyVector(v)
 		case [][]float32:
 			out[key] = deepCopyMultiVector(v)
+		case models.SparseVector:
+			out[key] = deepCopySparseVector(v)
+		case models.Int8Vector, models.Uint8Vector, models.BinaryVector:
+			out[key] = deepCopyCompactVector(v)
 		default:
 			// do nothing
 		}
@@ -1583,6 +1887,27 @@ func deepCopyMultiVectorsMap(orig map[string][][]float32) map[string][][]float32
 	return out
 }
 
+func deepCopySparseVectorsMap(orig map[string]models.SparseVector) map[string]models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]models.SparseVector, len(orig))
+	for key, vec := range orig {
+		out[key] = deepCopySparseVector(vec)
+	}
+	return out
+}
+
+func deepCopySparseVector(orig models.SparseVector) models.SparseVector {
+	out := models.SparseVector{
+		Indices: make([]uint32, len(orig.Indices)),
+		Values:  make([]float32, len(orig.Values)),
+	}
+	copy(out.Indices, orig.Indices)
+	copy(out.Values, orig.Values)
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
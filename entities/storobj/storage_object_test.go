@@ -171,6 +171,157 @@ func TestStorageObjectMarshallingMultiVector(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingSparseVector(t *testing.T) {
+	sparse := models.SparseVector{Indices: []uint32{3, 17, 1024}, Values: []float32{0.5, 1.25, 3}}
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.Vectors{"splade": sparse},
+		},
+		nil,
+		map[string][]float32{"dense": {1, 2, 3}},
+		map[string][][]float32{"colbert": {{4, 5}, {6, 7}}},
+	)
+	before.DocID = 7
+	require.Equal(t, map[string]models.SparseVector{"splade": sparse}, before.SparseVectors)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal all", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+		assert.Equal(t, models.Vectors{
+			"dense":   []float32{1, 2, 3},
+			"colbert": [][]float32{{4, 5}, {6, 7}},
+			"splade":  sparse,
+		}, after.GetVectors())
+	})
+
+	t.Run("unmarshal only the sparse vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary,
+			additional.Properties{Vectors: []string{"splade"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+		assert.Empty(t, after.MultiVectors)
+	})
+
+	t.Run("objects without sparse vectors are unchanged", func(t *testing.T) {
+		withoutSparse := before.DeepCopyDangerous()
+		withoutSparse.SparseVectors = nil
+		withoutSparseBinary, err := withoutSparse.MarshalBinary()
+		require.Nil(t, err)
+		assert.Less(t, len(withoutSparseBinary), len(asBinary))
+
+		after, err := FromBinary(withoutSparseBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.SparseVectors)
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		copied := before.DeepCopyDangerous()
+		copied.SparseVectors["splade"].Values[0] = 100
+		assert.Equal(t, float32(0.5), before.SparseVectors["splade"].Values[0])
+	})
+}
+
+func TestStorageObjectMarshallingCompactVectors(t *testing.T) {
+	int8Vec := models.Int8Vector{-128, 0, 127}
+	binaryVec := models.BinaryVector{0b10100000, 0b00000001}
+
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		},
+		nil,
+		map[string][]float32{
+			"dense":  {1, 2, 3},
+			"int8":   int8Vec.Float32(),
+			"binary": binaryVec.Float32(),
+		},
+		nil,
+	)
+	before.DocID = 7
+	before.CompactVectors = map[string]models.Vector{"int8": int8Vec, "binary": binaryVec}
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal all", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.CompactVectors, after.CompactVectors)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, []float32{1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, after.Vectors["binary"])
+		assert.Equal(t, models.Vectors{
+			"dense":  []float32{1, 2, 3},
+			"int8":   int8Vec,
+			"binary": binaryVec,
+		}, after.GetVectors())
+	})
+
+	t.Run("unmarshal only the int8 vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary,
+			additional.Properties{Vectors: []string{"int8"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]models.Vector{"int8": int8Vec}, after.CompactVectors)
+		assert.Equal(t, []float32{-128, 0, 127}, after.Vectors["int8"])
+	})
+
+	t.Run("vector from binary", func(t *testing.T) {
+		vec, err := VectorFromBinary(asBinary, nil, "int8")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{-128, 0, 127}, vec)
+
+		vec, err = VectorFromBinary(asBinary, nil, "dense")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, vec)
+
+		_, err = VectorFromBinary(asBinary, nil, "missing")
+		assert.ErrorContains(t, err, "vector not found for target vector: missing")
+	})
+
+	t.Run("compact vectors are stored compactly", func(t *testing.T) {
+		asFloats := before.DeepCopyDangerous()
+		asFloats.CompactVectors = nil
+		asFloatsBinary, err := asFloats.MarshalBinary()
+		require.Nil(t, err)
+		assert.Less(t, len(asBinary), len(asFloatsBinary))
+	})
+
+	t.Run("combined with sparse vectors", func(t *testing.T) {
+		withSparse := before.DeepCopyDangerous()
+		withSparse.SparseVectors = map[string]models.SparseVector{
+			"splade": {Indices: []uint32{1}, Values: []float32{0.5}},
+		}
+		withSparseBinary, err := withSparse.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(withSparseBinary)
+		require.Nil(t, err)
+		assert.Equal(t, withSparse.SparseVectors, after.SparseVectors)
+		assert.Equal(t, withSparse.CompactVectors, after.CompactVectors)
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		copied := before.DeepCopyDangerous()
+		copied.CompactVectors["int8"].(models.Int8Vector)[0] = 1
+		assert.Equal(t, int8(-128), before.CompactVectors["int8"].(models.Int8Vector)[0])
+	})
+}
+
 func TestStorageObjectUnMarshallingMultiVector(t *testing.T) {
 	t.Run("all vectors stored", func(t *testing.T) {
 		before := FromObject(
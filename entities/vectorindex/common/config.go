@@ -25,6 +25,8 @@ const (
 	DistanceL2Squared = "l2-squared"
 	DistanceManhattan = "manhattan"
 	DistanceHamming   = "hamming"
+	DistanceJaccard   = "jaccard"
+	DistanceAngular   = "angular"
 
 	// Set these defaults if the user leaves them blank
 	DefaultVectorCacheMaxObjects = 1e12
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"fmt"
+)
+
+const (
+	// VectorDataTypeFloat32 is the default, vectors are stored and indexed as
+	// 32 bit floats
+	VectorDataTypeFloat32 = "float32"
+	// VectorDataTypeInt8 stores one signed byte per dimension
+	VectorDataTypeInt8 = "int8"
+	// VectorDataTypeUint8 stores one unsigned byte per dimension
+	VectorDataTypeUint8 = "uint8"
+	// VectorDataTypeBinary stores packed bits, eight dimensions per byte with
+	// the most significant bit first
+	VectorDataTypeBinary = "binary"
+
+	DefaultVectorDataType = VectorDataTypeFloat32
+)
+
+// IsCompactDataType returns true for data types which are stored in their
+// compact form instead of as float32
+func IsCompactDataType(dataType string) bool {
+	switch dataType {
+	case VectorDataTypeInt8, VectorDataTypeUint8, VectorDataTypeBinary:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseDataTypeMap(in map[string]interface{}, dataType *string) error {
+	return OptionalStringFromMap(in, "dataType", func(v string) {
+		*dataType = v
+	})
+}
+
+// ValidateDataType checks that the data type is known and can be combined
+// with the remaining settings of the index
+func ValidateDataType(dataType, distance string, multiVector, compressed bool,
+	matryoshka MatryoshkaConfig,
+) error {
+	switch dataType {
+	case VectorDataTypeFloat32:
+		return nil
+	case VectorDataTypeInt8, VectorDataTypeUint8:
+		if distance != DistanceDot && distance != DistanceL2Squared {
+			return fmt.Errorf("dataType %q requires distance %q or %q, got %q",
+				dataType, DistanceDot, DistanceL2Squared, distance)
+		}
+	case VectorDataTypeBinary:
+		if distance != DistanceHamming && distance != DistanceJaccard {
+			return fmt.Errorf("dataType %q requires distance %q or %q, got %q",
+				dataType, DistanceHamming, DistanceJaccard, distance)
+		}
+	default:
+		return fmt.Errorf("invalid dataType %q. Supported types are %s, %s, %s and %s", dataType,
+			VectorDataTypeFloat32, VectorDataTypeInt8, VectorDataTypeUint8, VectorDataTypeBinary)
+	}
+
+	if multiVector {
+		return fmt.Errorf("dataType %q is not supported for multi vector indexes", dataType)
+	}
+	if compressed {
+		return fmt.Errorf("dataType %q cannot be combined with compression, the vectors are already stored in compact form", dataType)
+	}
+	if matryoshka.Enabled() {
+		return fmt.Errorf("dataType %q cannot be combined with matryoshka", dataType)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultMatryoshkaDimensions   = 0 // indicates "index the full vector"
+	DefaultMatryoshkaNormalize    = false
+	DefaultMatryoshkaRescoreLimit = 0 // indicates "let Weaviate pick"
+)
+
+// MatryoshkaConfig configures indexing only the leading dimensions of each
+// vector. This is meant for Matryoshka embeddings, where the leading
+// dimensions carry most of the information. The full vector is still stored
+// with the object and used to rescore the best candidates of every search.
+type MatryoshkaConfig struct {
+	// Dimensions is the number of leading dimensions which are indexed. Zero
+	// disables truncation.
+	Dimensions int `json:"dimensions"`
+	// Normalize re-normalizes the truncated vectors to unit length before
+	// they are indexed.
+	Normalize bool `json:"normalize"`
+	// RescoreLimit is the minimum number of candidates which are retrieved
+	// from the index and rescored on the full vectors. Zero lets Weaviate
+	// pick.
+	RescoreLimit int `json:"rescoreLimit"`
+}
+
+func NewDefaultMatryoshkaConfig() MatryoshkaConfig {
+	return MatryoshkaConfig{
+		Dimensions:   DefaultMatryoshkaDimensions,
+		Normalize:    DefaultMatryoshkaNormalize,
+		RescoreLimit: DefaultMatryoshkaRescoreLimit,
+	}
+}
+
+func (m MatryoshkaConfig) Enabled() bool {
+	return m.Dimensions > 0
+}
+
+func (m MatryoshkaConfig) Validate() error {
+	if m.Dimensions < 0 {
+		return fmt.Errorf("matryoshka.dimensions must not be negative, got %d", m.Dimensions)
+	}
+	if m.RescoreLimit < 0 {
+		return fmt.Errorf("matryoshka.rescoreLimit must not be negative, got %d", m.RescoreLimit)
+	}
+	return nil
+}
+
+func ParseMatryoshkaMap(in map[string]interface{}, m *MatryoshkaConfig) error {
+	value, ok := in["matryoshka"]
+	if !ok {
+		return nil
+	}
+
+	configMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := OptionalIntFromMap(configMap, "dimensions", func(v int) {
+		m.Dimensions = v
+	}); err != nil {
+		return err
+	}
+
+	if err := OptionalBoolFromMap(configMap, "normalize", func(v bool) {
+		m.Normalize = v
+	}); err != nil {
+		return err
+	}
+
+	if err := OptionalIntFromMap(configMap, "rescoreLimit", func(v int) {
+		m.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return m.Validate()
+}
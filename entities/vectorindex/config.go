@@ -15,9 +15,11 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 const (
@@ -25,6 +27,7 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeSPARSE  = "sparse"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -41,7 +44,27 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string, isMultiVe
 		return flat.ParseAndValidateConfig(input)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeSPARSE:
+		return sparse.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic and sparse", vectorIndexType)
 	}
 }
+
+// DataType returns the vector data type of an index config, which may be
+// given either parsed or as a map
+func DataType(config interface{}) string {
+	var dataType string
+	switch c := config.(type) {
+	case hnsw.UserConfig:
+		dataType = c.DataType
+	case flat.UserConfig:
+		dataType = c.DataType
+	case map[string]interface{}:
+		dataType, _ = c["dataType"].(string)
+	}
+	if dataType == "" {
+		return common.DefaultVectorDataType
+	}
+	return dataType
+}
@@ -101,6 +101,12 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		if uc.HnswUC.Multivector.Enabled {
 			return uc, fmt.Errorf("multi vector index is not supported for dynamic index")
 		}
+		if uc.HnswUC.Matryoshka.Enabled() {
+			return uc, fmt.Errorf("matryoshka is not supported for dynamic index")
+		}
+		if uc.HnswUC.DataType != common.VectorDataTypeFloat32 {
+			return uc, fmt.Errorf("dataType %q is not supported for dynamic index", uc.HnswUC.DataType)
+		}
 
 	}
 
@@ -119,6 +125,12 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, fmt.Errorf("invalid flat configuration")
 	}
 	uc.FlatUC = castedFlatUC
+	if uc.FlatUC.Matryoshka.Enabled() {
+		return uc, fmt.Errorf("matryoshka is not supported for dynamic index")
+	}
+	if uc.FlatUC.DataType != common.VectorDataTypeFloat32 {
+		return uc, fmt.Errorf("dataType %q is not supported for dynamic index", uc.FlatUC.DataType)
+	}
 
 	return uc, nil
 }
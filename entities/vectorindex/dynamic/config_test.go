@@ -70,6 +70,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: hnsw.DefaultRQRescoreLimit,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
+					DataType:       common.DefaultVectorDataType,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
@@ -83,6 +84,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+					DataType:              common.DefaultVectorDataType,
 					Distance:              common.DefaultDistanceMetric,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
@@ -143,6 +145,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: hnsw.DefaultRQRescoreLimit,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
+					DataType:       common.DefaultVectorDataType,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
@@ -156,6 +159,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+					DataType:              common.DefaultVectorDataType,
 					Distance:              common.DefaultDistanceMetric,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
@@ -236,6 +240,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: hnsw.DefaultRQRescoreLimit,
 					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
+					DataType:       common.DefaultVectorDataType,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
@@ -249,6 +254,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+					DataType:              common.DefaultVectorDataType,
 					Distance:              common.DefaultDistanceMetric,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
@@ -329,6 +335,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: hnsw.DefaultRQRescoreLimit,
 					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
+					DataType:       common.DefaultVectorDataType,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
@@ -342,6 +349,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: 100,
+					DataType:              common.DefaultVectorDataType,
 					Distance:              common.DefaultDistanceMetric,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      false,
@@ -38,6 +38,9 @@ type UserConfig struct {
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	DataType              string                `json:"dataType"`
+
+	Matryoshka vectorindexcommon.MatryoshkaConfig `json:"matryoshka"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -66,6 +69,8 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.Matryoshka = vectorindexcommon.NewDefaultMatryoshkaConfig()
+	u.DataType = vectorindexcommon.DefaultVectorDataType
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -99,6 +104,19 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.ParseMatryoshkaMap(asMap, &uc.Matryoshka); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.ParseDataTypeMap(asMap, &uc.DataType); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.ValidateDataType(uc.DataType, uc.Distance, false,
+		uc.PQ.Enabled || uc.BQ.Enabled || uc.SQ.Enabled, uc.Matryoshka); err != nil {
+		return uc, fmt.Errorf("invalid flat config: %w", err)
+	}
+
 	return uc, nil
 }
 
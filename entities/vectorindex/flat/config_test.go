@@ -34,6 +34,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			input: nil,
 			expected: UserConfig{
 				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				DataType:              common.DefaultVectorDataType,
 				Distance:              common.DefaultDistanceMetric,
 				PQ: CompressionUserConfig{
 					Enabled:      DefaultCompressionEnabled,
@@ -65,6 +66,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				VectorCacheMaxObjects: 100,
+				DataType:              common.DefaultVectorDataType,
 				Distance:              common.DefaultDistanceMetric,
 				PQ: CompressionUserConfig{
 					Enabled:      false,
@@ -130,6 +132,126 @@ func Test_FlatUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
+		{
+			name: "matryoshka enabled",
+			input: map[string]interface{}{
+				"matryoshka": map[string]interface{}{
+					"dimensions":   float64(256),
+					"normalize":    true,
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				DataType:              common.DefaultVectorDataType,
+				Distance:              common.DefaultDistanceMetric,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				Matryoshka: common.MatryoshkaConfig{
+					Dimensions:   256,
+					Normalize:    true,
+					RescoreLimit: 50,
+				},
+			},
+		},
+		{
+			name: "matryoshka with negative dimensions",
+			input: map[string]interface{}{
+				"matryoshka": map[string]interface{}{
+					"dimensions": float64(-1),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "matryoshka.dimensions must not be negative, got -1",
+		},
+		{
+			name: "binary data type with hamming distance",
+			input: map[string]interface{}{
+				"distance": "hamming",
+				"dataType": "binary",
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				DataType:              common.VectorDataTypeBinary,
+				Distance:              common.DistanceHamming,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+			},
+		},
+		{
+			name: "binary data type with cosine distance",
+			input: map[string]interface{}{
+				"dataType": "binary",
+			},
+			expectErr:    true,
+			expectErrMsg: `dataType "binary" requires distance "hamming" or "jaccard", got "cosine"`,
+		},
+		{
+			name: "int8 data type with cosine distance",
+			input: map[string]interface{}{
+				"dataType": "int8",
+			},
+			expectErr:    true,
+			expectErrMsg: `dataType "int8" requires distance "dot" or "l2-squared", got "cosine"`,
+		},
+		{
+			name: "uint8 data type with bq",
+			input: map[string]interface{}{
+				"dataType": "uint8",
+				"distance": "l2-squared",
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `dataType "uint8" cannot be combined with compression`,
+		},
+		{
+			name: "int8 data type with matryoshka",
+			input: map[string]interface{}{
+				"dataType": "int8",
+				"distance": "dot",
+				"matryoshka": map[string]interface{}{
+					"dimensions": float64(64),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `dataType "int8" cannot be combined with matryoshka`,
+		},
+		{
+			name: "unknown data type",
+			input: map[string]interface{}{
+				"dataType": "float16",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid dataType "float16"`,
+		},
 	}
 
 	for _, test := range tests {
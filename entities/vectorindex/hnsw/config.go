@@ -62,6 +62,9 @@ type UserConfig struct {
 	RQ                     RQConfig          `json:"rq"`
 	FilterStrategy         string            `json:"filterStrategy"`
 	Multivector            MultivectorConfig `json:"multivector"`
+	DataType               string            `json:"dataType"`
+
+	Matryoshka vectorIndexCommon.MatryoshkaConfig `json:"matryoshka"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -130,6 +133,8 @@ func (u *UserConfig) SetDefaults() {
 			Repetitions:  DefaultMultivectorRepetitions,
 		},
 	}
+	u.Matryoshka = vectorIndexCommon.NewDefaultMatryoshkaConfig()
+	u.DataType = vectorIndexCommon.DefaultVectorDataType
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -239,6 +244,14 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.ParseMatryoshkaMap(asMap, &uc.Matryoshka); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.ParseDataTypeMap(asMap, &uc.DataType); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -295,6 +308,16 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: ksim must be less than 10")
 	}
 
+	if u.Matryoshka.Enabled() && u.Multivector.Enabled {
+		return fmt.Errorf("invalid hnsw config: matryoshka is not supported for multi vector indexes")
+	}
+
+	if err := vectorIndexCommon.ValidateDataType(u.DataType, u.Distance,
+		u.Multivector.Enabled, u.PQ.Enabled || u.BQ.Enabled || u.SQ.Enabled || u.RQ.Enabled,
+		u.Matryoshka); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
 	return nil
 }
 
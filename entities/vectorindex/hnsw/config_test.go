@@ -69,6 +69,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -120,6 +121,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -182,6 +184,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -244,6 +247,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -307,6 +311,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -367,6 +372,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -436,6 +442,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -504,6 +511,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -592,6 +600,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -691,6 +700,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -751,6 +761,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -826,6 +837,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: FilterStrategyAcorn,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -877,6 +889,7 @@ func Test_UserConfig(t *testing.T) {
 					RescoreLimit: DefaultRQRescoreLimit,
 				},
 				FilterStrategy: DefaultFilterStrategy,
+				DataType:       common.DefaultVectorDataType,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
@@ -929,3 +942,30 @@ func Test_UserConfigFilterStrategy(t *testing.T) {
 		assert.Nil(t, os.Unsetenv("HNSW_DEFAULT_FILTER_STRATEGY"))
 	})
 }
+
+func Test_UserConfigMatryoshka(t *testing.T) {
+	t.Run("is parsed", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"matryoshka": map[string]interface{}{
+				"dimensions":   json.Number("512"),
+				"rescoreLimit": json.Number("200"),
+			},
+		}, false)
+		require.Nil(t, err)
+		assert.Equal(t, common.MatryoshkaConfig{Dimensions: 512, RescoreLimit: 200},
+			cfg.(UserConfig).Matryoshka)
+	})
+
+	t.Run("is not supported for multi vector indexes", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"matryoshka": map[string]interface{}{
+				"dimensions": json.Number("512"),
+			},
+			"multivector": map[string]interface{}{
+				"enabled": true,
+			},
+		}, true)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "matryoshka is not supported for multi vector indexes")
+	})
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"fmt"
+	"math"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// UserConfig of a sparse vector index. Sparse vectors (e.g. SPLADE) are
+// stored as term-id/weight pairs in an inverted index and scored by the dot
+// product of query and document weights, so there is nothing to tune apart
+// from the distance, which is fixed.
+type UserConfig struct {
+	Distance string `json:"distance"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "sparse"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DistanceDot
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if uc.Distance != vectorindexcommon.DistanceDot {
+		return uc, fmt.Errorf("sparse vector index only supports distance %q, got %q",
+			vectorindexcommon.DistanceDot, uc.Distance)
+	}
+
+	return uc, nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ValidateUserConfigUpdate makes sure a sparse vector index is not changed
+// into a different index type. There are no mutable settings.
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	if _, ok := updated.(UserConfig); !ok {
+		return fmt.Errorf("cannot change vector index type from %q to %q",
+			initial.IndexType(), updated.IndexType())
+	}
+	return nil
+}
+
+// ValidateVector checks that a sparse vector consists of unique indices with
+// finite, non-negative weights
+func ValidateVector(indices []uint32, values []float32) error {
+	if len(indices) != len(values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values", len(indices), len(values))
+	}
+
+	seen := make(map[uint32]struct{}, len(indices))
+	for i, index := range indices {
+		if _, ok := seen[index]; ok {
+			return fmt.Errorf("sparse vector contains index %d more than once", index)
+		}
+		seen[index] = struct{}{}
+
+		v := values[i]
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Errorf("sparse vector value for index %d is not a finite number", index)
+		}
+		if v < 0 {
+			return fmt.Errorf("sparse vector value for index %d must not be negative, got %v", index, v)
+		}
+	}
+	return nil
+}
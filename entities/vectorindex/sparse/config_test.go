@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_SparseUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: UserConfig{Distance: common.DistanceDot},
+		},
+		{
+			name:     "dot distance specified",
+			input:    map[string]interface{}{"distance": "dot"},
+			expected: UserConfig{Distance: common.DistanceDot},
+		},
+		{
+			name:         "unsupported distance",
+			input:        map[string]interface{}{"distance": "cosine"},
+			expectErr:    true,
+			expectErrMsg: "sparse vector index only supports distance \"dot\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
+
+func Test_SparseValidateVector(t *testing.T) {
+	assert.Nil(t, ValidateVector([]uint32{1, 7}, []float32{0.5, 0}))
+	assert.Nil(t, ValidateVector(nil, nil))
+
+	assert.ErrorContains(t, ValidateVector([]uint32{1}, []float32{0.5, 1}), "1 indices but 2 values")
+	assert.ErrorContains(t, ValidateVector([]uint32{1, 1}, []float32{0.5, 1}), "index 1 more than once")
+	assert.ErrorContains(t, ValidateVector([]uint32{3}, []float32{-1}), "must not be negative")
+	assert.ErrorContains(t, ValidateVector([]uint32{3}, []float32{float32(math.NaN())}), "not a finite number")
+}
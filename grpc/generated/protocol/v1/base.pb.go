@@ -26,6 +26,7 @@ const (
 	ConsistencyLevel_CONSISTENCY_LEVEL_ONE         ConsistencyLevel = 1
 	ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM      ConsistencyLevel = 2
 	ConsistencyLevel_CONSISTENCY_LEVEL_ALL         ConsistencyLevel = 3
+	ConsistencyLevel_CONSISTENCY_LEVEL_SESSION     ConsistencyLevel = 4
 )
 
 // Enum value maps for ConsistencyLevel.
@@ -35,12 +36,14 @@ var (
 		1: "CONSISTENCY_LEVEL_ONE",
 		2: "CONSISTENCY_LEVEL_QUORUM",
 		3: "CONSISTENCY_LEVEL_ALL",
+		4: "CONSISTENCY_LEVEL_SESSION",
 	}
 	ConsistencyLevel_value = map[string]int32{
 		"CONSISTENCY_LEVEL_UNSPECIFIED": 0,
 		"CONSISTENCY_LEVEL_ONE":         1,
 		"CONSISTENCY_LEVEL_QUORUM":      2,
 		"CONSISTENCY_LEVEL_ALL":         3,
+		"CONSISTENCY_LEVEL_SESSION":     4,
 	}
 )
 
@@ -156,9 +159,12 @@ func (Filters_Operator) EnumDescriptor() ([]byte, []int) {
 type Vectors_VectorType int32
 
 const (
-	Vectors_VECTOR_TYPE_UNSPECIFIED Vectors_VectorType = 0
-	Vectors_VECTOR_TYPE_SINGLE_FP32 Vectors_VectorType = 1
-	Vectors_VECTOR_TYPE_MULTI_FP32  Vectors_VectorType = 2
+	Vectors_VECTOR_TYPE_UNSPECIFIED   Vectors_VectorType = 0
+	Vectors_VECTOR_TYPE_SINGLE_FP32   Vectors_VectorType = 1
+	Vectors_VECTOR_TYPE_MULTI_FP32    Vectors_VectorType = 2
+	Vectors_VECTOR_TYPE_SINGLE_INT8   Vectors_VectorType = 3
+	Vectors_VECTOR_TYPE_SINGLE_UINT8  Vectors_VectorType = 4
+	Vectors_VECTOR_TYPE_SINGLE_BINARY Vectors_VectorType = 5
 )
 
 // Enum value maps for Vectors_VectorType.
@@ -167,11 +173,17 @@ var (
 		0: "VECTOR_TYPE_UNSPECIFIED",
 		1: "VECTOR_TYPE_SINGLE_FP32",
 		2: "VECTOR_TYPE_MULTI_FP32",
+		3: "VECTOR_TYPE_SINGLE_INT8",
+		4: "VECTOR_TYPE_SINGLE_UINT8",
+		5: "VECTOR_TYPE_SINGLE_BINARY",
 	}
 	Vectors_VectorType_value = map[string]int32{
-		"VECTOR_TYPE_UNSPECIFIED": 0,
-		"VECTOR_TYPE_SINGLE_FP32": 1,
-		"VECTOR_TYPE_MULTI_FP32":  2,
+		"VECTOR_TYPE_UNSPECIFIED":   0,
+		"VECTOR_TYPE_SINGLE_FP32":   1,
+		"VECTOR_TYPE_MULTI_FP32":    2,
+		"VECTOR_TYPE_SINGLE_INT8":   3,
+		"VECTOR_TYPE_SINGLE_UINT8":  4,
+		"VECTOR_TYPE_SINGLE_BINARY": 5,
 	}
 )
 
@@ -1524,22 +1536,26 @@ const file_v1_base_proto_rawDesc = "" +
 	"\x14GeoCoordinatesFilter\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x02R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x02 \x01(\x02R\tlongitude\x12\x1a\n" +
-	"\bdistance\x18\x03 \x01(\x02R\bdistance\"\xf3\x01\n" +
+	"\bdistance\x18\x03 \x01(\x02R\bdistance\"\xce\x02\n" +
 	"\aVectors\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\x05index\x18\x02 \x01(\x04B\x02\x18\x01R\x05index\x12!\n" +
 	"\fvector_bytes\x18\x03 \x01(\fR\vvectorBytes\x123\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x1f.weaviate.v1.Vectors.VectorTypeR\x04type\"b\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.weaviate.v1.Vectors.VectorTypeR\x04type\"\xbc\x01\n" +
 	"\n" +
 	"VectorType\x12\x1b\n" +
 	"\x17VECTOR_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17VECTOR_TYPE_SINGLE_FP32\x10\x01\x12\x1a\n" +
-	"\x16VECTOR_TYPE_MULTI_FP32\x10\x02*\x89\x01\n" +
+	"\x16VECTOR_TYPE_MULTI_FP32\x10\x02\x12\x1b\n" +
+	"\x17VECTOR_TYPE_SINGLE_INT8\x10\x03\x12\x1c\n" +
+	"\x18VECTOR_TYPE_SINGLE_UINT8\x10\x04\x12\x1d\n" +
+	"\x19VECTOR_TYPE_SINGLE_BINARY\x10\x05*\xa8\x01\n" +
 	"\x10ConsistencyLevel\x12!\n" +
 	"\x1dCONSISTENCY_LEVEL_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15CONSISTENCY_LEVEL_ONE\x10\x01\x12\x1c\n" +
 	"\x18CONSISTENCY_LEVEL_QUORUM\x10\x02\x12\x19\n" +
-	"\x15CONSISTENCY_LEVEL_ALL\x10\x03Bn\n" +
+	"\x15CONSISTENCY_LEVEL_ALL\x10\x03\x12\x1d\n" +
+	"\x19CONSISTENCY_LEVEL_SESSION\x10\x04Bn\n" +
 	"#io.weaviate.client.grpc.protocol.v1B\x11WeaviateProtoBaseZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
 
 var (
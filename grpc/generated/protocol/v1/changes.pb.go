@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangesReply_Operation int32
+
+const (
+	ChangesReply_OPERATION_UNSPECIFIED ChangesReply_Operation = 0
+	ChangesReply_OPERATION_INSERT      ChangesReply_Operation = 1
+	ChangesReply_OPERATION_UPDATE      ChangesReply_Operation = 2
+	ChangesReply_OPERATION_DELETE      ChangesReply_Operation = 3
+)
+
+// Enum value maps for ChangesReply_Operation.
+var (
+	ChangesReply_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_INSERT",
+		2: "OPERATION_UPDATE",
+		3: "OPERATION_DELETE",
+	}
+	ChangesReply_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_INSERT":      1,
+		"OPERATION_UPDATE":      2,
+		"OPERATION_DELETE":      3,
+	}
+)
+
+func (x ChangesReply_Operation) Enum() *ChangesReply_Operation {
+	p := new(ChangesReply_Operation)
+	*p = x
+	return p
+}
+
+func (x ChangesReply_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangesReply_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_changes_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangesReply_Operation) Type() protoreflect.EnumType {
+	return &file_v1_changes_proto_enumTypes[0]
+}
+
+func (x ChangesReply_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangesReply_Operation.Descriptor instead.
+func (ChangesReply_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_v1_changes_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type ChangesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant     *string                `protobuf:"bytes,2,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// offset of the last change received, changes after it are streamed. An
+	// empty offset starts at the oldest change within the retention window
+	Offset         string `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeVectors bool   `protobuf:"varint,4,opt,name=include_vectors,json=includeVectors,proto3" json:"include_vectors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangesRequest) Reset() {
+	*x = ChangesRequest{}
+	mi := &file_v1_changes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesRequest) ProtoMessage() {}
+
+func (x *ChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_changes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesRequest.ProtoReflect.Descriptor instead.
+func (*ChangesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_changes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangesRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetIncludeVectors() bool {
+	if x != nil {
+		return x.IncludeVectors
+	}
+	return false
+}
+
+type ChangesReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Operation ChangesReply_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=weaviate.v1.ChangesReply_Operation" json:"operation,omitempty"`
+	// pass in ChangesRequest.offset to resume the stream after this change
+	Offset     string `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant     string `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// time of the change in unix milliseconds
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// properties of the object after the change, empty for deletions
+	Properties    *structpb.Struct `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`
+	Vectors       []*Vectors       `protobuf:"bytes,8,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangesReply) Reset() {
+	*x = ChangesReply{}
+	mi := &file_v1_changes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesReply) ProtoMessage() {}
+
+func (x *ChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_changes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesReply.ProtoReflect.Descriptor instead.
+func (*ChangesReply) Descriptor() ([]byte, []int) {
+	return file_v1_changes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangesReply) GetOperation() ChangesReply_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return ChangesReply_OPERATION_UNSPECIFIED
+}
+
+func (x *ChangesReply) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *ChangesReply) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangesReply) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ChangesReply) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ChangesReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChangesReply) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *ChangesReply) GetVectors() []*Vectors {
+	if x != nil {
+		return x.Vectors
+	}
+	return nil
+}
+
+var File_v1_changes_proto protoreflect.FileDescriptor
+
+const file_v1_changes_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/changes.proto\x12\vweaviate.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\rv1/base.proto\"\x99\x01\n" +
+	"\x0eChangesRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12\x1b\n" +
+	"\x06tenant\x18\x02 \x01(\tH\x00R\x06tenant\x88\x01\x01\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\tR\x06offset\x12'\n" +
+	"\x0finclude_vectors\x18\x04 \x01(\bR\x0eincludeVectorsB\t\n" +
+	"\a_tenant\"\xa6\x03\n" +
+	"\fChangesReply\x12A\n" +
+	"\toperation\x18\x01 \x01(\x0e2#.weaviate.v1.ChangesReply.OperationR\toperation\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\tR\x06offset\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\x12\x12\n" +
+	"\x04uuid\x18\x05 \x01(\tR\x04uuid\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x127\n" +
+	"\n" +
+	"properties\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
+	"properties\x12.\n" +
+	"\avectors\x18\b \x03(\v2\x14.weaviate.v1.VectorsR\avectors\"h\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10OPERATION_INSERT\x10\x01\x12\x14\n" +
+	"\x10OPERATION_UPDATE\x10\x02\x12\x14\n" +
+	"\x10OPERATION_DELETE\x10\x03Bq\n" +
+	"#io.weaviate.client.grpc.protocol.v1B\x14WeaviateProtoChangesZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
+
+var (
+	file_v1_changes_proto_rawDescOnce sync.Once
+	file_v1_changes_proto_rawDescData []byte
+)
+
+func file_v1_changes_proto_rawDescGZIP() []byte {
+	file_v1_changes_proto_rawDescOnce.Do(func() {
+		file_v1_changes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_changes_proto_rawDesc), len(file_v1_changes_proto_rawDesc)))
+	})
+	return file_v1_changes_proto_rawDescData
+}
+
+var (
+	file_v1_changes_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+	file_v1_changes_proto_msgTypes  = make([]protoimpl.MessageInfo, 2)
+	file_v1_changes_proto_goTypes   = []any{
+		(ChangesReply_Operation)(0), // 0: weaviate.v1.ChangesReply.Operation
+		(*ChangesRequest)(nil),      // 1: weaviate.v1.ChangesRequest
+		(*ChangesReply)(nil),        // 2: weaviate.v1.ChangesReply
+		(*structpb.Struct)(nil),     // 3: google.protobuf.Struct
+		(*Vectors)(nil),             // 4: weaviate.v1.Vectors
+	}
+)
+
+var file_v1_changes_proto_depIdxs = []int32{
+	0, // 0: weaviate.v1.ChangesReply.operation:type_name -> weaviate.v1.ChangesReply.Operation
+	3, // 1: weaviate.v1.ChangesReply.properties:type_name -> google.protobuf.Struct
+	4, // 2: weaviate.v1.ChangesReply.vectors:type_name -> weaviate.v1.Vectors
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_changes_proto_init() }
+func file_v1_changes_proto_init() {
+	if File_v1_changes_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	file_v1_changes_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_changes_proto_rawDesc), len(file_v1_changes_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_changes_proto_goTypes,
+		DependencyIndexes: file_v1_changes_proto_depIdxs,
+		EnumInfos:         file_v1_changes_proto_enumTypes,
+		MessageInfos:      file_v1_changes_proto_msgTypes,
+	}.Build()
+	File_v1_changes_proto = out.File
+	file_v1_changes_proto_goTypes = nil
+	file_v1_changes_proto_depIdxs = nil
+}
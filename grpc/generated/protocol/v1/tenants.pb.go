@@ -268,6 +268,7 @@ type Tenant struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ActivityStatus TenantActivityStatus   `protobuf:"varint,2,opt,name=activity_status,json=activityStatus,proto3,enum=weaviate.v1.TenantActivityStatus" json:"activity_status,omitempty"`
+	Quotas         *TenantQuotas          `protobuf:"bytes,3,opt,name=quotas,proto3" json:"quotas,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -316,6 +317,74 @@ func (x *Tenant) GetActivityStatus() TenantActivityStatus {
 	return TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED
 }
 
+func (x *Tenant) GetQuotas() *TenantQuotas {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// limits of a single tenant, 0 means unlimited
+type TenantQuotas struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxObjects          int64                  `protobuf:"varint,1,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
+	MaxBytes            int64                  `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxVectorDimensions int64                  `protobuf:"varint,3,opt,name=max_vector_dimensions,json=maxVectorDimensions,proto3" json:"max_vector_dimensions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TenantQuotas) Reset() {
+	*x = TenantQuotas{}
+	mi := &file_v1_tenants_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantQuotas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQuotas) ProtoMessage() {}
+
+func (x *TenantQuotas) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tenants_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQuotas.ProtoReflect.Descriptor instead.
+func (*TenantQuotas) Descriptor() ([]byte, []int) {
+	return file_v1_tenants_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantQuotas) GetMaxObjects() int64 {
+	if x != nil {
+		return x.MaxObjects
+	}
+	return 0
+}
+
+func (x *TenantQuotas) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *TenantQuotas) GetMaxVectorDimensions() int64 {
+	if x != nil {
+		return x.MaxVectorDimensions
+	}
+	return 0
+}
+
 var File_v1_tenants_proto protoreflect.FileDescriptor
 
 const file_v1_tenants_proto_rawDesc = "" +
@@ -331,10 +400,16 @@ const file_v1_tenants_proto_rawDesc = "" +
 	"\x06values\x18\x01 \x03(\tR\x06values\"T\n" +
 	"\x0fTenantsGetReply\x12\x12\n" +
 	"\x04took\x18\x01 \x01(\x02R\x04took\x12-\n" +
-	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"h\n" +
+	"\atenants\x18\x02 \x03(\v2\x13.weaviate.v1.TenantR\atenants\"\x9b\x01\n" +
 	"\x06Tenant\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
-	"\x0factivity_status\x18\x02 \x01(\x0e2!.weaviate.v1.TenantActivityStatusR\x0eactivityStatus*\xaf\x03\n" +
+	"\x0factivity_status\x18\x02 \x01(\x0e2!.weaviate.v1.TenantActivityStatusR\x0eactivityStatus\x121\n" +
+	"\x06quotas\x18\x03 \x01(\v2\x19.weaviate.v1.TenantQuotasR\x06quotas\"\x80\x01\n" +
+	"\fTenantQuotas\x12\x1f\n" +
+	"\vmax_objects\x18\x01 \x01(\x03R\n" +
+	"maxObjects\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\x03R\bmaxBytes\x122\n" +
+	"\x15max_vector_dimensions\x18\x03 \x01(\x03R\x13maxVectorDimensions*\xaf\x03\n" +
 	"\x14TenantActivityStatus\x12&\n" +
 	"\"TENANT_ACTIVITY_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aTENANT_ACTIVITY_STATUS_HOT\x10\x01\x12\x1f\n" +
@@ -364,13 +439,14 @@ func file_v1_tenants_proto_rawDescGZIP() []byte {
 
 var (
 	file_v1_tenants_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_v1_tenants_proto_msgTypes  = make([]protoimpl.MessageInfo, 4)
+	file_v1_tenants_proto_msgTypes  = make([]protoimpl.MessageInfo, 5)
 	file_v1_tenants_proto_goTypes   = []any{
 		(TenantActivityStatus)(0), // 0: weaviate.v1.TenantActivityStatus
 		(*TenantsGetRequest)(nil), // 1: weaviate.v1.TenantsGetRequest
 		(*TenantNames)(nil),       // 2: weaviate.v1.TenantNames
 		(*TenantsGetReply)(nil),   // 3: weaviate.v1.TenantsGetReply
 		(*Tenant)(nil),            // 4: weaviate.v1.Tenant
+		(*TenantQuotas)(nil),      // 5: weaviate.v1.TenantQuotas
 	}
 )
 
@@ -378,11 +454,12 @@ var file_v1_tenants_proto_depIdxs = []int32{
 	2, // 0: weaviate.v1.TenantsGetRequest.names:type_name -> weaviate.v1.TenantNames
 	4, // 1: weaviate.v1.TenantsGetReply.tenants:type_name -> weaviate.v1.Tenant
 	0, // 2: weaviate.v1.Tenant.activity_status:type_name -> weaviate.v1.TenantActivityStatus
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 3: weaviate.v1.Tenant.quotas:type_name -> weaviate.v1.TenantQuotas
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_tenants_proto_init() }
@@ -399,7 +476,7 @@ func file_v1_tenants_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_tenants_proto_rawDesc), len(file_v1_tenants_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,14 +21,15 @@ var File_v1_weaviate_proto protoreflect.FileDescriptor
 
 const file_v1_weaviate_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\x8a\x03\n" +
+	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x10v1/changes.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\xd1\x03\n" +
 	"\bWeaviate\x12@\n" +
 	"\x06Search\x12\x1a.weaviate.v1.SearchRequest\x1a\x18.weaviate.v1.SearchReply\"\x00\x12R\n" +
 	"\fBatchObjects\x12 .weaviate.v1.BatchObjectsRequest\x1a\x1e.weaviate.v1.BatchObjectsReply\"\x00\x12O\n" +
 	"\vBatchDelete\x12\x1f.weaviate.v1.BatchDeleteRequest\x1a\x1d.weaviate.v1.BatchDeleteReply\"\x00\x12L\n" +
 	"\n" +
 	"TenantsGet\x12\x1e.weaviate.v1.TenantsGetRequest\x1a\x1c.weaviate.v1.TenantsGetReply\"\x00\x12I\n" +
-	"\tAggregate\x12\x1d.weaviate.v1.AggregateRequest\x1a\x1b.weaviate.v1.AggregateReply\"\x00Bj\n" +
+	"\tAggregate\x12\x1d.weaviate.v1.AggregateRequest\x1a\x1b.weaviate.v1.AggregateReply\"\x00\x12E\n" +
+	"\aChanges\x12\x1b.weaviate.v1.ChangesRequest\x1a\x19.weaviate.v1.ChangesReply\"\x000\x01Bj\n" +
 	"#io.weaviate.client.grpc.protocol.v1B\rWeaviateProtoZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
 
 var file_v1_weaviate_proto_goTypes = []any{
@@ -37,29 +38,33 @@ var file_v1_weaviate_proto_goTypes = []any{
 	(*BatchDeleteRequest)(nil),  // 2: weaviate.v1.BatchDeleteRequest
 	(*TenantsGetRequest)(nil),   // 3: weaviate.v1.TenantsGetRequest
 	(*AggregateRequest)(nil),    // 4: weaviate.v1.AggregateRequest
-	(*SearchReply)(nil),         // 5: weaviate.v1.SearchReply
-	(*BatchObjectsReply)(nil),   // 6: weaviate.v1.BatchObjectsReply
-	(*BatchDeleteReply)(nil),    // 7: weaviate.v1.BatchDeleteReply
-	(*TenantsGetReply)(nil),     // 8: weaviate.v1.TenantsGetReply
-	(*AggregateReply)(nil),      // 9: weaviate.v1.AggregateReply
+	(*ChangesRequest)(nil),      // 5: weaviate.v1.ChangesRequest
+	(*SearchReply)(nil),         // 6: weaviate.v1.SearchReply
+	(*BatchObjectsReply)(nil),   // 7: weaviate.v1.BatchObjectsReply
+	(*BatchDeleteReply)(nil),    // 8: weaviate.v1.BatchDeleteReply
+	(*TenantsGetReply)(nil),     // 9: weaviate.v1.TenantsGetReply
+	(*AggregateReply)(nil),      // 10: weaviate.v1.AggregateReply
+	(*ChangesReply)(nil),        // 11: weaviate.v1.ChangesReply
 }
 
 var file_v1_weaviate_proto_depIdxs = []int32{
-	0, // 0: weaviate.v1.Weaviate.Search:input_type -> weaviate.v1.SearchRequest
-	1, // 1: weaviate.v1.Weaviate.BatchObjects:input_type -> weaviate.v1.BatchObjectsRequest
-	2, // 2: weaviate.v1.Weaviate.BatchDelete:input_type -> weaviate.v1.BatchDeleteRequest
-	3, // 3: weaviate.v1.Weaviate.TenantsGet:input_type -> weaviate.v1.TenantsGetRequest
-	4, // 4: weaviate.v1.Weaviate.Aggregate:input_type -> weaviate.v1.AggregateRequest
-	5, // 5: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
-	6, // 6: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
-	7, // 7: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
-	8, // 8: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
-	9, // 9: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: weaviate.v1.Weaviate.Search:input_type -> weaviate.v1.SearchRequest
+	1,  // 1: weaviate.v1.Weaviate.BatchObjects:input_type -> weaviate.v1.BatchObjectsRequest
+	2,  // 2: weaviate.v1.Weaviate.BatchDelete:input_type -> weaviate.v1.BatchDeleteRequest
+	3,  // 3: weaviate.v1.Weaviate.TenantsGet:input_type -> weaviate.v1.TenantsGetRequest
+	4,  // 4: weaviate.v1.Weaviate.Aggregate:input_type -> weaviate.v1.AggregateRequest
+	5,  // 5: weaviate.v1.Weaviate.Changes:input_type -> weaviate.v1.ChangesRequest
+	6,  // 6: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
+	7,  // 7: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
+	8,  // 8: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
+	9,  // 9: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
+	10, // 10: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
+	11, // 11: weaviate.v1.Weaviate.Changes:output_type -> weaviate.v1.ChangesReply
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_v1_weaviate_proto_init() }
@@ -70,6 +75,7 @@ func file_v1_weaviate_proto_init() {
 	file_v1_aggregate_proto_init()
 	file_v1_batch_proto_init()
 	file_v1_batch_delete_proto_init()
+	file_v1_changes_proto_init()
 	file_v1_search_get_proto_init()
 	file_v1_tenants_proto_init()
 	type x struct{}
@@ -21,6 +21,7 @@ const (
 	Weaviate_BatchDelete_FullMethodName  = "/weaviate.v1.Weaviate/BatchDelete"
 	Weaviate_TenantsGet_FullMethodName   = "/weaviate.v1.Weaviate/TenantsGet"
 	Weaviate_Aggregate_FullMethodName    = "/weaviate.v1.Weaviate/Aggregate"
+	Weaviate_Changes_FullMethodName      = "/weaviate.v1.Weaviate/Changes"
 )
 
 // WeaviateClient is the client API for Weaviate service.
@@ -32,6 +33,7 @@ type WeaviateClient interface {
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangesReply], error)
 }
 
 type weaviateClient struct {
@@ -92,6 +94,25 @@ func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, op
 	return out, nil
 }
 
+func (c *weaviateClient) Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangesReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], Weaviate_Changes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChangesRequest, ChangesReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangesClient = grpc.ServerStreamingClient[ChangesReply]
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility.
@@ -101,6 +122,7 @@ type WeaviateServer interface {
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	Changes(*ChangesRequest, grpc.ServerStreamingServer[ChangesReply]) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -130,6 +152,10 @@ func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetReques
 func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
 }
+
+func (UnimplementedWeaviateServer) Changes(*ChangesRequest, grpc.ServerStreamingServer[ChangesReply]) error {
+	return status.Errorf(codes.Unimplemented, "method Changes not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 func (UnimplementedWeaviateServer) testEmbeddedByValue()                  {}
 
@@ -241,6 +267,17 @@ func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_Changes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).Changes(m, &grpc.GenericServerStream[ChangesRequest, ChangesReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangesServer = grpc.ServerStreamingServer[ChangesReply]
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +306,12 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Changes",
+			Handler:       _Weaviate_Changes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "v1/weaviate.proto",
 }
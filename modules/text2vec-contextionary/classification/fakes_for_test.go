@@ -64,6 +64,14 @@ func (f *fakeSchemaGetter) OptimisticTenantStatus(_ context.Context, class strin
 	return res, nil
 }
 
+func (f *fakeSchemaGetter) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	return nil
+}
+
+func (f *fakeSchemaGetter) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	return nil, nil
+}
+
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return "" }
 
 func (f *fakeSchemaGetter) Nodes() []string {
@@ -62,11 +62,87 @@ func (v *Vectors) UnmarshalJSON(data []byte) error {
 				}
 				continue
 			}
-			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32 or [][]float32 for target vector %s", targetVector)
+			// Try unmarshaling as SparseVector
+			var sparseVector SparseVector
+			if err := json.Unmarshal(rawMessage, &sparseVector); err == nil &&
+				(sparseVector.Indices != nil || sparseVector.Values != nil) {
+				if len(sparseVector.Indices) > 0 || len(sparseVector.Values) > 0 {
+					(*v)[targetVector] = sparseVector
+				}
+				continue
+			}
+			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32, [][]float32 or sparse vector for target vector %s", targetVector)
 		}
 	}
 	return nil
 }
+
+// SparseVector is a vector given as term-id/weight pairs, e.g. SPLADE
+// embeddings. Indices and Values need to have the same length.
+type SparseVector struct {
+	Indices []uint32  ` + "`json:\"indices\"`" + `
+	Values  []float32 ` + "`json:\"values\"`" + `
+}
+
+// Int8Vector is a vector with one signed byte per dimension
+type Int8Vector []int8
+
+// Float32 widens the vector to float32
+func (v Int8Vector) Float32() []float32 {
+	out := make([]float32, len(v))
+	for i := range v {
+		out[i] = float32(v[i])
+	}
+	return out
+}
+
+// Uint8Vector is a vector with one unsigned byte per dimension
+type Uint8Vector []uint8
+
+// Float32 widens the vector to float32
+func (v Uint8Vector) Float32() []float32 {
+	out := make([]float32, len(v))
+	for i := range v {
+		out[i] = float32(v[i])
+	}
+	return out
+}
+
+// MarshalJSON returns the dimensions as numbers, a plain []uint8 would be
+// marshaled as base64
+func (v Uint8Vector) MarshalJSON() ([]byte, error) {
+	out := make([]uint16, len(v))
+	for i := range v {
+		out[i] = uint16(v[i])
+	}
+	return json.Marshal(out)
+}
+
+// BinaryVector is a vector of packed bits, eight dimensions per byte with the
+// most significant bit first
+type BinaryVector []uint8
+
+// Dimensions returns the number of bits in the vector
+func (v BinaryVector) Dimensions() int {
+	return 8 * len(v)
+}
+
+// Float32 unpacks the vector into one 0 or 1 per dimension
+func (v BinaryVector) Float32() []float32 {
+	out := make([]float32, v.Dimensions())
+	for i := range out {
+		if v[i/8]&(0x80>>(i%8)) != 0 {
+			out[i] = 1
+		}
+	}
+	return out
+}
+
+// MarshalJSON returns one 0 or 1 per dimension, which is the form binary
+// vectors are given in over REST
+func (v BinaryVector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Float32())
+}
 `
 	return os.WriteFile(name, []byte(fmt.Sprintf("%s%s", objectStr, unmarshalStr)), 0)
 }
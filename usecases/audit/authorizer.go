@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// RecordDecision writes an authorization decision to the audit log. Resources
+// of a single decision always belong to the same domain, so the first one is
+// used to determine it. Allowed decisions are remembered for the request, so
+// that its outcome can be recorded once it was served, see [Logger.Track].
+func (l *Logger) RecordDecision(ctx context.Context, principal *models.Principal, verb string,
+	resources []string, outcome Outcome, err error, withSourceIP bool,
+) {
+	if len(resources) == 0 {
+		return
+	}
+	domain := DomainOf(resources[0])
+	read := verb == authorization.READ
+	if !l.Enabled(domain, read) {
+		return
+	}
+
+	event := Event{
+		Domain:    domain,
+		Action:    verb,
+		Resources: resources,
+		Outcome:   outcome,
+	}
+	if principal != nil {
+		event.User = principal.Username
+		event.UserType = string(principal.UserType)
+		event.Groups = principal.Groups
+	}
+	if perm, convErr := conv.PathToPermission(verb, resources[0]); convErr == nil && perm.Action != nil {
+		event.Action = *perm.Action
+	}
+	if withSourceIP {
+		event.SourceIP = SourceIP(ctx)
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	l.Record(ctx, event, read)
+}
+
+// Authorizer records the decisions of an authorizer which doesn't write to
+// the audit log itself, e.g. the admin list. RBAC records its decisions
+// directly.
+type Authorizer struct {
+	authorization.Authorizer
+	audit *Logger
+}
+
+// NewAuthorizer wraps the authorizer, it is returned as is if auditing is
+// disabled
+func NewAuthorizer(authorizer authorization.Authorizer, l *Logger) authorization.Authorizer {
+	if l == nil {
+		return authorizer
+	}
+	return &Authorizer{Authorizer: authorizer, audit: l}
+}
+
+func (a *Authorizer) Authorize(ctx context.Context, principal *models.Principal, verb string, resources ...string) error {
+	err := a.Authorizer.Authorize(ctx, principal, verb, resources...)
+
+	outcome := OutcomeAllowed
+	switch {
+	case err == nil:
+	case errors.As(err, &autherrs.Forbidden{}):
+		outcome = OutcomeDenied
+	default:
+		outcome = OutcomeError
+	}
+	a.audit.RecordDecision(ctx, principal, verb, resources, outcome, err, true)
+	return err
+}
+
+func (a *Authorizer) FilterAuthorizedResources(ctx context.Context, principal *models.Principal,
+	verb string, resources ...string,
+) ([]string, error) {
+	allowed, err := a.Authorizer.FilterAuthorizedResources(ctx, principal, verb, resources...)
+	if err == nil {
+		a.audit.RecordDecision(ctx, principal, verb, allowed, OutcomeAllowed, nil, true)
+	}
+	return allowed, err
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+)
+
+func TestAuthorizer(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	sink := &memorySink{}
+	l := NewWithSinks([]string{authorization.SchemaDomain}, false, logger, sink)
+
+	inner := adminlist.New(adminlist.Config{Enabled: true, Users: []string{"admin"}})
+	assert.Same(t, inner, NewAuthorizer(inner, nil))
+	authorizer := NewAuthorizer(inner, l)
+
+	ctx := context.WithValue(context.Background(), "sourceIp", "10.0.0.1")
+	resources := authorization.CollectionsMetadata("Documents")
+	admin := &models.Principal{Username: "admin", UserType: models.UserTypeInputDb}
+	other := &models.Principal{Username: "other", UserType: models.UserTypeInputDb}
+
+	require.NoError(t, authorizer.Authorize(ctx, admin, authorization.UPDATE, resources...))
+	require.Error(t, authorizer.Authorize(ctx, other, authorization.DELETE, resources...))
+	// silent checks are not recorded
+	require.NoError(t, authorizer.AuthorizeSilent(ctx, admin, authorization.DELETE, resources...))
+
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, authorization.UpdateCollections, sink.events[0].Action)
+	assert.Equal(t, OutcomeAllowed, sink.events[0].Outcome)
+	assert.Equal(t, "10.0.0.1", sink.events[0].SourceIP)
+	assert.Equal(t, "other", sink.events[1].User)
+	assert.Equal(t, OutcomeDenied, sink.events[1].Outcome)
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	DefaultMaxSizeMB  = 100
+	DefaultMaxBackups = 10
+	DefaultFileName   = "audit.jsonl"
+)
+
+// Domains lists every domain that can be audited. A domain is the first
+// segment of an authorization resource, e.g. "schema" for
+// "schema/collections/Foo/shards/*".
+var Domains = []string{
+	authorization.SchemaDomain,
+	authorization.UsersDomain,
+	authorization.RolesDomain,
+	authorization.BackupsDomain,
+	authorization.DataDomain,
+	authorization.AliasesDomain,
+	authorization.ReplicateDomain,
+	authorization.ClusterDomain,
+	authorization.NodesDomain,
+}
+
+// DefaultDomains are audited when no domains are configured explicitly
+var DefaultDomains = []string{
+	authorization.SchemaDomain,
+	authorization.UsersDomain,
+	authorization.RolesDomain,
+	authorization.BackupsDomain,
+	authorization.DataDomain,
+}
+
+// Config of the audit log. Events are appended to a JSONL file which is
+// rotated once it exceeds MaxSizeMB and can additionally be forwarded to a
+// local collector through a webhook.
+type Config struct {
+	Enabled    bool     `json:"enabled" yaml:"enabled"`
+	Path       string   `json:"path" yaml:"path"`
+	MaxSizeMB  int      `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxBackups int      `json:"max_backups" yaml:"max_backups"`
+	WebhookURL string   `json:"webhook_url" yaml:"webhook_url"`
+	Domains    []string `json:"domains" yaml:"domains"`
+	// Reads enables auditing of read requests in the configured domains,
+	// otherwise only requests that change state are recorded
+	Reads bool `json:"reads" yaml:"reads"`
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Path == "" {
+		return fmt.Errorf("audit log path is required")
+	}
+
+	if c.MaxSizeMB < 0 {
+		return fmt.Errorf("audit log max size must not be negative, got %d", c.MaxSizeMB)
+	}
+
+	if c.MaxBackups < 0 {
+		return fmt.Errorf("audit log max backups must not be negative, got %d", c.MaxBackups)
+	}
+
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("audit log webhook url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("audit log webhook url must use http or https, got %q", c.WebhookURL)
+		}
+	}
+
+	for _, domain := range c.Domains {
+		if !slices.Contains(Domains, domain) {
+			return fmt.Errorf("unknown audit log domain %q, must be one of %v", domain, Domains)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Version of the event format, increase when fields change in a non
+// backwards compatible way
+const Version = 1
+
+type Outcome string
+
+const (
+	OutcomeAllowed Outcome = "allowed"
+	OutcomeDenied  Outcome = "denied"
+	OutcomeError   Outcome = "error"
+
+	// OutcomeSucceeded and OutcomeFailed are recorded once a request was
+	// served, for every action that was allowed while serving it
+	OutcomeSucceeded Outcome = "succeeded"
+	OutcomeFailed    Outcome = "failed"
+)
+
+// Event is a single line of the audit log. It is either an authorization
+// decision or, with the status of the response, the outcome of a request.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Version   int       `json:"version"`
+	Domain    string    `json:"domain"`
+	Action    string    `json:"action"`
+	RequestID string    `json:"request_id,omitempty"`
+	User      string    `json:"user,omitempty"`
+	UserType  string    `json:"user_type,omitempty"`
+	Groups    []string  `json:"groups,omitempty"`
+	SourceIP  string    `json:"source_ip,omitempty"`
+	Resources []string  `json:"resources,omitempty"`
+	Outcome   Outcome   `json:"outcome"`
+	Status    string    `json:"status,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the id of the request, it is
+// attached to every event recorded while serving that request
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the id of the request or an empty string if the context
+// does not carry one
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// SourceIP returns the ip the request originated from as set by the REST and
+// gRPC middlewares
+func SourceIP(ctx context.Context) string {
+	ip, _ := ctx.Value("sourceIp").(string)
+	return ip
+}
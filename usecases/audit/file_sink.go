@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	fileSyncInterval   = time.Second
+	fileSinkBufferSize = 64 * 1024
+)
+
+// Sink receives every audit event that passed the domain filter
+type Sink interface {
+	Write(event Event) error
+	Close() error
+}
+
+// FileSink appends events as JSON lines to a file. Lines are buffered and
+// synced to disk in the background every second, so requests never wait for
+// the disk, and on Close. Once the file grows past maxSize it is renamed with
+// a timestamp suffix and a new file is started, keeping at most maxBackups
+// rotated files.
+type FileSink struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	writer     *bufio.Writer
+	size       int64
+	dirty      bool
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewFileSink(path string, maxSizeMB, maxBackups int, logger logrus.FieldLogger) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create audit log dir: %w", err)
+	}
+
+	s := &FileSink{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	s.stop = make(chan struct{})
+	s.wg.Add(1)
+	enterrors.GoWrapper(s.syncPeriodically, logger)
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+	s.file = f
+	s.writer = bufio.NewWriterSize(f, fileSinkBufferSize)
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) Write(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("audit log %s is closed", s.path)
+	}
+
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.writer.Write(line)
+	s.size += int64(n)
+	s.dirty = true
+	if err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+// Sync writes the buffered events to disk
+func (s *FileSink) Sync() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	return s.sync()
+}
+
+func (s *FileSink) sync() error {
+	if !s.dirty {
+		return nil
+	}
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("sync audit log: %w", err)
+	}
+	s.dirty = false
+	return nil
+}
+
+func (s *FileSink) syncPeriodically() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(fileSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			// errors are returned by the next Write or Close, the events are
+			// still buffered
+			_ = s.Sync()
+		}
+	}
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.sync(); err != nil {
+		return err
+	}
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("close audit log: %w", err)
+	}
+	s.file = nil
+
+	rotated := fmt.Sprintf("%s.%s", s.path, time.Now().UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(s.path, rotated); err != nil {
+		return fmt.Errorf("rotate audit log: %w", err)
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	return s.removeOldBackups()
+}
+
+func (s *FileSink) removeOldBackups() error {
+	if s.maxBackups <= 0 {
+		return nil
+	}
+
+	backups, err := filepath.Glob(s.path + ".*")
+	if err != nil {
+		return fmt.Errorf("list rotated audit logs: %w", err)
+	}
+	if len(backups) <= s.maxBackups {
+		return nil
+	}
+
+	// the timestamp suffix sorts lexicographically
+	sort.Strings(backups)
+	for _, backup := range backups[:len(backups)-s.maxBackups] {
+		if err := os.Remove(backup); err != nil {
+			return fmt.Errorf("remove rotated audit log: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close syncs the buffered events and closes the file
+func (s *FileSink) Close() error {
+	s.Lock()
+	select {
+	case <-s.stop:
+		s.Unlock()
+		return nil
+	default:
+		close(s.stop)
+	}
+
+	var err error
+	if s.file != nil {
+		err = errors.Join(s.sync(), s.file.Close())
+		s.file = nil
+	}
+	s.Unlock()
+
+	s.wg.Wait()
+	return err
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestFileSink(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("append and reopen", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit", DefaultFileName)
+
+		sink, err := NewFileSink(path, 1, 1, logger)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(Event{Action: "create_collections", Outcome: OutcomeAllowed}))
+		require.NoError(t, sink.Close())
+
+		sink, err = NewFileSink(path, 1, 1, logger)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(Event{Action: "delete_data", Outcome: OutcomeDenied}))
+		require.NoError(t, sink.Close())
+
+		events := readEvents(t, path)
+		require.Len(t, events, 2)
+		assert.Equal(t, "create_collections", events[0].Action)
+		assert.Equal(t, OutcomeDenied, events[1].Outcome)
+
+		assert.Error(t, sink.Write(Event{}))
+	})
+
+	t.Run("rotate and keep max backups", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, DefaultFileName)
+
+		sink, err := NewFileSink(path, 1, 2, logger)
+		require.NoError(t, err)
+		defer sink.Close()
+
+		// every event is over 100KB, so a file holds less than 10 events
+		groups := make([]string, 15_000)
+		for i := range groups {
+			groups[i] = "group"
+		}
+		for i := 0; i < 50; i++ {
+			require.NoError(t, sink.Write(Event{Groups: groups}))
+		}
+
+		require.NoError(t, sink.Sync())
+		backups, err := filepath.Glob(path + ".*")
+		require.NoError(t, err)
+		assert.Len(t, backups, 2)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1024*1024))
+		assert.NotEmpty(t, readEvents(t, path))
+	})
+	t.Run("buffered events are synced in the background", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), DefaultFileName)
+
+		sink, err := NewFileSink(path, 1, 1, logger)
+		require.NoError(t, err)
+		defer sink.Close()
+
+		require.NoError(t, sink.Write(Event{Action: "create_collections", Outcome: OutcomeAllowed}))
+		assert.Eventually(t, func() bool {
+			return len(readEvents(t, path)) == 1
+		}, 5*fileSyncInterval, 50*time.Millisecond)
+	})
+}
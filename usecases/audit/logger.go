@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Logger records audit events of the enabled domains to all configured sinks.
+// A nil *Logger is valid and records nothing, so callers don't need to check
+// whether auditing is enabled.
+type Logger struct {
+	sinks   []Sink
+	domains map[string]struct{}
+	reads   bool
+	logger  logrus.FieldLogger
+}
+
+// New creates the audit logger described by the config, returns nil if
+// auditing is disabled
+func New(cfg Config, logger logrus.FieldLogger) (*Logger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	fileSink, err := NewFileSink(cfg.Path, cfg.MaxSizeMB, cfg.MaxBackups, logger)
+	if err != nil {
+		return nil, err
+	}
+	sinks := []Sink{fileSink}
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, logger))
+	}
+
+	domains := cfg.Domains
+	if len(domains) == 0 {
+		domains = DefaultDomains
+	}
+	return NewWithSinks(domains, cfg.Reads, logger, sinks...), nil
+}
+
+func NewWithSinks(domains []string, reads bool, logger logrus.FieldLogger, sinks ...Sink) *Logger {
+	l := &Logger{
+		sinks:   sinks,
+		domains: make(map[string]struct{}, len(domains)),
+		reads:   reads,
+		logger:  logger.WithField("component", "audit"),
+	}
+	for _, domain := range domains {
+		l.domains[domain] = struct{}{}
+	}
+	return l
+}
+
+// Enabled returns whether events of the domain are audited. Reads are only
+// audited if enabled explicitly.
+func (l *Logger) Enabled(domain string, read bool) bool {
+	if l == nil {
+		return false
+	}
+	if read && !l.reads {
+		return false
+	}
+	_, ok := l.domains[domain]
+	return ok
+}
+
+// Record writes the event to all sinks if its domain is enabled. Time,
+// version and request id are filled in when not set. Failing
+// sinks are logged but never fail the request.
+func (l *Logger) Record(ctx context.Context, event Event, read bool) {
+	if !l.Enabled(event.Domain, read) {
+		return
+	}
+
+	if event.RequestID == "" {
+		event.RequestID = RequestID(ctx)
+	}
+	if event.Outcome == OutcomeAllowed {
+		l.track(ctx, event)
+	}
+	l.write(event)
+}
+
+func (l *Logger) write(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	event.Version = Version
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil {
+			l.logger.WithError(err).WithFields(logrus.Fields{
+				"domain":     event.Domain,
+				"action":     event.Action,
+				"request_id": event.RequestID,
+			}).Error("failed to write audit event")
+		}
+	}
+}
+
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	var errs []error
+	for _, sink := range l.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// DomainOf returns the domain of an authorization resource
+func DomainOf(resource string) string {
+	domain, _, _ := strings.Cut(resource, "/")
+	return domain
+}
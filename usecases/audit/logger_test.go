@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memorySink struct {
+	events []Event
+	err    error
+}
+
+func (s *memorySink) Write(event Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func TestLogger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	t.Run("nil logger records nothing", func(t *testing.T) {
+		var l *Logger
+		assert.False(t, l.Enabled("schema", false))
+		l.Record(context.Background(), Event{Domain: "schema"}, false)
+		assert.NoError(t, l.Close())
+	})
+
+	t.Run("filter domains and reads", func(t *testing.T) {
+		sink := &memorySink{}
+		l := NewWithSinks([]string{"schema", "data"}, false, logger, sink)
+
+		ctx := WithRequestID(context.Background(), "req-1")
+		l.Record(ctx, Event{Domain: "schema", Action: "create_collections"}, false)
+		l.Record(ctx, Event{Domain: "data", Action: "read_data"}, true)
+		l.Record(ctx, Event{Domain: "roles", Action: "create_roles"}, false)
+
+		require.Len(t, sink.events, 1)
+		event := sink.events[0]
+		assert.Equal(t, "create_collections", event.Action)
+		assert.Equal(t, "req-1", event.RequestID)
+		assert.Equal(t, Version, event.Version)
+		assert.False(t, event.Time.IsZero())
+
+		l = NewWithSinks([]string{"data"}, true, logger, sink)
+		l.Record(ctx, Event{Domain: "data", Action: "read_data"}, true)
+		require.Len(t, sink.events, 2)
+	})
+
+	t.Run("failing sink does not stop others", func(t *testing.T) {
+		hook.Reset()
+		failing := &memorySink{err: errors.New("disk full")}
+		sink := &memorySink{}
+		l := NewWithSinks([]string{"users"}, false, logger, failing, sink)
+
+		l.Record(context.Background(), Event{Domain: "users", Action: "delete_users"}, false)
+		assert.Len(t, sink.events, 1)
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, "failed to write audit event", hook.LastEntry().Message)
+	})
+
+	t.Run("new from config", func(t *testing.T) {
+		l, err := New(Config{}, logger)
+		require.NoError(t, err)
+		assert.Nil(t, l)
+
+		_, err = New(Config{Enabled: true, Path: filepath.Join(t.TempDir(), DefaultFileName), Domains: []string{"unknown"}}, logger)
+		assert.ErrorContains(t, err, "unknown audit log domain")
+
+		l, err = New(Config{Enabled: true, Path: filepath.Join(t.TempDir(), DefaultFileName)}, logger)
+		require.NoError(t, err)
+		assert.True(t, l.Enabled("backups", false))
+		assert.False(t, l.Enabled("nodes", false))
+		require.NoError(t, l.Close())
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{name: "disabled", config: Config{Path: ""}},
+		{name: "valid", config: Config{Enabled: true, Path: "/audit.jsonl", WebhookURL: "http://localhost:8080/audit", Domains: []string{"roles"}}},
+		{name: "missing path", config: Config{Enabled: true}, err: "path is required"},
+		{name: "negative size", config: Config{Enabled: true, Path: "/a", MaxSizeMB: -1}, err: "max size"},
+		{name: "webhook scheme", config: Config{Enabled: true, Path: "/a", WebhookURL: "ftp://collector"}, err: "http or https"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDomainOf(t *testing.T) {
+	assert.Equal(t, "schema", DomainOf("schema/collections/Foo/shards/*"))
+	assert.Equal(t, "cluster", DomainOf("cluster/*"))
+	assert.Equal(t, "users", DomainOf("users"))
+}
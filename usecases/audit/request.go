@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type requestKey struct{}
+
+// request collects the allowed decisions of a single request
+type request struct {
+	sync.Mutex
+	allowed []Event
+}
+
+// Track returns a context in which allowed decisions are remembered, so that
+// [Logger.Finish] can record whether the actions they allowed succeeded.
+// The context is returned as is if auditing is disabled.
+func (l *Logger) Track(ctx context.Context) context.Context {
+	if l == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, requestKey{}, &request{})
+}
+
+// Finish records the outcome of a request for every distinct action that was
+// allowed while serving it. Status is the HTTP status code for REST requests
+// and the name of the gRPC code for gRPC requests.
+func (l *Logger) Finish(ctx context.Context, failed bool, status string, err error) {
+	if l == nil {
+		return
+	}
+	req, ok := ctx.Value(requestKey{}).(*request)
+	if !ok {
+		return
+	}
+
+	req.Lock()
+	allowed := req.allowed
+	req.allowed = nil
+	req.Unlock()
+
+	outcome := OutcomeSucceeded
+	if failed {
+		outcome = OutcomeFailed
+	}
+
+	type action struct{ domain, action string }
+	seen := make(map[action]struct{}, len(allowed))
+	for _, event := range allowed {
+		key := action{event.Domain, event.Action}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		event.Time = time.Time{}
+		event.Outcome = outcome
+		event.Status = status
+		event.Error = ""
+		if err != nil {
+			event.Error = err.Error()
+		}
+		l.write(event)
+	}
+}
+
+func (l *Logger) track(ctx context.Context, event Event) {
+	req, ok := ctx.Value(requestKey{}).(*request)
+	if !ok {
+		return
+	}
+	req.Lock()
+	req.allowed = append(req.allowed, event)
+	req.Unlock()
+}
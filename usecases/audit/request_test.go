@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func TestRequestOutcome(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	principal := &models.Principal{Username: "alice", UserType: models.UserTypeInputDb}
+
+	t.Run("allowed actions are recorded once with the outcome", func(t *testing.T) {
+		sink := &memorySink{}
+		l := NewWithSinks([]string{authorization.SchemaDomain}, false, logger, sink)
+
+		ctx := l.Track(WithRequestID(context.Background(), "req-1"))
+		resources := authorization.CollectionsMetadata("Documents")
+		l.RecordDecision(ctx, principal, authorization.UPDATE, resources, OutcomeAllowed, nil, false)
+		l.RecordDecision(ctx, principal, authorization.UPDATE, resources, OutcomeAllowed, nil, false)
+		l.RecordDecision(ctx, principal, authorization.DELETE, resources, OutcomeDenied, errors.New("forbidden"), false)
+		l.Finish(ctx, true, "500", errors.New("disk full"))
+
+		require.Len(t, sink.events, 4)
+		outcome := sink.events[3]
+		assert.Equal(t, OutcomeFailed, outcome.Outcome)
+		assert.Equal(t, authorization.UpdateCollections, outcome.Action)
+		assert.Equal(t, "alice", outcome.User)
+		assert.Equal(t, "req-1", outcome.RequestID)
+		assert.Equal(t, "500", outcome.Status)
+		assert.Equal(t, "disk full", outcome.Error)
+	})
+
+	t.Run("requests without audited actions are not recorded", func(t *testing.T) {
+		sink := &memorySink{}
+		l := NewWithSinks([]string{authorization.SchemaDomain}, false, logger, sink)
+
+		ctx := l.Track(context.Background())
+		l.RecordDecision(ctx, principal, authorization.READ, authorization.CollectionsMetadata("Documents"), OutcomeAllowed, nil, false)
+		l.Finish(ctx, false, "200", nil)
+		assert.Empty(t, sink.events)
+	})
+
+	t.Run("untracked requests and nil logger", func(t *testing.T) {
+		sink := &memorySink{}
+		l := NewWithSinks([]string{authorization.SchemaDomain}, false, logger, sink)
+		l.RecordDecision(context.Background(), principal, authorization.UPDATE,
+			authorization.CollectionsMetadata("Documents"), OutcomeAllowed, nil, false)
+		l.Finish(context.Background(), false, "200", nil)
+		assert.Len(t, sink.events, 1)
+
+		var nilLogger *Logger
+		ctx := nilLogger.Track(context.Background())
+		nilLogger.Finish(ctx, false, "200", nil)
+	})
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	webhookQueueSize = 1024
+	webhookBatchSize = 100
+	webhookTimeout   = 5 * time.Second
+)
+
+// WebhookSink forwards events to a local collector. Events are queued and
+// posted in batches as newline delimited JSON in the background, so a slow
+// or unavailable collector never blocks a request. Events are dropped when
+// the queue is full, the file sink remains the durable record.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	logger logrus.FieldLogger
+	queue  chan Event
+	wg     sync.WaitGroup
+
+	sync.RWMutex
+	closed bool
+}
+
+func NewWebhookSink(url string, logger logrus.FieldLogger) *WebhookSink {
+	s := &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		logger: logger.WithField("component", "audit_webhook"),
+		queue:  make(chan Event, webhookQueueSize),
+	}
+	s.wg.Add(1)
+	enterrors.GoWrapper(s.run, s.logger)
+	return s
+}
+
+func (s *WebhookSink) Write(event Event) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("audit webhook is closed")
+	}
+
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("audit webhook queue is full, dropping event")
+	}
+}
+
+func (s *WebhookSink) run() {
+	defer s.wg.Done()
+
+	batch := make([]Event, 0, webhookBatchSize)
+	for event := range s.queue {
+		batch = append(batch, event)
+		// drain whatever is already queued to post it in a single request
+	drain:
+		for len(batch) < webhookBatchSize {
+			select {
+			case next, ok := <-s.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		if err := s.post(batch); err != nil {
+			s.logger.WithError(err).WithField("events", len(batch)).
+				Warn("failed to forward audit events")
+		}
+		batch = batch[:0]
+	}
+}
+
+func (s *WebhookSink) post(events []Event) error {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("marshal audit event: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Close stops accepting events and waits until the queued ones were posted
+func (s *WebhookSink) Close() error {
+	s.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	var (
+		mu       sync.Mutex
+		received []Event
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+
+		scanner := bufio.NewScanner(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		for scanner.Scan() {
+			var event Event
+			if assert.NoError(t, json.Unmarshal(scanner.Bytes(), &event)) {
+				received = append(received, event)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	sink := NewWebhookSink(collector.URL, logger)
+	for i := 0; i < 250; i++ {
+		require.NoError(t, sink.Write(Event{Domain: "schema", RequestID: "req"}))
+	}
+	// close waits until all queued events were forwarded
+	require.NoError(t, sink.Close())
+	require.NoError(t, sink.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 250)
+	assert.Equal(t, "req", received[0].RequestID)
+
+	assert.Error(t, sink.Write(Event{}))
+}
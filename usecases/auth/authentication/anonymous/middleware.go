@@ -25,12 +25,18 @@ type Client struct {
 	config        config.AnonymousAccess
 	apiKeyEnabled bool
 	oidcEnabled   bool
+	mtlsEnabled   bool
 }
 
 // New anonymous access client. Client.Middleware can be used as a regular
 // golang http-middleware
 func New(cfg config.Config) *Client {
-	return &Client{config: cfg.Authentication.AnonymousAccess, apiKeyEnabled: cfg.Authentication.AnyApiKeyAvailable(), oidcEnabled: cfg.Authentication.OIDC.Enabled}
+	return &Client{
+		config:        cfg.Authentication.AnonymousAccess,
+		apiKeyEnabled: cfg.Authentication.AnyApiKeyAvailable(),
+		oidcEnabled:   cfg.Authentication.OIDC.Enabled,
+		mtlsEnabled:   cfg.Authentication.MTLS.Enabled,
+	}
 }
 
 // Middleware will fail unauthenticated requests if anonymous access is
@@ -52,6 +58,13 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if c.mtlsEnabled && hasVerifiedClientCertificate(r) {
+			// the client certificate was verified during the TLS handshake, the
+			// mTLS authenticator extracts the principal from it
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		w.WriteHeader(401)
 		var authSchemas []string
 		if c.apiKeyEnabled {
@@ -60,6 +73,9 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 		if c.oidcEnabled {
 			authSchemas = append(authSchemas, "OIDC")
 		}
+		if c.mtlsEnabled {
+			authSchemas = append(authSchemas, "mTLS")
+		}
 
 		w.Write([]byte(
 			fmt.Sprintf(
@@ -92,3 +108,7 @@ func hasBearerAuth(r *http.Request) bool {
 
 	return token != ""
 }
+
+func hasVerifiedClientCertificate(r *http.Request) bool {
+	return r.TLS != nil && len(r.TLS.VerifiedChains) > 0
+}
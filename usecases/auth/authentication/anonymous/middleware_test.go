@@ -12,6 +12,8 @@
 package anonymous
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -99,4 +101,51 @@ func Test_AnonymousMiddleware_Disabled(t *testing.T) {
 
 		assert.Equal(t, response.StatusCode, 900)
 	})
+	t.Run("when mTLS is enabled, and a verified client certificate provided", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo", nil)
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}
+		w := httptest.NewRecorder()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(900)
+		})
+
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				MTLS: config.MTLS{
+					Enabled: true,
+				},
+			},
+		}
+
+		New(cfg).Middleware(next).ServeHTTP(w, r)
+		response := w.Result()
+		defer response.Body.Close()
+
+		assert.Equal(t, response.StatusCode, 900)
+	})
+
+	t.Run("when mTLS is enabled, but no client certificate provided", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo", nil)
+		r.TLS = &tls.ConnectionState{}
+		w := httptest.NewRecorder()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(900)
+		})
+
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				MTLS: config.MTLS{
+					Enabled: true,
+				},
+			},
+		}
+
+		New(cfg).Middleware(next).ServeHTTP(w, r)
+		response := w.Result()
+		defer response.Body.Close()
+
+		assert.Equal(t, response.StatusCode, 401)
+	})
 }
@@ -43,7 +43,7 @@ func TestDynUserConcurrency(t *testing.T) {
 	for i := 0; i < numUsers; i++ {
 		userName := fmt.Sprintf("user%v", i)
 		go func() {
-			err := dynUsers.CreateUser(userName, "something", userName, "", time.Now())
+			err := dynUsers.CreateUser(userName, "something", userName, "", time.Now(), time.Time{})
 			require.NoError(t, err)
 			wg.Done()
 		}()
@@ -65,12 +65,12 @@ func TestConcurrentValidate(t *testing.T) {
 	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId1, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId1, hash, identifier, "", time.Now(), time.Time{}))
 
 	apiKey2, hash2, identifier2, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId2, hash2, identifier2, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId2, hash2, identifier2, "", time.Now(), time.Time{}))
 
 	randomKey, _, err := keys.DecodeApiKey(apiKey)
 	require.NoError(t, err)
@@ -108,7 +108,7 @@ func TestDynUserTestSlowAfterWeakHash(t *testing.T) {
 	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Time{}))
 
 	randomKey, _, err := keys.DecodeApiKey(apiKey)
 	require.NoError(t, err)
@@ -139,7 +139,7 @@ func TestUpdateUser(t *testing.T) {
 	apiKey, hash, oldIdentifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId, hash, oldIdentifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId, hash, oldIdentifier, "", time.Now(), time.Time{}))
 
 	// login works
 	randomKeyOld, _, err := keys.DecodeApiKey(apiKey)
@@ -152,7 +152,7 @@ func TestUpdateUser(t *testing.T) {
 	// update key and check that original key does not work, but new one does
 	apiKeyNew, hashNew, newIdentifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
-	require.NoError(t, dynUsers.RotateKey(userId, apiKeyNew[:3], hashNew, oldIdentifier, newIdentifier))
+	require.NoError(t, dynUsers.RotateKey(userId, apiKeyNew[:3], hashNew, oldIdentifier, newIdentifier, time.Time{}))
 
 	randomKeyNew, _, err := keys.DecodeApiKey(apiKeyNew)
 	require.NoError(t, err)
@@ -185,13 +185,13 @@ func TestSnapShotAndRestore(t *testing.T) {
 	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId1, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId1, hash, identifier, "", time.Now(), time.Time{}))
 	login1, _, err := keys.DecodeApiKey(apiKey)
 	require.NoError(t, err)
 
 	apiKey2, hash2, identifier2, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
-	require.NoError(t, dynUsers.CreateUser(userId2, hash2, identifier2, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId2, hash2, identifier2, "", time.Now(), time.Time{}))
 	login2, _, err := keys.DecodeApiKey(apiKey2)
 	require.NoError(t, err)
 
@@ -241,7 +241,7 @@ func TestSnapShotAndRestore(t *testing.T) {
 
 	apiKey3, hash3, identifier3, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
-	require.NoError(t, dynUsers2.RotateKey(userId2, apiKey3[:3], hash3, identifier2, identifier3))
+	require.NoError(t, dynUsers2.RotateKey(userId2, apiKey3[:3], hash3, identifier2, identifier3, time.Time{}))
 
 	login3, _, err := keys.DecodeApiKey(apiKey3)
 	require.NoError(t, err)
@@ -257,7 +257,7 @@ func TestSuspendAfterDelete(t *testing.T) {
 	_, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Time{}))
 
 	users, err := dynUsers.GetUsers(userId)
 	require.NoError(t, err)
@@ -268,7 +268,7 @@ func TestSuspendAfterDelete(t *testing.T) {
 
 	require.Error(t, dynUsers.DeactivateUser(userId, false))
 	require.Error(t, dynUsers.ActivateUser(userId))
-	require.Error(t, dynUsers.RotateKey(userId, "", "", "", ""))
+	require.Error(t, dynUsers.RotateKey(userId, "", "", "", "", time.Time{}))
 	require.Error(t, dynUsers.ActivateUser(userId))
 }
 
@@ -282,7 +282,7 @@ func TestLastUsedTime(t *testing.T) {
 	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Time{}))
 
 	user, err := dynUsers.GetUsers(userId)
 	require.NoError(t, err)
@@ -348,7 +348,7 @@ func TestImportingStaticKeys(t *testing.T) {
 
 		apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
 		require.NoError(t, err)
-		require.NoError(t, dynUsers.RotateKey(userId, apiKey[:3], hash, "imported", identifier))
+		require.NoError(t, dynUsers.RotateKey(userId, apiKey[:3], hash, "imported", identifier, time.Time{}))
 
 		login, _, err := keys.DecodeApiKey(apiKey)
 		require.NoError(t, err)
@@ -395,7 +395,7 @@ func TestSnapshotRestoreEmpty(t *testing.T) {
 	_, hash, identifier, err := keys.CreateApiKeyAndHash()
 	require.NoError(t, err)
 
-	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now()))
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Time{}))
 	user, err := dynUsers.GetUsers(userId)
 	require.NoError(t, err)
 	require.Equal(t, user[userId].Id, userId)
@@ -415,3 +415,113 @@ func TestRestoreInvalidData(t *testing.T) {
 
 	require.Error(t, dynUsers.Restore([]byte("invalid json")))
 }
+
+func TestDynUserKeyExpiry(t *testing.T) {
+	dynUsers, err := NewDBUser(t.TempDir(), true, log)
+	require.NoError(t, err)
+	userId := "id"
+
+	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
+	require.NoError(t, err)
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Now().Add(time.Hour)))
+
+	randomKey, _, err := keys.DecodeApiKey(apiKey)
+	require.NoError(t, err)
+	_, err = dynUsers.ValidateAndExtract(randomKey, identifier)
+	require.NoError(t, err)
+
+	// rotating the key also replaces the expiry
+	apiKeyNew, hashNew, identifierNew, err := keys.CreateApiKeyAndHash()
+	require.NoError(t, err)
+	require.NoError(t, dynUsers.RotateKey(userId, apiKeyNew[:3], hashNew, identifier, identifierNew, time.Now().Add(-time.Second)))
+
+	randomKeyNew, _, err := keys.DecodeApiKey(apiKeyNew)
+	require.NoError(t, err)
+	_, err = dynUsers.ValidateAndExtract(randomKeyNew, identifierNew)
+	require.ErrorContains(t, err, "expired")
+}
+
+func TestDynUserAdditionalApiKeys(t *testing.T) {
+	dir := t.TempDir()
+	dynUsers, err := NewDBUser(dir, true, log)
+	require.NoError(t, err)
+	userId := "id"
+
+	apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
+	require.NoError(t, err)
+	require.NoError(t, dynUsers.CreateUser(userId, hash, identifier, "", time.Now(), time.Time{}))
+
+	createKey := func(keyId string, expiresAt time.Time, roles, collections []string) (string, string) {
+		apiKey, hash, identifier, err := keys.CreateApiKeyAndHash()
+		require.NoError(t, err)
+		require.NoError(t, dynUsers.CreateUserApiKey(UserApiKey{
+			Id: keyId, UserId: userId, Identifier: identifier, SecureHash: hash, ApiKeyFirstLetters: apiKey[:3],
+			CreatedAt: time.Now(), ExpiresAt: expiresAt, Roles: roles, Collections: collections,
+		}))
+		exists, err := dynUsers.CheckUserIdentifierExists(identifier)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		randomKey, _, err := keys.DecodeApiKey(apiKey)
+		require.NoError(t, err)
+		return randomKey, identifier
+	}
+
+	scopedKey, scopedIdentifier := createKey("scoped", time.Time{}, []string{"reader"}, []string{"Articles"})
+	expiredKey, expiredIdentifier := createKey("expired", time.Now().Add(-time.Second), nil, nil)
+
+	// the primary key of the user is not affected by additional keys
+	randomKey, _, err := keys.DecodeApiKey(apiKey)
+	require.NoError(t, err)
+	principal, err := dynUsers.ValidateAndExtract(randomKey, identifier)
+	require.NoError(t, err)
+	require.Empty(t, principal.ScopedRoles)
+	require.Empty(t, principal.ScopedCollections)
+
+	principal, err = dynUsers.ValidateAndExtract(scopedKey, scopedIdentifier)
+	require.NoError(t, err)
+	require.Equal(t, userId, principal.Username)
+	require.Equal(t, []string{"reader"}, principal.ScopedRoles)
+	require.Equal(t, []string{"Articles"}, principal.ScopedCollections)
+
+	// weak hash path
+	_, err = dynUsers.ValidateAndExtract(scopedKey, scopedIdentifier)
+	require.NoError(t, err)
+	_, err = dynUsers.ValidateAndExtract(randomKey, scopedIdentifier)
+	require.Error(t, err)
+
+	_, err = dynUsers.ValidateAndExtract(expiredKey, expiredIdentifier)
+	require.ErrorContains(t, err, "expired")
+
+	apiKeys, err := dynUsers.GetUserApiKeys(userId)
+	require.NoError(t, err)
+	require.Len(t, apiKeys, 2)
+	require.Equal(t, "scoped", apiKeys[0].Id)
+	require.Empty(t, apiKeys[0].SecureHash)
+	require.Empty(t, apiKeys[0].Identifier)
+
+	// keys survive a restart
+	dynUsers2, err := NewDBUser(dir, true, log)
+	require.NoError(t, err)
+	_, err = dynUsers2.ValidateAndExtract(scopedKey, scopedIdentifier)
+	require.NoError(t, err)
+
+	require.NoError(t, dynUsers.RevokeUserApiKey(userId, "scoped"))
+	require.Error(t, dynUsers.RevokeUserApiKey(userId, "scoped"))
+	_, err = dynUsers.ValidateAndExtract(scopedKey, scopedIdentifier)
+	require.Error(t, err)
+
+	require.NoError(t, dynUsers.DeleteUser(userId))
+	apiKeys, err = dynUsers.GetUserApiKeys(userId)
+	require.NoError(t, err)
+	require.Empty(t, apiKeys)
+}
+
+func TestDynUserAdditionalApiKeyUnknownUser(t *testing.T) {
+	dynUsers, err := NewDBUser(t.TempDir(), true, log)
+	require.NoError(t, err)
+
+	_, hash, identifier, err := keys.CreateApiKeyAndHash()
+	require.NoError(t, err)
+	require.Error(t, dynUsers.CreateUserApiKey(UserApiKey{Id: "key", UserId: "unknown", Identifier: identifier, SecureHash: hash}))
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,14 +39,17 @@ const (
 )
 
 type DBUsers interface {
-	CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt time.Time) error
+	CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt, expiresAt time.Time) error
 	CreateUserWithKey(userId, apiKeyFirstLetters string, weakHash [sha256.Size]byte, createdAt time.Time) error
 	DeleteUser(userId string) error
 	ActivateUser(userId string) error
 	DeactivateUser(userId string, revokeKey bool) error
 	GetUsers(userIds ...string) (map[string]*User, error)
-	RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string) error
+	RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string, expiresAt time.Time) error
 	CheckUserIdentifierExists(userIdentifier string) (bool, error)
+	CreateUserApiKey(key UserApiKey) error
+	RevokeUserApiKey(userId, keyId string) error
+	GetUserApiKeys(userId string) ([]*UserApiKey, error)
 }
 
 type User struct {
@@ -57,6 +61,37 @@ type User struct {
 	CreatedAt          time.Time
 	LastUsedAt         time.Time
 	ImportedWithKey    bool
+	// ApiKeyExpiresAt is the expiry of the primary key, the zero time means
+	// that the key never expires
+	ApiKeyExpiresAt time.Time
+}
+
+// UserApiKey is an additional key of a user. Several keys can be valid at
+// the same time, e.g. to rotate keys without downtime. A key can expire and
+// can be scoped to a subset of the user's roles and to specific collections.
+type UserApiKey struct {
+	Id                 string
+	UserId             string
+	Identifier         string
+	SecureHash         string
+	ApiKeyFirstLetters string
+	CreatedAt          time.Time
+	ExpiresAt          time.Time
+	Roles              []string
+	Collections        []string
+}
+
+// Expired returns whether a key with the given expiry is expired at the given time
+func Expired(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
+
+// withoutSecrets returns a copy of the key that can be handed out to callers
+func (k *UserApiKey) withoutSecrets() *UserApiKey {
+	c := *k
+	c.Identifier = ""
+	c.SecureHash = ""
+	return &c
 }
 
 type DBUser struct {
@@ -79,10 +114,14 @@ type dbUserdata struct {
 	Users                   map[string]*User
 	UserKeyRevoked          map[string]struct{}
 	ImportedApiKeysWeakHash map[string][sha256.Size]byte
+	// ApiKeys holds the additional keys of all users by their identifier
+	ApiKeys map[string]*UserApiKey
 }
 
 type memoryOnlyData struct {
 	weakKeyStorageById map[string][sha256.Size]byte
+	// same as weakKeyStorageById for additional keys, by identifier
+	weakKeyStorageByIdentifier map[string][sha256.Size]byte
 	// imported keys from static users should not work after key rotation, eg the following scenario
 	// - import user with "key"
 	// - login works when using "key" through dynamic users
@@ -132,14 +171,19 @@ func NewDBUser(path string, enabled bool, logger logrus.FieldLogger) (*DBUser, e
 		snapshot.Data.ImportedApiKeysWeakHash = make(map[string][sha256.Size]byte)
 	}
 
+	if snapshot.Data.ApiKeys == nil {
+		snapshot.Data.ApiKeys = make(map[string]*UserApiKey)
+	}
+
 	dbUsers := &DBUser{
 		path:         fullpath,
 		lock:         &sync.RWMutex{},
 		weakHashLock: &sync.RWMutex{},
 		data:         snapshot.Data,
 		memoryOnlyData: memoryOnlyData{
-			weakKeyStorageById:     make(map[string][sha256.Size]byte),
-			importedApiKeysBlocked: make([][sha256.Size]byte, 0),
+			weakKeyStorageById:         make(map[string][sha256.Size]byte),
+			weakKeyStorageByIdentifier: make(map[string][sha256.Size]byte),
+			importedApiKeysBlocked:     make([][sha256.Size]byte, 0),
 		},
 	}
 
@@ -169,7 +213,7 @@ func NewDBUser(path string, enabled bool, logger logrus.FieldLogger) (*DBUser, e
 	return dbUsers, nil
 }
 
-func (c *DBUser) CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt time.Time) error {
+func (c *DBUser) CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLetters string, createdAt, expiresAt time.Time) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -180,7 +224,14 @@ func (c *DBUser) CreateUser(userId, secureHash, userIdentifier, apiKeyFirstLette
 	c.data.SecureKeyStorageById[userId] = secureHash
 	c.data.IdentifierToId[userIdentifier] = userId
 	c.data.IdToIdentifier[userId] = userIdentifier
-	c.data.Users[userId] = &User{Id: userId, Active: true, InternalIdentifier: userIdentifier, CreatedAt: createdAt, ApiKeyFirstLetters: apiKeyFirstLetters}
+	c.data.Users[userId] = &User{
+		Id:                 userId,
+		Active:             true,
+		InternalIdentifier: userIdentifier,
+		CreatedAt:          createdAt,
+		ApiKeyFirstLetters: apiKeyFirstLetters,
+		ApiKeyExpiresAt:    expiresAt,
+	}
 	return c.storeToFile()
 }
 
@@ -205,7 +256,7 @@ func (c *DBUser) CreateUserWithKey(userId, apiKeyFirstLetters string, weakHash [
 	return c.storeToFile()
 }
 
-func (c *DBUser) RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string) error {
+func (c *DBUser) RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier, newIdentifier string, expiresAt time.Time) error {
 	if len(apiKeyFirstLetters) > 3 {
 		return errors.New("api key first letters too long")
 	}
@@ -231,6 +282,7 @@ func (c *DBUser) RotateKey(userId, apiKeyFirstLetters, secureHash, oldIdentifier
 	}
 
 	c.data.Users[userId].ApiKeyFirstLetters = apiKeyFirstLetters
+	c.data.Users[userId].ApiKeyExpiresAt = expiresAt
 	c.data.SecureKeyStorageById[userId] = secureHash
 	delete(c.memoryOnlyData.weakKeyStorageById, userId)
 	delete(c.data.UserKeyRevoked, userId)
@@ -247,6 +299,7 @@ func (c *DBUser) DeleteUser(userId string) error {
 	delete(c.data.Users, userId)
 	delete(c.memoryOnlyData.weakKeyStorageById, userId)
 	delete(c.data.UserKeyRevoked, userId)
+	c.deleteUserApiKeys(userId)
 	return c.storeToFile()
 }
 
@@ -270,6 +323,8 @@ func (c *DBUser) DeactivateUser(userId string, revokeKey bool) error {
 	}
 	if revokeKey {
 		c.data.UserKeyRevoked[userId] = struct{}{}
+		// additional keys can't be restored by rotating the primary key
+		c.deleteUserApiKeys(userId)
 	}
 	c.data.Users[userId].Active = false
 
@@ -298,10 +353,85 @@ func (c *DBUser) CheckUserIdentifierExists(userIdentifier string) (bool, error)
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	_, ok := c.data.Users[userIdentifier]
+	if _, ok := c.data.Users[userIdentifier]; ok {
+		return true, nil
+	}
+	if _, ok := c.data.IdentifierToId[userIdentifier]; ok {
+		return true, nil
+	}
+	_, ok := c.data.ApiKeys[userIdentifier]
 	return ok, nil
 }
 
+func (c *DBUser) CreateUserApiKey(key UserApiKey) error {
+	if len(key.ApiKeyFirstLetters) > 3 {
+		return errors.New("api key first letters too long")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.data.Users[key.UserId]; !ok {
+		return fmt.Errorf("user %s does not exist", key.UserId)
+	}
+	if _, ok := c.data.ApiKeys[key.Identifier]; ok {
+		return fmt.Errorf("api key identifier already in use")
+	}
+
+	c.data.ApiKeys[key.Identifier] = &key
+	return c.storeToFile()
+}
+
+func (c *DBUser) RevokeUserApiKey(userId, keyId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for identifier, key := range c.data.ApiKeys {
+		if key.UserId == userId && key.Id == keyId {
+			delete(c.data.ApiKeys, identifier)
+			c.weakHashLock.Lock()
+			delete(c.memoryOnlyData.weakKeyStorageByIdentifier, identifier)
+			c.weakHashLock.Unlock()
+			return c.storeToFile()
+		}
+	}
+	return fmt.Errorf("api key %s of user %s does not exist", keyId, userId)
+}
+
+// GetUserApiKeys returns the additional keys of the user sorted by creation
+// time. Hashes and identifiers are not included.
+func (c *DBUser) GetUserApiKeys(userId string) ([]*UserApiKey, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	keys := []*UserApiKey{}
+	for _, key := range c.data.ApiKeys {
+		if key.UserId == userId {
+			keys = append(keys, key.withoutSecrets())
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].Id < keys[j].Id
+		}
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+// deleteUserApiKeys needs to be called with the write lock held
+func (c *DBUser) deleteUserApiKeys(userId string) {
+	c.weakHashLock.Lock()
+	defer c.weakHashLock.Unlock()
+
+	for identifier, key := range c.data.ApiKeys {
+		if key.UserId == userId {
+			delete(c.data.ApiKeys, identifier)
+			delete(c.memoryOnlyData.weakKeyStorageByIdentifier, identifier)
+		}
+	}
+}
+
 func (c *DBUser) UpdateLastUsedTimestamp(users map[string]time.Time) {
 	// RLock is fine here, we only want to avoid that c.data.Users is being changed. LastUsed has its own
 	// locking mechanism
@@ -353,6 +483,10 @@ func (c *DBUser) ValidateAndExtract(key, userIdentifier string) (*models.Princip
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
+	if apiKey, ok := c.data.ApiKeys[userIdentifier]; ok {
+		return c.validateUserApiKey(key, apiKey)
+	}
+
 	userId, ok := c.data.IdentifierToId[userIdentifier]
 	if !ok {
 		return nil, fmt.Errorf("invalid token")
@@ -382,6 +516,9 @@ func (c *DBUser) ValidateAndExtract(key, userIdentifier string) (*models.Princip
 	if _, ok := c.data.UserKeyRevoked[userId]; ok {
 		return nil, fmt.Errorf("key is revoked")
 	}
+	if c.data.Users[userId] != nil && Expired(c.data.Users[userId].ApiKeyExpiresAt, time.Now()) {
+		return nil, fmt.Errorf("key is expired")
+	}
 
 	// Last used time does not have to be exact. If we have multiple concurrent requests for the same
 	// user, only recording one of them is good enough
@@ -393,6 +530,57 @@ func (c *DBUser) ValidateAndExtract(key, userIdentifier string) (*models.Princip
 	return &models.Principal{Username: userId, UserType: models.UserTypeInputDb}, nil
 }
 
+// validateUserApiKey validates an additional key of a user, the principal is
+// limited to the scope of the key. Needs to be called with the read lock held.
+func (c *DBUser) validateUserApiKey(key string, apiKey *UserApiKey) (*models.Principal, error) {
+	user, ok := c.data.Users[apiKey.UserId]
+	if !ok {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	c.weakHashLock.RLock()
+	weakHash, ok := c.memoryOnlyData.weakKeyStorageByIdentifier[apiKey.Identifier]
+	c.weakHashLock.RUnlock()
+	if ok {
+		if err := c.validateWeakHash([]byte(key+apiKey.SecureHash), weakHash); err != nil {
+			return nil, err
+		}
+	} else {
+		match, err := argon2id.ComparePasswordAndHash(key, apiKey.SecureHash)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, fmt.Errorf("invalid token")
+		}
+		c.weakHashLock.Lock()
+		c.memoryOnlyData.weakKeyStorageByIdentifier[apiKey.Identifier] = sha256.Sum256([]byte(key + apiKey.SecureHash))
+		c.weakHashLock.Unlock()
+	}
+
+	if !user.Active {
+		return nil, fmt.Errorf("user deactivated")
+	}
+	if _, ok := c.data.UserKeyRevoked[apiKey.UserId]; ok {
+		return nil, fmt.Errorf("key is revoked")
+	}
+	if Expired(apiKey.ExpiresAt, time.Now()) {
+		return nil, fmt.Errorf("key is expired")
+	}
+
+	if user.TryLock() {
+		user.LastUsedAt = time.Now()
+		user.Unlock()
+	}
+
+	return &models.Principal{
+		Username:          apiKey.UserId,
+		UserType:          models.UserTypeInputDb,
+		ScopedRoles:       apiKey.Roles,
+		ScopedCollections: apiKey.Collections,
+	}, nil
+}
+
 func (c *DBUser) validateWeakHash(key []byte, weakHash [32]byte) error {
 	keyHash := sha256.Sum256(key)
 	if subtle.ConstantTimeCompare(keyHash[:], weakHash[:]) != 1 {
@@ -452,6 +640,10 @@ func (c *DBUser) Restore(snapshot []byte) error {
 		return fmt.Errorf("invalid snapshot version")
 	}
 	c.data = snapshotRestore.Data
+	// snapshots of older versions don't contain additional keys
+	if c.data.ApiKeys == nil {
+		c.data.ApiKeys = make(map[string]*UserApiKey)
+	}
 
 	return nil
 }
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Client authenticates principals by the client certificate they presented
+// during the TLS handshake
+type Client struct {
+	Config config.MTLS
+}
+
+// New mTLS Client. The client is always created, if mTLS is disabled it
+// doesn't authenticate any connection.
+func New(cfg config.Config) (*Client, error) {
+	client := &Client{
+		Config: cfg.Authentication.MTLS,
+	}
+
+	if !client.Config.Enabled {
+		return client, nil
+	}
+
+	// the prefix is mandatory, without it a certificate could claim the roles
+	// of the OIDC user with the same name
+	if client.Config.UsernamePrefix == "" {
+		client.Config.UsernamePrefix = config.DefaultMTLSUsernamePrefix
+	}
+
+	switch client.Config.UsernameField {
+	case config.MTLSUsernameFieldCommonName, config.MTLSUsernameFieldDNSSAN,
+		config.MTLSUsernameFieldEmailSAN, config.MTLSUsernameFieldURISAN:
+	default:
+		return nil, fmt.Errorf("invalid config: unknown username_field '%s', must be one of '%s', '%s', '%s' or '%s'",
+			client.Config.UsernameField, config.MTLSUsernameFieldCommonName, config.MTLSUsernameFieldDNSSAN,
+			config.MTLSUsernameFieldEmailSAN, config.MTLSUsernameFieldURISAN)
+	}
+
+	return client, nil
+}
+
+// ValidateAndExtract returns the principal of the verified client certificate
+// of a TLS connection. It returns nil if mTLS is disabled or the connection
+// has no verified client certificate, so that the caller can fall back to
+// other authentication methods.
+func (c *Client) ValidateAndExtract(state *tls.ConnectionState) (*models.Principal, error) {
+	if !c.Config.Enabled || state == nil {
+		return nil, nil
+	}
+
+	// only certificates that were verified against the client CA can be used,
+	// unverified peer certificates are ignored
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+
+	username, err := c.username(state.VerifiedChains[0][0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Principal{
+		Username: c.Config.UsernamePrefix + username,
+		UserType: models.UserTypeInputOidc,
+	}, nil
+}
+
+func (c *Client) username(cert *x509.Certificate) (string, error) {
+	var username string
+	switch c.Config.UsernameField {
+	case config.MTLSUsernameFieldDNSSAN:
+		if len(cert.DNSNames) > 0 {
+			username = cert.DNSNames[0]
+		}
+	case config.MTLSUsernameFieldEmailSAN:
+		if len(cert.EmailAddresses) > 0 {
+			username = cert.EmailAddresses[0]
+		}
+	case config.MTLSUsernameFieldURISAN:
+		if len(cert.URIs) > 0 {
+			username = cert.URIs[0].String()
+		}
+	default:
+		username = cert.Subject.CommonName
+	}
+
+	if username == "" {
+		return "", fmt.Errorf("client certificate '%s' has no %s", cert.Subject, c.Config.UsernameField)
+	}
+	return username, nil
+}
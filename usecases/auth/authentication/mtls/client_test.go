@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newClient(t *testing.T, usernameField string) *Client {
+	client, err := New(config.Config{Authentication: config.Authentication{
+		MTLS: config.MTLS{Enabled: true, UsernameField: usernameField},
+	}})
+	require.Nil(t, err)
+	return client
+}
+
+func verified(cert *x509.Certificate) *tls.ConnectionState {
+	return &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+}
+
+func Test_MTLS(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "ingest-service"},
+		DNSNames:       []string{"ingest.mesh.local", "ingest"},
+		EmailAddresses: []string{"ingest@example.com"},
+		URIs:           []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/default/sa/ingest"}},
+	}
+
+	t.Run("username from certificate fields", func(t *testing.T) {
+		for field, expected := range map[string]string{
+			config.MTLSUsernameFieldCommonName: "ingest-service",
+			config.MTLSUsernameFieldDNSSAN:     "ingest.mesh.local",
+			config.MTLSUsernameFieldEmailSAN:   "ingest@example.com",
+			config.MTLSUsernameFieldURISAN:     "spiffe://cluster.local/ns/default/sa/ingest",
+		} {
+			principal, err := newClient(t, field).ValidateAndExtract(verified(cert))
+			require.Nil(t, err)
+			assert.Equal(t, &models.Principal{Username: "mtls:" + expected, UserType: models.UserTypeInputOidc}, principal)
+		}
+	})
+
+	t.Run("certificate without the username field", func(t *testing.T) {
+		_, err := newClient(t, config.MTLSUsernameFieldDNSSAN).
+			ValidateAndExtract(verified(&x509.Certificate{Subject: pkix.Name{CommonName: "foo"}}))
+		assert.ErrorContains(t, err, "dns_san")
+	})
+
+	t.Run("unverified certificate is ignored", func(t *testing.T) {
+		principal, err := newClient(t, config.MTLSUsernameFieldCommonName).
+			ValidateAndExtract(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}})
+		require.Nil(t, err)
+		assert.Nil(t, principal)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		client, err := New(config.Config{})
+		require.Nil(t, err)
+		principal, err := client.ValidateAndExtract(verified(cert))
+		require.Nil(t, err)
+		assert.Nil(t, principal)
+	})
+
+	t.Run("configured username prefix", func(t *testing.T) {
+		client, err := New(config.Config{Authentication: config.Authentication{
+			MTLS: config.MTLS{Enabled: true, UsernameField: config.MTLSUsernameFieldCommonName, UsernamePrefix: "cert/"},
+		}})
+		require.Nil(t, err)
+		principal, err := client.ValidateAndExtract(verified(cert))
+		require.Nil(t, err)
+		assert.Equal(t, "cert/ingest-service", principal.Username)
+	})
+
+	t.Run("invalid username field", func(t *testing.T) {
+		_, err := New(config.Config{Authentication: config.Authentication{
+			MTLS: config.MTLS{Enabled: true, UsernameField: "serial"},
+		}})
+		assert.ErrorContains(t, err, "unknown username_field")
+	})
+}
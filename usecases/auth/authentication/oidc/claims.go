@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package oidc
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// roleMapping is the compiled form of a config.OIDCRoleMapping
+type roleMapping struct {
+	claim string
+	match *regexp.Regexp
+	roles []string
+}
+
+func compileRoleMappings(mappings []config.OIDCRoleMapping) ([]roleMapping, error) {
+	compiled := make([]roleMapping, 0, len(mappings))
+	for i, mapping := range mappings {
+		if mapping.Match == "" {
+			return nil, fmt.Errorf("role mapping %d: missing required field 'match'", i)
+		}
+		if len(mapping.Roles) == 0 {
+			return nil, fmt.Errorf("role mapping %d: missing required field 'roles'", i)
+		}
+		match, err := regexp.Compile(mapping.Match)
+		if err != nil {
+			return nil, fmt.Errorf("role mapping %d: invalid regular expression: %w", i, err)
+		}
+		compiled = append(compiled, roleMapping{claim: mapping.Claim, match: match, roles: mapping.Roles})
+	}
+	return compiled, nil
+}
+
+// extractRoles applies the role mapping rules to the claims of a token. Rules
+// without a claim are matched against the groups of the principal.
+func (c *Client) extractRoles(claims map[string]interface{}, groups []string) []string {
+	var roles []string
+	for _, mapping := range c.roleMappings {
+		values := groups
+		if mapping.claim != "" {
+			values = claimValues(claims[mapping.claim])
+		}
+
+		if !slices.ContainsFunc(values, mapping.match.MatchString) {
+			continue
+		}
+		for _, role := range mapping.roles {
+			if !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// extractTenant returns the tenant the principal is bound to. If a tenant
+// claim is configured, every token needs to contain it, otherwise a token
+// without the claim would grant access to all tenants.
+func (c *Client) extractTenant(claims map[string]interface{}) (string, error) {
+	tenantClaim := c.Config.TenantClaim.Get()
+	if tenantClaim == "" {
+		return "", nil
+	}
+
+	tenantUntyped, ok := claims[tenantClaim]
+	if !ok {
+		return "", fmt.Errorf("token doesn't contain required claim '%s'", tenantClaim)
+	}
+
+	tenant, ok := tenantUntyped.(string)
+	if !ok || tenant == "" {
+		return "", fmt.Errorf("claim '%s' is not a non-empty string, but %T", tenantClaim, tenantUntyped)
+	}
+
+	return tenant, nil
+}
+
+// claimValues flattens a claim into the list of its string representations,
+// a claim can either be a single value or a list of values.
+func claimValues(claim interface{}) []string {
+	switch typed := claim.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{typed}
+	case []interface{}:
+		values := make([]string, 0, len(typed))
+		for _, untyped := range typed {
+			if untyped != nil {
+				values = append(values, fmt.Sprint(untyped))
+			}
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(typed)}
+	}
+}
@@ -33,9 +33,10 @@ import (
 // Client handles the OIDC setup at startup and provides a middleware to be
 // used with the goswagger API
 type Client struct {
-	Config   config.OIDC
-	provider *oidc.Provider
-	verifier *oidc.IDTokenVerifier
+	Config       config.OIDC
+	provider     *oidc.Provider
+	verifier     *oidc.IDTokenVerifier
+	roleMappings []roleMapping
 }
 
 // New OIDC Client: It tries to retrieve the JWKs at startup (or fails), it
@@ -65,6 +66,12 @@ func (c *Client) Init() error {
 		return fmt.Errorf("invalid config: %w", err)
 	}
 
+	roleMappings, err := compileRoleMappings(c.Config.RoleMappings)
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	c.roleMappings = roleMappings
+
 	ctx := context.Background()
 	if c.Config.Certificate.Get() != "" {
 		client, err := c.useCertificate()
@@ -137,9 +144,16 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 
 	groups := c.extractGroups(claims)
 
+	tenant, err := c.extractTenant(claims)
+	if err != nil {
+		return nil, errors.New(401, "unauthorized: %v", err)
+	}
+
 	return &models.Principal{
 		Username: username,
 		Groups:   groups,
+		Roles:    c.extractRoles(claims, groups),
+		Tenant:   tenant,
 		UserType: models.UserTypeInputOidc,
 	}, nil
 }
@@ -60,8 +60,10 @@ func Test_Middleware_IncompleteConfiguration(t *testing.T) {
 
 type claims struct {
 	jwt.StandardClaims
-	Email  string   `json:"email"`
-	Groups []string `json:"groups"`
+	Email      string   `json:"email"`
+	Groups     []string `json:"groups"`
+	TenantID   string   `json:"tenant_id,omitempty"`
+	Department string   `json:"department,omitempty"`
 }
 
 func Test_Middleware_WithValidToken(t *testing.T) {
@@ -144,6 +146,95 @@ func Test_Middleware_WithValidToken(t *testing.T) {
 	})
 }
 
+func Test_Middleware_RoleMappingAndTenantBinding(t *testing.T) {
+	server := newOIDCServer(t)
+	defer server.Close()
+
+	newClient := func(t *testing.T, tenantClaim string, mappings ...config.OIDCRoleMapping) *Client {
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				OIDC: config.OIDC{
+					Enabled:           true,
+					Issuer:            runtime.NewDynamicValue(server.URL),
+					ClientID:          runtime.NewDynamicValue("best_client"),
+					SkipClientIDCheck: runtime.NewDynamicValue(false),
+					UsernameClaim:     runtime.NewDynamicValue("sub"),
+					GroupsClaim:       runtime.NewDynamicValue("groups"),
+					TenantClaim:       runtime.NewDynamicValue(tenantClaim),
+					RoleMappings:      mappings,
+				},
+			},
+		}
+		client, err := New(cfg)
+		require.Nil(t, err)
+		return client
+	}
+
+	t.Run("roles mapped from groups", func(t *testing.T) {
+		client := newClient(t, "",
+			config.OIDCRoleMapping{Match: "^admins$", Roles: []string{"admin"}},
+			config.OIDCRoleMapping{Match: "^team-.*", Roles: []string{"viewer", "admin"}},
+			config.OIDCRoleMapping{Match: "^nobody$", Roles: []string{"unused"}},
+		)
+
+		token := tokenWithGroups(t, "best-user", server.URL, "best_client", []string{"admins", "team-a"})
+		principal, err := client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"admin", "viewer"}, principal.Roles)
+		assert.Empty(t, principal.Tenant)
+	})
+
+	t.Run("roles mapped from a custom claim", func(t *testing.T) {
+		client := newClient(t, "",
+			config.OIDCRoleMapping{Claim: "department", Match: "^research$", Roles: []string{"researcher"}},
+		)
+
+		token := tokenWithClaims(t, "best-user", server.URL, "best_client", claims{Department: "research"})
+		principal, err := client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"researcher"}, principal.Roles)
+
+		token = tokenWithClaims(t, "best-user", server.URL, "best_client", claims{Department: "sales"})
+		principal, err = client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Empty(t, principal.Roles)
+	})
+
+	t.Run("tenant bound by claim", func(t *testing.T) {
+		client := newClient(t, "tenant_id")
+
+		token := tokenWithClaims(t, "best-user", server.URL, "best_client", claims{TenantID: "tenantA"})
+		principal, err := client.ValidateAndExtract(token, []string{})
+		require.Nil(t, err)
+		assert.Equal(t, "tenantA", principal.Tenant)
+	})
+
+	t.Run("tenant claim missing", func(t *testing.T) {
+		client := newClient(t, "tenant_id")
+
+		token := token(t, "best-user", server.URL, "best_client")
+		_, err := client.ValidateAndExtract(token, []string{})
+		assert.ErrorContains(t, err, "tenant_id")
+	})
+
+	t.Run("invalid role mapping", func(t *testing.T) {
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				OIDC: config.OIDC{
+					Enabled:           true,
+					Issuer:            runtime.NewDynamicValue(server.URL),
+					ClientID:          runtime.NewDynamicValue("best_client"),
+					SkipClientIDCheck: runtime.NewDynamicValue(false),
+					UsernameClaim:     runtime.NewDynamicValue("sub"),
+					RoleMappings:      []config.OIDCRoleMapping{{Match: "(", Roles: []string{"admin"}}},
+				},
+			},
+		}
+		_, err := New(cfg)
+		assert.ErrorContains(t, err, "invalid regular expression")
+	})
+}
+
 func token(t *testing.T, subject string, issuer string, aud string) string {
 	return tokenWithEmail(t, subject, issuer, aud, "")
 }
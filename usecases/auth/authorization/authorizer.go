@@ -29,6 +29,31 @@ type Authorizer interface {
 	FilterAuthorizedResources(ctx context.Context, principal *models.Principal, verb string, resources ...string) ([]string, error)
 }
 
+// DataFilterer is implemented by authorizers which can restrict read access
+// to the objects and properties of a collection, see DataRestriction.
+type DataFilterer interface {
+	// ReadDataFilters returns the filters of which an object needs to match at
+	// least one to be readable by the principal, nil if reads are unrestricted
+	ReadDataFilters(ctx context.Context, principal *models.Principal, collection, tenant string) ([]*models.WhereFilter, error)
+	// ReadDataFiltered is true if any of the principal's read_data permissions,
+	// in whichever collection, is restricted by a filter
+	ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error)
+	// ReadDataProperties returns the properties which are readable by the
+	// principal, nil if all properties are readable
+	ReadDataProperties(ctx context.Context, principal *models.Principal, collection, tenant string) (PropertyAccess, error)
+}
+
+// DataRestrictionAuthorizer is implemented by authorizers which support
+// restricted read_data permissions, see DataFilterer. Granting such a
+// permission requires the granting principal to hold at least the same
+// access.
+type DataRestrictionAuthorizer interface {
+	// AuthorizeDataRestriction returns an error unless the principal's own
+	// read_data permissions on the resource cover the restriction, nil meaning
+	// unrestricted access
+	AuthorizeDataRestriction(ctx context.Context, principal *models.Principal, resource string, restriction *DataRestriction) error
+}
+
 // DummyAuthorizer is a pluggable Authorizer which can be used if no specific
 // authorizer is configured. It will allow every auth decision, i.e. it is
 // effectively the same as "no authorization at all"
@@ -12,6 +12,8 @@
 package conv
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -204,6 +206,38 @@ func extractFromExtAction(inputAction string) (string, string, error) {
 	return verb, domain, nil
 }
 
+// dataDomainWithRestriction encodes a data restriction into the domain of a
+// data policy, e.g. data:eyJmaWx0ZXIiOnsi... The domain is not used for
+// matching, casbin therefore still grants access to the collection and the
+// restriction is applied on top, see DataRestrictionFromDomain.
+func dataDomainWithRestriction(restriction *authorization.DataRestriction) (string, error) {
+	b, err := json.Marshal(restriction)
+	if err != nil {
+		return "", fmt.Errorf("marshal data restriction: %w", err)
+	}
+	return authorization.DataDomain + PREFIX_SEPARATOR + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DataRestrictionFromDomain splits a policy domain into the plain domain and
+// the restriction of a data policy. The restriction is nil if the policy is
+// not restricted.
+func DataRestrictionFromDomain(domain string) (string, *authorization.DataRestriction, error) {
+	encoded, ok := strings.CutPrefix(domain, authorization.DataDomain+PREFIX_SEPARATOR)
+	if !ok {
+		return domain, nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, fmt.Errorf("decode data restriction: %w", err)
+	}
+	restriction := &authorization.DataRestriction{}
+	if err := json.Unmarshal(b, restriction); err != nil {
+		return "", nil, fmt.Errorf("unmarshal data restriction: %w", err)
+	}
+	return authorization.DataDomain, restriction, nil
+}
+
 // casbinPolicyDomains decouples the endpoints domains
 // from the casbin internal domains.
 // e.g.
@@ -228,6 +262,7 @@ func policy(permission *models.Permission) (*authorization.Policy, error) {
 	}
 
 	var resource string
+	policyDomain := casbinPolicyDomains(domain)
 	switch domain {
 	case authorization.UsersDomain:
 		user := "*"
@@ -284,6 +319,22 @@ func policy(permission *models.Permission) (*authorization.Policy, error) {
 			object = *permission.Data.Object
 		}
 		resource = CasbinData(collection, tenant, object)
+		if permission.Data != nil {
+			restriction := &authorization.DataRestriction{
+				Filter:             permission.Data.Filter,
+				Properties:         permission.Data.Properties,
+				ExcludedProperties: permission.Data.ExcludedProperties,
+			}
+			if !restriction.Empty() {
+				if verb != authorization.READ {
+					return nil, fmt.Errorf("data filters and properties are only supported for %s", authorization.ReadData)
+				}
+				policyDomain, err = dataDomainWithRestriction(restriction)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 	case authorization.BackupsDomain:
 		collection := "*"
 		if permission.Backups != nil {
@@ -339,7 +390,7 @@ func policy(permission *models.Permission) (*authorization.Policy, error) {
 	return &authorization.Policy{
 		Resource: resource,
 		Verb:     verb,
-		Domain:   casbinPolicyDomains(domain),
+		Domain:   policyDomain,
 	}, nil
 }
 
@@ -373,6 +424,12 @@ func permission(policy []string, validatePath bool) (*models.Permission, error)
 		return nil, fmt.Errorf("invalid verb: %s", mapped.Verb)
 	}
 
+	domain, restriction, err := DataRestrictionFromDomain(mapped.Domain)
+	if err != nil {
+		return nil, err
+	}
+	mapped.Domain = domain
+
 	permission := &models.Permission{}
 
 	splits := strings.Split(mapped.Resource, "/")
@@ -400,6 +457,11 @@ func permission(policy []string, validatePath bool) (*models.Permission, error)
 			Tenant:     &splits[4],
 			Object:     &splits[6],
 		}
+		if restriction != nil {
+			permission.Data.Filter = restriction.Filter
+			permission.Data.Properties = restriction.Properties
+			permission.Data.ExcludedProperties = restriction.ExcludedProperties
+		}
 	case authorization.RolesDomain:
 		permission.Roles = &models.PermissionRoles{
 			Role: &splits[1],
@@ -869,6 +869,80 @@ func Test_permission(t *testing.T) {
 	}
 }
 
+func Test_dataRestriction(t *testing.T) {
+	filter := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"department"},
+		ValueText: authorization.String("legal"),
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		perm := &models.Permission{
+			Action: authorization.String(authorization.ReadData),
+			Data: &models.PermissionData{
+				Collection: foo,
+				Tenant:     authorization.All,
+				Object:     authorization.All,
+				Filter:     filter,
+			},
+		}
+		p, err := policy(perm)
+		require.Nil(t, err)
+		require.Equal(t, CasbinData("Foo", "*", "*"), p.Resource)
+		require.Equal(t, authorization.READ, p.Verb)
+		require.NotEqual(t, authorization.DataDomain, p.Domain)
+
+		domain, decoded, err := DataRestrictionFromDomain(p.Domain)
+		require.Nil(t, err)
+		require.Equal(t, authorization.DataDomain, domain)
+		require.Equal(t, filter, decoded.Filter)
+
+		back, err := permission([]string{"p", p.Resource, p.Verb, p.Domain}, true)
+		require.Nil(t, err)
+		require.Equal(t, perm, back)
+	})
+
+	t.Run("only for read_data", func(t *testing.T) {
+		_, err := policy(&models.Permission{
+			Action: authorization.String(authorization.DeleteData),
+			Data:   &models.PermissionData{Collection: foo, Filter: filter},
+		})
+		require.ErrorContains(t, err, "only supported for read_data")
+
+		_, err = policy(&models.Permission{
+			Action: authorization.String(authorization.UpdateData),
+			Data:   &models.PermissionData{Collection: foo, Properties: []string{"name"}},
+		})
+		require.ErrorContains(t, err, "only supported for read_data")
+	})
+
+	t.Run("properties round trip", func(t *testing.T) {
+		perm := &models.Permission{
+			Action: authorization.String(authorization.ReadData),
+			Data: &models.PermissionData{
+				Collection:         foo,
+				Tenant:             authorization.All,
+				Object:             authorization.All,
+				Properties:         []string{"name", "address"},
+				ExcludedProperties: []string{"address.street"},
+			},
+		}
+		p, err := policy(perm)
+		require.Nil(t, err)
+
+		back, err := permission([]string{"p", p.Resource, p.Verb, p.Domain}, true)
+		require.Nil(t, err)
+		require.Equal(t, perm, back)
+	})
+
+	t.Run("plain domain", func(t *testing.T) {
+		domain, decoded, err := DataRestrictionFromDomain(authorization.DataDomain)
+		require.Nil(t, err)
+		require.Equal(t, authorization.DataDomain, domain)
+		require.Nil(t, decoded)
+	})
+}
+
 func Test_pUsers(t *testing.T) {
 	tests := []struct {
 		user     string
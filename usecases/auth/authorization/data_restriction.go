@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DataRestriction narrows down a read_data permission. Objects have to match
+// the filter and only the listed properties are returned.
+type DataRestriction struct {
+	Filter             *models.WhereFilter `json:"filter,omitempty"`
+	Properties         []string            `json:"properties,omitempty"`
+	ExcludedProperties []string            `json:"excludedProperties,omitempty"`
+}
+
+// Empty is true if the restriction does not narrow down the permission
+func (r *DataRestriction) Empty() bool {
+	return r == nil || (r.Filter == nil && !r.RestrictsProperties())
+}
+
+// RestrictsProperties is true if not all properties are readable
+func (r *DataRestriction) RestrictsProperties() bool {
+	return r != nil && (len(r.Properties) > 0 || len(r.ExcludedProperties) > 0)
+}
+
+// Covers is true if the restriction grants at least the access of other,
+// i.e. every object and property readable with other is readable with r. A
+// nil restriction grants full access. Filters are compared structurally, a
+// filter covers equal filters and conjunctions containing it.
+func (r *DataRestriction) Covers(other *DataRestriction) bool {
+	if r.Empty() {
+		return true
+	}
+	if other == nil {
+		other = &DataRestriction{}
+	}
+	if !filterImplies(other.Filter, r.Filter) {
+		return false
+	}
+	if !r.RestrictsProperties() {
+		return true
+	}
+	if len(other.Properties) == 0 {
+		return false
+	}
+	access := PropertyAccess{*r}
+	for _, p := range other.Properties {
+		if !excludes(other.ExcludedProperties, p) && !access.Allowed(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterImplies is true if every object matching filter also matches other.
+// A nil filter matches every object. Only structural implications are
+// recognized, which errs on the side of denying access.
+func filterImplies(filter, other *models.WhereFilter) bool {
+	if other == nil {
+		return true
+	}
+	if filter == nil {
+		return false
+	}
+	if reflect.DeepEqual(filter, other) {
+		return true
+	}
+	if filter.Operator == models.WhereFilterOperatorAnd {
+		for _, operand := range filter.Operands {
+			if filterImplies(operand, other) {
+				return true
+			}
+		}
+	}
+	if other.Operator == models.WhereFilterOperatorOr {
+		for _, operand := range other.Operands {
+			if filterImplies(filter, operand) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PropertyAccess describes which properties of a collection a principal may
+// read. It consists of the property restrictions of all the principal's
+// read_data permissions on the collection, a property is readable if any of
+// them allows it. Paths of nested properties are separated by dots, e.g.
+// address.street.
+type PropertyAccess []DataRestriction
+
+// Visible is true if the property or some of its nested properties may be read
+func (a PropertyAccess) Visible(path string) bool {
+	for _, r := range a {
+		if excludes(r.ExcludedProperties, path) {
+			continue
+		}
+		if len(r.Properties) == 0 {
+			return true
+		}
+		for _, p := range r.Properties {
+			if p == path || isNestedPath(path, p) || isNestedPath(p, path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Allowed is true if the property including all its nested properties may be
+// read
+func (a PropertyAccess) Allowed(path string) bool {
+	for _, r := range a {
+		if excludes(r.ExcludedProperties, path) || excludesNested(r.ExcludedProperties, path) {
+			continue
+		}
+		if len(r.Properties) == 0 {
+			return true
+		}
+		for _, p := range r.Properties {
+			if p == path || isNestedPath(path, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func excludes(excluded []string, path string) bool {
+	for _, e := range excluded {
+		if e == path || isNestedPath(path, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func excludesNested(excluded []string, path string) bool {
+	for _, e := range excluded {
+		if isNestedPath(e, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNestedPath is true if path is nested below parent
+func isNestedPath(path, parent string) bool {
+	return strings.HasPrefix(path, parent+".")
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestPropertyAccess(t *testing.T) {
+	access := PropertyAccess{
+		{Properties: []string{"name", "address.city", "contact"}, ExcludedProperties: []string{"contact.phone"}},
+	}
+
+	tests := []struct {
+		path    string
+		visible bool
+		allowed bool
+	}{
+		{path: "name", visible: true, allowed: true},
+		{path: "ssn", visible: false, allowed: false},
+		{path: "address", visible: true, allowed: false},
+		{path: "address.city", visible: true, allowed: true},
+		{path: "address.street", visible: false, allowed: false},
+		{path: "contact", visible: true, allowed: false},
+		{path: "contact.email", visible: true, allowed: true},
+		{path: "contact.phone", visible: false, allowed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.visible, access.Visible(tt.path))
+			assert.Equal(t, tt.allowed, access.Allowed(tt.path))
+		})
+	}
+
+	t.Run("rules are combined", func(t *testing.T) {
+		combined := append(PropertyAccess{{ExcludedProperties: []string{"name"}}}, access...)
+		assert.True(t, combined.Allowed("ssn"))
+		assert.True(t, combined.Allowed("name"))
+		assert.True(t, combined.Allowed("contact.phone"))
+	})
+
+	t.Run("empty restriction", func(t *testing.T) {
+		var r *DataRestriction
+		assert.True(t, r.Empty())
+		assert.False(t, r.RestrictsProperties())
+		assert.False(t, (&DataRestriction{Properties: []string{"name"}}).Empty())
+	})
+}
+
+func TestDataRestrictionCovers(t *testing.T) {
+	legal := &models.WhereFilter{Operator: "Equal", Path: []string{"department"}, ValueText: String("legal")}
+	recent := &models.WhereFilter{Operator: "Equal", Path: []string{"status"}, ValueText: String("recent")}
+	and := &models.WhereFilter{Operator: "And", Operands: []*models.WhereFilter{recent, legal}}
+	or := &models.WhereFilter{Operator: "Or", Operands: []*models.WhereFilter{recent, legal}}
+
+	tests := []struct {
+		name   string
+		r      *DataRestriction
+		other  *DataRestriction
+		covers bool
+	}{
+		{name: "unrestricted covers all", r: nil, other: nil, covers: true},
+		{name: "filtered does not cover unrestricted", r: &DataRestriction{Filter: legal}, other: nil, covers: false},
+		{name: "equal filters", r: &DataRestriction{Filter: legal}, other: &DataRestriction{Filter: legal}, covers: true},
+		{name: "conjunction", r: &DataRestriction{Filter: legal}, other: &DataRestriction{Filter: and}, covers: true},
+		{name: "disjunction", r: &DataRestriction{Filter: legal}, other: &DataRestriction{Filter: or}, covers: false},
+		{name: "covered by disjunction", r: &DataRestriction{Filter: or}, other: &DataRestriction{Filter: legal}, covers: true},
+		{name: "other filter", r: &DataRestriction{Filter: legal}, other: &DataRestriction{Filter: recent}, covers: false},
+		{
+			name:   "all properties",
+			r:      &DataRestriction{Properties: []string{"name"}},
+			other:  &DataRestriction{},
+			covers: false,
+		},
+		{
+			name:   "fewer properties",
+			r:      &DataRestriction{Properties: []string{"name", "address"}},
+			other:  &DataRestriction{Properties: []string{"address.city"}},
+			covers: true,
+		},
+		{
+			name:   "excluded property",
+			r:      &DataRestriction{ExcludedProperties: []string{"ssn"}},
+			other:  &DataRestriction{Properties: []string{"name", "ssn"}},
+			covers: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.covers, tt.r.Covers(tt.other))
+		})
+	}
+}
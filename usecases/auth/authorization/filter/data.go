@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ReadData returns the filter which restricts the objects the principal is
+// allowed to read in the given class and tenant. It returns nil if the
+// authorizer does not restrict reads with filters or the principal may read
+// every object.
+func ReadData(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal, class, tenant string,
+) (*filters.LocalFilter, error) {
+	filterer, ok := authorizer.(authorization.DataFilterer)
+	if !ok || principal == nil {
+		return nil, nil
+	}
+
+	where, err := filterer.ReadDataFilters(ctx, principal, class, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("read data filters: %w", err)
+	}
+	if len(where) == 0 {
+		return nil, nil
+	}
+
+	operands := make([]filters.Clause, 0, len(where))
+	for _, w := range where {
+		parsed, err := filterext.Parse(w, class)
+		if err != nil {
+			return nil, fmt.Errorf("read data filter: %w", err)
+		}
+		operands = append(operands, *parsed.Root)
+	}
+	if len(operands) == 1 {
+		return &filters.LocalFilter{Root: &operands[0]}, nil
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorOr,
+		Operands: operands,
+	}}, nil
+}
+
+// ReadDataFiltered is true if the principal's reads are restricted by a
+// filter in any collection, see authorization.DataFilterer
+func ReadDataFiltered(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal,
+) (bool, error) {
+	filterer, ok := authorizer.(authorization.DataFilterer)
+	if !ok || principal == nil {
+		return false, nil
+	}
+	filtered, err := filterer.ReadDataFiltered(ctx, principal)
+	if err != nil {
+		return false, fmt.Errorf("read data filters: %w", err)
+	}
+	return filtered, nil
+}
+
+// WithReadData restricts the given filter to the objects the principal is
+// allowed to read, see ReadData. The filter may be nil.
+func WithReadData(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal, class, tenant string, filter *filters.LocalFilter,
+) (*filters.LocalFilter, error) {
+	restriction, err := ReadData(ctx, authorizer, principal, class, tenant)
+	if err != nil || restriction == nil {
+		return filter, err
+	}
+	return And(filter, restriction), nil
+}
+
+// And combines two filters, either of which may be nil
+func And(a, b *filters.LocalFilter) *filters.LocalFilter {
+	if a == nil || a.Root == nil {
+		return b
+	}
+	if b == nil || b.Root == nil {
+		return a
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{*a.Root, *b.Root},
+	}}
+}
+
+// WithReferenceReadData attaches the principal's read_data restrictions of
+// referenced classes to every reference hop of the filter, so that objects
+// are only matched through references the principal is allowed to read.
+// The filter is modified in place and may be nil.
+func WithReferenceReadData(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal, tenant string, filter *filters.LocalFilter,
+) error {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+	return withReferenceReadData(ctx, authorizer, principal, tenant, filter.Root)
+}
+
+func withReferenceReadData(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal, tenant string, clause *filters.Clause,
+) error {
+	for i := range clause.Operands {
+		if err := withReferenceReadData(ctx, authorizer, principal, tenant, &clause.Operands[i]); err != nil {
+			return err
+		}
+	}
+	if clause.On == nil {
+		return nil
+	}
+	for path := clause.On; path.Child != nil; path = path.Child {
+		restriction, err := ReadData(ctx, authorizer, principal, path.Child.Class.String(), tenant)
+		if err != nil {
+			return err
+		}
+		if restriction != nil {
+			path.Child.Restriction = restriction.Root
+		}
+	}
+	return nil
+}
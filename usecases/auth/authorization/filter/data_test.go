@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+type fakeDataFilterer struct {
+	*mocks.FakeAuthorizer
+	filters    []*models.WhereFilter
+	properties map[string]authorization.PropertyAccess
+}
+
+func (f *fakeDataFilterer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return f.filters, nil
+}
+
+func (f *fakeDataFilterer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return len(f.filters) > 0, nil
+}
+
+func (f *fakeDataFilterer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return f.properties[collection], nil
+}
+
+func TestReadData(t *testing.T) {
+	ctx := context.Background()
+	principal := &models.Principal{Username: "test-user"}
+	department := func(value string) *models.WhereFilter {
+		return &models.WhereFilter{
+			Operator:  "Equal",
+			Path:      []string{"department"},
+			ValueText: authorization.String(value),
+		}
+	}
+	userFilter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: "Documents", Property: "title"},
+		Value:    &filters.Value{Value: "contract", Type: "text"},
+	}}
+
+	t.Run("authorizer without data filters", func(t *testing.T) {
+		f, err := WithReadData(ctx, mocks.NewMockAuthorizer(), principal, "Documents", "", userFilter)
+		require.NoError(t, err)
+		assert.Equal(t, userFilter, f)
+	})
+
+	t.Run("unrestricted", func(t *testing.T) {
+		authorizer := &fakeDataFilterer{FakeAuthorizer: mocks.NewMockAuthorizer()}
+		f, err := WithReadData(ctx, authorizer, principal, "Documents", "", userFilter)
+		require.NoError(t, err)
+		assert.Equal(t, userFilter, f)
+	})
+
+	t.Run("single filter", func(t *testing.T) {
+		authorizer := &fakeDataFilterer{
+			FakeAuthorizer: mocks.NewMockAuthorizer(),
+			filters:        []*models.WhereFilter{department("legal")},
+		}
+		f, err := ReadData(ctx, authorizer, principal, "Documents", "")
+		require.NoError(t, err)
+		require.NotNil(t, f)
+		assert.Equal(t, filters.OperatorEqual, f.Root.Operator)
+		assert.Equal(t, "legal", f.Root.Value.Value)
+
+		f, err = WithReadData(ctx, authorizer, principal, "Documents", "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, filters.OperatorEqual, f.Root.Operator)
+	})
+
+	t.Run("filters of several roles are combined with or", func(t *testing.T) {
+		authorizer := &fakeDataFilterer{
+			FakeAuthorizer: mocks.NewMockAuthorizer(),
+			filters:        []*models.WhereFilter{department("legal"), department("sales")},
+		}
+		f, err := WithReadData(ctx, authorizer, principal, "Documents", "", userFilter)
+		require.NoError(t, err)
+		require.Equal(t, filters.OperatorAnd, f.Root.Operator)
+		require.Len(t, f.Root.Operands, 2)
+		assert.Equal(t, *userFilter.Root, f.Root.Operands[0])
+
+		restriction := f.Root.Operands[1]
+		assert.Equal(t, filters.OperatorOr, restriction.Operator)
+		require.Len(t, restriction.Operands, 2)
+		assert.Equal(t, "legal", restriction.Operands[0].Value.Value)
+		assert.Equal(t, "sales", restriction.Operands[1].Value.Value)
+	})
+}
+
+func TestWithReferenceReadData(t *testing.T) {
+	ctx := context.Background()
+	principal := &models.Principal{Username: "test-user"}
+	refFilter := func() *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class: "Documents", Property: "author",
+						Child: &filters.Path{Class: "Authors", Property: "name"},
+					},
+					Value: &filters.Value{Value: "alice", Type: "text"},
+				},
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Documents", Property: "title"},
+					Value:    &filters.Value{Value: "contract", Type: "text"},
+				},
+			},
+		}}
+	}
+
+	t.Run("unrestricted", func(t *testing.T) {
+		authorizer := &fakeDataFilterer{FakeAuthorizer: mocks.NewMockAuthorizer()}
+		f := refFilter()
+		require.NoError(t, WithReferenceReadData(ctx, authorizer, principal, "", f))
+		assert.Equal(t, refFilter(), f)
+	})
+
+	t.Run("restricted", func(t *testing.T) {
+		authorizer := &fakeDataFilterer{
+			FakeAuthorizer: mocks.NewMockAuthorizer(),
+			filters: []*models.WhereFilter{{
+				Operator:  "Equal",
+				Path:      []string{"department"},
+				ValueText: authorization.String("legal"),
+			}},
+		}
+		f := refFilter()
+		require.NoError(t, WithReferenceReadData(ctx, authorizer, principal, "", f))
+
+		ref := f.Root.Operands[0].On
+		assert.Nil(t, ref.Restriction)
+		require.NotNil(t, ref.Child.Restriction)
+		assert.Equal(t, schema.ClassName("Authors"), ref.Child.Restriction.On.Class)
+		assert.Equal(t, schema.PropertyName("department"), ref.Child.Restriction.On.Property)
+		assert.Nil(t, f.Root.Operands[1].On.Restriction)
+	})
+
+	t.Run("nil filter", func(t *testing.T) {
+		require.NoError(t, WithReferenceReadData(ctx, mocks.NewMockAuthorizer(), principal, "", nil))
+	})
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// PropertyMask hides the properties a principal is not allowed to read from
+// results and rejects requests which use them, see
+// authorization.PropertyAccess. The access of every class is only looked up
+// once, a mask should therefore not outlive a request.
+type PropertyMask struct {
+	ctx       context.Context
+	filterer  authorization.DataFilterer
+	principal *models.Principal
+	tenant    string
+	access    map[string]authorization.PropertyAccess
+}
+
+func NewPropertyMask(ctx context.Context, authorizer authorization.Authorizer,
+	principal *models.Principal, tenant string,
+) *PropertyMask {
+	m := &PropertyMask{
+		ctx:       ctx,
+		principal: principal,
+		tenant:    tenant,
+		access:    map[string]authorization.PropertyAccess{},
+	}
+	if filterer, ok := authorizer.(authorization.DataFilterer); ok && principal != nil {
+		m.filterer = filterer
+	}
+	return m
+}
+
+// Access returns the properties of the class the principal may read, nil if
+// all properties are readable
+func (m *PropertyMask) Access(class string) (authorization.PropertyAccess, error) {
+	if m.filterer == nil {
+		return nil, nil
+	}
+	if access, ok := m.access[class]; ok {
+		return access, nil
+	}
+
+	access, err := m.filterer.ReadDataProperties(m.ctx, m.principal, class, m.tenant)
+	if err != nil {
+		return nil, fmt.Errorf("read data properties: %w", err)
+	}
+	m.access[class] = access
+	return access, nil
+}
+
+// Check returns a forbidden error if any of the properties may not be read.
+// Properties can be given the way they are used in queries, e.g. title^2 or
+// len(title).
+func (m *PropertyMask) Check(class string, properties ...string) error {
+	access, err := m.Access(class)
+	if err != nil || access == nil {
+		return err
+	}
+
+	for _, prop := range properties {
+		prop, _, _ = strings.Cut(prop, "^")
+		if name, ok := schema.IsPropertyLength(prop, 0); ok {
+			prop = name
+		}
+		if prop == "" || prop == "id" || strings.HasPrefix(prop, "_") {
+			continue
+		}
+		if !access.Allowed(prop) {
+			return errors.NewForbidden(m.principal, authorization.ReadData,
+				fmt.Sprintf("property %s.%s", class, prop))
+		}
+	}
+	return nil
+}
+
+// CheckFilter returns a forbidden error if the filter uses properties which
+// may not be read, including the properties of referenced classes
+func (m *PropertyMask) CheckFilter(filter *filters.LocalFilter) error {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+	return m.checkClause(filter.Root)
+}
+
+func (m *PropertyMask) checkClause(clause *filters.Clause) error {
+	for i := range clause.Operands {
+		if err := m.checkClause(&clause.Operands[i]); err != nil {
+			return err
+		}
+	}
+	for path := clause.On; path != nil; path = path.Child {
+		if err := m.Check(path.Class.String(), path.Property.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Prune removes the properties which may not be read from the properties of
+// an object of the class. Referenced objects and the hits of groups are
+// pruned as well.
+func (m *PropertyMask) Prune(class string, props map[string]interface{}) error {
+	if m.filterer == nil || props == nil {
+		return nil
+	}
+	access, err := m.Access(class)
+	if err != nil {
+		return err
+	}
+	return m.prune(class, access, "", props)
+}
+
+func (m *PropertyMask) prune(class string, access authorization.PropertyAccess,
+	prefix string, props map[string]interface{},
+) error {
+	for name, value := range props {
+		if name == "_additional" {
+			if err := m.pruneAdditional(class, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		path := prefix + name
+		if access != nil && !access.Visible(path) {
+			delete(props, name)
+			continue
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := m.prune(class, access, path+".", v); err != nil {
+				return err
+			}
+		case []interface{}:
+			for _, item := range v {
+				switch item := item.(type) {
+				case search.LocalRef:
+					if err := m.Prune(item.Class, item.Fields); err != nil {
+						return err
+					}
+				case map[string]interface{}:
+					if err := m.prune(class, access, path+".", item); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (m *PropertyMask) pruneAdditional(class string, value interface{}) error {
+	var group interface{}
+	switch v := value.(type) {
+	case models.AdditionalProperties:
+		group = v["group"]
+	case map[string]interface{}:
+		group = v["group"]
+	}
+
+	g, ok := group.(*additional.Group)
+	if !ok || g == nil {
+		return nil
+	}
+	for _, hit := range g.Hits {
+		if err := m.Prune(class, hit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
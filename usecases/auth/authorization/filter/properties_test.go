@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+func TestPropertyMask(t *testing.T) {
+	ctx := context.Background()
+	principal := &models.Principal{Username: "test-user"}
+	authorizer := &fakeDataFilterer{
+		FakeAuthorizer: mocks.NewMockAuthorizer(),
+		properties: map[string]authorization.PropertyAccess{
+			"Person":  {{ExcludedProperties: []string{"ssn", "address.street"}}},
+			"Company": {{Properties: []string{"name"}}},
+		},
+	}
+
+	t.Run("prune", func(t *testing.T) {
+		mask := NewPropertyMask(ctx, authorizer, principal, "")
+		props := map[string]interface{}{
+			"name": "Jane",
+			"ssn":  "123",
+			"address": map[string]interface{}{
+				"street": "Main Street",
+				"city":   "Amsterdam",
+			},
+			"employer": []interface{}{
+				search.LocalRef{Class: "Company", Fields: map[string]interface{}{
+					"name":    "ACME",
+					"revenue": 100,
+				}},
+			},
+			"_additional": models.AdditionalProperties{"distance": 0.1},
+		}
+		require.NoError(t, mask.Prune("Person", props))
+
+		assert.Equal(t, map[string]interface{}{
+			"name": "Jane",
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+			},
+			"employer": []interface{}{
+				search.LocalRef{Class: "Company", Fields: map[string]interface{}{
+					"name": "ACME",
+				}},
+			},
+			"_additional": models.AdditionalProperties{"distance": 0.1},
+		}, props)
+	})
+
+	t.Run("prune group hits", func(t *testing.T) {
+		mask := NewPropertyMask(ctx, authorizer, principal, "")
+		hit := map[string]interface{}{"name": "Jane", "ssn": "123"}
+		props := map[string]interface{}{
+			"_additional": models.AdditionalProperties{
+				"group": &additional.Group{Hits: []map[string]interface{}{hit}},
+			},
+		}
+		require.NoError(t, mask.Prune("Person", props))
+		assert.Equal(t, map[string]interface{}{"name": "Jane"}, hit)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		mask := NewPropertyMask(ctx, authorizer, principal, "")
+		assert.NoError(t, mask.Check("Person", "name", "address.city", "len(name)", "_creationTimeUnix", "id"))
+
+		err := mask.Check("Person", "ssn^2")
+		assert.True(t, errors.As(err, &authzerrors.Forbidden{}))
+		assert.ErrorContains(t, err, "property Person.ssn")
+		assert.Error(t, mask.Check("Person", "address"))
+	})
+
+	t.Run("check filter on reference", func(t *testing.T) {
+		mask := NewPropertyMask(ctx, authorizer, principal, "")
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Person", Property: "name"},
+				},
+				{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class: "Person", Property: "employer",
+						Child: &filters.Path{Class: "Company", Property: "revenue"},
+					},
+				},
+			},
+		}}
+		assert.ErrorContains(t, mask.CheckFilter(filter), "property Company.revenue")
+	})
+
+	t.Run("authorizer without data restrictions", func(t *testing.T) {
+		mask := NewPropertyMask(ctx, mocks.NewMockAuthorizer(), principal, "")
+		props := map[string]interface{}{"ssn": "123"}
+		require.NoError(t, mask.Prune("Person", props))
+		assert.Equal(t, map[string]interface{}{"ssn": "123"}, props)
+		assert.NoError(t, mask.Check("Person", "ssn"))
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
@@ -49,6 +50,12 @@ func (m *Manager) authorize(ctx context.Context, principal *models.Principal, ve
 	if len(principal.Groups) > 0 {
 		logger = logger.WithField("groups", principal.Groups)
 	}
+	if len(principal.Roles) > 0 {
+		logger = logger.WithField("mapped_roles", principal.Roles)
+	}
+	if principal.Tenant != "" {
+		logger = logger.WithField("bound_tenant", principal.Tenant)
+	}
 
 	// Create a slice to store all permission results
 	permResults := make([]logrus.Fields, 0, len(resources))
@@ -59,11 +66,17 @@ func (m *Manager) authorize(ctx context.Context, principal *models.Principal, ve
 			logger.WithFields(logrus.Fields{
 				"resource": resource,
 			}).WithError(err).Error("failed to enforce policy")
+			if !skipAudit {
+				m.recordAudit(ctx, principal, verb, resources, audit.OutcomeError, err)
+			}
 			return err
 		}
 
 		perm, err := conv.PathToPermission(verb, resource)
 		if err != nil {
+			if !skipAudit {
+				m.recordAudit(ctx, principal, verb, resources, audit.OutcomeError, err)
+			}
 			return fmt.Errorf("rbac: %w", err)
 		}
 
@@ -75,16 +88,19 @@ func (m *Manager) authorize(ctx context.Context, principal *models.Principal, ve
 		}
 
 		if !allowed {
+			forbidden := errors.NewForbidden(principal, prettyPermissionsActions(perm), prettyPermissionsResources(perm))
 			if !skipAudit {
 				logger.WithField("permissions", permResults).Error("authorization denied")
+				m.recordAudit(ctx, principal, verb, resources, audit.OutcomeDenied, forbidden)
 			}
-			return fmt.Errorf("rbac: %w", errors.NewForbidden(principal, prettyPermissionsActions(perm), prettyPermissionsResources(perm)))
+			return fmt.Errorf("rbac: %w", forbidden)
 		}
 	}
 
 	// Log all results at once if audit is enabled
 	if !skipAudit {
 		logger.WithField("permissions", permResults).Info()
+		m.recordAudit(ctx, principal, verb, resources, audit.OutcomeAllowed, nil)
 	}
 
 	return nil
@@ -122,6 +138,12 @@ func (m *Manager) FilterAuthorizedResources(ctx context.Context, principal *mode
 	if len(principal.Groups) > 0 {
 		logger = logger.WithField("groups", principal.Groups)
 	}
+	if len(principal.Roles) > 0 {
+		logger = logger.WithField("mapped_roles", principal.Roles)
+	}
+	if principal.Tenant != "" {
+		logger = logger.WithField("bound_tenant", principal.Tenant)
+	}
 
 	permResults := make([]logrus.Fields, 0, len(resources))
 	allowedResources := make([]string, 0, len(resources))
@@ -148,5 +170,15 @@ func (m *Manager) FilterAuthorizedResources(ctx context.Context, principal *mode
 	}
 
 	logger.WithField("permissions", permResults).Info()
+	if len(allowedResources) > 0 {
+		m.recordAudit(ctx, principal, verb, allowedResources, audit.OutcomeAllowed, nil)
+	}
 	return allowedResources, nil
 }
+
+// recordAudit writes an authorization decision to the audit log
+func (m *Manager) recordAudit(ctx context.Context, principal *models.Principal, verb string,
+	resources []string, outcome audit.Outcome, err error,
+) {
+	m.audit.RecordDecision(ctx, principal, verb, resources, outcome, err, !m.rbacConf.IpInAuditDisabled)
+}
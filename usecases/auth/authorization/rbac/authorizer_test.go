@@ -13,9 +13,11 @@ package rbac
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/weaviate/weaviate/usecases/config"
@@ -26,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
 	authzErrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
@@ -493,3 +496,52 @@ func setupTestManager(t *testing.T, logger *logrus.Logger) (*Manager, error) {
 
 	return New(policyPath, conf, config.Authentication{OIDC: config.OIDC{Enabled: true}, APIKey: config.StaticAPIKey{Enabled: true, Users: []string{"test-user"}}}, logger)
 }
+
+func TestAuthorizeAudit(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), audit.DefaultFileName)
+	sink, err := audit.NewFileSink(path, 1, 1, logger)
+	require.NoError(t, err)
+	m.SetAuditLogger(audit.NewWithSinks([]string{authorization.SchemaDomain}, false, logger, sink))
+
+	_, err = m.casbin.AddNamedPolicy("p", conv.PrefixRoleName("schema-admin"), authorization.CollectionsMetadata("Test1")[0], authorization.UPDATE, authorization.SchemaDomain)
+	require.NoError(t, err)
+	_, err = m.casbin.AddRoleForUser(conv.UserNameWithTypeFromId("test-user", models.UserTypeInputDb), conv.PrefixRoleName("schema-admin"))
+	require.NoError(t, err)
+
+	principal := &models.Principal{Username: "test-user", UserType: models.UserTypeInputDb, Groups: []string{"team"}}
+	ctx := audit.WithRequestID(context.WithValue(context.Background(), "sourceIp", "10.0.0.1"), "req-1")
+
+	require.NoError(t, m.Authorize(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata("Test1")...))
+	require.Error(t, m.Authorize(ctx, principal, authorization.DELETE, authorization.CollectionsMetadata("Test1")...))
+	// internal checks and reads are not audited
+	require.NoError(t, m.AuthorizeSilent(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata("Test1")...))
+	require.Error(t, m.Authorize(ctx, principal, authorization.READ, authorization.CollectionsMetadata("Test1")...))
+	require.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var events []audit.Event
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var event audit.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 2)
+
+	assert.Equal(t, authorization.SchemaDomain, events[0].Domain)
+	assert.Equal(t, authorization.UpdateCollections, events[0].Action)
+	assert.Equal(t, "test-user", events[0].User)
+	assert.Equal(t, []string{"team"}, events[0].Groups)
+	assert.Equal(t, "10.0.0.1", events[0].SourceIP)
+	assert.Equal(t, "req-1", events[0].RequestID)
+	assert.Equal(t, audit.OutcomeAllowed, events[0].Outcome)
+	assert.Empty(t, events[0].Error)
+
+	assert.Equal(t, authorization.DeleteCollections, events[1].Action)
+	assert.Equal(t, audit.OutcomeDenied, events[1].Outcome)
+	assert.Contains(t, events[1].Error, "forbidden")
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+
+	casbinutil "github.com/casbin/casbin/v2/util"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// ReadDataFilters returns the where filters which restrict the objects the
+// principal is allowed to read in the given collection and tenant. An object
+// is readable if it matches at least one of them. A nil result means that
+// reads are not restricted, either because one of the principal's roles
+// grants read_data without a filter or because none grants read_data at all,
+// in which case the request is rejected by Authorize.
+func (m *Manager) ReadDataFilters(ctx context.Context, principal *models.Principal, collection, tenant string) ([]*models.WhereFilter, error) {
+	restrictions, err := m.readDataRestrictions(principal, collection, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []*models.WhereFilter
+	for _, r := range restrictions {
+		if r.Filter == nil {
+			return nil, nil
+		}
+		filters = append(filters, r.Filter)
+	}
+	return filters, nil
+}
+
+// ReadDataFiltered is true if any of the principal's read_data permissions
+// is restricted by a filter. Requests which can't be restricted to a single
+// collection, like listing the objects of all collections, are rejected for
+// such principals.
+func (m *Manager) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	if principal == nil {
+		return false, errors.NewUnauthenticated()
+	}
+
+	subjects, err := m.subjects(principal)
+	if err != nil {
+		return false, err
+	}
+	for _, subject := range subjects {
+		policies, err := m.casbin.GetImplicitPermissionsForUser(subject)
+		if err != nil {
+			return false, err
+		}
+		for _, policy := range policies {
+			if len(policy) < 4 || !casbinutil.RegexMatch(authorization.READ, policy[2]) {
+				continue
+			}
+			_, restriction, err := conv.DataRestrictionFromDomain(policy[3])
+			if err != nil {
+				return false, err
+			}
+			if restriction != nil && restriction.Filter != nil {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ReadDataProperties returns the properties the principal is allowed to read
+// in the given collection and tenant. Like for ReadDataFilters a nil result
+// means that all properties are readable. Filters and properties are
+// evaluated independently, the properties of all the principal's read_data
+// permissions are readable on all objects the principal may read.
+func (m *Manager) ReadDataProperties(ctx context.Context, principal *models.Principal, collection, tenant string) (authorization.PropertyAccess, error) {
+	restrictions, err := m.readDataRestrictions(principal, collection, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var access authorization.PropertyAccess
+	for _, r := range restrictions {
+		if !r.RestrictsProperties() {
+			return nil, nil
+		}
+		access = append(access, authorization.DataRestriction{
+			Properties:         r.Properties,
+			ExcludedProperties: r.ExcludedProperties,
+		})
+	}
+	return access, nil
+}
+
+// AuthorizeDataRestriction checks that the principal may grant read_data on
+// the resource with the given restriction, nil meaning unrestricted. This is
+// the case if one of the principal's own read_data permissions on the
+// resource covers it, see DataRestriction.Covers. Otherwise a principal with
+// filtered read access could hand out unfiltered access through roles.
+func (m *Manager) AuthorizeDataRestriction(ctx context.Context, principal *models.Principal, resource string, restriction *authorization.DataRestriction) error {
+	restrictions, err := m.readDataRestrictionsOn(principal, resource)
+	if err != nil {
+		return err
+	}
+	for _, r := range restrictions {
+		if r.Covers(restriction) {
+			return nil
+		}
+	}
+	return errors.NewForbidden(principal, authorization.READ, resource)
+}
+
+// readDataRestrictions returns the restrictions of all the principal's
+// read_data permissions which match the collection and tenant. Permissions
+// without restriction are returned as an empty restriction.
+func (m *Manager) readDataRestrictions(principal *models.Principal, collection, tenant string) ([]*authorization.DataRestriction, error) {
+	return m.readDataRestrictionsOn(principal, authorization.Objects(collection, tenant, ""))
+}
+
+// readDataRestrictionsOn is like readDataRestrictions for a data resource
+func (m *Manager) readDataRestrictionsOn(principal *models.Principal, resource string) ([]*authorization.DataRestriction, error) {
+	if principal == nil {
+		return nil, errors.NewUnauthenticated()
+	}
+
+	subjects, err := m.subjects(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	var restrictions []*authorization.DataRestriction
+	for _, subject := range subjects {
+		policies, err := m.casbin.GetImplicitPermissionsForUser(subject)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range policies {
+			// e.g. policy line in casbin -> role:roleName resource verb domain
+			if len(policy) < 4 || !WeaviateMatcher(resource, policy[1]) ||
+				!casbinutil.RegexMatch(authorization.READ, policy[2]) {
+				continue
+			}
+			_, restriction, err := conv.DataRestrictionFromDomain(policy[3])
+			if err != nil {
+				return nil, err
+			}
+			if restriction == nil {
+				restriction = &authorization.DataRestriction{}
+			}
+			restrictions = append(restrictions, restriction)
+		}
+	}
+	return restrictions, nil
+}
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
+)
+
+func TestReadDataFilters(t *testing.T) {
+	legal := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"department"},
+		ValueText: authorization.String("legal"),
+	}
+	sales := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"department"},
+		ValueText: authorization.String("sales"),
+	}
+	readData := func(collection string, filter *models.WhereFilter) *models.Permission {
+		return &models.Permission{
+			Action: authorization.String(authorization.ReadData),
+			Data: &models.PermissionData{
+				Collection: authorization.String(collection),
+				Filter:     filter,
+			},
+		}
+	}
+
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(
+		&models.Role{Name: authorization.String("legal"), Permissions: []*models.Permission{readData("Documents", legal)}},
+		&models.Role{Name: authorization.String("sales"), Permissions: []*models.Permission{readData("Documents", sales)}},
+		&models.Role{Name: authorization.String("reader"), Permissions: []*models.Permission{readData("Documents", nil)}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+
+	assign := func(user string, roles ...string) *models.Principal {
+		require.NoError(t, m.AddRolesForUser(conv.UserNameWithTypeFromId(user, models.UserTypeInputDb), roles))
+		return &models.Principal{Username: user, UserType: models.UserTypeInputDb}
+	}
+
+	t.Run("filtered role", func(t *testing.T) {
+		principal := assign("legal-user", "legal")
+		filters, err := m.ReadDataFilters(context.Background(), principal, "Documents", "")
+		require.NoError(t, err)
+		assert.Equal(t, []*models.WhereFilter{legal}, filters)
+
+		// the filter does not prevent access to the collection as such
+		require.NoError(t, m.Authorize(context.Background(), principal, authorization.READ,
+			authorization.ShardsData("Documents", "")...))
+	})
+
+	t.Run("multiple filtered roles", func(t *testing.T) {
+		principal := assign("legal-sales-user", "legal", "sales")
+		filters, err := m.ReadDataFilters(context.Background(), principal, "Documents", "")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*models.WhereFilter{legal, sales}, filters)
+	})
+
+	t.Run("unfiltered role wins", func(t *testing.T) {
+		principal := assign("reader-user", "legal", "reader")
+		filters, err := m.ReadDataFilters(context.Background(), principal, "Documents", "")
+		require.NoError(t, err)
+		assert.Nil(t, filters)
+	})
+
+	t.Run("other collection", func(t *testing.T) {
+		principal := assign("other-user", "legal")
+		filters, err := m.ReadDataFilters(context.Background(), principal, "Other", "")
+		require.NoError(t, err)
+		assert.Nil(t, filters)
+	})
+
+	t.Run("filters survive reading the roles", func(t *testing.T) {
+		roles, err := m.GetRoles("legal")
+		require.NoError(t, err)
+		perms, err := conv.PoliciesToPermission(roles["legal"]...)
+		require.NoError(t, err)
+		require.Len(t, perms, 1)
+		assert.Equal(t, legal, perms[0].Data.Filter)
+	})
+}
+
+func TestReadDataProperties(t *testing.T) {
+	readData := func(properties, excluded []string) *models.Permission {
+		return &models.Permission{
+			Action: authorization.String(authorization.ReadData),
+			Data: &models.PermissionData{
+				Collection:         authorization.String("People"),
+				Properties:         properties,
+				ExcludedProperties: excluded,
+			},
+		}
+	}
+
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(
+		&models.Role{Name: authorization.String("public"), Permissions: []*models.Permission{readData([]string{"name"}, nil)}},
+		&models.Role{Name: authorization.String("no-pii"), Permissions: []*models.Permission{readData(nil, []string{"ssn"})}},
+		&models.Role{Name: authorization.String("compliance"), Permissions: []*models.Permission{readData(nil, nil)}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+
+	assign := func(user string, roles ...string) *models.Principal {
+		require.NoError(t, m.AddRolesForUser(conv.UserNameWithTypeFromId(user, models.UserTypeInputDb), roles))
+		return &models.Principal{Username: user, UserType: models.UserTypeInputDb}
+	}
+
+	t.Run("restricted roles are combined", func(t *testing.T) {
+		access, err := m.ReadDataProperties(context.Background(), assign("public-user", "public", "no-pii"), "People", "")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, authorization.PropertyAccess{
+			{Properties: []string{"name"}},
+			{ExcludedProperties: []string{"ssn"}},
+		}, access)
+		assert.False(t, access.Allowed("ssn"))
+		assert.True(t, access.Allowed("address"))
+	})
+
+	t.Run("unrestricted role wins", func(t *testing.T) {
+		access, err := m.ReadDataProperties(context.Background(), assign("compliance-user", "no-pii", "compliance"), "People", "")
+		require.NoError(t, err)
+		assert.Nil(t, access)
+	})
+
+	t.Run("filters are not affected", func(t *testing.T) {
+		filters, err := m.ReadDataFilters(context.Background(), assign("filter-user", "no-pii"), "People", "")
+		require.NoError(t, err)
+		assert.Nil(t, filters)
+	})
+}
+
+func TestAuthorizeDataRestriction(t *testing.T) {
+	legal := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"department"},
+		ValueText: authorization.String("legal"),
+	}
+	recent := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"status"},
+		ValueText: authorization.String("recent"),
+	}
+
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(&models.Role{
+		Name: authorization.String("legal"),
+		Permissions: []*models.Permission{{
+			Action: authorization.String(authorization.ReadData),
+			Data: &models.PermissionData{
+				Collection: authorization.String("Documents"),
+				Filter:     legal,
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+	require.NoError(t, m.AddRolesForUser(conv.UserNameWithTypeFromId("legal-user", models.UserTypeInputDb), []string{"legal"}))
+	principal := &models.Principal{Username: "legal-user", UserType: models.UserTypeInputDb}
+	resource := authorization.Objects("Documents", "", "")
+
+	tests := []struct {
+		name        string
+		restriction *authorization.DataRestriction
+		allowed     bool
+	}{
+		{name: "unfiltered", restriction: nil, allowed: false},
+		{name: "other filter", restriction: &authorization.DataRestriction{Filter: recent}, allowed: false},
+		{name: "same filter", restriction: &authorization.DataRestriction{Filter: legal}, allowed: true},
+		{
+			name: "narrower filter",
+			restriction: &authorization.DataRestriction{Filter: &models.WhereFilter{
+				Operator: "And",
+				Operands: []*models.WhereFilter{recent, legal},
+			}},
+			allowed: true,
+		},
+		{
+			name: "broader filter",
+			restriction: &authorization.DataRestriction{Filter: &models.WhereFilter{
+				Operator: "Or",
+				Operands: []*models.WhereFilter{recent, legal},
+			}},
+			allowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.AuthorizeDataRestriction(context.Background(), principal, resource, tt.restriction)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("other collection", func(t *testing.T) {
+		err := m.AuthorizeDataRestriction(context.Background(), principal, authorization.Objects("Other", "", ""),
+			&authorization.DataRestriction{Filter: legal})
+		require.Error(t, err)
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac/rbacconf"
@@ -41,6 +42,7 @@ type Manager struct {
 	authNconf  config.Authentication
 	rbacConf   rbacconf.Config
 	backupLock sync.RWMutex
+	audit      *audit.Logger
 }
 
 func New(rbacStoragePath string, rbacConf rbacconf.Config, authNconf config.Authentication, logger logrus.FieldLogger) (*Manager, error) {
@@ -49,7 +51,13 @@ func New(rbacStoragePath string, rbacConf rbacconf.Config, authNconf config.Auth
 		return nil, err
 	}
 
-	return &Manager{csbin, logger, authNconf, rbacConf, sync.RWMutex{}}, nil
+	return &Manager{csbin, logger, authNconf, rbacConf, sync.RWMutex{}, nil}, nil
+}
+
+// SetAuditLogger makes the manager record its authorization decisions to the
+// audit log, nil disables auditing
+func (m *Manager) SetAuditLogger(auditLogger *audit.Logger) {
+	m.audit = auditLogger
 }
 
 // there is no different between UpdateRolesPermissions and CreateRolesPermissions, purely to satisfy an interface
@@ -380,9 +388,17 @@ func (m *Manager) Restore(b []byte) error {
 // source code https://github.com/casbin/casbin/blob/master/enforcer.go#L872
 // issue https://github.com/casbin/casbin/issues/710
 func (m *Manager) checkPermissions(principal *models.Principal, resource, verb string) (bool, error) {
-	// first check group permissions
-	for _, group := range principal.Groups {
-		allowed, err := m.casbin.Enforce(conv.PrefixGroupName(group), resource, verb)
+	if !inCollectionScope(principal, resource) || !inTenantScope(principal, resource, verb) {
+		return false, nil
+	}
+
+	// group permissions are checked before user permissions
+	subjects, err := m.subjects(principal)
+	if err != nil {
+		return false, err
+	}
+	for _, subject := range subjects {
+		allowed, err := m.casbin.Enforce(subject, resource, verb)
 		if err != nil {
 			return false, err
 		}
@@ -390,9 +406,7 @@ func (m *Manager) checkPermissions(principal *models.Principal, resource, verb s
 			return true, nil
 		}
 	}
-
-	// If no group permissions, check user permissions
-	return m.casbin.Enforce(conv.UserNameWithTypeFromPrincipal(principal), resource, verb)
+	return false, nil
 }
 
 func prettyPermissionsActions(perm *models.Permission) string {
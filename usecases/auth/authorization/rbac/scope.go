@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
+)
+
+// subjects returns the casbin subjects whose permissions apply to the
+// principal. Roles that were mapped to the principal during authentication
+// apply directly. A principal authenticated with a key that is scoped to roles
+// only gets the permissions of these roles, as far as they are still assigned
+// to the user.
+func (m *Manager) subjects(principal *models.Principal) ([]string, error) {
+	user := conv.UserNameWithTypeFromPrincipal(principal)
+	if len(principal.ScopedRoles) == 0 {
+		subjects := make([]string, 0, len(principal.Groups)+len(principal.Roles)+1)
+		for _, group := range principal.Groups {
+			subjects = append(subjects, conv.PrefixGroupName(group))
+		}
+		for _, role := range principal.Roles {
+			subjects = append(subjects, conv.PrefixRoleName(role))
+		}
+		return append(subjects, user), nil
+	}
+
+	roles, err := m.casbin.GetRolesForUser(user)
+	if err != nil {
+		return nil, err
+	}
+	subjects := make([]string, 0, len(principal.ScopedRoles))
+	for _, role := range roles {
+		if slices.Contains(principal.ScopedRoles, conv.TrimRoleNamePrefix(role)) {
+			subjects = append(subjects, role)
+		}
+	}
+	return subjects, nil
+}
+
+// inCollectionScope returns whether the resource belongs to one of the
+// collections a principal authenticated with a scoped key is restricted to.
+// Resources that don't belong to a single collection, e.g. users or
+// "schema/collections/*", are out of scope for such principals.
+func inCollectionScope(principal *models.Principal, resource string) bool {
+	if len(principal.ScopedCollections) == 0 {
+		return true
+	}
+
+	parts := strings.Split(resource, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "collections" {
+			return slices.Contains(principal.ScopedCollections, parts[i+1])
+		}
+	}
+	return false
+}
+
+// inTenantScope returns whether a principal that is bound to a tenant may
+// access the resource with the given verb. Resources of a single shard need
+// to address the tenant of the principal, resources of all shards ("*") are
+// out of scope. Collection level resources ("#") may only be read, changing
+// or deleting a collection affects all of its tenants. Resources that don't
+// belong to a collection are not affected by the binding.
+func inTenantScope(principal *models.Principal, resource, verb string) bool {
+	if principal.Tenant == "" {
+		return true
+	}
+
+	parts := strings.Split(resource, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "shards" {
+			if parts[i+1] == "#" {
+				return verb == authorization.READ
+			}
+			return parts[i+1] == principal.Tenant
+		}
+	}
+	return true
+}
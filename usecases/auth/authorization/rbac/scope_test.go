@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/conv"
+)
+
+func TestScopedPrincipal(t *testing.T) {
+	data := func(action, collection string) *models.Permission {
+		return &models.Permission{
+			Action: authorization.String(action),
+			Data:   &models.PermissionData{Collection: authorization.String(collection)},
+		}
+	}
+
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(
+		&models.Role{Name: authorization.String("reader"), Permissions: []*models.Permission{
+			data(authorization.ReadData, "Documents"), data(authorization.ReadData, "Other"),
+		}},
+		&models.Role{Name: authorization.String("writer"), Permissions: []*models.Permission{
+			data(authorization.CreateData, "Documents"),
+		}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+	require.NoError(t, m.AddRolesForUser(conv.UserNameWithTypeFromId("user", models.UserTypeInputDb), []string{"reader", "writer"}))
+
+	ctx := context.Background()
+	readDocuments := authorization.ShardsData("Documents", "")
+	readOther := authorization.ShardsData("Other", "")
+
+	t.Run("unscoped", func(t *testing.T) {
+		principal := &models.Principal{Username: "user", UserType: models.UserTypeInputDb}
+		require.NoError(t, m.Authorize(ctx, principal, authorization.READ, readDocuments...))
+		require.NoError(t, m.Authorize(ctx, principal, authorization.CREATE, readDocuments...))
+		require.NoError(t, m.Authorize(ctx, principal, authorization.READ, readOther...))
+	})
+
+	t.Run("scoped roles", func(t *testing.T) {
+		principal := &models.Principal{Username: "user", UserType: models.UserTypeInputDb, ScopedRoles: []string{"reader"}}
+		require.NoError(t, m.Authorize(ctx, principal, authorization.READ, readDocuments...))
+		assert.Error(t, m.Authorize(ctx, principal, authorization.CREATE, readDocuments...))
+	})
+
+	t.Run("scoped role that is no longer assigned", func(t *testing.T) {
+		principal := &models.Principal{Username: "user", UserType: models.UserTypeInputDb, ScopedRoles: []string{"admin"}}
+		assert.Error(t, m.Authorize(ctx, principal, authorization.READ, readDocuments...))
+	})
+
+	t.Run("scoped collections", func(t *testing.T) {
+		principal := &models.Principal{Username: "user", UserType: models.UserTypeInputDb, ScopedCollections: []string{"Documents"}}
+		require.NoError(t, m.Authorize(ctx, principal, authorization.READ, readDocuments...))
+		require.NoError(t, m.Authorize(ctx, principal, authorization.CREATE, readDocuments...))
+		assert.Error(t, m.Authorize(ctx, principal, authorization.READ, readOther...))
+		assert.Error(t, m.Authorize(ctx, principal, authorization.READ, authorization.Users("user")...))
+	})
+}
+
+func TestTenantBoundPrincipal(t *testing.T) {
+	collections := func(action string) *models.Permission {
+		return &models.Permission{
+			Action:      authorization.String(action),
+			Collections: &models.PermissionCollections{Collection: authorization.String("Documents")},
+		}
+	}
+
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(&models.Role{
+		Name: authorization.String("owner"),
+		Permissions: []*models.Permission{
+			collections(authorization.ReadCollections),
+			collections(authorization.UpdateCollections),
+			collections(authorization.DeleteCollections),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+
+	ctx := context.Background()
+	collection := authorization.CollectionsMetadata("Documents")
+
+	principal := &models.Principal{Username: "oidc-user", UserType: models.UserTypeInputOidc, Roles: []string{"owner"}}
+	require.NoError(t, m.Authorize(ctx, principal, authorization.UPDATE, collection...))
+	require.NoError(t, m.Authorize(ctx, principal, authorization.DELETE, collection...))
+
+	principal.Tenant = "tenantA"
+	require.NoError(t, m.Authorize(ctx, principal, authorization.READ, collection...))
+	assert.Error(t, m.Authorize(ctx, principal, authorization.UPDATE, collection...))
+	assert.Error(t, m.Authorize(ctx, principal, authorization.DELETE, collection...))
+}
+
+func TestInCollectionScope(t *testing.T) {
+	principal := &models.Principal{ScopedCollections: []string{"Documents"}}
+	assert.True(t, inCollectionScope(principal, authorization.CollectionsMetadata("Documents")[0]))
+	assert.True(t, inCollectionScope(principal, authorization.ShardsData("Documents", "shard")[0]))
+	assert.False(t, inCollectionScope(principal, authorization.ShardsData("Other", "")[0]))
+	assert.False(t, inCollectionScope(principal, authorization.Users("user")[0]))
+	assert.True(t, inCollectionScope(&models.Principal{}, authorization.Users("user")[0]))
+}
+
+func TestMappedRoles(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	policies, err := conv.RolesToPolicies(&models.Role{
+		Name: authorization.String("researcher"),
+		Permissions: []*models.Permission{{
+			Action: authorization.String(authorization.ReadData),
+			Data:   &models.PermissionData{Collection: authorization.String("Documents")},
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.CreateRolesPermissions(policies))
+
+	ctx := context.Background()
+	principal := &models.Principal{Username: "oidc-user", UserType: models.UserTypeInputOidc}
+	assert.Error(t, m.Authorize(ctx, principal, authorization.READ, authorization.ShardsData("Documents", "")...))
+
+	principal.Roles = []string{"researcher"}
+	require.NoError(t, m.Authorize(ctx, principal, authorization.READ, authorization.ShardsData("Documents", "")...))
+	assert.Error(t, m.Authorize(ctx, principal, authorization.READ, authorization.ShardsData("Other", "")...))
+}
+
+func TestInTenantScope(t *testing.T) {
+	principal := &models.Principal{Tenant: "tenantA"}
+	assert.True(t, inTenantScope(principal, authorization.ShardsData("Documents", "tenantA")[0], authorization.UPDATE))
+	assert.False(t, inTenantScope(principal, authorization.ShardsData("Documents", "tenantB")[0], authorization.READ))
+	assert.False(t, inTenantScope(principal, authorization.ShardsData("Documents", "")[0], authorization.READ))
+	assert.True(t, inTenantScope(principal, authorization.CollectionsMetadata("Documents")[0], authorization.READ))
+	assert.False(t, inTenantScope(principal, authorization.CollectionsMetadata("Documents")[0], authorization.CREATE))
+	assert.False(t, inTenantScope(principal, authorization.CollectionsMetadata("Documents")[0], authorization.UPDATE))
+	assert.False(t, inTenantScope(principal, authorization.CollectionsMetadata("Documents")[0], authorization.DELETE))
+	assert.True(t, inTenantScope(principal, authorization.Users("user")[0], authorization.UPDATE))
+	assert.True(t, inTenantScope(&models.Principal{}, authorization.ShardsData("Documents", "tenantB")[0], authorization.READ))
+	assert.True(t, inTenantScope(&models.Principal{}, authorization.CollectionsMetadata("Documents")[0], authorization.DELETE))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -98,6 +99,14 @@ func (f *fakeSchemaGetter) OptimisticTenantStatus(_ context.Context, class strin
 	return res, nil
 }
 
+func (f *fakeSchemaGetter) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	return nil
+}
+
+func (f *fakeSchemaGetter) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	return nil, nil
+}
+
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	ss := f.shardState
 	return ss.Shard("", string(uuid))
@@ -424,6 +433,14 @@ func (f *fakeRemoteClient) RemoveAsyncReplicationTargetNode(ctx context.Context,
 	return nil
 }
 
+func (f *fakeRemoteClient) SplitShardReplica(ctx context.Context, hostName, indexName, shardName, targetShard string, schemaVersion uint64) error {
+	return nil
+}
+
+func (f *fakeRemoteClient) DropUnownedObjects(ctx context.Context, hostName, indexName, shardName string, schemaVersion uint64) error {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetObject(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
@@ -511,6 +528,12 @@ func (f *fakeRemoteClient) GetShardQueueSize(ctx context.Context,
 	return 0, nil
 }
 
+func (f *fakeRemoteClient) GetShardChanges(ctx context.Context,
+	hostName, indexName, shardName string, after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
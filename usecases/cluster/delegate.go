@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/rand"
 	"sort"
 	"sync"
@@ -122,11 +123,20 @@ type delegate struct {
 	hostInfo NodeInfo
 
 	metadata NodeMetadata
+
+	// zones and readOnly cache the metadata of the live members. They are
+	// maintained by the memberlist events, so that routing doesn't need to
+	// decode the metadata of every member.
+	metaLock sync.RWMutex
+	zones    map[string]string
+	readOnly map[string]struct{}
 }
 
 type NodeMetadata struct {
-	RestPort int `json:"rest_port"`
-	GrpcPort int `json:"grpc_port"`
+	RestPort int    `json:"rest_port"`
+	GrpcPort int    `json:"grpc_port"`
+	Zone     string `json:"zone,omitempty"`
+	ReadOnly bool   `json:"read_only,omitempty"`
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -157,6 +167,7 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 
 	d.setOwnSpace(space)
 	d.set(d.Name, NodeInfo{space, lastTime.UnixMilli()}) // cache
+	d.setMeta(d.Name, d.metadata)
 
 	// delegate remains alive throughout the entire program.
 	enterrors.GoWrapper(func() { d.updater(_ProtoTTL, minUpdatePeriod, diskSpace) }, d.log)
@@ -255,6 +266,82 @@ func (d *delegate) delete(node string) {
 	delete(d.Cache, node)
 }
 
+// setMeta caches the zone and read-only flag advertised by a node
+func (d *delegate) setMeta(node string, meta NodeMetadata) {
+	d.metaLock.Lock()
+	defer d.metaLock.Unlock()
+
+	if d.zones == nil {
+		d.zones = map[string]string{}
+		d.readOnly = map[string]struct{}{}
+	}
+	if meta.Zone != "" {
+		d.zones[node] = meta.Zone
+	} else {
+		delete(d.zones, node)
+	}
+	if meta.ReadOnly {
+		d.readOnly[node] = struct{}{}
+	} else {
+		delete(d.readOnly, node)
+	}
+}
+
+// deleteMeta removes a node which left the cluster from the metadata cache
+func (d *delegate) deleteMeta(node string) {
+	d.metaLock.Lock()
+	defer d.metaLock.Unlock()
+	delete(d.zones, node)
+	delete(d.readOnly, node)
+}
+
+// updateMeta caches the metadata of a member which joined or was updated
+func (d *delegate) updateMeta(node *memberlist.Node) {
+	if node == nil {
+		return
+	}
+	if node.Name == d.Name {
+		// the local node advertises its own metadata
+		d.setMeta(node.Name, d.metadata)
+		return
+	}
+	meta, err := nodeMetadata(node)
+	if err != nil {
+		d.log.WithField("action", "delegate.update_meta").WithField("node", node.Name).
+			WithError(err).Debug("member without metadata")
+	}
+	d.setMeta(node.Name, meta)
+}
+
+// zone returns the zone advertised by a live member
+func (d *delegate) zone(node string) string {
+	d.metaLock.RLock()
+	defer d.metaLock.RUnlock()
+	return d.zones[node]
+}
+
+// isReadOnly returns whether a live member only hosts read-only replicas
+func (d *delegate) isReadOnly(node string) bool {
+	d.metaLock.RLock()
+	defer d.metaLock.RUnlock()
+	_, ok := d.readOnly[node]
+	return ok
+}
+
+// memberZones returns a copy of the zones of the live members
+func (d *delegate) memberZones() map[string]string {
+	d.metaLock.RLock()
+	defer d.metaLock.RUnlock()
+	return maps.Clone(d.zones)
+}
+
+// readOnlyMembers returns a copy of the read-only live members
+func (d *delegate) readOnlyMembers() map[string]struct{} {
+	d.metaLock.RLock()
+	defer d.metaLock.RUnlock()
+	return maps.Clone(d.readOnly)
+}
+
 // sortCandidates by the amount of free space in descending order
 //
 // Two nodes are considered equivalent if the difference between their
@@ -306,15 +393,20 @@ type events struct {
 
 // NotifyJoin is invoked when a node is detected to have joined.
 // The Node argument must not be modified.
-func (e events) NotifyJoin(*memberlist.Node) {}
+func (e events) NotifyJoin(node *memberlist.Node) {
+	e.d.updateMeta(node)
+}
 
 // NotifyLeave is invoked when a node is detected to have left.
 // The Node argument must not be modified.
 func (e events) NotifyLeave(node *memberlist.Node) {
 	e.d.delete(node.Name)
+	e.d.deleteMeta(node.Name)
 }
 
 // NotifyUpdate is invoked when a node is detected to have
 // updated, usually involving the meta data. The Node argument
 // must not be modified.
-func (e events) NotifyUpdate(*memberlist.Node) {}
+func (e events) NotifyUpdate(node *memberlist.Node) {
+	e.d.updateMeta(node)
+}
@@ -235,6 +235,42 @@ func TestDelegateCleanUp(t *testing.T) {
 	assert.Empty(t, st.delegate.Cache)
 }
 
+func TestDelegateMemberMeta(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	st := State{
+		delegate: delegate{
+			Name:     "N0",
+			dataPath: ".",
+			log:      logger,
+			metadata: NodeMetadata{Zone: "zone-a"},
+		},
+	}
+	st.delegate.init(func(path string) (DiskUsage, error) {
+		return DiskUsage{100, 50}, nil
+	})
+	assert.Equal(t, "zone-a", st.NodeZone("N0"))
+	assert.False(t, st.NodeReadOnly("N0"))
+
+	handler := events{&st.delegate}
+	handler.NotifyJoin(&memberlist.Node{Name: "N1", Meta: []byte(`{"zone":"zone-b","read_only":true}`)})
+	handler.NotifyJoin(&memberlist.Node{Name: "N2"})
+	assert.Equal(t, "zone-b", st.NodeZone("N1"))
+	assert.True(t, st.NodeReadOnly("N1"))
+	assert.Equal(t, "", st.NodeZone("N2"))
+	assert.False(t, st.NodeReadOnly("N2"))
+	assert.Equal(t, []string{"N1"}, st.ReadOnlyNodes())
+	assert.Equal(t, map[string]string{"N0": "zone-a", "N1": "zone-b"}, st.nodeZones())
+
+	handler.NotifyUpdate(&memberlist.Node{Name: "N1", Meta: []byte(`{"zone":"zone-c"}`)})
+	assert.Equal(t, "zone-c", st.NodeZone("N1"))
+	assert.False(t, st.NodeReadOnly("N1"))
+
+	handler.NotifyUpdate(&memberlist.Node{Name: "N2", Meta: []byte(`{"read_only":true}`)})
+	handler.NotifyLeave(&memberlist.Node{Name: "N1"})
+	assert.Equal(t, "", st.NodeZone("N1"))
+	assert.Equal(t, []string{"N2"}, st.ReadOnlyNodes())
+}
+
 func TestDelegateLocalState(t *testing.T) {
 	now := time.Now().UnixMilli() - 1
 	errAny := errors.New("any error")
@@ -222,6 +222,98 @@ func (_c *MockNodeSelector_NodeHostname_Call) RunAndReturn(run func(string) (str
 	return _c
 }
 
+// NodeReadOnly provides a mock function with given fields: name
+func (_m *MockNodeSelector) NodeReadOnly(name string) bool {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeReadOnly")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockNodeSelector_NodeReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeReadOnly'
+type MockNodeSelector_NodeReadOnly_Call struct {
+	*mock.Call
+}
+
+// NodeReadOnly is a helper method to define mock.On call
+//   - name string
+func (_e *MockNodeSelector_Expecter) NodeReadOnly(name interface{}) *MockNodeSelector_NodeReadOnly_Call {
+	return &MockNodeSelector_NodeReadOnly_Call{Call: _e.mock.On("NodeReadOnly", name)}
+}
+
+func (_c *MockNodeSelector_NodeReadOnly_Call) Run(run func(name string)) *MockNodeSelector_NodeReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockNodeSelector_NodeReadOnly_Call) Return(_a0 bool) *MockNodeSelector_NodeReadOnly_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNodeSelector_NodeReadOnly_Call) RunAndReturn(run func(string) bool) *MockNodeSelector_NodeReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NodeZone provides a mock function with given fields: name
+func (_m *MockNodeSelector) NodeZone(name string) string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeZone")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockNodeSelector_NodeZone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeZone'
+type MockNodeSelector_NodeZone_Call struct {
+	*mock.Call
+}
+
+// NodeZone is a helper method to define mock.On call
+//   - name string
+func (_e *MockNodeSelector_Expecter) NodeZone(name interface{}) *MockNodeSelector_NodeZone_Call {
+	return &MockNodeSelector_NodeZone_Call{Call: _e.mock.On("NodeZone", name)}
+}
+
+func (_c *MockNodeSelector_NodeZone_Call) Run(run func(name string)) *MockNodeSelector_NodeZone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockNodeSelector_NodeZone_Call) Return(_a0 string) *MockNodeSelector_NodeZone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNodeSelector_NodeZone_Call) RunAndReturn(run func(string) string) *MockNodeSelector_NodeZone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NonStorageNodes provides a mock function with no fields
 func (_m *MockNodeSelector) NonStorageNodes() []string {
 	ret := _m.Called()
@@ -16,6 +16,10 @@ import "sort"
 type memberlist struct {
 	// nodes include the node names only
 	nodes []string
+	// zones maps node names to their zone
+	zones map[string]string
+	// readOnly contains the names of read-only nodes
+	readOnly map[string]bool
 }
 
 func (m memberlist) StorageCandidates() []string {
@@ -62,6 +66,31 @@ func (m memberlist) NodeAddress(name string) string {
 	return ""
 }
 
+func (m memberlist) NodeZone(name string) string {
+	return m.zones[name]
+}
+
+func (m memberlist) NodeReadOnly(name string) bool {
+	return m.readOnly[name]
+}
+
+// WithReadOnly returns a copy of the node selector in which the given nodes
+// are read-only
+func (m memberlist) WithReadOnly(nodes ...string) memberlist {
+	m.readOnly = make(map[string]bool, len(nodes))
+	for _, name := range nodes {
+		m.readOnly[name] = true
+	}
+	return m
+}
+
+// WithZones returns a copy of the node selector in which nodes advertise the
+// given zones
+func (m memberlist) WithZones(zones map[string]string) memberlist {
+	m.zones = zones
+	return m
+}
+
 func NewMockNodeSelector(node ...string) memberlist {
 	return memberlist{nodes: node}
 }
@@ -42,6 +42,11 @@ type NodeSelector interface {
 	// NodeHostname return hosts address for a specific node name
 	NodeHostname(name string) (string, bool)
 	AllHostnames() []string
+	// NodeZone returns the zone a node advertised, or an empty string if
+	// the node has no zone or is unknown
+	NodeZone(name string) string
+	// NodeReadOnly returns whether a node only hosts read-only replicas
+	NodeReadOnly(name string) bool
 }
 
 type State struct {
@@ -71,6 +76,14 @@ type Config struct {
 	FastFailureDetection bool `json:"fastFailureDetection" yaml:"fastFailureDetection"`
 	// LocalHost flag enables running a multi-node setup with the same localhost and different ports
 	Localhost bool `json:"localhost" yaml:"localhost"`
+	// Zone is the failure domain (e.g. availability zone or rack) of this node.
+	// Replicas of a shard are spread across zones and reads prefer replicas in
+	// the same zone.
+	Zone string `json:"zone" yaml:"zone"`
+	// ReadOnly makes this node a query node: it hosts read-only replicas which
+	// are kept up to date by async replication, it is not part of write quorums
+	// and it doesn't vote in Raft. No new shards are placed on it.
+	ReadOnly bool `json:"readOnly" yaml:"readOnly"`
 	// MaintenanceNodes is experimental. You should not use this directly, but should use the
 	// public methods on the State struct. This is a list of nodes (by Hostname) that are in
 	// maintenance mode (eg return a 418 for all data requests). We use a list here instead of a
@@ -112,6 +125,8 @@ func Init(userConfig Config, grpcPort, raftBootstrapExpect int, dataPath string,
 			metadata: NodeMetadata{
 				RestPort: userConfig.DataBindPort,
 				GrpcPort: grpcPort,
+				Zone:     userConfig.Zone,
+				ReadOnly: userConfig.ReadOnly,
 			},
 		},
 	}
@@ -295,9 +310,19 @@ func (s *State) storageNodes() []string {
 }
 
 // StorageCandidates returns list of storage nodes (names)
-// sorted by the free amount of disk space in descending order
+// sorted by the free amount of disk space in descending order.
+// If nodes advertise zones, consecutive nodes are in different zones.
+// Read-only nodes are not candidates, replicas are copied to them instead.
 func (s *State) StorageCandidates() []string {
-	return s.delegate.sortCandidates(s.storageNodes())
+	readOnly := s.readOnlyNodes()
+	nodes := s.storageNodes()
+	candidates := make([]string, 0, len(nodes))
+	for _, name := range nodes {
+		if _, ok := readOnly[name]; !ok {
+			candidates = append(candidates, name)
+		}
+	}
+	return SpreadAcrossZones(s.delegate.sortCandidates(candidates), s.nodeZones())
 }
 
 // NonStorageNodes return nodes from member list which
@@ -314,7 +339,39 @@ func (s *State) NonStorageNodes() []string {
 // SortCandidates Sort passed nodes names by the
 // free amount of disk space in descending order
 func (s *State) SortCandidates(nodes []string) []string {
-	return s.delegate.sortCandidates(nodes)
+	return SpreadAcrossZones(s.delegate.sortCandidates(nodes), s.nodeZones())
+}
+
+// NodeZone returns the zone advertised by a node
+func (s *State) NodeZone(name string) string {
+	return s.delegate.zone(name)
+}
+
+// NodeReadOnly returns whether a node advertised to only host read-only
+// replicas
+func (s *State) NodeReadOnly(name string) bool {
+	return s.delegate.isReadOnly(name)
+}
+
+// ReadOnlyNodes returns the names of the live read-only nodes, sorted by name
+func (s *State) ReadOnlyNodes() []string {
+	readOnly := s.readOnlyNodes()
+	nodes := make([]string, 0, len(readOnly))
+	for name := range readOnly {
+		nodes = append(nodes, name)
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+// readOnlyNodes returns the names of all live members which are read-only
+func (s *State) readOnlyNodes() map[string]struct{} {
+	return s.delegate.readOnlyMembers()
+}
+
+// nodeZones returns the zones of all live members which advertise one
+func (s *State) nodeZones() map[string]string {
+	return s.delegate.memberZones()
 }
 
 // All node names (not their hostnames!) for live members, including self.
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+// SpreadAcrossZones reorders names so that consecutive nodes are in different
+// zones for as long as possible. Nodes are taken from the zones in turn, in
+// the order in which the zones first appear in names, and keep their relative
+// order within a zone. Shard placement assigns the replicas of a shard to
+// consecutive nodes, so this places them in distinct zones.
+//
+// names is returned unchanged if there is at most one zone.
+func SpreadAcrossZones(names []string, zones map[string]string) []string {
+	var (
+		order  []string
+		byZone = map[string][]string{}
+	)
+	for _, name := range names {
+		zone := zones[name]
+		if _, ok := byZone[zone]; !ok {
+			order = append(order, zone)
+		}
+		byZone[zone] = append(byZone[zone], name)
+	}
+	if len(order) <= 1 {
+		return names
+	}
+
+	out := make([]string, 0, len(names))
+	for len(out) < len(names) {
+		for _, zone := range order {
+			if nodes := byZone[zone]; len(nodes) > 0 {
+				out = append(out, nodes[0])
+				byZone[zone] = nodes[1:]
+			}
+		}
+	}
+	return out
+}
+
+// ZonesOf returns the distinct zones of nodes, nodes without a zone are
+// ignored
+func ZonesOf(nodes []string, zoneOf func(string) string) map[string]struct{} {
+	zones := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		if zone := zoneOf(node); zone != "" {
+			zones[zone] = struct{}{}
+		}
+	}
+	return zones
+}
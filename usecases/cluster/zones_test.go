@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadAcrossZones(t *testing.T) {
+	zones := map[string]string{
+		"a1": "a", "a2": "a", "a3": "a",
+		"b1": "b", "b2": "b",
+		"c1": "c",
+	}
+
+	tests := []struct {
+		name  string
+		nodes []string
+		want  []string
+	}{
+		{
+			name:  "no zones",
+			nodes: []string{"n1", "n2", "n3"},
+			want:  []string{"n1", "n2", "n3"},
+		},
+		{
+			name:  "single zone",
+			nodes: []string{"a2", "a1", "a3"},
+			want:  []string{"a2", "a1", "a3"},
+		},
+		{
+			name:  "balanced zones",
+			nodes: []string{"a1", "a2", "b1", "b2"},
+			want:  []string{"a1", "b1", "a2", "b2"},
+		},
+		{
+			name:  "unbalanced zones",
+			nodes: []string{"a1", "a2", "a3", "b1", "b2", "c1"},
+			want:  []string{"a1", "b1", "c1", "a2", "b2", "a3"},
+		},
+		{
+			name:  "nodes without zone form their own group",
+			nodes: []string{"n1", "a1", "n2", "a2"},
+			want:  []string{"n1", "a1", "n2", "a2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SpreadAcrossZones(tt.nodes, zones))
+		})
+	}
+}
@@ -23,6 +23,7 @@ type Authentication struct {
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
 	APIKey          StaticAPIKey    // don't change name to not break yaml files
 	DBUsers         DbUsers         `json:"db_users" yaml:"db_users"`
+	MTLS            MTLS            `json:"mtls" yaml:"mtls"`
 }
 
 // DefaultAuthentication is the default authentication scheme when no authentication is provided
@@ -44,7 +45,7 @@ func (a Authentication) Validate() error {
 }
 
 func (a Authentication) AnyAuthMethodSelected() bool {
-	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled || a.DBUsers.Enabled
+	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled || a.DBUsers.Enabled || a.MTLS.Enabled
 }
 
 func (a Authentication) AnyApiKeyAvailable() bool {
@@ -69,6 +70,17 @@ type OIDC struct {
 	GroupsClaim       *runtime.DynamicValue[string]   `yaml:"groups_claim" json:"groups_claim"`
 	Scopes            *runtime.DynamicValue[[]string] `yaml:"scopes" json:"scopes"`
 	Certificate       *runtime.DynamicValue[string]   `yaml:"certificate" json:"certificate"`
+	TenantClaim       *runtime.DynamicValue[string]   `yaml:"tenant_claim" json:"tenant_claim"`
+	RoleMappings      []OIDCRoleMapping               `yaml:"role_mappings" json:"role_mappings"`
+}
+
+// OIDCRoleMapping assigns roles to all OIDC principals with a claim value that
+// matches the regular expression. If no claim is set, the groups of the
+// principal are matched.
+type OIDCRoleMapping struct {
+	Claim string   `json:"claim" yaml:"claim"`
+	Match string   `json:"match" yaml:"match"`
+	Roles []string `json:"roles" yaml:"roles"`
 }
 
 type StaticAPIKey struct {
@@ -80,3 +92,28 @@ type StaticAPIKey struct {
 type DbUsers struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
 }
+
+// Fields of a client certificate that can be used as the username of an mTLS
+// principal
+const (
+	MTLSUsernameFieldCommonName = "common_name"
+	MTLSUsernameFieldDNSSAN     = "dns_san"
+	MTLSUsernameFieldEmailSAN   = "email_san"
+	MTLSUsernameFieldURISAN     = "uri_san"
+)
+
+// DefaultMTLSUsernamePrefix is prepended to the usernames of mTLS principals
+// if no other prefix is configured
+const DefaultMTLSUsernamePrefix = "mtls:"
+
+// MTLS authenticates clients by the certificate they present during the TLS
+// handshake. Only certificates that were verified against the client CA of
+// the server are considered. Certificate identities are managed outside of
+// Weaviate like OIDC users, so they share the user type "oidc" when roles are
+// assigned to them. Their usernames always start with UsernamePrefix so that
+// they can't be confused with OIDC subjects of the same name.
+type MTLS struct {
+	Enabled        bool   `json:"enabled" yaml:"enabled"`
+	UsernameField  string `json:"username_field" yaml:"username_field"`
+	UsernamePrefix string `json:"username_prefix" yaml:"username_prefix"`
+}
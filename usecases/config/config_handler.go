@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -121,6 +122,7 @@ type Config struct {
 	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
 	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
 	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
+	AuditLog                            audit.Config             `json:"audit_log" yaml:"audit_log"`
 	Origin                              string                   `json:"origin" yaml:"origin"`
 	Persistence                         Persistence              `json:"persistence" yaml:"persistence"`
 	DefaultVectorizerModule             string                   `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
@@ -174,6 +176,9 @@ type Config struct {
 
 	ReplicaMovementEnabled          bool                                 `json:"replica_movement_enabled" yaml:"replica_movement_enabled"`
 	ReplicaMovementMinimumAsyncWait *runtime.DynamicValue[time.Duration] `json:"REPLICA_MOVEMENT_MINIMUM_ASYNC_WAIT" yaml:"REPLICA_MOVEMENT_MINIMUM_ASYNC_WAIT"`
+	ReplicaRebalancer               ReplicaRebalancer                    `json:"replica_rebalancer" yaml:"replica_rebalancer"`
+	ChangeStream                    ChangeStream                         `json:"change_stream" yaml:"change_stream"`
+	CrossClusterReplication         CrossClusterReplication              `json:"cross_cluster_replication" yaml:"cross_cluster_replication"`
 
 	// TenantActivityReadLogLevel is 'debug' by default as every single READ
 	// interaction with a tenant leads to a log line. However, this may
@@ -206,6 +211,8 @@ type Config struct {
 	QuerySlowLogEnabled   *runtime.DynamicValue[bool]          `json:"query_slow_log_enabled" yaml:"query_slow_log_enabled"`
 	QuerySlowLogThreshold *runtime.DynamicValue[time.Duration] `json:"query_slow_log_threshold" yaml:"query_slow_log_threshold"`
 
+	RateLimits RateLimits `json:"rate_limits" yaml:"rate_limits"`
+
 	// InvertedSorterDisabled forces the "objects bucket" strategy and doesn't
 	// not consider inverted sorting, even when the query planner thinks this is
 	// the better option.
@@ -251,6 +258,10 @@ func (c *Config) Validate() error {
 		return configErr(err)
 	}
 
+	if err := c.AuditLog.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	if c.Authentication.AnonymousAccess.Enabled && c.Authorization.Rbac.Enabled {
 		return fmt.Errorf("cannot enable anonymous access and rbac authorization")
 	}
@@ -302,10 +313,11 @@ type moduleProvider interface {
 
 func (c *Config) validateDefaultVectorDistanceMetric() error {
 	switch c.DefaultVectorDistanceMetric {
-	case "", common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared, common.DistanceManhattan, common.DistanceHamming:
+	case "", common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared, common.DistanceManhattan, common.DistanceHamming,
+		common.DistanceJaccard, common.DistanceAngular:
 		return nil
 	default:
-		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]")
+		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\",\"angular\"]")
 	}
 }
 
@@ -351,6 +363,9 @@ type GRPC struct {
 	CertFile   string `json:"certFile" yaml:"certFile"`
 	KeyFile    string `json:"keyFile" yaml:"keyFile"`
 	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
+	// ClientCAFile enables mutual TLS, clients need to present a certificate
+	// signed by one of the CAs in this file
+	ClientCAFile string `json:"clientCAFile" yaml:"clientCAFile"`
 }
 
 type Profiling struct {
@@ -387,6 +402,17 @@ type Persistence struct {
 	HNSWSnapshotOnStartup                        bool   `json:"hnswSnapshotOnStartup" yaml:"hnswSnapshotOnStartup"`
 	HNSWSnapshotMinDeltaCommitlogsNumber         int    `json:"hnswSnapshotMinDeltaCommitlogsNumber" yaml:"hnswSnapshotMinDeltaCommitlogsNumber"`
 	HNSWSnapshotMinDeltaCommitlogsSizePercentage int    `json:"hnswSnapshotMinDeltaCommitlogsSizePercentage" yaml:"hnswSnapshotMinDeltaCommitlogsSizePercentage"`
+	// EncryptionAtRestEnabled encrypts the files of all shards with per-shard
+	// data keys, which in turn are encrypted with the master key. The master
+	// key is either set directly (base64 encoded) or read from a file.
+	// Tenants get their own data key, which is stored wrapped in the cluster's
+	// schema, so all nodes of a cluster must use the same master key.
+	// Encrypted segments which aren't read into memory are decrypted block by
+	// block on demand, the decrypted blocks share a cache of the given size.
+	EncryptionAtRestEnabled        bool   `json:"encryptionAtRestEnabled" yaml:"encryptionAtRestEnabled"`
+	EncryptionAtRestBlockCacheSize int64  `json:"encryptionAtRestBlockCacheSize" yaml:"encryptionAtRestBlockCacheSize"`
+	EncryptionAtRestMasterKeyFile  string `json:"encryptionAtRestMasterKeyFile" yaml:"encryptionAtRestMasterKeyFile"`
+	EncryptionAtRestMasterKey      string `json:"-" yaml:"-"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -407,6 +433,10 @@ const DefaultPersistenceLSMCycleManagerRoutinesFactor = 2
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+// DefaultEncryptionAtRestBlockCacheSize is the default size of the cache of
+// decrypted blocks of encrypted segments
+const DefaultEncryptionAtRestBlockCacheSize = 256 * 1024 * 1024 // 256MB
+
 const (
 	// minimal interval for new hnws snapshot to be created after last one
 	DefaultHNSWSnapshotIntervalSeconds                  = 6 * 3600 // 6h
@@ -437,6 +467,17 @@ const (
 	DefaultMetadataServerDataEventsChannelCapacity = 100
 )
 
+// ChangeStream records the object mutations of every shard so that clients
+// can subscribe to the changes of a collection through gRPC
+type ChangeStream struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Retention is how long changes are kept, clients which resume from an
+	// older offset miss changes
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
+const DefaultChangeStreamRetention = 24 * time.Hour
+
 const DefaultHNSWVisitedListPoolSize = -1 // unlimited for backward compatibility
 
 const DefaultHNSWFlatSearchConcurrency = 1 // 1 for backward compatibility
@@ -451,6 +492,10 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	if p.EncryptionAtRestEnabled && p.EncryptionAtRestMasterKey == "" && p.EncryptionAtRestMasterKeyFile == "" {
+		return fmt.Errorf("encryption at rest requires a master key or a master key file")
+	}
+
 	return nil
 }
 
@@ -502,7 +547,7 @@ type CORS struct {
 const (
 	DefaultCORSAllowOrigin  = "*"
 	DefaultCORSAllowMethods = "*"
-	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Azure-Concurrency, X-Azure-Block-Size, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Goog-Api-Key, X-Goog-Vertex-Api-Key, X-Goog-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key, X-Weaviate-Cluster-Url, X-Nvidia-Api-Key, X-Nvidia-Baseurl"
+	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Azure-Concurrency, X-Azure-Block-Size, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Goog-Api-Key, X-Goog-Vertex-Api-Key, X-Goog-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key, X-Weaviate-Cluster-Url, X-Nvidia-Api-Key, X-Nvidia-Baseurl, X-Weaviate-Session, X-Weaviate-Sharding-Key"
 )
 
 func (r ResourceUsage) Validate() error {
@@ -35,7 +35,7 @@ func TestConfigModules(t *testing.T) {
 		assert.EqualError(
 			t,
 			err,
-			"default vector distance metric: must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]",
+			"default vector distance metric: must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\",\"angular\"]",
 		)
 	})
 
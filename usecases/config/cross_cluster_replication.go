@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const DefaultCrossClusterReplicationFrequency = 30 * time.Second
+
+// CrossClusterReplication continuously replicates collections to a follower
+// cluster, e.g. in another region, so that it can take over without
+// restoring a backup. The first replica of every shard compares its hashtree
+// with the replica of the shard in the follower cluster and propagates the
+// differences through the cluster API of the follower. The follower
+// collections must have the same shards as the leader collections and both
+// clusters must use the same async replication hashtree height.
+type CrossClusterReplication struct {
+	// Followers maps a collection of this cluster to the cluster API
+	// addresses of the nodes of its follower cluster
+	Followers map[string][]string `json:"followers" yaml:"followers"`
+	// Followed are the collections this cluster is a follower for. Their
+	// shards keep a hashtree even if async replication is disabled, so that
+	// the leader cluster can compare them with its own.
+	Followed  []string      `json:"followed" yaml:"followed"`
+	Frequency time.Duration `json:"frequency" yaml:"frequency"`
+}
+
+// FollowerHosts returns the follower cluster addresses of a collection, nil
+// if the collection is not replicated to a follower cluster
+func (c CrossClusterReplication) FollowerHosts(collection string) []string {
+	return c.Followers[collection]
+}
+
+// IsFollowed returns whether this cluster is a follower for the collection
+func (c CrossClusterReplication) IsFollowed(collection string) bool {
+	for _, name := range c.Followed {
+		if name == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCrossClusterFollowers parses a semicolon separated list of
+// "Collection=host:port,host:port" entries
+func ParseCrossClusterFollowers(followers string) (map[string][]string, error) {
+	out := map[string][]string{}
+	for _, entry := range strings.Split(followers, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		collection, hostList, ok := strings.Cut(entry, "=")
+		collection = strings.TrimSpace(collection)
+		if !ok || collection == "" {
+			return nil, fmt.Errorf("follower %q: expected format Collection=host:port,host:port", entry)
+		}
+		if _, ok := out[collection]; ok {
+			return nil, fmt.Errorf("follower %q: collection %q configured twice", entry, collection)
+		}
+		var hosts []string
+		for _, host := range strings.Split(hostList, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+		if len(hosts) == 0 {
+			return nil, fmt.Errorf("follower %q: no hosts configured", entry)
+		}
+		out[collection] = hosts
+	}
+	return out, nil
+}
@@ -12,9 +12,11 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strconv"
@@ -24,8 +26,10 @@ import (
 	dbhelpers "github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/sentry"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
 )
@@ -174,6 +178,7 @@ func FromEnv(config *Config) error {
 			userClaim       string
 			groupsClaim     string
 			certificate     string
+			tenantClaim     string
 		)
 
 		if entcfg.Enabled(os.Getenv("AUTHENTICATION_OIDC_SKIP_CLIENT_ID_CHECK")) {
@@ -204,6 +209,18 @@ func FromEnv(config *Config) error {
 			certificate = v
 		}
 
+		if v := os.Getenv("AUTHENTICATION_OIDC_TENANT_CLAIM"); v != "" {
+			tenantClaim = v
+		}
+
+		if v := os.Getenv("AUTHENTICATION_OIDC_ROLE_MAPPINGS"); v != "" {
+			var mappings []OIDCRoleMapping
+			if err := json.Unmarshal([]byte(v), &mappings); err != nil {
+				return fmt.Errorf("parse AUTHENTICATION_OIDC_ROLE_MAPPINGS as JSON: %w", err)
+			}
+			config.Authentication.OIDC.RoleMappings = mappings
+		}
+
 		config.Authentication.OIDC.SkipClientIDCheck = runtime.NewDynamicValue(skipClientCheck)
 		config.Authentication.OIDC.Issuer = runtime.NewDynamicValue(issuer)
 		config.Authentication.OIDC.ClientID = runtime.NewDynamicValue(clientID)
@@ -211,6 +228,19 @@ func FromEnv(config *Config) error {
 		config.Authentication.OIDC.UsernameClaim = runtime.NewDynamicValue(userClaim)
 		config.Authentication.OIDC.GroupsClaim = runtime.NewDynamicValue(groupsClaim)
 		config.Authentication.OIDC.Certificate = runtime.NewDynamicValue(certificate)
+		config.Authentication.OIDC.TenantClaim = runtime.NewDynamicValue(tenantClaim)
+	}
+
+	if entcfg.Enabled(os.Getenv("AUTHENTICATION_MTLS_ENABLED")) {
+		config.Authentication.MTLS.Enabled = true
+		config.Authentication.MTLS.UsernameField = MTLSUsernameFieldCommonName
+		if v := os.Getenv("AUTHENTICATION_MTLS_USERNAME_FIELD"); v != "" {
+			config.Authentication.MTLS.UsernameField = v
+		}
+		config.Authentication.MTLS.UsernamePrefix = DefaultMTLSUsernamePrefix
+		if v := os.Getenv("AUTHENTICATION_MTLS_USERNAME_PREFIX"); v != "" {
+			config.Authentication.MTLS.UsernamePrefix = v
+		}
 	}
 
 	if entcfg.Enabled(os.Getenv("AUTHENTICATION_DB_USERS_ENABLED")) {
@@ -388,6 +418,26 @@ func FromEnv(config *Config) error {
 		config.Persistence.HNSWDisableSnapshots = entcfg.Enabled(v)
 	}
 
+	if entcfg.Enabled(os.Getenv("ENCRYPTION_AT_REST_ENABLED")) {
+		config.Persistence.EncryptionAtRestEnabled = true
+	}
+	if v := os.Getenv("ENCRYPTION_AT_REST_MASTER_KEY"); v != "" {
+		config.Persistence.EncryptionAtRestMasterKey = v
+	}
+	if v := os.Getenv("ENCRYPTION_AT_REST_MASTER_KEY_FILE"); v != "" {
+		config.Persistence.EncryptionAtRestMasterKeyFile = v
+	}
+	if v := os.Getenv("ENCRYPTION_AT_REST_BLOCK_CACHE_SIZE"); v != "" {
+		parsed, err := parseResourceString(v)
+		if err != nil {
+			return fmt.Errorf("parse ENCRYPTION_AT_REST_BLOCK_CACHE_SIZE: %w", err)
+		}
+
+		config.Persistence.EncryptionAtRestBlockCacheSize = parsed
+	} else {
+		config.Persistence.EncryptionAtRestBlockCacheSize = DefaultEncryptionAtRestBlockCacheSize
+	}
+
 	if err := parseNonNegativeInt(
 		"PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS",
 		func(seconds int) { config.Persistence.HNSWSnapshotIntervalSeconds = seconds },
@@ -460,6 +510,10 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if err := parseAuditLogConfig(&config.AuditLog, config.Persistence.DataPath); err != nil {
+		return err
+	}
+
 	parsePositiveFloat("REINDEXER_GOROUTINES_FACTOR",
 		func(val float64) { config.ReindexerGoroutinesFactor = val },
 		DefaultReindexerGoroutinesFactor)
@@ -697,6 +751,10 @@ func FromEnv(config *Config) error {
 	if v := os.Getenv("GRPC_KEY_FILE"); v != "" {
 		config.GRPC.KeyFile = v
 	}
+	config.GRPC.ClientCAFile = ""
+	if v := os.Getenv("GRPC_CLIENT_CA_FILE"); v != "" {
+		config.GRPC.ClientCAFile = v
+	}
 
 	config.DisableGraphQL = entcfg.Enabled(os.Getenv("DISABLE_GRAPHQL"))
 
@@ -718,6 +776,10 @@ func FromEnv(config *Config) error {
 		config.Replication.DeletionStrategy = v
 	}
 
+	if err := parseHintedHandoffConfig(&config.Replication.HintedHandoff); err != nil {
+		return err
+	}
+
 	config.DisableTelemetry = false
 	if entcfg.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
@@ -811,6 +873,30 @@ func FromEnv(config *Config) error {
 	} else {
 		config.ReplicaMovementMinimumAsyncWait = runtime.NewDynamicValue(DefaultReplicaMovementMinimumAsyncWait)
 	}
+
+	if err := parseReplicaRebalancerConfig(&config.ReplicaRebalancer); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("CHANGE_STREAM_ENABLED"); v != "" {
+		config.ChangeStream.Enabled = entcfg.Enabled(v)
+	}
+	config.ChangeStream.Retention = DefaultChangeStreamRetention
+	if v := os.Getenv("CHANGE_STREAM_RETENTION"); v != "" {
+		retention, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse CHANGE_STREAM_RETENTION as time.Duration: %w", err)
+		}
+		if retention <= 0 {
+			return fmt.Errorf("CHANGE_STREAM_RETENTION must be a positive duration")
+		}
+		config.ChangeStream.Retention = retention
+	}
+
+	if err := parseCrossClusterReplicationConfig(&config.CrossClusterReplication); err != nil {
+		return err
+	}
+
 	revoctorizeCheckDisabled := false
 	if v := os.Getenv("REVECTORIZE_CHECK_DISABLED"); v != "" {
 		revoctorizeCheckDisabled = !(strings.ToLower(v) == "false")
@@ -830,6 +916,21 @@ func FromEnv(config *Config) error {
 	}
 	config.QuerySlowLogThreshold = runtime.NewDynamicValue(querySlowLogThreshold)
 
+	for envPrefix, limit := range config.RateLimits.rateLimitEnvVars() {
+		if err := parseFloat64(envPrefix, 0, func(val float64) error {
+			if val < 0 {
+				return fmt.Errorf("%s must not be negative", envPrefix)
+			}
+			return nil
+		}, func(val float64) { limit.Rate = runtime.NewDynamicValue(val) }); err != nil {
+			return err
+		}
+		if err := parseNonNegativeInt(envPrefix+"_BURST",
+			func(val int) { limit.Burst = runtime.NewDynamicValue(val) }, 0); err != nil {
+			return err
+		}
+	}
+
 	invertedSorterDisabled := false
 	if v := os.Getenv("INVERTED_SORTER_DISABLED"); v != "" {
 		invertedSorterDisabled = !(strings.ToLower(v) == "false")
@@ -839,6 +940,134 @@ func FromEnv(config *Config) error {
 	return nil
 }
 
+func parseReplicaRebalancerConfig(cfg *ReplicaRebalancer) error {
+	if v := os.Getenv("REPLICA_REBALANCER_ENABLED"); v != "" {
+		cfg.Enabled = entcfg.Enabled(v)
+	}
+	if v := os.Getenv("REPLICA_REBALANCER_PLAN_ONLY"); v != "" {
+		cfg.PlanOnly = entcfg.Enabled(v)
+	}
+
+	cfg.Interval = DefaultReplicaRebalancerInterval
+	if v := os.Getenv("REPLICA_REBALANCER_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICA_REBALANCER_INTERVAL as time.Duration: %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("REPLICA_REBALANCER_INTERVAL must be a positive duration")
+		}
+		cfg.Interval = interval
+	}
+
+	cfg.NodeLossGracePeriod = DefaultReplicaRebalancerNodeLossGracePeriod
+	if v := os.Getenv("REPLICA_REBALANCER_NODE_LOSS_GRACE_PERIOD"); v != "" {
+		period, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICA_REBALANCER_NODE_LOSS_GRACE_PERIOD as time.Duration: %w", err)
+		}
+		if period < 0 {
+			return fmt.Errorf("REPLICA_REBALANCER_NODE_LOSS_GRACE_PERIOD must not be negative")
+		}
+		cfg.NodeLossGracePeriod = period
+	}
+
+	if err := parsePositiveInt(
+		"REPLICA_REBALANCER_MAX_CONCURRENT_MOVES",
+		func(val int) { cfg.MaxConcurrentMoves = val },
+		DefaultReplicaRebalancerMaxConcurrentMoves,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveFloat(
+		"REPLICA_REBALANCER_IMBALANCE_THRESHOLD",
+		func(val float64) { cfg.ImbalanceThreshold = val },
+		DefaultReplicaRebalancerImbalanceThreshold,
+	); err != nil {
+		return err
+	}
+
+	if err := parseFloat64("REPLICA_REBALANCER_MAX_DISK_USAGE", DefaultReplicaRebalancerMaxDiskUsage,
+		func(val float64) error {
+			if val <= 0 || val > 1 {
+				return fmt.Errorf("REPLICA_REBALANCER_MAX_DISK_USAGE must be in (0, 1]. Got: %v", val)
+			}
+			return nil
+		}, func(val float64) { cfg.MaxDiskUsage = val }); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("REPLICA_REBALANCER_MAINTENANCE_WINDOWS"); v != "" {
+		if _, err := ParseMaintenanceWindows(v); err != nil {
+			return fmt.Errorf("parse REPLICA_REBALANCER_MAINTENANCE_WINDOWS: %w", err)
+		}
+		cfg.MaintenanceWindows = v
+	}
+	return nil
+}
+
+func parseCrossClusterReplicationConfig(cfg *CrossClusterReplication) error {
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_FOLLOWERS"); v != "" {
+		followers, err := ParseCrossClusterFollowers(v)
+		if err != nil {
+			return fmt.Errorf("parse CROSS_CLUSTER_REPLICATION_FOLLOWERS: %w", err)
+		}
+		cfg.Followers = followers
+	}
+
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_FOLLOWED"); v != "" {
+		cfg.Followed = nil
+		for _, collection := range strings.Split(v, ",") {
+			if collection = strings.TrimSpace(collection); collection != "" {
+				cfg.Followed = append(cfg.Followed, collection)
+			}
+		}
+	}
+
+	cfg.Frequency = DefaultCrossClusterReplicationFrequency
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_FREQUENCY"); v != "" {
+		frequency, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse CROSS_CLUSTER_REPLICATION_FREQUENCY as time.Duration: %w", err)
+		}
+		if frequency <= 0 {
+			return fmt.Errorf("CROSS_CLUSTER_REPLICATION_FREQUENCY must be a positive duration")
+		}
+		cfg.Frequency = frequency
+	}
+	return nil
+}
+
+func parseHintedHandoffConfig(cfg *replication.HintedHandoff) error {
+	cfg.Enabled = entcfg.Enabled(os.Getenv("REPLICATION_HINTED_HANDOFF_ENABLED"))
+
+	cfg.MaxAge = replication.DefaultHintedHandoffMaxAge
+	if v := os.Getenv("REPLICATION_HINTED_HANDOFF_MAX_AGE"); v != "" {
+		maxAge, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICATION_HINTED_HANDOFF_MAX_AGE as time.Duration: %w", err)
+		}
+		if maxAge <= 0 {
+			return fmt.Errorf("REPLICATION_HINTED_HANDOFF_MAX_AGE must be a positive duration")
+		}
+		cfg.MaxAge = maxAge
+	}
+
+	cfg.ReplayFrequency = replication.DefaultHintedHandoffReplayFrequency
+	if v := os.Getenv("REPLICATION_HINTED_HANDOFF_REPLAY_FREQUENCY"); v != "" {
+		frequency, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICATION_HINTED_HANDOFF_REPLAY_FREQUENCY as time.Duration: %w", err)
+		}
+		if frequency <= 0 {
+			return fmt.Errorf("REPLICATION_HINTED_HANDOFF_REPLAY_FREQUENCY must be a positive duration")
+		}
+		cfg.ReplayFrequency = frequency
+	}
+	return nil
+}
+
 func parseRAFTConfig(hostname string) (Raft, error) {
 	// flag.IntVar()
 	cfg := Raft{
@@ -1190,6 +1419,56 @@ func parseStringList(varName string, cb func(val []string), defaultValue []strin
 	}
 }
 
+// parseAuditLogConfig overrides the audit log settings from the config file
+// with the ones given through the environment
+func parseAuditLogConfig(cfg *audit.Config, dataPath string) error {
+	if entcfg.Enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		cfg.Enabled = true
+	}
+	if entcfg.Enabled(os.Getenv("AUDIT_LOG_READS")) {
+		cfg.Reads = true
+	}
+
+	if v := os.Getenv("AUDIT_LOG_PATH"); v != "" {
+		cfg.Path = v
+	} else if cfg.Path == "" {
+		cfg.Path = filepath.Join(dataPath, "audit", audit.DefaultFileName)
+	}
+
+	if cfg.MaxSizeMB == 0 {
+		cfg.MaxSizeMB = audit.DefaultMaxSizeMB
+	}
+	if err := parseNonNegativeInt("AUDIT_LOG_MAX_SIZE_MB",
+		func(val int) { cfg.MaxSizeMB = val }, cfg.MaxSizeMB); err != nil {
+		return err
+	}
+	if cfg.MaxBackups == 0 {
+		cfg.MaxBackups = audit.DefaultMaxBackups
+	}
+	if err := parseNonNegativeInt("AUDIT_LOG_MAX_BACKUPS",
+		func(val int) { cfg.MaxBackups = val }, cfg.MaxBackups); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("AUDIT_LOG_WEBHOOK_URL"); v != "" {
+		cfg.WebhookURL = v
+	}
+
+	if v := os.Getenv("AUDIT_LOG_DOMAINS"); v != "" {
+		cfg.Domains = nil
+		for _, domain := range strings.Split(v, ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				cfg.Domains = append(cfg.Domains, domain)
+			}
+		}
+	}
+	if len(cfg.Domains) == 0 {
+		cfg.Domains = audit.DefaultDomains
+	}
+
+	return nil
+}
+
 func parseResourceUsageEnvVars() (ResourceUsage, error) {
 	ru := ResourceUsage{}
 
@@ -1248,6 +1527,8 @@ func parseClusterConfig() (cluster.Config, error) {
 		cfg.Hostname, _ = os.Hostname()
 	}
 	cfg.Join = os.Getenv("CLUSTER_JOIN")
+	cfg.Zone = os.Getenv("CLUSTER_ZONE")
+	cfg.ReadOnly = entcfg.Enabled(os.Getenv("CLUSTER_READ_ONLY"))
 
 	advertiseAddr, advertiseAddrSet := os.LookupEnv("CLUSTER_ADVERTISE_ADDR")
 	advertisePort, advertisePortSet := os.LookupEnv("CLUSTER_ADVERTISE_PORT")
@@ -15,10 +15,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
 )
@@ -281,6 +284,32 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				MaintenanceNodes: make([]string, 0),
 			},
 		},
+		{
+			name: "valid cluster config - zone provided",
+			envVars: map[string]string{
+				"CLUSTER_ZONE": "eu-west-1a",
+			},
+			expectedResult: cluster.Config{
+				Hostname:         hostname,
+				GossipBindPort:   DefaultGossipBindPort,
+				DataBindPort:     DefaultGossipBindPort + 1,
+				Zone:             "eu-west-1a",
+				MaintenanceNodes: make([]string, 0),
+			},
+		},
+		{
+			name: "valid cluster config - read-only node",
+			envVars: map[string]string{
+				"CLUSTER_READ_ONLY": "true",
+			},
+			expectedResult: cluster.Config{
+				Hostname:         hostname,
+				GossipBindPort:   DefaultGossipBindPort,
+				DataBindPort:     DefaultGossipBindPort + 1,
+				ReadOnly:         true,
+				MaintenanceNodes: make([]string, 0),
+			},
+		},
 		{
 			name: "valid cluster config - no ports and advertiseaddr provided",
 			expectedResult: cluster.Config{
@@ -727,6 +756,7 @@ func TestEnvironmentAuthentication(t *testing.T) {
 					GroupsClaim:       runtime.NewDynamicValue(""),
 					Scopes:            runtime.NewDynamicValue([]string(nil)),
 					Certificate:       runtime.NewDynamicValue(""),
+					TenantClaim:       runtime.NewDynamicValue(""),
 				},
 			},
 		},
@@ -791,6 +821,35 @@ func TestEnvironmentHNSWMaxLogSize(t *testing.T) {
 	}
 }
 
+func TestEnvironmentEncryptionAtRestBlockCacheSize(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int64
+		expectedErr bool
+	}{
+		{"Valid no unit", []string{"4096"}, 4096, false},
+		{"Valid SI unit", []string{"64MiB"}, 64 * 1024 * 1024, false},
+		{"not given", []string{}, DefaultEncryptionAtRestBlockCacheSize, false},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("ENCRYPTION_AT_REST_BLOCK_CACHE_SIZE", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.Persistence.EncryptionAtRestBlockCacheSize)
+			}
+		})
+	}
+}
+
 func TestEnvironmentHNSWWaitForPrefill(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -1290,3 +1349,185 @@ func TestParsePositiveFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentAuditLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", "/var/lib/weaviate")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.False(t, conf.AuditLog.Enabled)
+		assert.Equal(t, "/var/lib/weaviate/audit/audit.jsonl", conf.AuditLog.Path)
+		assert.Equal(t, audit.DefaultMaxSizeMB, conf.AuditLog.MaxSizeMB)
+		assert.Equal(t, audit.DefaultMaxBackups, conf.AuditLog.MaxBackups)
+		assert.Equal(t, audit.DefaultDomains, conf.AuditLog.Domains)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		t.Setenv("AUDIT_LOG_PATH", "/audit/weaviate.jsonl")
+		t.Setenv("AUDIT_LOG_MAX_SIZE_MB", "10")
+		t.Setenv("AUDIT_LOG_MAX_BACKUPS", "3")
+		t.Setenv("AUDIT_LOG_WEBHOOK_URL", "http://localhost:9000/audit")
+		t.Setenv("AUDIT_LOG_DOMAINS", "roles, users")
+		t.Setenv("AUDIT_LOG_READS", "true")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.Equal(t, audit.Config{
+			Enabled:    true,
+			Path:       "/audit/weaviate.jsonl",
+			MaxSizeMB:  10,
+			MaxBackups: 3,
+			WebhookURL: "http://localhost:9000/audit",
+			Domains:    []string{"roles", "users"},
+			Reads:      true,
+		}, conf.AuditLog)
+	})
+
+	t.Run("env does not override config file", func(t *testing.T) {
+		conf := Config{AuditLog: audit.Config{Enabled: true, Path: "/from/file.jsonl", Domains: []string{"backups"}}}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.True(t, conf.AuditLog.Enabled)
+		assert.Equal(t, "/from/file.jsonl", conf.AuditLog.Path)
+		assert.Equal(t, []string{"backups"}, conf.AuditLog.Domains)
+	})
+}
+
+func TestEnvironmentReplicaRebalancer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.ReplicaRebalancer.Enabled)
+		assert.Equal(t, DefaultReplicaRebalancerInterval, conf.ReplicaRebalancer.Interval)
+		assert.Equal(t, DefaultReplicaRebalancerMaxConcurrentMoves, conf.ReplicaRebalancer.MaxConcurrentMoves)
+		assert.Equal(t, DefaultReplicaRebalancerMaxDiskUsage, conf.ReplicaRebalancer.MaxDiskUsage)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("REPLICA_REBALANCER_ENABLED", "true")
+		t.Setenv("REPLICA_REBALANCER_PLAN_ONLY", "true")
+		t.Setenv("REPLICA_REBALANCER_INTERVAL", "1m")
+		t.Setenv("REPLICA_REBALANCER_MAX_CONCURRENT_MOVES", "5")
+		t.Setenv("REPLICA_REBALANCER_MAINTENANCE_WINDOWS", "22:00-06:00")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.ReplicaRebalancer.Enabled)
+		assert.True(t, conf.ReplicaRebalancer.PlanOnly)
+		assert.Equal(t, time.Minute, conf.ReplicaRebalancer.Interval)
+		assert.Equal(t, 5, conf.ReplicaRebalancer.MaxConcurrentMoves)
+		assert.Equal(t, "22:00-06:00", conf.ReplicaRebalancer.MaintenanceWindows)
+	})
+
+	t.Run("invalid maintenance window", func(t *testing.T) {
+		t.Setenv("REPLICA_REBALANCER_MAINTENANCE_WINDOWS", "22:00")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentChangeStream(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.ChangeStream.Enabled)
+		assert.Equal(t, DefaultChangeStreamRetention, conf.ChangeStream.Retention)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("CHANGE_STREAM_ENABLED", "true")
+		t.Setenv("CHANGE_STREAM_RETENTION", "2h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.ChangeStream.Enabled)
+		assert.Equal(t, 2*time.Hour, conf.ChangeStream.Retention)
+	})
+
+	t.Run("invalid retention", func(t *testing.T) {
+		t.Setenv("CHANGE_STREAM_RETENTION", "0s")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentCrossClusterReplication(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Empty(t, conf.CrossClusterReplication.Followers)
+		assert.Empty(t, conf.CrossClusterReplication.Followed)
+		assert.Equal(t, DefaultCrossClusterReplicationFrequency, conf.CrossClusterReplication.Frequency)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_FOLLOWERS", "Article=10.0.0.1:7101, 10.0.0.2:7101;Book=10.0.1.1:7101")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_FOLLOWED", "Author, Publisher")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_FREQUENCY", "10s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, map[string][]string{
+			"Article": {"10.0.0.1:7101", "10.0.0.2:7101"},
+			"Book":    {"10.0.1.1:7101"},
+		}, conf.CrossClusterReplication.Followers)
+		assert.Equal(t, []string{"10.0.0.1:7101", "10.0.0.2:7101"}, conf.CrossClusterReplication.FollowerHosts("Article"))
+		assert.Nil(t, conf.CrossClusterReplication.FollowerHosts("Author"))
+		assert.True(t, conf.CrossClusterReplication.IsFollowed("Publisher"))
+		assert.False(t, conf.CrossClusterReplication.IsFollowed("Article"))
+		assert.Equal(t, 10*time.Second, conf.CrossClusterReplication.Frequency)
+	})
+
+	for _, followers := range []string{"Article", "Article=", "=host:7101", "Article=a:1;Article=b:1"} {
+		t.Run("invalid followers "+followers, func(t *testing.T) {
+			t.Setenv("CROSS_CLUSTER_REPLICATION_FOLLOWERS", followers)
+			conf := Config{}
+			require.NotNil(t, FromEnv(&conf))
+		})
+	}
+}
+
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Replication.HintedHandoff.Enabled)
+		assert.Equal(t, replication.DefaultHintedHandoffMaxAge, conf.Replication.HintedHandoff.MaxAge)
+		assert.Equal(t, replication.DefaultHintedHandoffReplayFrequency, conf.Replication.HintedHandoff.ReplayFrequency)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("REPLICATION_HINTED_HANDOFF_ENABLED", "true")
+		t.Setenv("REPLICATION_HINTED_HANDOFF_MAX_AGE", "30m")
+		t.Setenv("REPLICATION_HINTED_HANDOFF_REPLAY_FREQUENCY", "5s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.Replication.HintedHandoff.Enabled)
+		assert.Equal(t, 30*time.Minute, conf.Replication.HintedHandoff.MaxAge)
+		assert.Equal(t, 5*time.Second, conf.Replication.HintedHandoff.ReplayFrequency)
+	})
+
+	for _, env := range []string{"REPLICATION_HINTED_HANDOFF_MAX_AGE", "REPLICATION_HINTED_HANDOFF_REPLAY_FREQUENCY"} {
+		for _, value := range []string{"0s", "-1m", "soon"} {
+			t.Run("invalid "+env+" "+value, func(t *testing.T) {
+				t.Setenv(env, value)
+				conf := Config{}
+				require.NotNil(t, FromEnv(&conf))
+			})
+		}
+	}
+}
+
+func TestMaintenanceWindowContains(t *testing.T) {
+	windows, err := ParseMaintenanceWindows("22:00-06:00, 12:00-13:00")
+	require.Nil(t, err)
+	require.Len(t, windows, 2)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	assert.True(t, windows[0].Contains(at(23, 0)))
+	assert.True(t, windows[0].Contains(at(5, 59)))
+	assert.False(t, windows[0].Contains(at(6, 0)))
+	assert.True(t, windows[1].Contains(at(12, 30)))
+	assert.False(t, windows[1].Contains(at(13, 0)))
+}
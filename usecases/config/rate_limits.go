@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+// RateLimits configures token bucket limits that are applied per principal
+// and per collection, so that a single client can't starve all others. All
+// limits can be changed at runtime, a limit with a rate of 0 is disabled.
+type RateLimits struct {
+	QueriesPerPrincipal       RateLimit `json:"queries_per_principal" yaml:"queries_per_principal"`
+	QueriesPerCollection      RateLimit `json:"queries_per_collection" yaml:"queries_per_collection"`
+	BatchObjectsPerPrincipal  RateLimit `json:"batch_objects_per_principal" yaml:"batch_objects_per_principal"`
+	BatchObjectsPerCollection RateLimit `json:"batch_objects_per_collection" yaml:"batch_objects_per_collection"`
+	ModuleCallsPerPrincipal   RateLimit `json:"module_calls_per_principal" yaml:"module_calls_per_principal"`
+	ModuleCallsPerCollection  RateLimit `json:"module_calls_per_collection" yaml:"module_calls_per_collection"`
+}
+
+// RateLimit is a token bucket that is refilled with Rate tokens per second
+// and holds at most Burst tokens. If Burst is not set, the bucket holds the
+// tokens of one second.
+type RateLimit struct {
+	Rate  *runtime.DynamicValue[float64] `json:"rate" yaml:"rate"`
+	Burst *runtime.DynamicValue[int]     `json:"burst" yaml:"burst"`
+}
+
+// rateLimitEnvVars maps the name prefix of the environment variables to the
+// rate limit they configure
+func (r *RateLimits) rateLimitEnvVars() map[string]*RateLimit {
+	return map[string]*RateLimit{
+		"RATE_LIMIT_QUERIES_PER_PRINCIPAL":        &r.QueriesPerPrincipal,
+		"RATE_LIMIT_QUERIES_PER_COLLECTION":       &r.QueriesPerCollection,
+		"RATE_LIMIT_BATCH_OBJECTS_PER_PRINCIPAL":  &r.BatchObjectsPerPrincipal,
+		"RATE_LIMIT_BATCH_OBJECTS_PER_COLLECTION": &r.BatchObjectsPerCollection,
+		"RATE_LIMIT_MODULE_CALLS_PER_PRINCIPAL":   &r.ModuleCallsPerPrincipal,
+		"RATE_LIMIT_MODULE_CALLS_PER_COLLECTION":  &r.ModuleCallsPerCollection,
+	}
+}
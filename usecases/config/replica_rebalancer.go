@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultReplicaRebalancerInterval            = 5 * time.Minute
+	DefaultReplicaRebalancerMaxConcurrentMoves  = 2
+	DefaultReplicaRebalancerImbalanceThreshold  = 0.2
+	DefaultReplicaRebalancerMaxDiskUsage        = 0.8
+	DefaultReplicaRebalancerNodeLossGracePeriod = 15 * time.Minute
+)
+
+// ReplicaRebalancer configures the background component which moves shard
+// replicas between nodes to even out their load after nodes joined or left
+// the cluster. It relies on the replica movement engine to carry out moves.
+type ReplicaRebalancer struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// PlanOnly logs the moves the rebalancer would start without starting them
+	PlanOnly bool          `json:"plan_only" yaml:"plan_only"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// MaxConcurrentMoves is the number of replication operations which may be
+	// in flight at once, including the ones not started by the rebalancer
+	MaxConcurrentMoves int `json:"max_concurrent_moves" yaml:"max_concurrent_moves"`
+	// ImbalanceThreshold is how far the load of the most loaded node may be
+	// above the average, relative to the average, before replicas are moved
+	ImbalanceThreshold float64 `json:"imbalance_threshold" yaml:"imbalance_threshold"`
+	// MaxDiskUsage is the fraction of the disk above which a node doesn't
+	// receive replicas anymore
+	MaxDiskUsage float64 `json:"max_disk_usage" yaml:"max_disk_usage"`
+	// NodeLossGracePeriod is how long a node has to be gone before the
+	// replicas it held are recreated on other nodes
+	NodeLossGracePeriod time.Duration `json:"node_loss_grace_period" yaml:"node_loss_grace_period"`
+	// MaintenanceWindows restricts when moves are started, e.g.
+	// "22:00-06:00,12:00-13:00" in UTC. Moves may be started at any time if
+	// no window is configured.
+	MaintenanceWindows string `json:"maintenance_windows" yaml:"maintenance_windows"`
+}
+
+// MaintenanceWindow is a daily time range in UTC. A window whose end is
+// before its start spans midnight.
+type MaintenanceWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Contains returns whether t is within the window
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// ParseMaintenanceWindows parses a comma separated list of "HH:MM-HH:MM"
+// ranges
+func ParseMaintenanceWindows(windows string) ([]MaintenanceWindow, error) {
+	var out []MaintenanceWindow
+	for _, window := range strings.Split(windows, ",") {
+		window = strings.TrimSpace(window)
+		if window == "" {
+			continue
+		}
+		start, end, ok := strings.Cut(window, "-")
+		if !ok {
+			return nil, fmt.Errorf("maintenance window %q: expected format HH:MM-HH:MM", window)
+		}
+		startOffset, err := parseTimeOfDay(start)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %q: %w", window, err)
+		}
+		endOffset, err := parseTimeOfDay(end)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %q: %w", window, err)
+		}
+		if startOffset == endOffset {
+			return nil, fmt.Errorf("maintenance window %q: start and end are the same", window)
+		}
+		out = append(out, MaintenanceWindow{Start: startOffset, End: endOffset})
+	}
+	return out, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("parse time of day %q: %w", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
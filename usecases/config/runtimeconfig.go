@@ -45,6 +45,20 @@ type WeaviateRuntimeConfig struct {
 	UsageScrapeInterval             *runtime.DynamicValue[time.Duration] `json:"usage_scrape_interval" yaml:"usage_scrape_interval"`
 	UsagePolicyVersion              *runtime.DynamicValue[string]        `json:"usage_policy_version" yaml:"usage_policy_version"`
 
+	// Rate limits, see RateLimits
+	RateLimitQueriesPerPrincipal            *runtime.DynamicValue[float64] `json:"rate_limit_queries_per_principal" yaml:"rate_limit_queries_per_principal"`
+	RateLimitQueriesPerPrincipalBurst       *runtime.DynamicValue[int]     `json:"rate_limit_queries_per_principal_burst" yaml:"rate_limit_queries_per_principal_burst"`
+	RateLimitQueriesPerCollection           *runtime.DynamicValue[float64] `json:"rate_limit_queries_per_collection" yaml:"rate_limit_queries_per_collection"`
+	RateLimitQueriesPerCollectionBurst      *runtime.DynamicValue[int]     `json:"rate_limit_queries_per_collection_burst" yaml:"rate_limit_queries_per_collection_burst"`
+	RateLimitBatchObjectsPerPrincipal       *runtime.DynamicValue[float64] `json:"rate_limit_batch_objects_per_principal" yaml:"rate_limit_batch_objects_per_principal"`
+	RateLimitBatchObjectsPerPrincipalBurst  *runtime.DynamicValue[int]     `json:"rate_limit_batch_objects_per_principal_burst" yaml:"rate_limit_batch_objects_per_principal_burst"`
+	RateLimitBatchObjectsPerCollection      *runtime.DynamicValue[float64] `json:"rate_limit_batch_objects_per_collection" yaml:"rate_limit_batch_objects_per_collection"`
+	RateLimitBatchObjectsPerCollectionBurst *runtime.DynamicValue[int]     `json:"rate_limit_batch_objects_per_collection_burst" yaml:"rate_limit_batch_objects_per_collection_burst"`
+	RateLimitModuleCallsPerPrincipal        *runtime.DynamicValue[float64] `json:"rate_limit_module_calls_per_principal" yaml:"rate_limit_module_calls_per_principal"`
+	RateLimitModuleCallsPerPrincipalBurst   *runtime.DynamicValue[int]     `json:"rate_limit_module_calls_per_principal_burst" yaml:"rate_limit_module_calls_per_principal_burst"`
+	RateLimitModuleCallsPerCollection       *runtime.DynamicValue[float64] `json:"rate_limit_module_calls_per_collection" yaml:"rate_limit_module_calls_per_collection"`
+	RateLimitModuleCallsPerCollectionBurst  *runtime.DynamicValue[int]     `json:"rate_limit_module_calls_per_collection_burst" yaml:"rate_limit_module_calls_per_collection_burst"`
+
 	// Experimental configs. Will be removed in the future.
 	OIDCIssuer            *runtime.DynamicValue[string]   `json:"exp_oidc_issuer" yaml:"exp_oidc_issuer"`
 	OIDCClientID          *runtime.DynamicValue[string]   `json:"exp_oidc_client_id" yaml:"exp_oidc_client_id"`
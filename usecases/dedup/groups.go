@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dedup
+
+import (
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Group is a set of objects which are near duplicates of each other. Two
+// objects end up in the same group if they are connected by a chain of
+// pairs within the configured distance.
+type Group struct {
+	Tenant string `json:"tenant,omitempty"`
+	// Representative is the smallest id of the group. It is the value written
+	// to the mark property of every member when marking is enabled.
+	Representative strfmt.UUID   `json:"representative"`
+	IDs            []strfmt.UUID `json:"ids"`
+}
+
+type member struct {
+	tenant string
+	id     strfmt.UUID
+}
+
+// grouper merges pairs of near duplicates into groups using a union-find
+// structure. Objects of different tenants are never merged.
+type grouper struct {
+	parent map[member]member
+}
+
+func newGrouper() *grouper {
+	return &grouper{parent: map[member]member{}}
+}
+
+func (g *grouper) addPair(tenant string, a, b strfmt.UUID) {
+	rootA := g.find(member{tenant: tenant, id: a})
+	rootB := g.find(member{tenant: tenant, id: b})
+	if rootA == rootB {
+		return
+	}
+
+	// keep the smallest id as root, so it can directly be used as the
+	// representative of the group
+	if rootB.id < rootA.id {
+		rootA, rootB = rootB, rootA
+	}
+	g.parent[rootB] = rootA
+}
+
+func (g *grouper) find(m member) member {
+	parent, ok := g.parent[m]
+	if !ok {
+		g.parent[m] = m
+		return m
+	}
+	if parent == m {
+		return m
+	}
+
+	root := g.find(parent)
+	g.parent[m] = root
+	return root
+}
+
+// groups returns all groups sorted by tenant and representative, the ids of
+// each group are sorted as well.
+func (g *grouper) groups() []Group {
+	byRoot := map[member][]strfmt.UUID{}
+	for m := range g.parent {
+		root := g.find(m)
+		byRoot[root] = append(byRoot[root], m.id)
+	}
+
+	groups := make([]Group, 0, len(byRoot))
+	for root, ids := range byRoot {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		groups = append(groups, Group{
+			Tenant:         root.tenant,
+			Representative: root.id,
+			IDs:            ids,
+		})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Tenant != groups[j].Tenant {
+			return groups[i].Tenant < groups[j].Tenant
+		}
+		return groups[i].Representative < groups[j].Representative
+	})
+	return groups
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+)
+
+// ErrInvalidTask is returned by Handler.StartTask if the task does not match
+// the collection it targets
+var ErrInvalidTask = errors.New("invalid near duplicates task")
+
+// TaskAdder submits a distributed task to the cluster
+type TaskAdder interface {
+	AddDistributedTask(ctx context.Context, namespace, taskID string, payload any) error
+}
+
+// ClassGetter returns the class of a collection
+type ClassGetter interface {
+	ReadOnlyClass(name string) *models.Class
+}
+
+// Handler starts near duplicates tasks on behalf of a user
+type Handler struct {
+	authorizer authorization.Authorizer
+	classes    ClassGetter
+	tasks      TaskAdder
+	localNode  string
+}
+
+func NewHandler(authorizer authorization.Authorizer, classes ClassGetter, tasks TaskAdder,
+	localNode string,
+) *Handler {
+	return &Handler{
+		authorizer: authorizer,
+		classes:    classes,
+		tasks:      tasks,
+		localNode:  localNode,
+	}
+}
+
+// StartTask submits a near duplicates task which is coordinated by the local
+// node and returns its id. A random id is assigned if taskID is empty. The
+// principal needs to be allowed to read all data of the collection, and to
+// update it if the members of the groups are marked.
+func (h *Handler) StartTask(ctx context.Context, principal *models.Principal,
+	taskID string, payload Payload,
+) (string, error) {
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ,
+		authorization.CollectionsData(payload.Collection)...); err != nil {
+		return "", err
+	}
+	if payload.MarkProperty != "" {
+		if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE,
+			authorization.CollectionsData(payload.Collection)...); err != nil {
+			return "", err
+		}
+	}
+	if err := h.checkUnrestrictedRead(ctx, principal, payload.Collection); err != nil {
+		return "", err
+	}
+
+	if taskID == "" {
+		taskID = uuid.NewString()
+	}
+	if err := ValidateTaskID(taskID); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidTask, err)
+	}
+	if err := payload.Validate(h.classes.ReadOnlyClass(payload.Collection)); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidTask, err)
+	}
+
+	payload.Coordinator = h.localNode
+	if err := h.tasks.AddDistributedTask(ctx, Namespace, taskID, payload); err != nil {
+		return "", fmt.Errorf("add near duplicates task: %w", err)
+	}
+	return taskID, nil
+}
+
+// checkUnrestrictedRead rejects principals whose reads are restricted by
+// read_data filters or properties. The scan compares the vectors of all
+// objects of the collection, so the pairs and groups would reveal objects
+// the principal must not see.
+func (h *Handler) checkUnrestrictedRead(ctx context.Context, principal *models.Principal,
+	collection string,
+) error {
+	filtered, err := filter.ReadDataFiltered(ctx, h.authorizer, principal)
+	if err != nil {
+		return err
+	}
+	if !filtered {
+		access, err := filter.NewPropertyMask(ctx, h.authorizer, principal, "").Access(collection)
+		if err != nil {
+			return err
+		}
+		filtered = access != nil
+	}
+	if filtered {
+		return autherrs.NewForbidden(principal, authorization.READ, authorization.CollectionsData(collection)...)
+	}
+	return nil
+}
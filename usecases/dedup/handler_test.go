@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dedup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type fakeClasses map[string]*models.Class
+
+func (f fakeClasses) ReadOnlyClass(name string) *models.Class { return f[name] }
+
+type fakeTaskAdder struct {
+	added map[string]Payload
+}
+
+func (f *fakeTaskAdder) AddDistributedTask(ctx context.Context, namespace, taskID string, payload any) error {
+	f.added[taskID] = payload.(Payload)
+	return nil
+}
+
+func TestHandlerStartTask(t *testing.T) {
+	classes := fakeClasses{"Docs": {
+		Class: "Docs",
+		Properties: []*models.Property{
+			{Name: "duplicateOf", DataType: schema.DataTypeText.PropString()},
+		},
+	}}
+	principal := &models.Principal{Username: "user"}
+
+	t.Run("coordinated by the local node", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.EXPECT().Authorize(mock.Anything, principal, authorization.READ,
+			authorization.CollectionsData("Docs")[0]).Return(nil)
+		tasks := &fakeTaskAdder{added: map[string]Payload{}}
+
+		id, err := NewHandler(authorizer, classes, tasks, "node1").
+			StartTask(context.Background(), principal, "", Payload{Collection: "Docs", MaxDistance: 0.1})
+		require.Nil(t, err)
+		assert.NotEmpty(t, id)
+		assert.Equal(t, Payload{Collection: "Docs", MaxDistance: 0.1, Coordinator: "node1"}, tasks.added[id])
+	})
+
+	t.Run("marking requires updating the data", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.EXPECT().Authorize(mock.Anything, principal, authorization.READ,
+			authorization.CollectionsData("Docs")[0]).Return(nil)
+		authorizer.EXPECT().Authorize(mock.Anything, principal, authorization.UPDATE,
+			authorization.CollectionsData("Docs")[0]).Return(autherrs.NewForbidden(principal, authorization.UPDATE, "Docs"))
+		tasks := &fakeTaskAdder{added: map[string]Payload{}}
+
+		_, err := NewHandler(authorizer, classes, tasks, "node1").StartTask(context.Background(), principal,
+			"dedup", Payload{Collection: "Docs", MaxDistance: 0.1, MarkProperty: "duplicateOf"})
+		assert.ErrorAs(t, err, &autherrs.Forbidden{})
+		assert.Empty(t, tasks.added)
+	})
+
+	t.Run("restricted reads", func(t *testing.T) {
+		for name, filterer := range map[string]*fakeDataFilterer{
+			"read_data filter": {filtered: true},
+			"read_data properties": {properties: authorization.PropertyAccess{
+				{ExcludedProperties: []string{"secret"}},
+			}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				authorizer := authorization.NewMockAuthorizer(t)
+				authorizer.EXPECT().Authorize(mock.Anything, principal, authorization.READ,
+					authorization.CollectionsData("Docs")[0]).Return(nil)
+				filterer.MockAuthorizer = authorizer
+				tasks := &fakeTaskAdder{added: map[string]Payload{}}
+
+				_, err := NewHandler(filterer, classes, tasks, "node1").StartTask(context.Background(), principal,
+					"dedup", Payload{Collection: "Docs", MaxDistance: 0.1})
+				assert.ErrorAs(t, err, &autherrs.Forbidden{})
+				assert.Empty(t, tasks.added)
+			})
+		}
+	})
+
+	t.Run("invalid task", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.EXPECT().Authorize(mock.Anything, principal, authorization.READ,
+			authorization.CollectionsData("Docs")[0]).Return(nil)
+		tasks := &fakeTaskAdder{added: map[string]Payload{}}
+
+		_, err := NewHandler(authorizer, classes, tasks, "node1").StartTask(context.Background(), principal,
+			"dedup", Payload{Collection: "Docs", MaxDistance: -1})
+		assert.ErrorIs(t, err, ErrInvalidTask)
+		assert.Empty(t, tasks.added)
+	})
+}
+
+type fakeDataFilterer struct {
+	*authorization.MockAuthorizer
+	filtered   bool
+	properties authorization.PropertyAccess
+}
+
+func (f *fakeDataFilterer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return nil, nil
+}
+
+func (f *fakeDataFilterer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return f.filtered, nil
+}
+
+func (f *fakeDataFilterer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return f.properties, nil
+}
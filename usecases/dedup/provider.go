@@ -0,0 +1,456 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package dedup implements a distributed task which finds groups of near
+// duplicate objects in a collection. Every node scans the shards it owns
+// using the neighbor lists of the vector index and writes the pairs it found
+// to a local result file. The coordinator of the task collects the pairs of
+// all nodes, merges them into groups spanning the whole cluster and
+// optionally marks every member of a group by setting a property to the id of
+// the group representative.
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Namespace is the distributed task namespace handled by the Provider
+const Namespace = "near-duplicates"
+
+var taskIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// Payload is the payload of a near duplicates task
+type Payload struct {
+	Collection   string  `json:"collection"`
+	TargetVector string  `json:"targetVector,omitempty"`
+	MaxDistance  float32 `json:"maxDistance"`
+	// MarkProperty is an optional text or uuid property which is set to the id
+	// of the group representative on every member of a group.
+	MarkProperty string `json:"markProperty,omitempty"`
+	// Coordinator is the node which merges the pairs found by all nodes into
+	// groups. Tasks without a coordinator are coordinated by every node.
+	Coordinator string `json:"coordinator,omitempty"`
+}
+
+// Validate checks the payload against the class it targets
+func (p Payload) Validate(class *models.Class) error {
+	if class == nil {
+		return fmt.Errorf("collection %q not found", p.Collection)
+	}
+	if p.MaxDistance < 0 {
+		return fmt.Errorf("maxDistance must not be negative, got %v", p.MaxDistance)
+	}
+
+	if p.TargetVector == "" {
+		if class.VectorIndexConfig == nil && len(class.VectorConfig) > 0 {
+			return fmt.Errorf("collection %q has named vectors, targetVector is required", p.Collection)
+		}
+	} else if _, ok := class.VectorConfig[p.TargetVector]; !ok {
+		return fmt.Errorf("target vector %q not found in collection %q", p.TargetVector, p.Collection)
+	}
+
+	if p.MarkProperty == "" {
+		return nil
+	}
+	prop, err := schema.GetPropertyByName(class, p.MarkProperty)
+	if err != nil {
+		return fmt.Errorf("mark property: %w", err)
+	}
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeUUID:
+		return nil
+	default:
+		return fmt.Errorf("mark property %q must be of type text or uuid, got %v",
+			p.MarkProperty, prop.DataType)
+	}
+}
+
+// ValidateTaskID checks that the id can be used as part of a file name
+func ValidateTaskID(id string) error {
+	if !taskIDRegexp.MatchString(id) {
+		return fmt.Errorf("task id %q must only contain letters, digits and dashes", id)
+	}
+	return nil
+}
+
+// ErrResultNotFound is returned by a PairsClient if the node has not
+// finished scanning its shards yet
+var ErrResultNotFound = errors.New("near duplicates result not found")
+
+// Pair is a pair of near duplicate objects
+type Pair struct {
+	Tenant string      `json:"tenant,omitempty"`
+	A      strfmt.UUID `json:"a"`
+	B      strfmt.UUID `json:"b"`
+}
+
+// Result is the outcome of a near duplicates task on a single node
+type Result struct {
+	Payload    Payload   `json:"payload"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// Pairs are the near duplicates found in the shards owned by the node
+	Pairs []Pair `json:"pairs,omitempty"`
+	// Groups are the groups of the whole cluster, only set on the coordinator
+	Groups []Group `json:"groups,omitempty"`
+}
+
+// NearDuplicatesFinder finds pairs of near duplicate objects in the shards
+// owned by the local node
+type NearDuplicatesFinder interface {
+	NearDuplicates(ctx context.Context, className, targetVector string, maxDist float32,
+		fn func(tenant string, a, b strfmt.UUID, dist float32) error) error
+}
+
+// PairsClient fetches the pairs another node found for a task
+type PairsClient interface {
+	NearDuplicatePairs(ctx context.Context, node string, desc distributedtask.TaskDescriptor) ([]Pair, error)
+}
+
+// NodeSelector lists the nodes taking part in a task
+type NodeSelector interface {
+	LocalName() string
+	AllNames() []string
+}
+
+// ObjectMerger is used to mark the members of a group
+type ObjectMerger interface {
+	Merge(ctx context.Context, merge objects.MergeDocument,
+		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
+}
+
+// Provider executes near duplicates tasks on the local node, see
+// distributedtask.Provider
+type Provider struct {
+	mu                 sync.Mutex
+	completionRecorder distributedtask.TaskCompletionRecorder
+
+	finder       NearDuplicatesFinder
+	merger       ObjectMerger
+	nodes        NodeSelector
+	pairs        PairsClient
+	resultsDir   string
+	pollInterval time.Duration
+	// pairsTimeout limits how long the coordinator waits for the pairs of all
+	// other nodes, maxPairsErrors the number of failed requests to a node
+	pairsTimeout   time.Duration
+	maxPairsErrors int
+	logger         logrus.FieldLogger
+}
+
+func NewProvider(finder NearDuplicatesFinder, merger ObjectMerger, nodes NodeSelector,
+	pairs PairsClient, resultsDir string, logger logrus.FieldLogger,
+) *Provider {
+	return &Provider{
+		finder:         finder,
+		merger:         merger,
+		nodes:          nodes,
+		pairs:          pairs,
+		resultsDir:     resultsDir,
+		pollInterval:   5 * time.Second,
+		pairsTimeout:   time.Hour,
+		maxPairsErrors: 60,
+		logger:         logger.WithField("action", "near_duplicates"),
+	}
+}
+
+func (p *Provider) SetCompletionRecorder(recorder distributedtask.TaskCompletionRecorder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.completionRecorder = recorder
+}
+
+func (p *Provider) GetLocalTasks() []distributedtask.TaskDescriptor {
+	entries, err := os.ReadDir(p.resultsDir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			p.logger.WithError(err).Error("failed to list local near duplicates results")
+		}
+		return nil
+	}
+
+	var descs []distributedtask.TaskDescriptor
+	for _, entry := range entries {
+		desc, ok := descriptorFromFileName(entry.Name())
+		if ok {
+			descs = append(descs, desc)
+		}
+	}
+	return descs
+}
+
+func (p *Provider) CleanupTask(desc distributedtask.TaskDescriptor) error {
+	err := os.Remove(p.resultPath(desc))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove near duplicates result: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) StartTask(task *distributedtask.Task) (distributedtask.TaskHandle, error) {
+	var payload Payload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal near duplicates payload: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	desc := task.TaskDescriptor
+	enterrors.GoWrapper(func() {
+		p.runTask(ctx, desc, payload)
+	}, p.logger)
+
+	return &taskHandle{cancel: cancel}, nil
+}
+
+// Result returns the local result of a finished task
+func (p *Provider) Result(desc distributedtask.TaskDescriptor) (*Result, error) {
+	raw, err := os.ReadFile(p.resultPath(desc))
+	if err != nil {
+		return nil, err
+	}
+
+	var res Result
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal near duplicates result: %w", err)
+	}
+	return &res, nil
+}
+
+func (p *Provider) runTask(ctx context.Context, desc distributedtask.TaskDescriptor, payload Payload) {
+	logger := p.logger.WithField("task_id", desc.ID).WithField("task_version", desc.Version).
+		WithField("collection", payload.Collection)
+
+	// the task might have already been executed before a restart, but its
+	// completion could not be recorded
+	if _, err := os.Stat(p.resultPath(desc)); err == nil {
+		p.recordCompletion(ctx, logger, desc, nil)
+		return
+	}
+
+	logger.Info("searching for near duplicates")
+	res := Result{Payload: payload}
+	pairs, err := p.findPairs(ctx, payload)
+	res.Pairs = pairs
+	if err == nil && p.coordinates(payload) {
+		res.Groups, err = p.mergeGroups(ctx, desc, pairs)
+		if err == nil && payload.MarkProperty != "" {
+			err = p.markGroups(ctx, payload, res.Groups)
+		}
+	}
+	if err == nil {
+		res.FinishedAt = time.Now()
+		err = p.writeResult(desc, res)
+	}
+	if ctx.Err() != nil {
+		// terminated by the scheduler, the task is no longer running
+		return
+	}
+
+	if err == nil {
+		logger.WithField("pairs", len(res.Pairs)).WithField("groups", len(res.Groups)).
+			Info("finished searching for near duplicates")
+	}
+	p.recordCompletion(ctx, logger, desc, err)
+}
+
+func (p *Provider) coordinates(payload Payload) bool {
+	return payload.Coordinator == "" || payload.Coordinator == p.nodes.LocalName()
+}
+
+func (p *Provider) findPairs(ctx context.Context, payload Payload) ([]Pair, error) {
+	var pairs []Pair
+	err := p.finder.NearDuplicates(ctx, payload.Collection, payload.TargetVector, payload.MaxDistance,
+		func(tenant string, a, b strfmt.UUID, dist float32) error {
+			pairs = append(pairs, Pair{Tenant: tenant, A: a, B: b})
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("find near duplicates: %w", err)
+	}
+	return pairs, nil
+}
+
+// mergeGroups waits for every other node to finish scanning its shards and
+// merges their pairs with the local ones. Chains of pairs may span shards
+// on different nodes, so groups can only be built from the pairs of the
+// whole cluster. The task fails if a node does not return its pairs within
+// the pairs timeout.
+func (p *Provider) mergeGroups(ctx context.Context, desc distributedtask.TaskDescriptor,
+	pairs []Pair,
+) ([]Group, error) {
+	g := newGrouper()
+	for _, pair := range pairs {
+		g.addPair(pair.Tenant, pair.A, pair.B)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.pairsTimeout)
+	defer cancel()
+
+	localNode := p.nodes.LocalName()
+	for _, node := range p.nodes.AllNames() {
+		if node == localNode {
+			continue
+		}
+		remote, err := p.remotePairs(ctx, node, desc)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range remote {
+			g.addPair(pair.Tenant, pair.A, pair.B)
+		}
+	}
+	return g.groups(), nil
+}
+
+func (p *Provider) remotePairs(ctx context.Context, node string,
+	desc distributedtask.TaskDescriptor,
+) ([]Pair, error) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		pairs, err := p.pairs.NearDuplicatePairs(ctx, node, desc)
+		if err == nil {
+			return pairs, nil
+		}
+		// the node is still scanning its shards as long as it has no result,
+		// any other error is counted, e.g. if the node is down
+		if !errors.Is(err, ErrResultNotFound) {
+			failures++
+			if failures >= p.maxPairsErrors {
+				return nil, fmt.Errorf("fetch near duplicates of node %s: giving up after %d attempts: %w",
+					node, failures, err)
+			}
+			p.logger.WithField("task_id", desc.ID).WithField("node", node).WithError(err).
+				Warn("fetch near duplicates of node, retrying")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for near duplicates of node %s: %w", node, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Provider) markGroups(ctx context.Context, payload Payload, groups []Group) error {
+	for _, group := range groups {
+		for _, id := range group.IDs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := p.merger.Merge(ctx, objects.MergeDocument{
+				Class: payload.Collection,
+				ID:    id,
+				PrimitiveSchema: map[string]interface{}{
+					payload.MarkProperty: group.Representative.String(),
+				},
+				UpdateTime: time.Now().UnixMilli(),
+			}, nil, group.Tenant, 0)
+			if err != nil {
+				return fmt.Errorf("mark object %s: %w", id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Provider) writeResult(desc distributedtask.TaskDescriptor, res Result) error {
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("marshal near duplicates result: %w", err)
+	}
+
+	if err := os.MkdirAll(p.resultsDir, os.ModePerm); err != nil {
+		return fmt.Errorf("create near duplicates results dir: %w", err)
+	}
+
+	path := p.resultPath(desc)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, raw, 0o644); err != nil {
+		return fmt.Errorf("write near duplicates result: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename near duplicates result: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) recordCompletion(ctx context.Context, logger logrus.FieldLogger,
+	desc distributedtask.TaskDescriptor, taskErr error,
+) {
+	p.mu.Lock()
+	recorder := p.completionRecorder
+	p.mu.Unlock()
+
+	var err error
+	if taskErr != nil {
+		logger.WithError(taskErr).Error("near duplicates task failed")
+		err = recorder.RecordDistributedTaskNodeFailure(ctx, Namespace, desc.ID, desc.Version, taskErr.Error())
+	} else {
+		err = recorder.RecordDistributedTaskNodeCompletion(ctx, Namespace, desc.ID, desc.Version)
+	}
+	if err != nil {
+		logger.WithError(err).Error("failed to record near duplicates task completion")
+	}
+}
+
+func (p *Provider) resultPath(desc distributedtask.TaskDescriptor) string {
+	return filepath.Join(p.resultsDir, fmt.Sprintf("%s_%d.json", desc.ID, desc.Version))
+}
+
+func descriptorFromFileName(name string) (distributedtask.TaskDescriptor, bool) {
+	base, ok := strings.CutSuffix(name, ".json")
+	if !ok {
+		return distributedtask.TaskDescriptor{}, false
+	}
+
+	pos := strings.LastIndex(base, "_")
+	if pos < 1 {
+		return distributedtask.TaskDescriptor{}, false
+	}
+
+	version, err := strconv.ParseUint(base[pos+1:], 10, 64)
+	if err != nil {
+		return distributedtask.TaskDescriptor{}, false
+	}
+	return distributedtask.TaskDescriptor{ID: base[:pos], Version: version}, true
+}
+
+type taskHandle struct {
+	cancel context.CancelFunc
+}
+
+func (h *taskHandle) Terminate() {
+	h.cancel()
+}
@@ -0,0 +1,331 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	id1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	id2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	id3 = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+	id4 = strfmt.UUID("00000000-0000-0000-0000-000000000004")
+	id5 = strfmt.UUID("00000000-0000-0000-0000-000000000005")
+)
+
+type pair struct {
+	tenant string
+	a, b   strfmt.UUID
+}
+
+type fakeFinder struct {
+	pairs []pair
+}
+
+func (f *fakeFinder) NearDuplicates(ctx context.Context, className, targetVector string,
+	maxDist float32, fn func(tenant string, a, b strfmt.UUID, dist float32) error,
+) error {
+	for _, p := range f.pairs {
+		if err := fn(p.tenant, p.a, p.b, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeMerger struct {
+	sync.Mutex
+	merged map[strfmt.UUID]interface{}
+}
+
+func (f *fakeMerger) Merge(ctx context.Context, merge objects.MergeDocument,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	f.merged[merge.ID] = merge.PrimitiveSchema["duplicateOf"]
+	return nil
+}
+
+type fakeRecorder struct {
+	completed chan distributedtask.TaskDescriptor
+	failed    chan string
+}
+
+func (f *fakeRecorder) RecordDistributedTaskNodeCompletion(ctx context.Context, namespace, taskID string, version uint64) error {
+	f.completed <- distributedtask.TaskDescriptor{ID: taskID, Version: version}
+	return nil
+}
+
+func (f *fakeRecorder) RecordDistributedTaskNodeFailure(ctx context.Context, namespace, taskID string, version uint64, errMsg string) error {
+	if f.failed != nil {
+		f.failed <- errMsg
+	}
+	return nil
+}
+
+type fakeNodes struct {
+	local string
+	all   []string
+}
+
+func (f fakeNodes) LocalName() string  { return f.local }
+func (f fakeNodes) AllNames() []string { return f.all }
+
+type fakePairsClient struct {
+	sync.Mutex
+	pairs map[string][]Pair
+	// notFound is the number of requests answered with ErrResultNotFound
+	// before the pairs are returned
+	notFound int
+	// err is returned by every request if set
+	err error
+}
+
+func (f *fakePairsClient) NearDuplicatePairs(ctx context.Context, node string,
+	desc distributedtask.TaskDescriptor,
+) ([]Pair, error) {
+	f.Lock()
+	defer f.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.notFound > 0 {
+		f.notFound--
+		return nil, ErrResultNotFound
+	}
+	return f.pairs[node], nil
+}
+
+func TestProvider(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	finder := &fakeFinder{pairs: []pair{
+		{a: id3, b: id4},
+		{a: id1, b: id2},
+		{tenant: "tenantA", a: id1, b: id5},
+	}}
+	merger := &fakeMerger{merged: map[strfmt.UUID]interface{}{}}
+	recorder := &fakeRecorder{completed: make(chan distributedtask.TaskDescriptor, 1)}
+	// the pair joining both local groups was found by another node
+	pairs := &fakePairsClient{pairs: map[string][]Pair{"node2": {{A: id2, B: id4}}}, notFound: 2}
+
+	provider := NewProvider(finder, merger, fakeNodes{local: "node1", all: []string{"node1", "node2"}},
+		pairs, t.TempDir(), logger)
+	provider.pollInterval = time.Millisecond
+	provider.SetCompletionRecorder(recorder)
+
+	payload, err := json.Marshal(Payload{
+		Collection: "Docs", MaxDistance: 0.01, MarkProperty: "duplicateOf", Coordinator: "node1",
+	})
+	require.Nil(t, err)
+	task := &distributedtask.Task{
+		Namespace:      Namespace,
+		TaskDescriptor: distributedtask.TaskDescriptor{ID: "dedup-docs", Version: 7},
+		Payload:        payload,
+	}
+
+	_, err = provider.StartTask(task)
+	require.Nil(t, err)
+
+	select {
+	case desc := <-recorder.completed:
+		assert.Equal(t, task.TaskDescriptor, desc)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task did not complete")
+	}
+
+	t.Run("groups of the cluster are written to the result", func(t *testing.T) {
+		res, err := provider.Result(task.TaskDescriptor)
+		require.Nil(t, err)
+		assert.Len(t, res.Pairs, 3)
+		assert.Equal(t, []Group{
+			{Representative: id1, IDs: []strfmt.UUID{id1, id2, id3, id4}},
+			{Tenant: "tenantA", Representative: id1, IDs: []strfmt.UUID{id1, id5}},
+		}, res.Groups)
+	})
+
+	t.Run("members are marked with the representative", func(t *testing.T) {
+		for _, id := range []strfmt.UUID{id1, id2, id3, id4, id5} {
+			assert.Equal(t, id1.String(), merger.merged[id])
+		}
+	})
+
+	t.Run("finished task is listed and can be cleaned up", func(t *testing.T) {
+		assert.Equal(t, []distributedtask.TaskDescriptor{task.TaskDescriptor}, provider.GetLocalTasks())
+		require.Nil(t, provider.CleanupTask(task.TaskDescriptor))
+		assert.Empty(t, provider.GetLocalTasks())
+	})
+}
+
+func TestProviderNotCoordinating(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	finder := &fakeFinder{pairs: []pair{{a: id1, b: id2}}}
+	merger := &fakeMerger{merged: map[strfmt.UUID]interface{}{}}
+	recorder := &fakeRecorder{completed: make(chan distributedtask.TaskDescriptor, 1)}
+
+	provider := NewProvider(finder, merger, fakeNodes{local: "node2", all: []string{"node1", "node2"}},
+		&fakePairsClient{}, t.TempDir(), logger)
+	provider.SetCompletionRecorder(recorder)
+
+	payload, err := json.Marshal(Payload{
+		Collection: "Docs", MaxDistance: 0.01, MarkProperty: "duplicateOf", Coordinator: "node1",
+	})
+	require.Nil(t, err)
+	task := &distributedtask.Task{
+		Namespace:      Namespace,
+		TaskDescriptor: distributedtask.TaskDescriptor{ID: "dedup-docs", Version: 1},
+		Payload:        payload,
+	}
+
+	_, err = provider.StartTask(task)
+	require.Nil(t, err)
+
+	select {
+	case <-recorder.completed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task did not complete")
+	}
+
+	// only the pairs are kept for the coordinator, nothing is marked
+	res, err := provider.Result(task.TaskDescriptor)
+	require.Nil(t, err)
+	assert.Equal(t, []Pair{{A: id1, B: id2}}, res.Pairs)
+	assert.Empty(t, res.Groups)
+	assert.Empty(t, merger.merged)
+}
+
+func TestProviderRemotePairsFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		pairs   *fakePairsClient
+		wantErr string
+	}{
+		{
+			name:    "node is down",
+			pairs:   &fakePairsClient{err: errors.New("connection refused")},
+			wantErr: "giving up after 3 attempts: connection refused",
+		},
+		{
+			name:    "node never finishes",
+			pairs:   &fakePairsClient{notFound: math.MaxInt},
+			wantErr: "wait for near duplicates of node node2: context deadline exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := test.NewNullLogger()
+			recorder := &fakeRecorder{
+				completed: make(chan distributedtask.TaskDescriptor, 1),
+				failed:    make(chan string, 1),
+			}
+			provider := NewProvider(&fakeFinder{}, &fakeMerger{merged: map[strfmt.UUID]interface{}{}},
+				fakeNodes{local: "node1", all: []string{"node1", "node2"}}, tt.pairs, t.TempDir(), logger)
+			provider.pollInterval = time.Millisecond
+			provider.pairsTimeout = 50 * time.Millisecond
+			provider.maxPairsErrors = 3
+			provider.SetCompletionRecorder(recorder)
+
+			payload, err := json.Marshal(Payload{Collection: "Docs", MaxDistance: 0.01, Coordinator: "node1"})
+			require.Nil(t, err)
+			_, err = provider.StartTask(&distributedtask.Task{
+				Namespace:      Namespace,
+				TaskDescriptor: distributedtask.TaskDescriptor{ID: "dedup-docs", Version: 1},
+				Payload:        payload,
+			})
+			require.Nil(t, err)
+
+			select {
+			case errMsg := <-recorder.failed:
+				assert.Contains(t, errMsg, tt.wantErr)
+			case <-recorder.completed:
+				t.Fatal("task completed")
+			case <-time.After(5 * time.Second):
+				t.Fatal("task did not fail")
+			}
+			assert.Empty(t, provider.GetLocalTasks())
+		})
+	}
+}
+
+func TestPayloadValidate(t *testing.T) {
+	class := &models.Class{
+		Class: "Docs",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {},
+		},
+		Properties: []*models.Property{
+			{Name: "duplicateOf", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		payload Payload
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			payload: Payload{Collection: "Docs", TargetVector: "title", MarkProperty: "duplicateOf"},
+		},
+		{
+			name:    "named vectors require target vector",
+			payload: Payload{Collection: "Docs"},
+			wantErr: `collection "Docs" has named vectors, targetVector is required`,
+		},
+		{
+			name:    "unknown target vector",
+			payload: Payload{Collection: "Docs", TargetVector: "body"},
+			wantErr: `target vector "body" not found in collection "Docs"`,
+		},
+		{
+			name:    "negative distance",
+			payload: Payload{Collection: "Docs", TargetVector: "title", MaxDistance: -1},
+			wantErr: "maxDistance must not be negative, got -1",
+		},
+		{
+			name:    "mark property of wrong type",
+			payload: Payload{Collection: "Docs", TargetVector: "title", MarkProperty: "count"},
+			wantErr: `mark property "count" must be of type text or uuid, got [int]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate(class)
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
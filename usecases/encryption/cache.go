@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBlockCacheSize is the size of the cache of decrypted blocks in bytes
+const DefaultBlockCacheSize = 256 * 1024 * 1024
+
+// Encrypted files can't be mmapped. Files which are opened read-only, e.g.
+// segments, decrypt the blocks they read on demand instead, similar to the
+// page cache filling pages of mmapped files. The decrypted blocks of all such
+// files share a single cache of a bounded size, the least recently used
+// blocks are evicted first.
+var blockCache = newLRUBlockCache(DefaultBlockCacheSize / BlockSize)
+
+// nextFileID identifies the files in the block cache. Ids are never reused,
+// blocks of closed files are simply evicted over time.
+var nextFileID atomic.Uint64
+
+// SetBlockCacheSize sets the size of the cache of decrypted blocks in bytes.
+// Blocks are evicted right away if the cache shrinks.
+func SetBlockCacheSize(size int64) {
+	blockCache.resize(int(max(size/BlockSize, 1)))
+}
+
+type blockKey struct {
+	file  uint64
+	index int64
+}
+
+type cachedBlock struct {
+	key  blockKey
+	data []byte
+}
+
+type lruBlockCache struct {
+	sync.Mutex
+	capacity int // in blocks
+	blocks   map[blockKey]*list.Element
+	lru      *list.List // most recently used first
+}
+
+func newLRUBlockCache(capacity int) *lruBlockCache {
+	return &lruBlockCache{
+		capacity: capacity,
+		blocks:   make(map[blockKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// get returns the decrypted block, which must not be modified
+func (c *lruBlockCache) get(key blockKey) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.blocks[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cachedBlock).data, true
+}
+
+func (c *lruBlockCache) put(key blockKey, data []byte) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.blocks[key]; ok {
+		// decrypted concurrently by another reader
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.blocks[key] = c.lru.PushFront(&cachedBlock{key: key, data: data})
+	c.evict()
+}
+
+func (c *lruBlockCache) resize(capacity int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.capacity = capacity
+	c.evict()
+}
+
+// evict removes the least recently used blocks until the cache fits its
+// capacity. The caller must hold the lock.
+func (c *lruBlockCache) evict() {
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.blocks, oldest.Value.(*cachedBlock).key)
+	}
+}
+
+func (c *lruBlockCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.lru.Len()
+}
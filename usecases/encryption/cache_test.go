@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCache(t *testing.T) {
+	dir := encryptedDir(t)
+	path := filepath.Join(dir, "segment.db")
+	data := randomBytes(t, 10*BlockSize+123)
+
+	f, err := Create(path)
+	require.NoError(t, err)
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	SetBlockCacheSize(4 * BlockSize)
+	t.Cleanup(func() { SetBlockCacheSize(DefaultBlockCacheSize) })
+
+	f, err = Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	t.Run("concurrent reads", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for off := i * 100; off < len(data); off += 1000 {
+					buf := make([]byte, min(3000, len(data)-off))
+					_, err := f.ReadAt(buf, int64(off))
+					assert.NoError(t, err)
+					assert.Equal(t, data[off:off+len(buf)], buf)
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		assert.LessOrEqual(t, blockCache.len(), 4)
+
+		SetBlockCacheSize(BlockSize)
+		assert.Equal(t, 1, blockCache.len())
+	})
+
+	t.Run("cached blocks are not modified by reads", func(t *testing.T) {
+		buf := make([]byte, BlockSize)
+		_, err := f.ReadAt(buf, 0)
+		require.NoError(t, err)
+		buf[0]++
+		_, err = f.ReadAt(buf, 0)
+		require.NoError(t, err)
+		assert.Equal(t, data[:BlockSize], buf)
+	})
+}
@@ -0,0 +1,471 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// An encrypted file starts with a header, followed by blocks of BlockSize
+// bytes of plaintext, each encrypted with AES-GCM:
+//
+//	header: magic (6) | version (1) | reserved (1) | block size (4) | file id (16) | reserved (4)
+//	block:  nonce (12) | ciphertext (<= BlockSize) | tag (16)
+//
+// All blocks but the last one are full, so the offset of any block can be
+// calculated and the file can be read at random offsets. The index of a block
+// is authenticated, so blocks can't be reordered. Every file has its own key
+// derived from the data key and the random file id.
+const (
+	BlockSize = 4096
+
+	headerSize    = 32
+	formatVersion = 1
+	nonceSize     = 12
+	tagSize       = 16
+	blockOverhead = nonceSize + tagSize
+	blockStride   = BlockSize + blockOverhead
+)
+
+var magic = [6]byte{0xff, 'W', 'V', 'E', 'N', 'C'}
+
+// File is the subset of *os.File the storage layer uses to read and write
+// shard data. Depending on the directory, it is either a plain *os.File or
+// transparently encrypts what is written to it.
+type File interface {
+	io.Reader
+	io.ReaderAt
+	io.Writer
+	io.Seeker
+	io.Closer
+	Name() string
+	Stat() (os.FileInfo, error)
+	Sync() error
+}
+
+// OpenFile is the equivalent of os.OpenFile. If a data key is registered for
+// a parent directory, new files are encrypted with it. Existing files are
+// read as they are, i.e. plaintext files written before encryption was enabled
+// stay readable (and writable) until they are replaced, e.g. by compactions.
+func OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	if key := dataKeyFor(name); key != nil {
+		return openEncrypted(name, flag, perm, key)
+	}
+
+	f, err := os.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Open is the equivalent of os.Open
+func Open(name string) (File, error) {
+	return OpenFile(name, os.O_RDONLY, 0)
+}
+
+// Create is the equivalent of os.Create
+func Create(name string) (File, error) {
+	return OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+// IsEncrypted tells whether the file is encrypted
+func IsEncrypted(f File) bool {
+	_, ok := f.(*encryptedFile)
+	return ok
+}
+
+// ReadAll reads the whole file starting at its beginning
+func ReadAll(f File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	contents := make([]byte, info.Size())
+	if _, err := f.ReadAt(contents, 0); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return contents, nil
+}
+
+type encryptedFile struct {
+	sync.Mutex
+	file   *os.File
+	aead   cipher.AEAD
+	append bool
+
+	// files which are opened read-only never change, they are read through
+	// the shared block cache without serializing the reads
+	readOnly bool
+	id       uint64
+
+	size int64 // of the plaintext
+	pos  int64
+
+	// the plaintext of the block that was read or written last
+	block      []byte
+	blockIndex int64
+	dirty      bool
+
+	sealed []byte
+	aad    [8]byte
+}
+
+func openEncrypted(name string, flag int, perm os.FileMode, key *DataKey) (File, error) {
+	writable := flag&(os.O_WRONLY|os.O_RDWR) != 0
+	// blocks are read before they are modified, and appends are done by the
+	// file itself, as writes are always done at a computed offset
+	osFlag := flag &^ (os.O_WRONLY | os.O_APPEND)
+	if writable {
+		osFlag |= os.O_RDWR
+	}
+
+	f, err := os.OpenFile(name, osFlag, perm)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	var header [headerSize]byte
+	if info.Size() == 0 {
+		if !writable {
+			return f, nil
+		}
+		newHeader(header[:])
+		if _, err := f.WriteAt(header[:], 0); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("write encryption header: %w", err)
+		}
+	} else {
+		n, err := f.ReadAt(header[:], 0)
+		if err != nil && !errors.Is(err, io.EOF) {
+			f.Close()
+			return nil, fmt.Errorf("read encryption header: %w", err)
+		}
+		if n < headerSize || !bytes.Equal(header[:len(magic)], magic[:]) {
+			// a plaintext file, reopen with the original flags
+			f.Close()
+			plain, err := os.OpenFile(name, flag&^(os.O_CREATE|os.O_TRUNC|os.O_EXCL), perm)
+			if err != nil {
+				return nil, err
+			}
+			return plain, nil
+		}
+		if err := checkHeader(header[:]); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	aead, err := key.fileCipher(header[12:28])
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &encryptedFile{
+		file:       f,
+		aead:       aead,
+		append:     flag&os.O_APPEND != 0,
+		readOnly:   !writable,
+		id:         nextFileID.Add(1),
+		size:       plaintextSize(info.Size()),
+		block:      make([]byte, 0, BlockSize),
+		blockIndex: -1,
+		sealed:     make([]byte, 0, blockStride),
+	}, nil
+}
+
+func newHeader(header []byte) {
+	copy(header, magic[:])
+	header[6] = formatVersion
+	binary.LittleEndian.PutUint32(header[8:12], BlockSize)
+	mustRandom(header[12:28])
+}
+
+func checkHeader(header []byte) error {
+	if header[6] != formatVersion {
+		return fmt.Errorf("unsupported encryption format version %d", header[6])
+	}
+	if bs := binary.LittleEndian.Uint32(header[8:12]); bs != BlockSize {
+		return fmt.Errorf("unsupported encryption block size %d", bs)
+	}
+	return nil
+}
+
+// plaintextSize calculates the size of the plaintext from the size of the
+// file. A trailing block too short to hold any data, e.g. due to a crash in
+// the middle of a write, is ignored.
+func plaintextSize(fileSize int64) int64 {
+	if fileSize <= headerSize {
+		return 0
+	}
+	n := fileSize - headerSize
+	size := n / blockStride * BlockSize
+	if rest := n % blockStride; rest > blockOverhead {
+		size += rest - blockOverhead
+	}
+	return size
+}
+
+func (f *encryptedFile) Read(p []byte) (int, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	// sequential reads, e.g. of a whole file, would only evict the blocks
+	// other files read at random from the block cache
+	n, err := f.readAt(p, f.pos, false)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *encryptedFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.readOnly {
+		return f.readAt(p, off, true)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	return f.readAt(p, off, false)
+}
+
+// readAt reads the blocks of read-only files through the block cache if
+// cached is set, otherwise through the block of the file, which requires the
+// lock to be held.
+func (f *encryptedFile) readAt(p []byte, off int64, cached bool) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("read %s: negative offset", f.Name())
+	}
+
+	read := 0
+	for read < len(p) {
+		if off >= f.size {
+			return read, io.EOF
+		}
+
+		var block []byte
+		if cached {
+			b, err := f.cachedBlock(off / BlockSize)
+			if err != nil {
+				return read, err
+			}
+			block = b
+		} else {
+			if err := f.loadBlock(off / BlockSize); err != nil {
+				return read, err
+			}
+			block = f.block
+		}
+		n := copy(p[read:], block[off%BlockSize:])
+		read += n
+		off += int64(n)
+	}
+	return read, nil
+}
+
+// cachedBlock returns the plaintext of a block of a read-only file from the
+// block cache, it is decrypted and added to the cache if it isn't cached yet.
+// The returned block must not be modified.
+func (f *encryptedFile) cachedBlock(index int64) ([]byte, error) {
+	key := blockKey{file: f.id, index: index}
+	if block, ok := blockCache.get(key); ok {
+		return block, nil
+	}
+
+	length := min(f.size-index*BlockSize, BlockSize)
+	sealed := make([]byte, length+blockOverhead)
+	if _, err := f.file.ReadAt(sealed, headerSize+index*blockStride); err != nil {
+		return nil, fmt.Errorf("read block %d of %s: %w", index, f.Name(), err)
+	}
+
+	var aad [8]byte
+	binary.LittleEndian.PutUint64(aad[:], uint64(index))
+	// decrypt in place, the plaintext overwrites the ciphertext
+	block, err := f.aead.Open(sealed[nonceSize:nonceSize], sealed[:nonceSize], sealed[nonceSize:], aad[:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %d of %s: %w", ErrDecrypt, index, f.Name(), err)
+	}
+	blockCache.put(key, block)
+	return block, nil
+}
+
+func (f *encryptedFile) Write(p []byte) (int, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.append {
+		f.pos = f.size
+	}
+	// fill a gap after seeking beyond the end with zeros, like a sparse file
+	for f.pos > f.size {
+		zeros := make([]byte, min(f.pos-f.size, BlockSize))
+		if _, err := f.writeAt(zeros, f.size); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.writeAt(p, f.pos)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *encryptedFile) writeAt(p []byte, off int64) (int, error) {
+	written := 0
+	for written < len(p) {
+		index, within := off/BlockSize, int(off%BlockSize)
+		if err := f.loadBlock(index); err != nil {
+			return written, err
+		}
+
+		n := min(len(p)-written, BlockSize-within)
+		if end := within + n; end > len(f.block) {
+			f.block = f.block[:end]
+		}
+		copy(f.block[within:], p[written:written+n])
+		f.dirty = true
+
+		written += n
+		off += int64(n)
+		f.size = max(f.size, index*BlockSize+int64(len(f.block)))
+	}
+	return written, nil
+}
+
+// loadBlock makes the block with the given index the current one
+func (f *encryptedFile) loadBlock(index int64) error {
+	if index == f.blockIndex {
+		return nil
+	}
+	if err := f.flushBlock(); err != nil {
+		return err
+	}
+
+	f.block = f.block[:0]
+	f.blockIndex = index
+	start := index * BlockSize
+	if start >= f.size {
+		return nil
+	}
+
+	length := min(f.size-start, BlockSize)
+	f.sealed = f.sealed[:length+blockOverhead]
+	if _, err := f.file.ReadAt(f.sealed, headerSize+index*blockStride); err != nil {
+		f.blockIndex = -1
+		return fmt.Errorf("read block %d of %s: %w", index, f.Name(), err)
+	}
+
+	binary.LittleEndian.PutUint64(f.aad[:], uint64(index))
+	block, err := f.aead.Open(f.block, f.sealed[:nonceSize], f.sealed[nonceSize:], f.aad[:])
+	if err != nil {
+		f.blockIndex = -1
+		return fmt.Errorf("%w: block %d of %s: %w", ErrDecrypt, index, f.Name(), err)
+	}
+	f.block = block
+	return nil
+}
+
+func (f *encryptedFile) flushBlock() error {
+	if !f.dirty {
+		return nil
+	}
+
+	f.sealed = f.sealed[:nonceSize]
+	mustRandom(f.sealed)
+	binary.LittleEndian.PutUint64(f.aad[:], uint64(f.blockIndex))
+	f.sealed = f.aead.Seal(f.sealed, f.sealed[:nonceSize], f.block, f.aad[:])
+	if _, err := f.file.WriteAt(f.sealed, headerSize+f.blockIndex*blockStride); err != nil {
+		return fmt.Errorf("write block %d of %s: %w", f.blockIndex, f.Name(), err)
+	}
+	f.dirty = false
+	return nil
+}
+
+func (f *encryptedFile) Seek(offset int64, whence int) (int64, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = f.pos + offset
+	case io.SeekEnd:
+		pos = f.size + offset
+	default:
+		return 0, fmt.Errorf("seek %s: invalid whence %d", f.Name(), whence)
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("seek %s: negative position", f.Name())
+	}
+	f.pos = pos
+	return pos, nil
+}
+
+func (f *encryptedFile) Sync() error {
+	f.Lock()
+	defer f.Unlock()
+
+	if err := f.flushBlock(); err != nil {
+		return err
+	}
+	return f.file.Sync()
+}
+
+func (f *encryptedFile) Close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	if err := f.flushBlock(); err != nil {
+		f.file.Close()
+		return err
+	}
+	return f.file.Close()
+}
+
+func (f *encryptedFile) Name() string {
+	return f.file.Name()
+}
+
+// Stat returns the file info of the underlying file, but with the size of the
+// plaintext
+func (f *encryptedFile) Stat() (os.FileInfo, error) {
+	info, err := f.file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	return plaintextInfo{FileInfo: info, size: f.size}, nil
+}
+
+type plaintextInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (i plaintextInfo) Size() int64 {
+	return i.size
+}
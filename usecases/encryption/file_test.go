@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encryptedDir(t *testing.T) string {
+	dir := t.TempDir()
+	RegisterDataKey(dir, GenerateDataKey())
+	t.Cleanup(func() { UnregisterDataKey(dir) })
+	return dir
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func TestEncryptedFile(t *testing.T) {
+	dir := encryptedDir(t)
+	path := filepath.Join(dir, "sub", "segment.db")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o777))
+	data := randomBytes(t, 3*BlockSize+123)
+
+	t.Run("write", func(t *testing.T) {
+		f, err := Create(path)
+		require.NoError(t, err)
+		require.True(t, IsEncrypted(f))
+
+		// write in chunks not aligned with the blocks
+		for i := 0; i < len(data); i += 1000 {
+			_, err := f.Write(data[i:min(i+1000, len(data))])
+			require.NoError(t, err)
+		}
+		// overwrite the header like segments do after writing the data
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, err = f.Write(data[:16])
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	})
+
+	t.Run("ciphertext on disk", func(t *testing.T) {
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, magic[:], raw[:len(magic)])
+		assert.False(t, bytes.Contains(raw, data[100:132]))
+	})
+
+	t.Run("read", func(t *testing.T) {
+		f, err := Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		info, err := f.Stat()
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(data)), info.Size())
+
+		contents, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, data, contents)
+
+		buf := make([]byte, 200)
+		_, err = f.ReadAt(buf, BlockSize-100)
+		require.NoError(t, err)
+		assert.Equal(t, data[BlockSize-100:BlockSize+100], buf)
+
+		n, err := f.ReadAt(buf, int64(len(data)-50))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 50, n)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		f, err := OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o666)
+		require.NoError(t, err)
+		more := randomBytes(t, 2*BlockSize)
+		_, err = f.Write(more)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		data = append(data, more...)
+
+		f, err = Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		contents, err := ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, data, contents)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		raw[headerSize+blockStride+100] ^= 1
+		require.NoError(t, os.WriteFile(path, raw, 0o666))
+
+		f, err := Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = ReadAll(f)
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		RegisterDataKey(dir, GenerateDataKey())
+		f, err := Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = f.Read(make([]byte, 10))
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+}
+
+func TestPlaintextFiles(t *testing.T) {
+	t.Run("without a data key files are not encrypted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "wal")
+		f, err := Create(path)
+		require.NoError(t, err)
+		assert.False(t, IsEncrypted(f))
+		require.NoError(t, f.Close())
+	})
+
+	t.Run("existing plaintext files stay readable", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "wal")
+		require.NoError(t, os.WriteFile(path, []byte("plaintext"), 0o666))
+		RegisterDataKey(dir, GenerateDataKey())
+		defer UnregisterDataKey(dir)
+
+		f, err := OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
+		require.NoError(t, err)
+		defer f.Close()
+		assert.False(t, IsEncrypted(f))
+		contents, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("plaintext"), contents)
+	})
+}
+
+func TestPlaintextSize(t *testing.T) {
+	tests := []struct {
+		fileSize int64
+		expected int64
+	}{
+		{fileSize: 0, expected: 0},
+		{fileSize: headerSize, expected: 0},
+		{fileSize: headerSize + blockOverhead + 1, expected: 1},
+		{fileSize: headerSize + blockStride, expected: BlockSize},
+		{fileSize: headerSize + 2*blockStride + blockOverhead + 10, expected: 2*BlockSize + 10},
+		// torn write of the last block
+		{fileSize: headerSize + blockStride + 5, expected: BlockSize},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, plaintextSize(test.fileSize), "file size %d", test.fileSize)
+	}
+}
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// KeySize is the size of master and data keys in bytes (AES-256)
+const KeySize = 32
+
+// DataKeyFileName is the name of the file holding the wrapped data key of a
+// shard, relative to the shard's directory
+const DataKeyFileName = "data.key"
+
+const wrappedKeyVersion = 1
+
+var (
+	// ErrDecrypt is returned if data can't be decrypted, either because it was
+	// tampered with or because it was encrypted with another key
+	ErrDecrypt = errors.New("decrypt")
+	// ErrWrongMasterKey is returned if a data key was wrapped by another
+	// master key than the configured one
+	ErrWrongMasterKey = errors.New("data key was wrapped by another master key")
+)
+
+// MasterKey is the root of the key hierarchy. It never encrypts data itself,
+// it only wraps the data keys which are stored next to the data.
+type MasterKey struct {
+	id   [8]byte
+	aead cipher.AEAD
+}
+
+// NewMasterKey creates a master key from KeySize bytes of key material
+func NewMasterKey(raw []byte) (*MasterKey, error) {
+	if len(raw) != KeySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", KeySize, len(raw))
+	}
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &MasterKey{aead: aead}
+	// identifies the master key without revealing it, so that data keys
+	// wrapped by another master key can be told apart from corrupted ones
+	sum := sha256.Sum256(append([]byte("weaviate master key id"), raw...))
+	copy(k.id[:], sum[:])
+	return k, nil
+}
+
+// LoadMasterKey reads a base64 encoded master key. If the key isn't set
+// directly, it is read from keyFile.
+func LoadMasterKey(key, keyFile string) (*MasterKey, error) {
+	if key == "" {
+		if keyFile == "" {
+			return nil, fmt.Errorf("encryption at rest requires a master key or a master key file")
+		}
+		contents, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read master key file: %w", err)
+		}
+		key = string(bytes.TrimSpace(contents))
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decode master key: %w", err)
+	}
+	return NewMasterKey(raw)
+}
+
+// ID identifies the master key, e.g. in logs
+func (k *MasterKey) ID() string {
+	return hex.EncodeToString(k.id[:])
+}
+
+// Wrap encrypts the data key, so it can be stored at rest
+func (k *MasterKey) Wrap(dk *DataKey) []byte {
+	out := make([]byte, 0, 1+len(k.id)+k.aead.NonceSize()+KeySize+k.aead.Overhead())
+	out = append(out, wrappedKeyVersion)
+	out = append(out, k.id[:]...)
+	nonce := make([]byte, k.aead.NonceSize())
+	mustRandom(nonce)
+	out = append(out, nonce...)
+	return k.aead.Seal(out, nonce, dk.raw, k.id[:])
+}
+
+// Unwrap is the inverse of Wrap
+func (k *MasterKey) Unwrap(wrapped []byte) (*DataKey, error) {
+	prefix := 1 + len(k.id) + k.aead.NonceSize()
+	if len(wrapped) < prefix+k.aead.Overhead() || wrapped[0] != wrappedKeyVersion {
+		return nil, fmt.Errorf("unwrap data key: unsupported format")
+	}
+	if !bytes.Equal(wrapped[1:1+len(k.id)], k.id[:]) {
+		return nil, ErrWrongMasterKey
+	}
+
+	raw, err := k.aead.Open(nil, wrapped[1+len(k.id):prefix], wrapped[prefix:], k.id[:])
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w: %w", ErrDecrypt, err)
+	}
+	return NewDataKey(raw)
+}
+
+// DataKey encrypts the files of a single shard. Every file uses its own
+// key derived from the data key, so that the number of blocks encrypted with
+// random nonces under the same key stays small.
+type DataKey struct {
+	raw []byte
+}
+
+// NewDataKey creates a data key from KeySize bytes of key material
+func NewDataKey(raw []byte) (*DataKey, error) {
+	if len(raw) != KeySize {
+		return nil, fmt.Errorf("data key must be %d bytes, got %d", KeySize, len(raw))
+	}
+	return &DataKey{raw: bytes.Clone(raw)}, nil
+}
+
+// GenerateDataKey creates a new random data key
+func GenerateDataKey() *DataKey {
+	raw := make([]byte, KeySize)
+	mustRandom(raw)
+	return &DataKey{raw: raw}
+}
+
+// NewKeyID returns a random id to reference a data key by
+func NewKeyID() string {
+	id := make([]byte, 16)
+	mustRandom(id)
+	return hex.EncodeToString(id)
+}
+
+func (k *DataKey) fileCipher(fileID []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, k.raw)
+	mac.Write([]byte("weaviate file key"))
+	mac.Write(fileID)
+	return newAEAD(mac.Sum(nil))
+}
+
+// LoadOrCreateDataKey returns the data key of the shard in dir. A new data key
+// is created if the shard doesn't have one yet.
+func LoadOrCreateDataKey(dir string, master *MasterKey) (*DataKey, error) {
+	path := filepath.Join(dir, DataKeyFileName)
+	wrapped, err := os.ReadFile(path)
+	if err == nil {
+		dk, err := master.Unwrap(wrapped)
+		if err != nil {
+			return nil, fmt.Errorf("data key %q: %w", path, err)
+		}
+		return dk, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read data key: %w", err)
+	}
+
+	dk := GenerateDataKey()
+	tmpPath := path + ".tmp"
+	if err := writeFileSync(tmpPath, master.Wrap(dk)); err != nil {
+		return nil, fmt.Errorf("write data key: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, fmt.Errorf("rename data key: %w", err)
+	}
+	if err := diskio.Fsync(dir); err != nil {
+		return nil, fmt.Errorf("fsync data key dir: %w", err)
+	}
+	return dk, nil
+}
+
+func writeFileSync(path string, contents []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func mustRandom(b []byte) {
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand never fails on supported platforms
+		panic(fmt.Sprintf("read random bytes: %v", err))
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMasterKey(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(randomBytes(t, KeySize))
+
+	t.Run("from value", func(t *testing.T) {
+		_, err := LoadMasterKey(encoded, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "master.key")
+		require.NoError(t, os.WriteFile(path, []byte(encoded+"\n"), 0o600))
+		_, err := LoadMasterKey("", path)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := LoadMasterKey("", "")
+		require.Error(t, err)
+	})
+
+	t.Run("wrong size", func(t *testing.T) {
+		_, err := LoadMasterKey(base64.StdEncoding.EncodeToString([]byte("too short")), "")
+		require.ErrorContains(t, err, "must be 32 bytes")
+	})
+}
+
+func TestDataKeyWrapping(t *testing.T) {
+	master, err := NewMasterKey(randomBytes(t, KeySize))
+	require.NoError(t, err)
+	other, err := NewMasterKey(randomBytes(t, KeySize))
+	require.NoError(t, err)
+
+	dk := GenerateDataKey()
+	wrapped := master.Wrap(dk)
+
+	unwrapped, err := master.Unwrap(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dk.raw, unwrapped.raw)
+
+	_, err = other.Unwrap(wrapped)
+	assert.ErrorIs(t, err, ErrWrongMasterKey)
+
+	wrapped[len(wrapped)-1] ^= 1
+	_, err = master.Unwrap(wrapped)
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestLoadOrCreateDataKey(t *testing.T) {
+	dir := t.TempDir()
+	master, err := NewMasterKey(randomBytes(t, KeySize))
+	require.NoError(t, err)
+
+	created, err := LoadOrCreateDataKey(dir, master)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, DataKeyFileName))
+
+	loaded, err := LoadOrCreateDataKey(dir, master)
+	require.NoError(t, err)
+	assert.Equal(t, created.raw, loaded.raw)
+}
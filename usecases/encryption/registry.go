@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"path/filepath"
+	"sync"
+)
+
+// keyRegistry maps directories to the data key that encrypts all files
+// below them. Files are opened in many places of the storage layer, the
+// registry spares passing the key to each of them.
+type keyRegistry struct {
+	sync.RWMutex
+	dirs map[string]*DataKey
+}
+
+var registry = &keyRegistry{dirs: map[string]*DataKey{}}
+
+// RegisterDataKey encrypts all files which are created below dir from now on
+// with key. Existing files are read as they are, whether they are encrypted or
+// not.
+func RegisterDataKey(dir string, key *DataKey) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.dirs[filepath.Clean(dir)] = key
+}
+
+// UnregisterDataKey is the inverse of RegisterDataKey
+func UnregisterDataKey(dir string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	delete(registry.dirs, filepath.Clean(dir))
+}
+
+// Enabled tells whether files created below path are encrypted
+func Enabled(path string) bool {
+	return dataKeyFor(path) != nil
+}
+
+// dataKeyFor returns the data key of the closest registered parent directory
+// of path, nil if there is none
+func dataKeyFor(path string) *DataKey {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	if len(registry.dirs) == 0 {
+		return nil
+	}
+
+	dir := filepath.Clean(path)
+	for {
+		if key, ok := registry.dirs[dir]; ok {
+			return key
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil
+		}
+		dir = parent
+	}
+}
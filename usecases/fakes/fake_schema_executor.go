@@ -53,6 +53,11 @@ func (m *MockSchemaExecutor) DeleteReplicaFromShard(class string, shard string,
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) SplitShard(class string, shard string, targetShard string) error {
+	args := m.Called(class, shard, targetShard)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) LoadShard(class string, shard string) {
 	m.Called(class, shard)
 }
@@ -88,6 +88,14 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
+	rangeVersion, err := m.autoSchemaManager.autoRangeShards(ctx, principal, []*models.Object{object}, fetchedClasses)
+	if err != nil {
+		return nil, err
+	}
+	if rangeVersion > schemaVersion {
+		schemaVersion = rangeVersion
+	}
+
 	now := m.timeSource.Now()
 	object.CreationTimeUnix = now
 	object.LastUpdateTimeUnix = now
@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 type AutoSchemaManager struct {
@@ -586,6 +587,46 @@ func (m *AutoSchemaManager) autoTenants(ctx context.Context,
 	return maxSchemaVersion, totalTenants, nil
 }
 
+// autoRangeShards adds the shards needed to store objects of collections
+// which are sharded by range. Objects without a valid sharding key are
+// skipped here, they are rejected once they are written.
+func (m *AutoSchemaManager) autoRangeShards(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, fetchedClasses map[string]versioned.Class,
+) (uint64, error) {
+	classStarts := make(map[string][]int64)
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		vclass, exists := fetchedClasses[obj.Class]
+		if !exists || vclass.Class == nil {
+			continue
+		}
+		cfg, ok := vclass.Class.ShardingConfig.(shardingConfig.Config)
+		if !ok || !cfg.ShardedByRange() {
+			continue
+		}
+		props, _ := obj.Properties.(map[string]interface{})
+		value, err := shardingConfig.RangeValue(props[cfg.Key])
+		if err != nil {
+			continue
+		}
+		classStarts[obj.Class] = append(classStarts[obj.Class], shardingConfig.RangeStart(value, cfg.RangeWidth))
+	}
+
+	var maxSchemaVersion uint64
+	for className, starts := range classStarts {
+		version, err := m.schemaManager.AddRangeShards(ctx, principal, className, starts)
+		if err != nil {
+			return 0, fmt.Errorf("add range shards to class %q: %w", className, err)
+		}
+		if version > maxSchemaVersion {
+			maxSchemaVersion = version
+		}
+	}
+	return maxSchemaVersion, nil
+}
+
 func (m *AutoSchemaManager) addTenants(ctx context.Context, principal *models.Principal,
 	class string, tenants []*models.Tenant,
 ) error {
@@ -25,7 +25,9 @@ import (
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least one object for batching")
@@ -86,15 +88,41 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 		return nil, errEmptyObjects
 	}
 
+	objectsPerClass := map[string]int{}
+	for _, obj := range objects {
+		objectsPerClass[obj.Class]++
+	}
+	taken := make(map[string]int, len(objectsPerClass))
+	for className, count := range objectsPerClass {
+		if err := b.quotas.Take(ratelimiter.KindBatch, principal, className, count); err != nil {
+			b.returnBatchQuotas(principal, taken)
+			var errTooLarge *ratelimiter.ErrRequestTooLarge
+			if errors.As(err, &errTooLarge) {
+				return nil, NewErrInvalidUserInput("%v", err)
+			}
+			return nil, err
+		}
+		taken[className] = count
+	}
+
 	var maxSchemaVersion uint64
 	batchObjects, maxSchemaVersion := b.validateAndGetVector(ctx, principal, objects, repl, fetchedClasses)
 	schemaVersion, tenantCount, err := b.autoSchemaManager.autoTenants(ctx, principal, objects, fetchedClasses)
 	if err != nil {
+		b.returnBatchQuotas(principal, taken)
 		return nil, fmt.Errorf("auto create tenants: %w", err)
 	}
 	if schemaVersion > maxSchemaVersion {
 		maxSchemaVersion = schemaVersion
 	}
+	schemaVersion, err = b.autoSchemaManager.autoRangeShards(ctx, principal, objects, fetchedClasses)
+	if err != nil {
+		b.returnBatchQuotas(principal, taken)
+		return nil, fmt.Errorf("auto create range shards: %w", err)
+	}
+	if schemaVersion > maxSchemaVersion {
+		maxSchemaVersion = schemaVersion
+	}
 
 	b.metrics.BatchTenants(tenantCount)
 	b.metrics.BatchObjects(len(objects))
@@ -188,7 +216,19 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 
 	for className, objectsForClass := range objectsPerClass {
 		class := fetchedClasses[className]
-		errorsPerObj, err := b.modulesProvider.BatchUpdateVector(ctx, class.Class, objectsForClass, b.findObject, b.logger)
+		var err error
+		var errorsPerObj map[int]error
+		if vectorizedByModule(class.Class) {
+			err = b.quotas.Take(ratelimiter.KindModuleCalls, principal, className, len(objectsForClass))
+			if err != nil {
+				// the objects of the class are rejected, they must not count
+				// against the batch limits
+				b.quotas.Return(ratelimiter.KindBatch, principal, className, len(objectsForClass))
+			}
+		}
+		if err == nil {
+			errorsPerObj, err = b.modulesProvider.BatchUpdateVector(ctx, class.Class, objectsForClass, b.findObject, b.logger)
+		}
 		if err != nil {
 			for i := range objectsForClass {
 				origIndex := originalIndexPerClass[className][i]
@@ -203,3 +243,29 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 
 	return batchObjects, maxSchemaVersion
 }
+
+// returnBatchQuotas gives back the batch tokens taken per class for a batch
+// that was rejected
+func (b *BatchManager) returnBatchQuotas(principal *models.Principal, taken map[string]int) {
+	for className, count := range taken {
+		b.quotas.Return(ratelimiter.KindBatch, principal, className, count)
+	}
+}
+
+// vectorizedByModule reports whether objects of the class are vectorized by a
+// module when they are inserted
+func vectorizedByModule(class *models.Class) bool {
+	if class.Vectorizer != "" && class.Vectorizer != config.VectorizerModuleNone {
+		return true
+	}
+	for _, vectorConfig := range class.VectorConfig {
+		vectorizer, ok := vectorConfig.Vectorizer.(map[string]interface{})
+		if !ok || len(vectorizer) == 0 {
+			continue
+		}
+		if _, ok := vectorizer[config.VectorizerModuleNone]; !ok {
+			return true
+		}
+	}
+	return false
+}
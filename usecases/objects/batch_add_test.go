@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
@@ -74,7 +75,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, schemaManager, config, logger, authorizer, nil,
-			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()))
+			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()), nil)
 	}
 
 	reset := func() {
@@ -327,7 +328,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, schemaManager, config, logger, authorizer, nil,
-			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()))
+			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()), nil)
 	}
 
 	ctx := context.Background()
@@ -471,7 +472,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, schemaManager, config, logger, authorizer, nil,
-			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()))
+			NewAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger, prometheus.NewPedanticRegistry()), nil)
 	}
 	reset()
 	objects := []*models.Object{
@@ -501,3 +502,191 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_RateLimited(t *testing.T) {
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+
+	vectorRepo := &fakeVectorRepo{}
+	cfg := &config.WeaviateConfig{}
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema}
+	logger, _ := test.NewNullLogger()
+	authorizer := mocks.NewMockAuthorizer()
+	modulesProvider := getFakeModulesProvider()
+	quotas := ratelimiter.NewQuotas(config.RateLimits{
+		BatchObjectsPerPrincipal: config.RateLimit{
+			Rate:  runtime.NewDynamicValue(0.001),
+			Burst: runtime.NewDynamicValue(2),
+		},
+	})
+	manager := NewBatchManager(vectorRepo, modulesProvider, schemaManager, cfg, logger, authorizer, nil,
+		NewAutoSchemaManager(schemaManager, vectorRepo, cfg, authorizer, logger, prometheus.NewPedanticRegistry()), quotas)
+
+	ctx := context.Background()
+	principal := &models.Principal{Username: "ingest", UserType: models.UserTypeInputDb}
+	modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+	_, err := manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Foo", Vector: []float32{0.1, 0.1, 0.1111}},
+		{Class: "Foo", Vector: []float32{0.2, 0.2, 0.2222}},
+	}, []*string{}, nil)
+	require.Nil(t, err)
+
+	_, err = manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Foo", Vector: []float32{0.1, 0.1, 0.1111}},
+	}, []*string{}, nil)
+	var errRateLimited *ratelimiter.ErrRateLimited
+	require.ErrorAs(t, err, &errRateLimited)
+	assert.Equal(t, ratelimiter.KindBatch, errRateLimited.Kind)
+	vectorRepo.AssertNumberOfCalls(t, "BatchPutObjects", 1)
+
+	// other principals have their own limit
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+	_, err = manager.AddObjects(ctx, &models.Principal{Username: "other", UserType: models.UserTypeInputDb}, []*models.Object{
+		{Class: "Foo", Vector: []float32{0.1, 0.1, 0.1111}},
+	}, []*string{}, nil)
+	require.Nil(t, err)
+}
+
+func Test_BatchManager_AddObjects_RateLimitReturnsTokens(t *testing.T) {
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Bar",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+
+	vectorRepo := &fakeVectorRepo{}
+	cfg := &config.WeaviateConfig{}
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema}
+	logger, _ := test.NewNullLogger()
+	authorizer := mocks.NewMockAuthorizer()
+	modulesProvider := getFakeModulesProvider()
+	quotas := ratelimiter.NewQuotas(config.RateLimits{
+		BatchObjectsPerCollection: config.RateLimit{
+			Rate:  runtime.NewDynamicValue(0.001),
+			Burst: runtime.NewDynamicValue(2),
+		},
+	})
+	manager := NewBatchManager(vectorRepo, modulesProvider, schemaManager, cfg, logger, authorizer, nil,
+		NewAutoSchemaManager(schemaManager, vectorRepo, cfg, authorizer, logger, prometheus.NewPedanticRegistry()), quotas)
+
+	ctx := context.Background()
+	principal := &models.Principal{Username: "ingest", UserType: models.UserTypeInputDb}
+	modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+
+	// more Bar objects than the burst size can never be accepted
+	_, err := manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Foo", Vector: []float32{0.1, 0.1, 0.1111}},
+		{Class: "Bar", Vector: []float32{0.1, 0.1, 0.1111}},
+		{Class: "Bar", Vector: []float32{0.2, 0.2, 0.2222}},
+		{Class: "Bar", Vector: []float32{0.3, 0.3, 0.3333}},
+	}, []*string{}, nil)
+	require.ErrorAs(t, err, &ErrInvalidUserInput{})
+	vectorRepo.AssertNotCalled(t, "BatchPutObjects", mock.Anything)
+
+	// the token taken for Foo was returned
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+	_, err = manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Foo", Vector: []float32{0.1, 0.1, 0.1111}},
+		{Class: "Foo", Vector: []float32{0.2, 0.2, 0.2222}},
+	}, []*string{}, nil)
+	require.Nil(t, err)
+}
+
+func Test_BatchManager_AddObjects_ModuleCallsLimitReturnsBatchTokens(t *testing.T) {
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        "text2vec-contextionary",
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Bar",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+
+	vectorRepo := &fakeVectorRepo{}
+	cfg := &config.WeaviateConfig{}
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema}
+	logger, _ := test.NewNullLogger()
+	authorizer := mocks.NewMockAuthorizer()
+	modulesProvider := getFakeModulesProvider()
+	quotas := ratelimiter.NewQuotas(config.RateLimits{
+		BatchObjectsPerPrincipal: config.RateLimit{
+			Rate:  runtime.NewDynamicValue(0.001),
+			Burst: runtime.NewDynamicValue(2),
+		},
+		ModuleCallsPerPrincipal: config.RateLimit{
+			Rate:  runtime.NewDynamicValue(0.001),
+			Burst: runtime.NewDynamicValue(1),
+		},
+	})
+	manager := NewBatchManager(vectorRepo, modulesProvider, schemaManager, cfg, logger, authorizer, nil,
+		NewAutoSchemaManager(schemaManager, vectorRepo, cfg, authorizer, logger, prometheus.NewPedanticRegistry()), quotas)
+
+	ctx := context.Background()
+	principal := &models.Principal{Username: "ingest", UserType: models.UserTypeInputDb}
+	modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+
+	// vectorizing two objects exceeds the module calls limit
+	res, err := manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Foo"},
+		{Class: "Foo"},
+	}, []*string{}, nil)
+	require.Nil(t, err)
+	require.Len(t, res, 2)
+	for _, obj := range res {
+		var errTooLarge *ratelimiter.ErrRequestTooLarge
+		require.ErrorAs(t, obj.Err, &errTooLarge)
+		assert.Equal(t, ratelimiter.KindModuleCalls, errTooLarge.Kind)
+	}
+
+	// the batch tokens of the rejected objects were returned
+	res, err = manager.AddObjects(ctx, principal, []*models.Object{
+		{Class: "Bar", Vector: []float32{0.1, 0.1, 0.1111}},
+		{Class: "Bar", Vector: []float32{0.2, 0.2, 0.2222}},
+	}, []*string{}, nil)
+	require.Nil(t, err)
+	for _, obj := range res {
+		require.Nil(t, obj.Err)
+	}
+}
+
+func Test_VectorizedByModule(t *testing.T) {
+	assert.False(t, vectorizedByModule(&models.Class{Vectorizer: config.VectorizerModuleNone}))
+	assert.True(t, vectorizedByModule(&models.Class{Vectorizer: "text2vec-contextionary"}))
+	assert.False(t, vectorizedByModule(&models.Class{VectorConfig: map[string]models.VectorConfig{
+		"title": {Vectorizer: map[string]interface{}{config.VectorizerModuleNone: map[string]interface{}{}}},
+	}}))
+	assert.True(t, vectorizedByModule(&models.Class{VectorConfig: map[string]models.VectorConfig{
+		"title": {Vectorizer: map[string]interface{}{"text2vec-openai": map[string]interface{}{}}},
+	}}))
+}
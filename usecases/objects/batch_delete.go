@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/verbosity"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 )
 
 // DeleteObjects deletes objects in batch based on the match filter
@@ -59,6 +60,16 @@ func (b *BatchManager) DeleteObjectsFromGRPCAfterAuth(ctx context.Context, princ
 	b.metrics.BatchDeleteInc()
 	defer b.metrics.BatchDeleteDec()
 
+	if err := filter.NewPropertyMask(ctx, b.authorizer, principal, tenant).CheckFilter(params.Filters); err != nil {
+		return BatchDeleteResult{}, err
+	}
+
+	var err error
+	params.Filters, err = filter.WithReadData(ctx, b.authorizer, principal, params.ClassName.String(), tenant, params.Filters)
+	if err != nil {
+		return BatchDeleteResult{}, fmt.Errorf("read data filter: %w", err)
+	}
+
 	deletionTime := time.UnixMilli(b.timeSource.Now())
 	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
 }
@@ -67,7 +78,7 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	match *models.BatchDeleteMatch, deletionTimeUnixMilli *int64, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
 ) (*BatchDeleteResponse, error) {
-	params, schemaVersion, err := b.validateBatchDelete(ctx, principal, match, dryRun, output)
+	params, schemaVersion, err := b.validateBatchDelete(ctx, principal, match, dryRun, output, tenant)
 	if err != nil {
 		return nil, errors.Wrap(err, "validate")
 	}
@@ -107,7 +118,7 @@ func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 }
 
 func (b *BatchManager) validateBatchDelete(ctx context.Context, principal *models.Principal,
-	match *models.BatchDeleteMatch, dryRun *bool, output *string,
+	match *models.BatchDeleteMatch, dryRun *bool, output *string, tenant string,
 ) (*BatchDeleteParams, uint64, error) {
 	if match == nil {
 		return nil, 0, errors.New("empty match clause")
@@ -131,12 +142,22 @@ func (b *BatchManager) validateBatchDelete(ctx context.Context, principal *model
 	}
 	class := vclasses[match.Class].Class
 
-	filter, err := filterext.Parse(match.Where, class.Class)
+	where, err := filterext.Parse(match.Where, class.Class)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to parse where filter: %w", err)
 	}
 
-	err = filters.ValidateFilters(b.classGetterFunc(ctx, principal), filter)
+	if err := filter.NewPropertyMask(ctx, b.authorizer, principal, tenant).CheckFilter(where); err != nil {
+		return nil, 0, err
+	}
+
+	// only objects the principal is allowed to read can be deleted
+	where, err = filter.WithReadData(ctx, b.authorizer, principal, class.Class, tenant, where)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read data filter: %w", err)
+	}
+
+	err = filters.ValidateFilters(b.classGetterFunc(ctx, principal), where)
 	if err != nil {
 		return nil, 0, fmt.Errorf("invalid where filter: %w", err)
 	}
@@ -153,7 +174,7 @@ func (b *BatchManager) validateBatchDelete(ctx context.Context, principal *model
 
 	params := &BatchDeleteParams{
 		ClassName: schema.ClassName(class.Class),
-		Filters:   filter,
+		Filters:   where,
 		DryRun:    dryRunParam,
 		Output:    outputParam,
 	}
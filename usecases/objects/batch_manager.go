@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // BatchManager manages kind changes in batch at a use-case level , i.e.
@@ -35,6 +36,7 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *AutoSchemaManager
 	metrics           *Metrics
+	quotas            *ratelimiter.Quotas
 }
 
 type BatchVectorRepo interface {
@@ -56,6 +58,7 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 	schemaManager schemaManager, config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorization.Authorizer,
 	prom *monitoring.PrometheusMetrics, autoSchemaManager *AutoSchemaManager,
+	quotas *ratelimiter.Quotas,
 ) *BatchManager {
 	return &BatchManager{
 		config:            config,
@@ -67,5 +70,6 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: autoSchemaManager,
 		metrics:           NewMetrics(prom),
+		quotas:            quotas,
 	}
 }
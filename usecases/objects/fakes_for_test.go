@@ -190,6 +190,10 @@ func (f *fakeSchemaManager) AddTenants(ctx context.Context,
 	return 0, nil
 }
 
+func (f *fakeSchemaManager) AddRangeShards(ctx context.Context, principal *models.Principal, class string, starts []int64) (uint64, error) {
+	return 0, nil
+}
+
 func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, schemaVersion uint64) error {
 	return nil
 }
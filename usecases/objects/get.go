@@ -46,11 +46,23 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	allowed, err := m.readDataAllowed(ctx, principal, res.ClassName, id, tenant)
+	if err != nil {
+		return nil, NewErrInternal("read data filter: %v", err)
+	}
+	if !allowed {
+		return nil, NewErrNotFound("no object with id '%s'", id)
+	}
+
 	if additional.Vector {
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	obj := res.ObjectWithVector(additional.Vector)
+	if err := pruneProperties(filter.NewPropertyMask(ctx, m.authorizer, principal, tenant), obj); err != nil {
+		return nil, NewErrInternal("read data properties: %v", err)
+	}
+	return obj, nil
 }
 
 // GetObjects Class from the connected DB
@@ -63,6 +75,16 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	// the objects of all collections are listed with a single search, which
+	// can't be restricted by the read_data filters of each collection
+	filtered, err := filter.ReadDataFiltered(ctx, m.authorizer, principal)
+	if err != nil {
+		return nil, NewErrInternal("read data filter: %v", err)
+	}
+	if filtered {
+		return nil, authzerrs.NewForbidden(principal, authorization.READ, authorization.Objects("", tenant, ""))
+	}
+
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
@@ -84,6 +106,16 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 		},
 	)
 
+	mask := filter.NewPropertyMask(ctx, m.authorizer, principal, tenant)
+	for _, obj := range filteredObjects {
+		if err := checkSortPropertyAccess(mask, obj.Class, m.getSort(sort, order)); err != nil {
+			return nil, err
+		}
+	}
+	if err := pruneProperties(mask, filteredObjects...); err != nil {
+		return nil, NewErrInternal("read data properties: %v", err)
+	}
+
 	return filteredObjects, nil
 }
 
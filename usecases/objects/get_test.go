@@ -28,6 +28,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzerrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -1051,6 +1053,61 @@ func Test_GetObject(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
+
+	t.Run("restricted by read_data filter", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.Manager.authorizer = &fakeDataFilterer{
+			FakeAuthorizer: m.authorizer,
+			filters: []*models.WhereFilter{{
+				Operator:  "Equal",
+				Path:      []string{"foo"},
+				ValueText: authorization.String("bar"),
+			}},
+		}
+		byIDAndFilter := mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Class == className && q.Limit == 1 &&
+				q.Filters.Root.Operator == filters.OperatorAnd
+		})
+
+		m.repo.On("Object", className, id, mock.Anything, mock.Anything, "").Return(result, nil).Once()
+		m.repo.On("Query", byIDAndFilter).Return([]search.Result{*result}, nil).Once()
+		_, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		require.Nil(t, err)
+
+		m.repo.On("Object", className, id, mock.Anything, mock.Anything, "").Return(result, nil).Once()
+		m.repo.On("Query", byIDAndFilter).Return([]search.Result{}, nil).Once()
+		_, err = m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		var notFound ErrNotFound
+		require.ErrorAs(t, err, &notFound)
+
+		// objects of all collections can't be listed with the filter applied
+		_, err = m.GetObjects(context.Background(), &principal, nil, nil, nil, nil, nil, adds, "")
+		var forbidden authzerrs.Forbidden
+		require.ErrorAs(t, err, &forbidden)
+		m.repo.AssertNotCalled(t, "ObjectSearch", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type fakeDataFilterer struct {
+	*mocks.FakeAuthorizer
+	filters    []*models.WhereFilter
+	properties map[string]authorization.PropertyAccess
+}
+
+func (f *fakeDataFilterer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return f.filters, nil
+}
+
+func (f *fakeDataFilterer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return len(f.filters) > 0, nil
+}
+
+func (f *fakeDataFilterer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return f.properties[collection], nil
 }
 
 func ptInt64(in int64) *int64 {
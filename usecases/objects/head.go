@@ -45,5 +45,12 @@ func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, c
 			return false, &Error{"repo.exists", StatusInternalServerError, err}
 		}
 	}
+	if ok {
+		allowed, err := m.readDataAllowed(ctx, principal, class, id, tenant)
+		if err != nil {
+			return false, &Error{"read data filter", StatusInternalServerError, err}
+		}
+		ok = allowed
+	}
 	return ok, nil
 }
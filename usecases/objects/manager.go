@@ -40,6 +40,9 @@ import (
 type schemaManager interface {
 	AddClass(ctx context.Context, principal *models.Principal, class *models.Class) (*models.Class, uint64, error)
 	AddTenants(ctx context.Context, principal *models.Principal, class string, tenants []*models.Tenant) (uint64, error)
+	// AddRangeShards adds the shards owning the ranges starting at starts to a
+	// collection sharded by range, if they don't exist yet
+	AddRangeShards(ctx context.Context, principal *models.Principal, class string, starts []int64) (uint64, error)
 	GetClass(ctx context.Context, principal *models.Principal, name string) (*models.Class, error)
 	// ReadOnlyClass return class model.
 	ReadOnlyClass(name string) *models.Class
@@ -101,6 +101,27 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		return nil, &Error{err.Error(), StatusForbidden, err}
 	}
 
+	mask := filter.NewPropertyMask(ctx, m.authorizer, principal, q.Tenant)
+	if q.Class == "" {
+		// without a class the read_data filters can't be applied
+		filtered, err := filter.ReadDataFiltered(ctx, m.authorizer, principal)
+		if err != nil {
+			return nil, &Error{"read data filter", StatusInternalServerError, err}
+		}
+		if filtered {
+			err := fmt.Errorf("listing objects of all collections is not allowed with filtered read_data permissions, set the class")
+			return nil, &Error{err.Error(), StatusForbidden, err}
+		}
+	} else {
+		if err := checkSortPropertyAccess(mask, q.Class, q.Sort); err != nil {
+			return nil, &Error{err.Error(), StatusForbidden, err}
+		}
+		q.Filters, err = filter.WithReadData(ctx, m.authorizer, principal, q.Class, q.Tenant, q.Filters)
+		if err != nil {
+			return nil, &Error{"read data filter", StatusInternalServerError, err}
+		}
+	}
+
 	res, rerr := m.vectorRepo.Query(ctx, filteredQuery[0])
 	if rerr != nil {
 		return nil, rerr
@@ -117,5 +138,9 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(q.Additional.Vector), nil
+	objs := res.ObjectsWithVector(q.Additional.Vector)
+	if err := pruneProperties(mask, objs...); err != nil {
+		return nil, &Error{"read data properties", StatusInternalServerError, err}
+	}
+	return objs, nil
 }
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+)
+
+// readDataAllowed reports whether the object matches the read_data filters of
+// the principal's roles. Objects which do not match are treated as if they did
+// not exist, so that their existence is not leaked.
+func (m *Manager) readDataAllowed(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, tenant string,
+) (bool, error) {
+	restriction, err := filter.ReadData(ctx, m.authorizer, principal, class, tenant)
+	if err != nil || restriction == nil {
+		return err == nil, err
+	}
+
+	byID := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class:    schema.ClassName(class),
+			Property: filters.InternalPropID,
+		},
+		Value: &filters.Value{Value: id.String(), Type: schema.DataTypeText},
+	}}
+	res, qerr := m.vectorRepo.Query(ctx, &QueryInput{
+		Class:   class,
+		Limit:   1,
+		Filters: filter.And(byID, restriction),
+		Tenant:  tenant,
+	})
+	if qerr != nil {
+		return false, qerr
+	}
+	return len(res) > 0, nil
+}
+
+// pruneProperties removes the properties the principal may not read, see
+// filter.PropertyMask
+func pruneProperties(mask *filter.PropertyMask, objects ...*models.Object) error {
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		if props, ok := obj.Properties.(map[string]interface{}); ok {
+			if err := mask.Prune(obj.Class, props); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkSortPropertyAccess returns a forbidden error if the results are sorted
+// by a property the principal may not read
+func checkSortPropertyAccess(mask *filter.PropertyMask, class string, sort []filters.Sort) error {
+	for _, s := range sort {
+		if len(s.Path) > 0 {
+			if err := mask.Check(class, s.Path[0]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
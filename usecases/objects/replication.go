@@ -33,9 +33,10 @@ type VObject struct {
 	// LatestObject is to most up-to-date version of an object
 	LatestObject *models.Object `json:"object,omitempty"`
 
-	Vector       []float32              `json:"vector"`
-	Vectors      map[string][]float32   `json:"vectors"`
-	MultiVectors map[string][][]float32 `json:"multiVectors"`
+	Vector        []float32                      `json:"vector"`
+	Vectors       map[string][]float32           `json:"vectors"`
+	MultiVectors  map[string][][]float32         `json:"multiVectors"`
+	SparseVectors map[string]models.SparseVector `json:"sparseVectors,omitempty"`
 
 	// StaleUpdateTime is the LastUpdateTimeUnix of the stale object sent to the coordinator
 	StaleUpdateTime int64 `json:"updateTime,omitempty"`
@@ -58,6 +59,7 @@ type vobjectMarshaler struct {
 	Vector                  []float32
 	Vectors                 map[string][]float32
 	MultiVectors            map[string][][]float32
+	SparseVectors           map[string]models.SparseVector
 	LatestObject            []byte
 }
 
@@ -70,6 +72,7 @@ func (vo *VObject) MarshalBinary() ([]byte, error) {
 		Vector:                  vo.Vector,
 		Vectors:                 vo.Vectors,
 		MultiVectors:            vo.MultiVectors,
+		SparseVectors:           vo.SparseVectors,
 		Version:                 vo.Version,
 	}
 	if vo.LatestObject != nil {
@@ -98,6 +101,7 @@ func (vo *VObject) UnmarshalBinary(data []byte) error {
 	vo.Vector = b.Vector
 	vo.Vectors = b.Vectors
 	vo.MultiVectors = b.MultiVectors
+	vo.SparseVectors = b.SparseVectors
 	vo.Version = b.Version
 
 	if b.LatestObject != nil {
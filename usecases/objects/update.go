@@ -96,6 +96,14 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
+	schemaVersion, err = m.autoSchemaManager.autoRangeShards(ctx, principal, []*models.Object{updates}, fetchedClasses)
+	if err != nil {
+		return nil, err
+	}
+	if schemaVersion > maxSchemaVersion {
+		maxSchemaVersion = schemaVersion
+	}
+
 	// Set the original creation timestamp before call to put,
 	// otherwise it is lost. This is because `class` is unmarshalled
 	// directly from the request body, therefore `CreationTimeUnix`
@@ -17,6 +17,8 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modelsext"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 func (v *Validator) vector(ctx context.Context, class *models.Class,
@@ -55,10 +57,21 @@ func (v *Validator) vector(ctx context.Context, class *models.Class,
 
 	var incomingTargetVectors []string
 	for name := range incomingObject.Vectors {
-		_, ok := class.VectorConfig[name]
+		vectorConfig, ok := class.VectorConfig[name]
 		if !ok {
 			return fmt.Errorf("collection %v does not have configuration for vector %s", class.Class, name)
 		}
+		_, isSparse := incomingObject.Vectors[name].(models.SparseVector)
+		if isSparse != (vectorConfig.VectorIndexType == vectorindex.VectorIndexTypeSPARSE) {
+			if isSparse {
+				return fmt.Errorf("vector %s is not configured as a sparse vector, but received a sparse vector", name)
+			}
+			return fmt.Errorf("vector %s is configured as a sparse vector and has to be given as indices and values", name)
+		}
+
+		if err := validateVectorDataType(name, incomingObject.Vectors[name], vectorConfig.VectorIndexConfig); err != nil {
+			return err
+		}
 
 		incomingTargetVectors = append(incomingTargetVectors, name)
 	}
@@ -69,3 +82,26 @@ func (v *Validator) vector(ctx context.Context, class *models.Class,
 
 	return nil
 }
+
+// validateVectorDataType makes sure that int8, uint8 and binary vectors are
+// only given for target vectors configured with the same data type. Float
+// vectors are accepted for every data type and checked when they are stored.
+func validateVectorDataType(name string, vector models.Vector, vectorIndexConfig interface{}) error {
+	var given string
+	switch vector.(type) {
+	case models.Int8Vector:
+		given = common.VectorDataTypeInt8
+	case models.Uint8Vector:
+		given = common.VectorDataTypeUint8
+	case models.BinaryVector:
+		given = common.VectorDataTypeBinary
+	default:
+		return nil
+	}
+
+	if configured := vectorindex.DataType(vectorIndexConfig); configured != given {
+		return fmt.Errorf("vector %s is configured with dataType %s, but received a %s vector",
+			name, configured, given)
+	}
+	return nil
+}
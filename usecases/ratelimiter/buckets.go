@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// idleBucketsCleanupInterval is how often buckets that are full again are
+// removed. A full bucket behaves exactly like a new one, so removing it
+// doesn't change any limit.
+const idleBucketsCleanupInterval = time.Minute
+
+// Buckets is a thread-safe set of token buckets, one per key. The rate and
+// burst of the buckets are read on every call, so they can be changed at
+// runtime.
+type Buckets struct {
+	limit config.RateLimit
+	now   func() time.Time
+
+	sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewBuckets creates [Buckets] for the given rate limit
+func NewBuckets(limit config.RateLimit) *Buckets {
+	return &Buckets{
+		limit:   limit,
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+	}
+}
+
+func (b *Buckets) rateAndBurst() (float64, float64) {
+	rate := b.limit.Rate.Get()
+	burst := float64(b.limit.Burst.Get())
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(rate))
+	}
+	return rate, burst
+}
+
+// Take removes n tokens from the bucket of the key. If the bucket does not
+// hold enough tokens, no token is removed and the time until enough tokens
+// are available is returned. Requests for more tokens than the burst size can
+// never succeed, they are rejected without a retry time, see [Buckets.Fits].
+func (b *Buckets) Take(key string, n int) (bool, time.Duration) {
+	rate, burst := b.rateAndBurst()
+	if rate <= 0 {
+		return true, 0
+	}
+
+	tokens := float64(n)
+	if tokens > burst {
+		return false, 0
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.now()
+	b.cleanup(now, rate, burst)
+
+	bu := b.refill(key, now, rate, burst)
+	if bu.tokens < tokens {
+		retryAfter := time.Duration((tokens - bu.tokens) / rate * float64(time.Second))
+		return false, retryAfter
+	}
+
+	bu.tokens -= tokens
+	return true, 0
+}
+
+// Fits returns whether a request for n tokens can be served at all, i.e. it
+// does not exceed the burst size, and the current burst size
+func (b *Buckets) Fits(n int) (bool, int) {
+	rate, burst := b.rateAndBurst()
+	if rate <= 0 {
+		return true, 0
+	}
+	return float64(n) <= burst, int(burst)
+}
+
+// Return gives n tokens back to the bucket of the key, this is used if a
+// request was rejected by another limit after the tokens were taken.
+func (b *Buckets) Return(key string, n int) {
+	rate, burst := b.rateAndBurst()
+	if rate <= 0 {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	bu := b.refill(key, b.now(), rate, burst)
+	bu.tokens = math.Min(burst, bu.tokens+math.Min(float64(n), burst))
+}
+
+func (b *Buckets) refill(key string, now time.Time, rate, burst float64) *bucket {
+	bu, ok := b.buckets[key]
+	if !ok {
+		bu = &bucket{tokens: burst, updated: now}
+		b.buckets[key] = bu
+		return bu
+	}
+
+	elapsed := now.Sub(bu.updated).Seconds()
+	bu.tokens = math.Min(burst, bu.tokens+elapsed*rate)
+	bu.updated = now
+	return bu
+}
+
+func (b *Buckets) cleanup(now time.Time, rate, burst float64) {
+	if now.Sub(b.lastCleanup) < idleBucketsCleanupInterval {
+		return
+	}
+	b.lastCleanup = now
+
+	for key, bu := range b.buckets {
+		if bu.tokens+now.Sub(bu.updated).Seconds()*rate >= burst {
+			delete(b.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Kind of requests that are limited independently of each other
+type Kind string
+
+const (
+	KindQuery       Kind = "query"
+	KindBatch       Kind = "batch objects"
+	KindModuleCalls Kind = "module calls"
+)
+
+// ErrRateLimited is returned if a request exceeds one of the rate limits. It
+// is translated to 429 (REST) or RESOURCE_EXHAUSTED (gRPC).
+type ErrRateLimited struct {
+	Kind       Kind
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("429 Too many requests: %s rate limit for %s exceeded, retry after %s",
+		e.Kind, e.Scope, e.RetryAfter.Round(time.Millisecond))
+}
+
+// ErrRequestTooLarge is returned if a single request needs more tokens than
+// the burst size of a rate limit. Retrying can't help, the request needs to
+// be split into smaller ones.
+type ErrRequestTooLarge struct {
+	Kind      Kind
+	Scope     string
+	Requested int
+	Burst     int
+}
+
+func (e *ErrRequestTooLarge) Error() string {
+	return fmt.Sprintf("request for %d %s exceeds the burst size %d of the rate limit for %s, split it into smaller requests",
+		e.Requested, e.Kind, e.Burst, e.Scope)
+}
+
+// Quotas enforces the configured rate limits per principal and per
+// collection. A nil *Quotas does not limit anything.
+type Quotas struct {
+	perPrincipal  map[Kind]*Buckets
+	perCollection map[Kind]*Buckets
+}
+
+// NewQuotas creates the token buckets for all configured limits
+func NewQuotas(cfg config.RateLimits) *Quotas {
+	return &Quotas{
+		perPrincipal: map[Kind]*Buckets{
+			KindQuery:       NewBuckets(cfg.QueriesPerPrincipal),
+			KindBatch:       NewBuckets(cfg.BatchObjectsPerPrincipal),
+			KindModuleCalls: NewBuckets(cfg.ModuleCallsPerPrincipal),
+		},
+		perCollection: map[Kind]*Buckets{
+			KindQuery:       NewBuckets(cfg.QueriesPerCollection),
+			KindBatch:       NewBuckets(cfg.BatchObjectsPerCollection),
+			KindModuleCalls: NewBuckets(cfg.ModuleCallsPerCollection),
+		},
+	}
+}
+
+// Take consumes n tokens of the principal and the collection. Tokens are
+// only consumed if both limits allow the request.
+func (q *Quotas) Take(kind Kind, principal *models.Principal, collection string, n int) error {
+	if q == nil || n <= 0 {
+		return nil
+	}
+
+	if fits, burst := q.perPrincipal[kind].Fits(n); !fits {
+		return &ErrRequestTooLarge{Kind: kind, Scope: "principal", Requested: n, Burst: burst}
+	}
+	if fits, burst := q.perCollection[kind].Fits(n); collection != "" && !fits {
+		return &ErrRequestTooLarge{Kind: kind, Scope: fmt.Sprintf("collection %q", collection), Requested: n, Burst: burst}
+	}
+
+	principalKey := principalKey(principal)
+	if ok, retryAfter := q.perPrincipal[kind].Take(principalKey, n); !ok {
+		return &ErrRateLimited{Kind: kind, Scope: "principal", RetryAfter: retryAfter}
+	}
+
+	if collection == "" {
+		return nil
+	}
+
+	if ok, retryAfter := q.perCollection[kind].Take(collection, n); !ok {
+		q.perPrincipal[kind].Return(principalKey, n)
+		return &ErrRateLimited{Kind: kind, Scope: fmt.Sprintf("collection %q", collection), RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// Return gives back n tokens that were taken with [Quotas.Take] for a request
+// that was rejected afterwards
+func (q *Quotas) Return(kind Kind, principal *models.Principal, collection string, n int) {
+	if q == nil || n <= 0 {
+		return
+	}
+
+	q.perPrincipal[kind].Return(principalKey(principal), n)
+	if collection != "" {
+		q.perCollection[kind].Return(collection, n)
+	}
+}
+
+// principalKey identifies the bucket of a principal. DB and OIDC users with
+// the same name are different principals. All anonymous requests share a
+// single bucket.
+func principalKey(principal *models.Principal) string {
+	if principal == nil {
+		return "anonymous"
+	}
+	return string(principal.UserType) + ":" + principal.Username
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+func rateLimit(rate float64, burst int) config.RateLimit {
+	return config.RateLimit{
+		Rate:  runtime.NewDynamicValue(rate),
+		Burst: runtime.NewDynamicValue(burst),
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	now := time.Now()
+	b := NewBuckets(rateLimit(2, 4))
+	b.now = func() time.Time { return now }
+
+	t.Run("burst is available immediately", func(t *testing.T) {
+		ok, _ := b.Take("a", 3)
+		assert.True(t, ok)
+		ok, _ = b.Take("a", 1)
+		assert.True(t, ok)
+	})
+
+	t.Run("empty bucket reports when to retry", func(t *testing.T) {
+		ok, retryAfter := b.Take("a", 1)
+		assert.False(t, ok)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+	})
+
+	t.Run("other keys are independent", func(t *testing.T) {
+		ok, _ := b.Take("b", 4)
+		assert.True(t, ok)
+	})
+
+	t.Run("bucket refills over time", func(t *testing.T) {
+		now = now.Add(time.Second)
+		ok, _ := b.Take("a", 2)
+		assert.True(t, ok)
+		ok, _ = b.Take("a", 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("requests larger than the burst are rejected", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		fits, burst := b.Fits(100)
+		assert.False(t, fits)
+		assert.Equal(t, 4, burst)
+		ok, retryAfter := b.Take("a", 100)
+		assert.False(t, ok)
+		assert.Zero(t, retryAfter)
+
+		// nothing was taken
+		ok, _ = b.Take("a", 4)
+		assert.True(t, ok)
+	})
+
+	t.Run("returned tokens are available again", func(t *testing.T) {
+		b.Return("a", 1)
+		ok, _ := b.Take("a", 1)
+		assert.True(t, ok)
+	})
+
+	t.Run("full buckets are cleaned up", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		ok, _ := b.Take("c", 1)
+		assert.True(t, ok)
+		assert.Len(t, b.buckets, 1)
+	})
+
+	t.Run("disabled limit", func(t *testing.T) {
+		disabled := NewBuckets(config.RateLimit{})
+		for i := 0; i < 100; i++ {
+			ok, _ := disabled.Take("a", 100)
+			require.True(t, ok)
+		}
+	})
+
+	t.Run("limit changed at runtime", func(t *testing.T) {
+		limit := rateLimit(0, 0)
+		changed := NewBuckets(limit)
+		ok, _ := changed.Take("a", 10)
+		assert.True(t, ok)
+
+		limit.Rate.SetValue(1)
+		ok, _ = changed.Take("a", 1)
+		assert.True(t, ok)
+		ok, _ = changed.Take("a", 1)
+		assert.False(t, ok)
+	})
+}
+
+func TestQuotas(t *testing.T) {
+	q := NewQuotas(config.RateLimits{
+		QueriesPerPrincipal:  rateLimit(1, 2),
+		QueriesPerCollection: rateLimit(1, 3),
+	})
+	alice := &models.Principal{Username: "alice", UserType: models.UserTypeInputDb}
+	bob := &models.Principal{Username: "bob", UserType: models.UserTypeInputDb}
+
+	require.NoError(t, q.Take(KindQuery, alice, "Documents", 2))
+
+	err := q.Take(KindQuery, alice, "Documents", 1)
+	var errRateLimited *ErrRateLimited
+	require.True(t, errors.As(err, &errRateLimited))
+	assert.Equal(t, "principal", errRateLimited.Scope)
+	assert.Positive(t, errRateLimited.RetryAfter)
+
+	// the collection still has one token left
+	require.NoError(t, q.Take(KindQuery, bob, "Documents", 1))
+	err = q.Take(KindQuery, bob, "Documents", 1)
+	require.True(t, errors.As(err, &errRateLimited))
+	assert.Equal(t, `collection "Documents"`, errRateLimited.Scope)
+
+	// tokens of the principal are returned if the collection is limited
+	require.NoError(t, q.Take(KindQuery, bob, "Other", 1))
+
+	// returned tokens can be taken again
+	q.Return(KindQuery, bob, "Other", 1)
+	require.NoError(t, q.Take(KindQuery, bob, "Other", 1))
+
+	// requests that can never fit are rejected without taking tokens
+	err = q.Take(KindQuery, &models.Principal{Username: "carol"}, "Large", 3)
+	var errTooLarge *ErrRequestTooLarge
+	require.True(t, errors.As(err, &errTooLarge))
+	assert.Equal(t, "principal", errTooLarge.Scope)
+	assert.Equal(t, 2, errTooLarge.Burst)
+	require.NoError(t, q.Take(KindQuery, &models.Principal{Username: "carol"}, "Large", 2))
+
+	// other kinds are not limited
+	require.NoError(t, q.Take(KindBatch, alice, "Documents", 1000))
+
+	var nilQuotas *Quotas
+	require.NoError(t, nilQuotas.Take(KindQuery, alice, "Documents", 1000))
+}
@@ -14,10 +14,12 @@ package replica
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/cluster/utils"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -52,6 +54,19 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+
+		// version and committed track the replicas which committed a write
+		// for the session of the request
+		version     int64
+		committedMu sync.Mutex
+		committed   []string
+
+		// hints keeps the write for replicas which missed it, hintIDs are the
+		// objects affected by the write and hintNodes maps the hosts of the
+		// replicas to their node names
+		hints     *hints
+		hintIDs   []strfmt.UUID
+		hintNodes map[string]string
 	}
 )
 
@@ -67,6 +82,7 @@ func newCoordinator[T any](r *Replicator, shard, requestID string, l logrus.Fiel
 		TxID:                          requestID,
 		pullBackOffPreInitialInterval: defaultPullBackOffInitialInterval / 2,
 		pullBackOffMaxElapsedTime:     defaultPullBackOffMaxElapsedTime,
+		hints:                         r.hints,
 	}
 }
 
@@ -118,9 +134,11 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 	f := func() {
 		defer close(replicaCh)
 		actives := make([]string, 0, level) // cache for active replicas
+		var missed []string                 // replicas which failed to prepare
 		for r := range prepare() {
 			if r.Err != nil { // connection error
 				c.log.WithField("op", "broadcast").Error(r.Err)
+				missed = append(missed, r.Value)
 				continue
 			}
 
@@ -142,7 +160,9 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 			for _, node := range replicas {
 				c.Abort(ctx, node, c.Class, c.Shard, c.TxID)
 			}
+			return
 		}
+		c.hint(missed...)
 	}
 	enterrors.GoWrapper(f, c.log)
 	return replicaCh
@@ -163,6 +183,9 @@ func (c *coordinator[T]) commitAll(ctx context.Context,
 			g := func() {
 				defer wg.Done()
 				resp, err := op(ctx, replica, c.TxID)
+				if err != nil {
+					c.hint(replica)
+				}
 				replyCh <- _Result[T]{resp, err}
 			}
 			enterrors.GoWrapper(g, c.log)
@@ -189,6 +212,15 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
 	level := writeRoutingPlan.IntConsistencyLevel
+	if SessionFromContext(ctx) != nil {
+		com = c.trackCommits(com, writeRoutingPlan.Replicas())
+	}
+	if c.hints != nil {
+		c.hintNodes = make(map[string]string)
+		for _, replica := range append(writeRoutingPlan.Replicas(), writeRoutingPlan.AdditionalReplicas()...) {
+			c.hintNodes[replica.HostAddr] = replica.NodeName
+		}
+	}
 	//nolint:govet // we expressely don't want to cancel that context as the timeout will take care of it
 	ctxWithTimeout, _ := context.WithTimeout(context.Background(), 20*time.Second)
 	c.log.WithFields(logrus.Fields{
@@ -209,6 +241,78 @@ func (c *coordinator[T]) Push(ctx context.Context,
 	return commitCh, level, nil
 }
 
+// hint persists hints for the replicas on hosts which missed the write,
+// if hinted handoff is enabled
+func (c *coordinator[T]) hint(hosts ...string) {
+	if c.hints == nil || len(c.hintIDs) == 0 {
+		return
+	}
+	now := time.Now().UnixMilli()
+	for _, host := range hosts {
+		node, ok := c.hintNodes[host]
+		if !ok {
+			continue
+		}
+		if err := c.hints.add(node, hint{Shard: c.Shard, IDs: c.hintIDs, Time: now}); err != nil {
+			c.log.WithField("op", "hint").WithField("class", c.Class).
+				WithField("shard", c.Shard).WithField("node", node).Error(err)
+		}
+	}
+}
+
+// trackCommits wraps op to remember the replicas which committed successfully
+func (c *coordinator[T]) trackCommits(op commitOp[T], replicas []types.Replica) commitOp[T] {
+	c.version = time.Now().UnixMilli()
+	nodes := make(map[string]string, len(replicas))
+	for _, replica := range replicas {
+		nodes[replica.HostAddr] = replica.NodeName
+	}
+	return func(ctx context.Context, host, requestID string) (T, error) {
+		resp, err := op(ctx, host, requestID)
+		if err == nil {
+			c.committedMu.Lock()
+			c.committed = append(c.committed, nodes[host])
+			c.committedMu.Unlock()
+		}
+		return resp, err
+	}
+}
+
+// recordSession records the replicas which committed the write so far in the
+// session of the request, if there is one
+func (c *coordinator[T]) recordSession(ctx context.Context) {
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return
+	}
+	c.committedMu.Lock()
+	nodes := slices.Clone(c.committed)
+	c.committedMu.Unlock()
+	session.RecordWrite(c.Class, c.Shard, c.version, nodes)
+}
+
+// sessionHosts returns the hosts of the replicas which applied all writes
+// recorded for the shard in the session of the request, in the order of the
+// routing plan. written is false if the session didn't write to the shard.
+// If it did, but none of these replicas is part of the plan, no hosts are
+// returned.
+func (c *coordinator[T]) sessionHosts(ctx context.Context, plan types.ReadRoutingPlan) (hosts []string, written bool) {
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return nil, false
+	}
+	shard, ok := session.Shard(c.Class, c.Shard)
+	if !ok {
+		return nil, false
+	}
+	for _, replica := range plan.Replicas() {
+		if slices.Contains(shard.Nodes, replica.NodeName) {
+			hosts = append(hosts, replica.HostAddr)
+		}
+	}
+	return hosts, true
+}
+
 // Pull data from replica depending on consistency level, trying to reach level successful calls
 // to op, while cycling through replicas for the coordinator's shard.
 //
@@ -235,6 +339,29 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 	}
 	level := readRoutingPlan.IntConsistencyLevel
 	hosts := readRoutingPlan.HostAddresses()
+	if cl == types.ConsistencyLevelSession {
+		sessionHosts, written := c.sessionHosts(ctx, readRoutingPlan)
+		switch {
+		case len(sessionHosts) > 0:
+			// only replicas which applied the writes of the session may answer,
+			// wait briefly for one of them instead of falling back to the others
+			hosts = sessionHosts
+			timeout = min(timeout, sessionReadTimeout)
+		case written:
+			// no single replica is known to have applied all writes of the
+			// session, read from all of them so that the newest version wins
+			readRoutingPlan, err = c.Router.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
+				Shard:               c.Shard,
+				ConsistencyLevel:    types.ConsistencyLevelAll,
+				DirectCandidateNode: directCandidate,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+			}
+			level = readRoutingPlan.IntConsistencyLevel
+			hosts = readRoutingPlan.HostAddresses()
+		}
+	}
 	replyCh := make(chan _Result[T], level)
 	f := func() {
 		hostRetryQueue := make(chan hostRetry, len(hosts))
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
@@ -42,6 +43,8 @@ var (
 	ErrRead     = errors.New("read error")
 
 	ErrNoDiffFound = errors.New("no diff found")
+
+	errShardNotHosted = errors.New("shard not hosted")
 )
 
 type (
@@ -219,8 +222,20 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
 	for _, part := range cluster(createBatch(xs)) {
 		part := part
+		partLevel := l
+		if l == types.ConsistencyLevelSession {
+			if !f.mayMissSessionWrites(ctx, part) {
+				for _, idx := range part.Index {
+					part.Data[idx].IsConsistent = true
+				}
+				continue
+			}
+			// the part was read from a replica which may not have applied the
+			// writes of the session, compare it with all replicas
+			partLevel = types.ConsistencyLevelAll
+		}
 		gr.Go(func() error {
-			_, err := f.checkShardConsistency(ctx, l, part)
+			_, err := f.checkShardConsistency(ctx, partLevel, part)
 			if err != nil {
 				f.log.WithField("op", "check_shard_consistency").
 					WithField("shard", part.Shard).Error(err)
@@ -231,6 +246,17 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 	return gr.Wait()
 }
 
+// mayMissSessionWrites returns whether part was read from a replica which
+// isn't known to have applied the writes of the session of the request
+func (f *Finder) mayMissSessionWrites(ctx context.Context, part ShardPart) bool {
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return false
+	}
+	shard, ok := session.Shard(f.class, part.Shard)
+	return ok && !slices.Contains(shard.Nodes, part.Node)
+}
+
 // Exists checks if an object exists which satisfies the giving consistency
 func (f *Finder) Exists(ctx context.Context,
 	l types.ConsistencyLevel,
@@ -330,52 +356,6 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 		return nil, fmt.Errorf("%w : class %q shard %q", err, f.class, shardName)
 	}
 
-	collectDiffForTargetNode := func(targetNodeAddress, targetNodeName string) (*ShardDifferenceReader, error) {
-		ctx, cancel := context.WithTimeout(ctx, diffTimeoutPerNode)
-		defer cancel()
-
-		diff := hashtree.NewBitset(hashtree.NodesCount(ht.Height()))
-
-		digests := make([]hashtree.Digest, hashtree.LeavesCount(ht.Height()))
-
-		diff.Set(0) // init comparison at root level
-
-		for l := 0; l <= ht.Height(); l++ {
-			_, err := ht.Level(l, diff, digests)
-			if err != nil {
-				return nil, fmt.Errorf("%q: %w", targetNodeAddress, err)
-			}
-
-			levelDigests, err := f.client.HashTreeLevel(ctx, targetNodeAddress, f.class, shardName, l, diff)
-			if err != nil {
-				return nil, fmt.Errorf("%q: %w", targetNodeAddress, err)
-			}
-			if len(levelDigests) == 0 {
-				// no differences were found
-				break
-			}
-
-			levelDiffCount := hashtree.LevelDiff(l, diff, digests, levelDigests)
-			if levelDiffCount == 0 {
-				// no differences were found
-				break
-			}
-		}
-
-		if diff.SetCount() == 0 {
-			return &ShardDifferenceReader{
-				TargetNodeName:    targetNodeName,
-				TargetNodeAddress: targetNodeAddress,
-			}, ErrNoDiffFound
-		}
-
-		return &ShardDifferenceReader{
-			TargetNodeName:    targetNodeName,
-			TargetNodeAddress: targetNodeAddress,
-			RangeReader:       ht.NewRangeReader(diff),
-		}, nil
-	}
-
 	ec := errorcompounder.New()
 
 	// If the caller provided a list of target node overrides, filter the replicas to only include
@@ -416,7 +396,8 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 			continue
 		}
 
-		diffReader, err := collectDiffForTargetNode(targetNodeAddress, targetNodeName)
+		diffReader, err := f.collectShardDiff(ctx, shardName, ht, diffTimeoutPerNode,
+			targetNodeAddress, targetNodeName, false)
 		if err != nil {
 			if !errors.Is(err, ErrNoDiffFound) {
 				ec.Add(err)
@@ -435,6 +416,90 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 	return &ShardDifferenceReader{}, ErrNoDiffFound
 }
 
+// CollectFollowerShardDifferences collects the differences between the local
+// replica of a shard and its replica in a follower cluster. hosts are the
+// addresses of the nodes of the follower cluster, the differences to the
+// first one hosting the shard are returned. If no differences are found, it
+// returns ErrNoDiffFound.
+func (f *Finder) CollectFollowerShardDifferences(ctx context.Context,
+	shardName string, ht hashtree.AggregatedHashTree, diffTimeoutPerNode time.Duration,
+	hosts []string,
+) (*ShardDifferenceReader, error) {
+	ec := errorcompounder.New()
+	for _, host := range hosts {
+		diffReader, err := f.collectShardDiff(ctx, shardName, ht, diffTimeoutPerNode, host, host, true)
+		if errors.Is(err, errShardNotHosted) {
+			continue
+		}
+		if err != nil && !errors.Is(err, ErrNoDiffFound) {
+			ec.Add(err)
+			continue
+		}
+		return diffReader, err
+	}
+
+	if err := ec.ToError(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("shard %q not found on follower hosts %v", shardName, hosts)
+}
+
+// collectShardDiff compares the hashtree of the local replica of a shard
+// level by level with the one of the replica on the target node. A node which
+// doesn't host the shard returns no digests, if requireShard is set this is
+// reported as errShardNotHosted instead of comparing the whole tree.
+func (f *Finder) collectShardDiff(ctx context.Context,
+	shardName string, ht hashtree.AggregatedHashTree, diffTimeoutPerNode time.Duration,
+	targetNodeAddress, targetNodeName string, requireShard bool,
+) (*ShardDifferenceReader, error) {
+	ctx, cancel := context.WithTimeout(ctx, diffTimeoutPerNode)
+	defer cancel()
+
+	diff := hashtree.NewBitset(hashtree.NodesCount(ht.Height()))
+
+	digests := make([]hashtree.Digest, hashtree.LeavesCount(ht.Height()))
+
+	diff.Set(0) // init comparison at root level
+
+	for l := 0; l <= ht.Height(); l++ {
+		_, err := ht.Level(l, diff, digests)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", targetNodeAddress, err)
+		}
+
+		levelDigests, err := f.client.HashTreeLevel(ctx, targetNodeAddress, f.class, shardName, l, diff)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", targetNodeAddress, err)
+		}
+		if len(levelDigests) == 0 {
+			if l == 0 && requireShard {
+				return nil, fmt.Errorf("%q: %w", targetNodeAddress, errShardNotHosted)
+			}
+			// no differences were found
+			break
+		}
+
+		levelDiffCount := hashtree.LevelDiff(l, diff, digests, levelDigests)
+		if levelDiffCount == 0 {
+			// no differences were found
+			break
+		}
+	}
+
+	if diff.SetCount() == 0 {
+		return &ShardDifferenceReader{
+			TargetNodeName:    targetNodeName,
+			TargetNodeAddress: targetNodeAddress,
+		}, ErrNoDiffFound
+	}
+
+	return &ShardDifferenceReader{
+		TargetNodeName:    targetNodeName,
+		TargetNodeAddress: targetNodeAddress,
+		RangeReader:       ht.NewRangeReader(diff),
+	}, nil
+}
+
 func (f *Finder) DigestObjectsInRange(ctx context.Context,
 	shardName string, host string, initialUUID, finalUUID strfmt.UUID, limit int,
 ) (ds []types.RepairResponse, err error) {
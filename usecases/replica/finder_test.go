@@ -20,11 +20,13 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 )
 
 func object(id strfmt.UUID, lastTime int64) *storobj.Object {
@@ -453,6 +455,58 @@ func TestFinderGetOneWithConsistencyLevelOne(t *testing.T) {
 	})
 }
 
+func TestFinderGetOneWithConsistencyLevelSession(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		item      = replica.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []types.RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		now       = time.Now().UnixMilli()
+	)
+
+	t.Run("ReplicaWithAllWrites", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, "C1", shard, nodes)
+			finder  = f.newFinder("A")
+			session = replica.NewSession()
+		)
+		session.RecordWrite(cls, shard, now, []string{"B", "C"})
+		session.RecordWrite(cls, shard, now+1, []string{"A", "C"})
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(replica.ContextWithSession(context.Background(), session),
+			types.ConsistencyLevelSession, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+	})
+
+	t.Run("NoReplicaWithAllWrites", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, "C1", shard, nodes)
+			finder  = f.newFinder("A")
+			session = replica.NewSession()
+		)
+		// A and B each missed one of the writes, so every replica is read
+		session.RecordWrite(cls, shard, now, []string{"A"})
+		session.RecordWrite(cls, shard, now+1, []string{"B"})
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		got, err := finder.GetOne(replica.ContextWithSession(context.Background(), session),
+			types.ConsistencyLevelSession, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 2)
+	})
+}
+
 func TestFinderExistsWithConsistencyLevelALL(t *testing.T) {
 	var (
 		id       = strfmt.UUID("123")
@@ -867,3 +921,62 @@ func TestFinderCheckConsistencyOne(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, want, xs)
 }
+
+func TestFinderCollectFollowerShardDifferences(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		ctx   = context.Background()
+	)
+
+	ht, err := hashtree.NewHashTree(0)
+	require.NoError(t, err)
+	require.NoError(t, ht.AggregateLeafWith(0, []byte("object")))
+
+	root := hashtree.NewBitset(hashtree.NodesCount(0))
+	root.Set(0)
+	localDigests := make([]hashtree.Digest, 1)
+	_, err = ht.Level(0, root, localDigests)
+	require.NoError(t, err)
+
+	t.Run("NoDiff", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A"})
+		finder := f.newFinder("A")
+		f.RClient.On("HashTreeLevel", anyVal, "F1", cls, shard, 0, anyVal).Return([]hashtree.Digest{}, nil)
+		f.RClient.On("HashTreeLevel", anyVal, "F2", cls, shard, 0, anyVal).Return(localDigests, nil)
+
+		diffReader, err := finder.CollectFollowerShardDifferences(ctx, shard, ht, time.Second, []string{"F1", "F2"})
+		assert.ErrorIs(t, err, replica.ErrNoDiffFound)
+		assert.Equal(t, "F2", diffReader.TargetNodeAddress)
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A"})
+		finder := f.newFinder("A")
+		f.RClient.On("HashTreeLevel", anyVal, "F1", cls, shard, 0, anyVal).Return([]hashtree.Digest{{1, 2}}, nil)
+
+		diffReader, err := finder.CollectFollowerShardDifferences(ctx, shard, ht, time.Second, []string{"F1", "F2"})
+		require.NoError(t, err)
+		assert.Equal(t, "F1", diffReader.TargetNodeAddress)
+		assert.Equal(t, "F1", diffReader.TargetNodeName)
+		assert.NotNil(t, diffReader.RangeReader)
+	})
+
+	t.Run("NotHosted", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A"})
+		finder := f.newFinder("A")
+		f.RClient.On("HashTreeLevel", anyVal, "F1", cls, shard, 0, anyVal).Return([]hashtree.Digest{}, nil)
+
+		_, err := finder.CollectFollowerShardDifferences(ctx, shard, ht, time.Second, []string{"F1"})
+		assert.ErrorContains(t, err, "not found on follower hosts")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A"})
+		finder := f.newFinder("A")
+		f.RClient.On("HashTreeLevel", anyVal, "F1", cls, shard, 0, anyVal).Return([]hashtree.Digest{}, errAny)
+
+		_, err := finder.CollectFollowerShardDifferences(ctx, shard, ht, time.Second, []string{"F1"})
+		assert.ErrorContains(t, err, errAny.Error())
+	})
+}
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// hintReplayBatchSize is the maximum number of objects replayed per request
+	hintReplayBatchSize = 100
+	// hintReplayTimeout bounds a single replay request
+	hintReplayTimeout = 30 * time.Second
+	// hintsSyncInterval is how often new hints are flushed to disk
+	hintsSyncInterval = time.Second
+)
+
+// StartHintedHandoff makes the replicator keep hints in dir for replicas
+// which miss writes that succeeded on enough other replicas. The hints are
+// replayed every cfg.ReplayFrequency until ctx is done. It must be called
+// before the replicator is used.
+func (r *Replicator) StartHintedHandoff(ctx context.Context, dir string, cfg replication.HintedHandoff) error {
+	h, err := newHints(dir)
+	if err != nil {
+		return err
+	}
+	r.hints = h
+
+	frequency := cfg.ReplayFrequency
+	if frequency <= 0 {
+		frequency = replication.DefaultHintedHandoffReplayFrequency
+	}
+	maxAge := cfg.MaxAge
+	if maxAge <= 0 {
+		maxAge = replication.DefaultHintedHandoffMaxAge
+	}
+
+	enterrors.GoWrapper(func() {
+		t := time.NewTicker(frequency)
+		defer t.Stop()
+		syncTicker := time.NewTicker(hintsSyncInterval)
+		defer syncTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				if err := h.close(); err != nil {
+					r.log.WithField("op", "hints.close").WithField("class", r.class).Error(err)
+				}
+				return
+			case <-syncTicker.C:
+				if err := h.sync(); err != nil {
+					r.log.WithField("op", "hints.sync").WithField("class", r.class).Error(err)
+				}
+			case <-t.C:
+				r.replayHints(ctx, maxAge)
+			}
+		}
+	}, r.log)
+
+	return nil
+}
+
+// replayHints replays the hints of all nodes which are reachable. The hints
+// of the other ones are dropped once they expired, as the node may have left
+// the cluster for good.
+func (r *Replicator) replayHints(ctx context.Context, maxAge time.Duration) {
+	nodes, err := r.hints.nodes()
+	if err != nil {
+		r.log.WithField("op", "hints.replay").WithField("class", r.class).Error(err)
+		return
+	}
+
+	minTime := time.Now().Add(-maxAge)
+	for _, node := range nodes {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.replayNodeHints(ctx, node, minTime); err != nil {
+			// the node is most likely still unreachable, try again later
+			r.log.WithField("op", "hints.replay").WithField("class", r.class).
+				WithField("node", node).Debug(err)
+		}
+	}
+}
+
+func (r *Replicator) replayNodeHints(ctx context.Context, node string, minTime time.Time) error {
+	host, ok := r.router.NodeHostname(node)
+	if !ok {
+		if err := r.hints.prune(node, minTime); err != nil {
+			return err
+		}
+		return fmt.Errorf("resolve node %q", node)
+	}
+
+	xs, err := r.hints.take(node)
+	if err != nil {
+		return err
+	}
+
+	shards, ids := groupHints(xs, minTime)
+	count := 0
+	for _, shard := range shards {
+		shardIDs := ids[shard]
+		for i := 0; i < len(shardIDs); i += hintReplayBatchSize {
+			batch := shardIDs[i:min(i+hintReplayBatchSize, len(shardIDs))]
+			if err := r.replayHint(ctx, host, shard, batch); err != nil {
+				return fmt.Errorf("shard %q: %w", shard, err)
+			}
+			count += len(batch)
+		}
+	}
+
+	if err := r.hints.done(node); err != nil {
+		return err
+	}
+	if count > 0 {
+		r.log.WithField("op", "hints.replay").WithField("class", r.class).
+			WithField("node", node).WithField("objects", count).Info("replayed hints")
+	}
+	return nil
+}
+
+// replayHint copies the current state of objects ids from a replica of the
+// shard to the replica on host, which missed writes of them
+func (r *Replicator) replayHint(ctx context.Context, host, shard string, ids []strfmt.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, hintReplayTimeout)
+	defer cancel()
+
+	// the target replica is checked first, there is no point in reading the
+	// objects while it is still unreachable
+	digests, err := r.client.DigestObjects(ctx, host, r.class, shard, ids, 0)
+	if err != nil {
+		return fmt.Errorf("%q: %w", host, err)
+	}
+	if len(digests) != len(ids) {
+		return fmt.Errorf("%q: expected %d digests, got %d", host, len(ids), len(digests))
+	}
+
+	replicas, err := r.fetchFromOtherReplicas(ctx, host, shard, ids)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]*objects.VObject, 0, len(ids))
+	for i, x := range replicas {
+		d := digests[i]
+		updateTime := x.UpdateTime()
+
+		switch {
+		case x.Deleted:
+			if d.Deleted || d.UpdateTime == 0 || d.UpdateTime > updateTime {
+				continue
+			}
+			batch = append(batch, &objects.VObject{
+				ID:                      ids[i],
+				Deleted:                 true,
+				LastUpdateTimeUnixMilli: updateTime,
+				StaleUpdateTime:         d.UpdateTime,
+			})
+		case x.Object != nil:
+			if d.UpdateTime >= updateTime {
+				continue
+			}
+			batch = append(batch, &objects.VObject{
+				ID:                      ids[i],
+				LastUpdateTimeUnixMilli: updateTime,
+				LatestObject:            &x.Object.Object,
+				Vector:                  x.Object.Vector,
+				Vectors:                 x.Object.Vectors,
+				MultiVectors:            x.Object.MultiVectors,
+				SparseVectors:           x.Object.SparseVectors,
+				StaleUpdateTime:         d.UpdateTime,
+			})
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	// conflicts mean the replica changed meanwhile, they are left to read
+	// repair and async replication
+	if _, err := r.client.OverwriteObjects(ctx, host, r.class, shard, batch); err != nil {
+		return fmt.Errorf("%q: %w", host, err)
+	}
+	return nil
+}
+
+// fetchFromOtherReplicas reads objects ids from all replicas of the shard
+// other than the one on host which can be reached, and returns the most
+// recent version of each of them. Replicas which missed writes as well
+// mustn't overwrite newer versions.
+func (r *Replicator) fetchFromOtherReplicas(ctx context.Context, host, shard string, ids []strfmt.UUID,
+) ([]Replica, error) {
+	plan, err := r.router.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
+		Shard:            shard,
+		ConsistencyLevel: types.ConsistencyLevelOne,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, r.class, shard)
+	}
+
+	var newest []Replica
+	ec := errorcompounder.New()
+	for _, source := range plan.HostAddresses() {
+		if source == host {
+			continue
+		}
+		replicas, err := r.client.FetchObjects(ctx, source, r.class, shard, ids)
+		if err == nil && len(replicas) != len(ids) {
+			err = fmt.Errorf("expected %d objects, got %d", len(ids), len(replicas))
+		}
+		if err != nil {
+			ec.Add(fmt.Errorf("%q: %w", source, err))
+			continue
+		}
+		if newest == nil {
+			newest = replicas
+			continue
+		}
+		for i, x := range replicas {
+			if x.UpdateTime() > newest[i].UpdateTime() {
+				newest[i] = x
+			}
+		}
+	}
+	if newest != nil {
+		return newest, nil
+	}
+	if err := ec.ToError(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("no other replica of shard %q", shard)
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+)
+
+const (
+	// hintsLogExt is the extension of the files new hints are appended to
+	hintsLogExt = ".log"
+	// hintsReplayExt is the extension of the files being replayed. New hints
+	// go to a fresh log file meanwhile, the replay file is removed only once
+	// all its hints have been replayed.
+	hintsReplayExt = ".replay"
+)
+
+// hint records that a replica missed a write of the objects ids of a shard.
+// It doesn't hold the write itself: when replaying, the current state of the
+// objects is copied from another replica.
+type hint struct {
+	Shard string        `json:"shard"`
+	IDs   []strfmt.UUID `json:"ids"`
+	// Time is the unix time in milliseconds when the write was missed
+	Time int64 `json:"time"`
+}
+
+// hints is an on-disk store of hints, with one append-only file per node
+// which missed writes. The files are kept open and synced in the background
+// rather than on every write, hints lost in a crash are left to read repair
+// and async replication.
+type hints struct {
+	dir   string
+	mu    sync.Mutex
+	files map[string]*os.File
+	// dirty are the nodes whose files were written to since the last sync
+	dirty map[string]struct{}
+}
+
+func newHints(dir string) (*hints, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create hints directory %q: %w", dir, err)
+	}
+	return &hints{
+		dir:   dir,
+		files: make(map[string]*os.File),
+		dirty: make(map[string]struct{}),
+	}, nil
+}
+
+func (h *hints) path(node, ext string) string {
+	return filepath.Join(h.dir, url.PathEscape(node)+ext)
+}
+
+// add persists a hint for node
+func (h *hints) add(node string, x hint) error {
+	line, err := json.Marshal(x)
+	if err != nil {
+		return fmt.Errorf("marshal hint: %w", err)
+	}
+	line = append(line, '\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f, ok := h.files[node]
+	if !ok {
+		f, err = os.OpenFile(h.path(node, hintsLogExt), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+		if err != nil {
+			return fmt.Errorf("open hints of node %q: %w", node, err)
+		}
+		h.files[node] = f
+	}
+	if _, err := f.Write(line); err != nil {
+		return fmt.Errorf("write hint of node %q: %w", node, err)
+	}
+	h.dirty[node] = struct{}{}
+	return nil
+}
+
+// sync flushes the hints added since the last sync to disk
+func (h *hints) sync() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ec := errorcompounder.New()
+	for node := range h.dirty {
+		if err := h.files[node].Sync(); err != nil {
+			ec.Add(fmt.Errorf("sync hints of node %q: %w", node, err))
+			continue
+		}
+		delete(h.dirty, node)
+	}
+	return ec.ToError()
+}
+
+// close syncs and closes all files, hints can still be added afterwards
+func (h *hints) close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ec := errorcompounder.New()
+	for node := range h.files {
+		ec.Add(h.closeFile(node))
+	}
+	return ec.ToError()
+}
+
+// closeFile syncs and closes the log file of node if it is open. The caller
+// must hold h.mu.
+func (h *hints) closeFile(node string) error {
+	f, ok := h.files[node]
+	if !ok {
+		return nil
+	}
+	delete(h.files, node)
+	delete(h.dirty, node)
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync hints of node %q: %w", node, err)
+	}
+	return f.Close()
+}
+
+// nodes returns the nodes there are hints for
+func (h *hints) nodes() ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(entries))
+	nodes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if entry.IsDir() || (ext != hintsLogExt && ext != hintsReplayExt) {
+			continue
+		}
+		node, err := url.PathUnescape(strings.TrimSuffix(name, ext))
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[node]; !ok {
+			seen[node] = struct{}{}
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// take returns the hints of node to be replayed. A replay which wasn't
+// completed is resumed first, otherwise the hints logged so far are taken.
+// They stay on disk until done is called.
+func (h *hints) take(node string) ([]hint, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	replayPath := h.path(node, hintsReplayExt)
+	if _, err := os.Stat(replayPath); errors.Is(err, fs.ErrNotExist) {
+		if err := h.closeFile(node); err != nil {
+			return nil, err
+		}
+		if err := os.Rename(h.path(node, hintsLogExt), replayPath); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("rotate hints of node %q: %w", node, err)
+		}
+		if err := diskio.Fsync(h.dir); err != nil {
+			return nil, fmt.Errorf("fsync hints directory %q: %w", h.dir, err)
+		}
+	}
+
+	f, err := os.Open(replayPath)
+	if err != nil {
+		return nil, fmt.Errorf("open hints of node %q: %w", node, err)
+	}
+	defer f.Close()
+
+	var xs []hint
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var x hint
+		if err := json.Unmarshal(scanner.Bytes(), &x); err != nil {
+			// the last line may be incomplete after a crash
+			continue
+		}
+		xs = append(xs, x)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read hints of node %q: %w", node, err)
+	}
+	return xs, nil
+}
+
+// done removes the hints of node returned by take
+func (h *hints) done(node string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := os.Remove(h.path(node, hintsReplayExt)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove replayed hints of node %q: %w", node, err)
+	}
+	return nil
+}
+
+// prune removes the hints of node if all of them are older than minTime.
+// Hints of nodes which left the cluster can't be replayed, this way they
+// don't pile up. As files are only appended to, their modification time is
+// the time of the newest hint.
+func (h *hints) prune(node string, minTime time.Time) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, ext := range []string{hintsLogExt, hintsReplayExt} {
+		path := h.path(node, ext)
+		info, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("stat hints of node %q: %w", node, err)
+		}
+		if !info.ModTime().Before(minTime) {
+			continue
+		}
+		if ext == hintsLogExt {
+			if err := h.closeFile(node); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("remove expired hints of node %q: %w", node, err)
+		}
+	}
+	return nil
+}
+
+// groupHints merges the hints newer than minTime by shard, keeping the first
+// occurrence of every id
+func groupHints(xs []hint, minTime time.Time) (shards []string, ids map[string][]strfmt.UUID) {
+	ids = make(map[string][]strfmt.UUID)
+	seen := make(map[string]map[strfmt.UUID]struct{})
+	for _, x := range xs {
+		if x.Time < minTime.UnixMilli() {
+			continue
+		}
+		if _, ok := seen[x.Shard]; !ok {
+			seen[x.Shard] = make(map[strfmt.UUID]struct{})
+			shards = append(shards, x.Shard)
+		}
+		for _, id := range x.IDs {
+			if _, ok := seen[x.Shard][id]; !ok {
+				seen[x.Shard][id] = struct{}{}
+				ids[x.Shard] = append(ids[x.Shard], id)
+			}
+		}
+	}
+	return shards, ids
+}
@@ -142,6 +142,7 @@ func (r *repairer) repairOne(ctx context.Context,
 			var vector []float32
 			var vectors map[string][]float32
 			var multiVectors map[string][][]float32
+			var sparseVectors map[string]models.SparseVector
 
 			if !updates.Deleted {
 				latestObject = &updates.Object.Object
@@ -158,6 +159,12 @@ func (r *repairer) repairOne(ctx context.Context,
 						multiVectors[targetVector] = v
 					}
 				}
+				if updates.Object.SparseVectors != nil {
+					sparseVectors = make(map[string]models.SparseVector, len(updates.Object.SparseVectors))
+					for targetVector, v := range updates.Object.SparseVectors {
+						sparseVectors[targetVector] = v
+					}
+				}
 			}
 
 			ups := []*objects.VObject{{
@@ -168,6 +175,7 @@ func (r *repairer) repairOne(ctx context.Context,
 				Vector:                  vector,
 				Vectors:                 vectors,
 				MultiVectors:            multiVectors,
+				SparseVectors:           sparseVectors,
 				StaleUpdateTime:         vote.UTime,
 			}}
 			resp, err := cl.Overwrite(ctx, vote.Sender, r.class, shard, ups)
@@ -280,6 +288,7 @@ func (r *repairer) repairExist(ctx context.Context,
 			var vector []float32
 			var vectors map[string][]float32
 			var multiVectors map[string][][]float32
+			var sparseVectors map[string]models.SparseVector
 
 			if !resp.Deleted {
 				latestObject = &resp.Object.Object
@@ -296,6 +305,12 @@ func (r *repairer) repairExist(ctx context.Context,
 						multiVectors[targetVector] = v
 					}
 				}
+				if resp.Object.SparseVectors != nil {
+					sparseVectors = make(map[string]models.SparseVector, len(resp.Object.SparseVectors))
+					for targetVector, v := range resp.Object.SparseVectors {
+						sparseVectors[targetVector] = v
+					}
+				}
 			}
 
 			ups := []*objects.VObject{{
@@ -306,6 +321,7 @@ func (r *repairer) repairExist(ctx context.Context,
 				Vector:                  vector,
 				Vectors:                 vectors,
 				MultiVectors:            multiVectors,
+				SparseVectors:           sparseVectors,
 				StaleUpdateTime:         vote.UTime,
 			}}
 
@@ -480,6 +496,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 				var vector []float32
 				var vectors map[string][]float32
 				var multiVectors map[string][][]float32
+				var sparseVectors map[string]models.SparseVector
 
 				deleted := x.Deleted && lastDeletionTimes[j] == x.T
 
@@ -498,6 +515,12 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 							multiVectors[targetVector] = v
 						}
 					}
+					if result[j].SparseVectors != nil {
+						sparseVectors = make(map[string]models.SparseVector, len(result[j].SparseVectors))
+						for targetVector, v := range result[j].SparseVectors {
+							sparseVectors[targetVector] = v
+						}
+					}
 				}
 
 				obj := objects.VObject{
@@ -508,6 +531,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 					Vector:                  vector,
 					Vectors:                 vectors,
 					MultiVectors:            multiVectors,
+					SparseVectors:           sparseVectors,
 					StaleUpdateTime:         cTime,
 				}
 				query = append(query, &obj)
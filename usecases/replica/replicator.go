@@ -62,6 +62,8 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	// hints is set if hinted handoff is enabled
+	hints *hints
 	*Finder
 }
 
@@ -95,6 +97,11 @@ func (r *Replicator) AllHostnames() []string {
 	return r.router.AllHostnames()
 }
 
+// NodeHostname returns the address of the node, false if it isn't alive
+func (r *Replicator) NodeHostname(nodeName string) (string, bool) {
+	return r.router.NodeHostname(nodeName)
+}
+
 func (r *Replicator) PutObject(ctx context.Context,
 	shard string,
 	obj *storobj.Object,
@@ -102,6 +109,9 @@ func (r *Replicator) PutObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	if r.hints != nil {
+		coord.hintIDs = []strfmt.UUID{obj.ID()}
+	}
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj, schemaVersion)
 		if err == nil {
@@ -120,6 +130,7 @@ func (r *Replicator) PutObject(ctx context.Context,
 
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
+	coord.recordSession(ctx)
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
@@ -134,6 +145,9 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	if r.hints != nil {
+		coord.hintIDs = []strfmt.UUID{doc.ID}
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
@@ -151,6 +165,7 @@ func (r *Replicator) MergeObject(ctx context.Context,
 		return fmt.Errorf("%s %q: %w", MsgCLevel, l, ErrReplicas)
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
+	coord.recordSession(ctx)
 	if err != nil {
 		r.log.WithField("op", "merge").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", doc.ID).Error(err)
@@ -170,6 +185,9 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	if r.hints != nil {
+		coord.hintIDs = []strfmt.UUID{id}
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, deletionTime, schemaVersion)
 		if err == nil {
@@ -187,6 +205,7 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 		return fmt.Errorf("%s %q: %w", MsgCLevel, l, ErrReplicas)
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
+	coord.recordSession(ctx)
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", id).Error(err)
@@ -201,6 +220,14 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	schemaVersion uint64,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjects), r.log)
+	if r.hints != nil {
+		coord.hintIDs = make([]strfmt.UUID, 0, len(objs))
+		for _, obj := range objs {
+			if obj != nil {
+				coord.hintIDs = append(coord.hintIDs, obj.ID())
+			}
+		}
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs, schemaVersion)
 		if err == nil {
@@ -224,6 +251,7 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		return errs
 	}
 	errs := r.stream.readErrors(len(objs), level, replyCh)
+	coord.recordSession(ctx)
 	if err := firstError(errs); err != nil {
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
@@ -240,6 +268,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 	schemaVersion uint64,
 ) []objects.BatchSimpleObject {
 	coord := newCoordinator[DeleteBatchResponse](r, shard, r.requestID(opDeleteObjects), r.log)
+	if r.hints != nil && !dryRun {
+		coord.hintIDs = uuids
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObjects(ctx, host, r.class, shard, requestID, uuids, deletionTime, dryRun, schemaVersion)
 		if err == nil {
@@ -274,6 +305,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		return errs
 	}
 	rs := r.stream.readDeletions(len(uuids), level, replyCh)
+	if !dryRun {
+		coord.recordSession(ctx)
+	}
 	if err := firstBatchError(rs); err != nil {
 		r.log.WithField("op", "put.deletes").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
@@ -288,6 +322,14 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	schemaVersion uint64,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opAddReferences), r.log)
+	if r.hints != nil {
+		coord.hintIDs = make([]strfmt.UUID, 0, len(refs))
+		for _, ref := range refs {
+			if ref.From != nil {
+				coord.hintIDs = append(coord.hintIDs, ref.From.TargetID)
+			}
+		}
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.AddReferences(ctx, host, r.class, shard, requestID, refs, schemaVersion)
 		if err == nil {
@@ -310,6 +352,7 @@ func (r *Replicator) AddReferences(ctx context.Context,
 		return errs
 	}
 	errs := r.stream.readErrors(len(refs), level, replyCh)
+	coord.recordSession(ctx)
 	if err := firstError(errs); err != nil {
 		r.log.WithField("op", "put.refs").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
@@ -15,6 +15,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -29,11 +31,13 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
 	clusterRouter "github.com/weaviate/weaviate/cluster/router"
 	"github.com/weaviate/weaviate/cluster/router/types"
 	schemaTypes "github.com/weaviate/weaviate/cluster/schema/types"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/storobj"
 	clusterMocks "github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -710,6 +714,150 @@ func TestReplicatorAddReferences(t *testing.T) {
 	})
 }
 
+func TestReplicatorHintedHandoff(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		id    = strfmt.UUID("c0ffee00-0000-0000-0000-000000000001")
+		obj   = objectEx(id, 3, shard, "A")
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := newFakeFactory(t, cls, shard, nodes)
+	rep := f.newReplicator()
+	err := rep.StartHintedHandoff(ctx, t.TempDir(), replication.HintedHandoff{
+		Enabled:         true,
+		MaxAge:          time.Hour,
+		ReplayFrequency: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	resp := replica.SimpleResponse{}
+	// B is down while the object is written
+	for _, n := range []string{"A", "C"} {
+		f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		f.WClient.On("Commit", mock.Anything, n, cls, shard, anyVal, anyVal).Return(nil)
+	}
+	f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny)
+	f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return([]types.RepairResponse{}, errAny).Once()
+
+	require.NoError(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelQuorum, 123))
+
+	// once B is back, the object is copied from another replica
+	f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).
+		Return([]types.RepairResponse{{ID: id.String()}}, nil)
+	f.RClient.On("FetchObjects", anyVal, anyVal, cls, shard, []strfmt.UUID{id}).
+		Return([]replica.Replica{{ID: id, Object: obj}}, nil)
+
+	overwritten := make(chan []*objects.VObject, 1)
+	f.RClient.On("OverwriteObjects", anyVal, "B", cls, shard, anyVal).
+		Return([]types.RepairResponse{}, nil).Once().
+		Run(func(args mock.Arguments) { overwritten <- args.Get(4).([]*objects.VObject) })
+
+	select {
+	case xs := <-overwritten:
+		require.Len(t, xs, 1)
+		assert.Equal(t, id, xs[0].ID)
+		assert.Equal(t, int64(3), xs[0].LastUpdateTimeUnixMilli)
+		assert.Equal(t, int64(0), xs[0].StaleUpdateTime)
+		assert.Equal(t, obj.Object, *xs[0].LatestObject)
+	case <-time.After(5 * time.Second):
+		t.Fatal("hint was not replayed")
+	}
+
+	// replayed hints are removed
+	time.Sleep(100 * time.Millisecond)
+	f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+}
+
+func TestReplicatorHintedHandoffNewestReplica(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		id    = strfmt.UUID("c0ffee00-0000-0000-0000-000000000001")
+		obj   = objectEx(id, 3, shard, "A")
+		newer = objectEx(id, 5, shard, "C")
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := newFakeFactory(t, cls, shard, nodes)
+	rep := f.newReplicator()
+	err := rep.StartHintedHandoff(ctx, t.TempDir(), replication.HintedHandoff{
+		Enabled:         true,
+		MaxAge:          time.Hour,
+		ReplayFrequency: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	resp := replica.SimpleResponse{}
+	for _, n := range []string{"A", "C"} {
+		f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		f.WClient.On("Commit", mock.Anything, n, cls, shard, anyVal, anyVal).Return(nil)
+	}
+	f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny)
+	f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return([]types.RepairResponse{}, errAny).Once()
+
+	require.NoError(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelQuorum, 123))
+
+	// C was updated meanwhile, A still has the version B missed
+	f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).
+		Return([]types.RepairResponse{{ID: id.String()}}, nil)
+	f.RClient.On("FetchObjects", anyVal, "A", cls, shard, []strfmt.UUID{id}).
+		Return([]replica.Replica{{ID: id, Object: obj}}, nil)
+	f.RClient.On("FetchObjects", anyVal, "C", cls, shard, []strfmt.UUID{id}).
+		Return([]replica.Replica{{ID: id, Object: newer}}, nil)
+
+	overwritten := make(chan []*objects.VObject, 1)
+	f.RClient.On("OverwriteObjects", anyVal, "B", cls, shard, anyVal).
+		Return([]types.RepairResponse{}, nil).Once().
+		Run(func(args mock.Arguments) { overwritten <- args.Get(4).([]*objects.VObject) })
+
+	select {
+	case xs := <-overwritten:
+		require.Len(t, xs, 1)
+		assert.Equal(t, int64(5), xs[0].LastUpdateTimeUnixMilli)
+		assert.Equal(t, newer.Object, *xs[0].LatestObject)
+	case <-time.After(5 * time.Second):
+		t.Fatal("hint was not replayed")
+	}
+}
+
+func TestReplicatorHintedHandoffPrune(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// D left the cluster, E is only down
+	dir := t.TempDir()
+	line := []byte(`{"shard":"SH1","ids":["c0ffee00-0000-0000-0000-000000000001"],"time":1}` + "\n")
+	expired := filepath.Join(dir, "D.log")
+	recent := filepath.Join(dir, "E.log")
+	require.NoError(t, os.WriteFile(expired, line, 0o666))
+	require.NoError(t, os.WriteFile(recent, line, 0o666))
+	twoHoursAgo := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(expired, twoHoursAgo, twoHoursAgo))
+
+	f := newFakeFactory(t, "C1", "SH1", []string{"A", "B", "C"})
+	rep := f.newReplicator()
+	err := rep.StartHintedHandoff(ctx, dir, replication.HintedHandoff{
+		Enabled:         true,
+		MaxAge:          time.Hour,
+		ReplayFrequency: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(expired)
+		return errors.Is(err, os.ErrNotExist)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.FileExists(t, recent)
+}
+
 type fakeFactory struct {
 	t              *testing.T
 	CLS            string
@@ -778,6 +926,7 @@ func (f *fakeFactory) newRouter(thisNode string) clusterRouter.Router {
 		}
 		return v, nil
 	}).Maybe()
+	schemaReaderMock.On("ShardReadOnlyReplicas", mock.Anything, mock.Anything).Return([]string{}, nil).Maybe()
 	replicationFsmMock := replicationTypes.NewMockReplicationFSMReader(f.t)
 	replicationFsmMock.On("FilterOneShardReplicasRead", mock.Anything, mock.Anything, mock.Anything).Return(func(collection string, shard string, shardReplicasLocation []string) []string {
 		return shardReplicasLocation
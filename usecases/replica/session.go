@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// SessionHeader carries the session token in requests and responses
+	SessionHeader = "X-Weaviate-Session"
+
+	// maxSessionShards bounds the size of a session token, the shards written
+	// to longest ago are dropped first
+	maxSessionShards = 64
+
+	// sessionShardTTL is how long a write is tracked by a session. Replicas
+	// which missed it are expected to have caught up through async
+	// replication or read repair by then.
+	sessionShardTTL = time.Hour
+
+	// sessionReadTimeout bounds how long a read at the SESSION consistency
+	// level waits for a replica which applied the writes of the session
+	sessionReadTimeout = 5 * time.Second
+)
+
+type sessionCtxKey struct{}
+
+// Session tracks the writes of a client so that subsequent reads at the
+// SESSION consistency level are served by a replica which applied them.
+//
+// For every shard written to, the session holds the version of the latest
+// write, which is its coordinator time in milliseconds, and the replicas
+// which acknowledged all writes of the session to that shard. If no replica
+// acknowledged all of them, no single replica is known to have applied the
+// writes and reads of the shard are upgraded to the ALL consistency level.
+type Session struct {
+	mu      sync.Mutex
+	shards  map[string]SessionShard
+	changed bool
+}
+
+// SessionShard is the state of a shard in a session. Nodes is empty if no
+// replica acknowledged all writes of the session.
+type SessionShard struct {
+	Version int64    `json:"v"`
+	Nodes   []string `json:"n,omitempty"`
+}
+
+func NewSession() *Session {
+	return &Session{shards: map[string]SessionShard{}}
+}
+
+// ParseSessionToken decodes a token returned by Session.Token. Shards
+// written to longer than sessionShardTTL ago are dropped.
+func ParseSessionToken(token string) (*Session, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode session token: %w", err)
+	}
+	shards := map[string]SessionShard{}
+	if err := json.Unmarshal(data, &shards); err != nil {
+		return nil, fmt.Errorf("unmarshal session token: %w", err)
+	}
+
+	expired := time.Now().Add(-sessionShardTTL).UnixMilli()
+	s := NewSession()
+	for key, shard := range shards {
+		if shard.Version >= expired {
+			s.shards[key] = shard
+		}
+	}
+	return s, nil
+}
+
+// Token encodes the session. It returns an empty string if the session
+// doesn't track any shard.
+func (s *Session) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.shards) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(s.shards)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Changed returns whether writes were recorded since the session was created
+func (s *Session) Changed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// RecordWrite records that nodes applied a write to a shard of class
+func (s *Session) RecordWrite(class, shard string, version int64, nodes []string) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := sessionKey(class, shard)
+	next := SessionShard{Version: version, Nodes: slices.Clone(nodes)}
+	if prev, ok := s.shards[key]; ok {
+		next.Version = max(prev.Version, version)
+		// a replica which missed an earlier write can't serve the session,
+		// even if it applied the latest one
+		var common []string
+		for _, node := range nodes {
+			if slices.Contains(prev.Nodes, node) {
+				common = append(common, node)
+			}
+		}
+		next.Nodes = common
+	}
+	slices.Sort(next.Nodes)
+	s.shards[key] = next
+	s.changed = true
+
+	for len(s.shards) > maxSessionShards {
+		oldest := ""
+		for k, v := range s.shards {
+			if oldest == "" || v.Version < s.shards[oldest].Version {
+				oldest = k
+			}
+		}
+		delete(s.shards, oldest)
+	}
+}
+
+// Shard returns the state of a shard of class, ok is false if the session
+// didn't write to it
+func (s *Session) Shard(class, shard string) (SessionShard, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	x, ok := s.shards[sessionKey(class, shard)]
+	return x, ok
+}
+
+// ContextWithSession returns a copy of ctx which carries session
+func ContextWithSession(ctx context.Context, session *Session) context.Context {
+	return context.WithValue(ctx, sessionCtxKey{}, session)
+}
+
+// SessionFromContext returns the session carried by ctx or nil
+func SessionFromContext(ctx context.Context) *Session {
+	session, _ := ctx.Value(sessionCtxKey{}).(*Session)
+	return session
+}
+
+func sessionKey(class, shard string) string {
+	return class + "/" + shard
+}
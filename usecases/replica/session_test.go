@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestSession(t *testing.T) {
+	now := time.Now().UnixMilli()
+
+	t.Run("empty session has no token", func(t *testing.T) {
+		s := replica.NewSession()
+		assert.Empty(t, s.Token())
+		assert.False(t, s.Changed())
+	})
+
+	t.Run("token round trip", func(t *testing.T) {
+		s := replica.NewSession()
+		s.RecordWrite("C", "s1", now, []string{"B", "A"})
+		assert.True(t, s.Changed())
+
+		parsed, err := replica.ParseSessionToken(s.Token())
+		require.NoError(t, err)
+		shard, ok := parsed.Shard("C", "s1")
+		require.True(t, ok)
+		assert.Equal(t, replica.SessionShard{Version: now, Nodes: []string{"A", "B"}}, shard)
+		assert.False(t, parsed.Changed())
+	})
+
+	t.Run("keeps replicas which applied all writes", func(t *testing.T) {
+		s := replica.NewSession()
+		s.RecordWrite("C", "s1", now, []string{"A", "B"})
+		s.RecordWrite("C", "s1", now+1, []string{"B", "C"})
+		shard, _ := s.Shard("C", "s1")
+		assert.Equal(t, replica.SessionShard{Version: now + 1, Nodes: []string{"B"}}, shard)
+
+		// B missed the latest write, C the first one
+		s.RecordWrite("C", "s1", now+2, []string{"C"})
+		shard, ok := s.Shard("C", "s1")
+		require.True(t, ok)
+		assert.Equal(t, now+2, shard.Version)
+		assert.Empty(t, shard.Nodes)
+
+		// the shard is still tracked after a round trip
+		parsed, err := replica.ParseSessionToken(s.Token())
+		require.NoError(t, err)
+		shard, ok = parsed.Shard("C", "s1")
+		require.True(t, ok)
+		assert.Empty(t, shard.Nodes)
+	})
+
+	t.Run("evicts oldest shards", func(t *testing.T) {
+		s := replica.NewSession()
+		for i := 0; i <= 64; i++ {
+			s.RecordWrite("C", fmt.Sprintf("s%d", i), now+int64(i), []string{"A"})
+		}
+		_, ok := s.Shard("C", "s0")
+		assert.False(t, ok)
+		_, ok = s.Shard("C", "s64")
+		assert.True(t, ok)
+	})
+
+	t.Run("drops expired shards", func(t *testing.T) {
+		s := replica.NewSession()
+		s.RecordWrite("C", "old", time.Now().Add(-2*time.Hour).UnixMilli(), []string{"A"})
+		s.RecordWrite("C", "new", now, []string{"A"})
+
+		parsed, err := replica.ParseSessionToken(s.Token())
+		require.NoError(t, err)
+		_, ok := parsed.Shard("C", "old")
+		assert.False(t, ok)
+		_, ok = parsed.Shard("C", "new")
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := replica.ParseSessionToken("not a token!")
+		assert.Error(t, err)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		assert.Nil(t, replica.SessionFromContext(context.Background()))
+		s := replica.NewSession()
+		assert.Same(t, s, replica.SessionFromContext(replica.ContextWithSession(context.Background(), s)))
+	})
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 // A component-test like test suite that makes sure that every available UC is
@@ -118,6 +120,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.DELETE,
 			expectedResources: authorization.ShardsMetadata("className", "P1"),
 		},
+		{
+			methodName:        "AddRangeShards",
+			additionalArgs:    []interface{}{"className", []int64{0}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("className"),
+		},
 		{
 			methodName:        "ConsistentTenantExists",
 			additionalArgs:    []interface{}{"className", false, "P1"},
@@ -202,6 +210,10 @@ func Test_Schema_Authorization(t *testing.T) {
 				handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
 				fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
 				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(models.Class{})
+				fakeSchemaManager.On("Read", mock.Anything, mock.Anything).Return(&models.Class{}, &sharding.State{
+					Config:   shardingConfig.Config{Strategy: shardingConfig.StrategyRange, RangeWidth: 10},
+					Physical: map[string]sharding.Physical{},
+				})
 
 				var args []interface{}
 				if test.methodName == "GetSchema" || test.methodName == "GetConsistentSchema" {
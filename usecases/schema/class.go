@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -212,10 +213,33 @@ func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m
 		return fmt.Errorf("error while migrating replication factor: %w", err)
 	}
 	shardingState.ApplyNodeMapping(m)
-	_, err = h.schemaManager.RestoreClass(ctx, class, &shardingState)
+	if err := h.validateTenantKeys(&shardingState, d.TenantKeys); err != nil {
+		return err
+	}
+	_, err = h.schemaManager.RestoreClass(ctx, class, &shardingState, d.TenantKeys)
 	return err
 }
 
+// validateTenantKeys makes sure the data key of every restored tenant which
+// has its own one is either part of the backup or still exists. Backups
+// taken before the keys were added to them rely on the latter.
+func (h *Handler) validateTenantKeys(ss *sharding.State, keys map[string][]byte) error {
+	for name, physical := range ss.Physical {
+		id := physical.EncryptionKeyID
+		if id == "" {
+			continue
+		}
+		if _, ok := keys[id]; ok {
+			continue
+		}
+		if _, ok := h.schemaReader.TenantKey(id); ok {
+			continue
+		}
+		return fmt.Errorf("restore tenant %s: %w", name, ErrTenantKeyDeleted)
+	}
+	return nil
+}
+
 // DeleteClass from the schema
 func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
 	err := h.Authorizer.Authorize(ctx, principal, authorization.DELETE, authorization.CollectionsMetadata(class)...)
@@ -786,6 +810,9 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(class.VectorIndexType); err != nil {
 			return err
 		}
+		if class.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			return errors.New("class.VectorIndexType sparse is only configurable using named vectors")
+		}
 
 		if err := h.validateVectorizer(class.Vectorizer); err != nil {
 			return err
@@ -799,6 +826,9 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 			if parsed.IsMultiVector() {
 				return errors.New("class.VectorIndexConfig multi vector type index type is only configurable using named vectors")
 			}
+			if dataType := vectorindex.DataType(parsed); dataType != vectorindexcommon.DefaultVectorDataType {
+				return fmt.Errorf("class.VectorIndexConfig dataType %s is only configurable using named vectors", dataType)
+			}
 		}
 	}
 
@@ -815,6 +845,22 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(cfg.VectorIndexType); err != nil {
 			return fmt.Errorf("target vector %q: %w", name, err)
 		}
+		if cfg.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			if vm, ok := cfg.Vectorizer.(map[string]interface{}); ok {
+				if _, ok := vm[config.VectorizerModuleNone]; !ok || len(vm) != 1 {
+					return fmt.Errorf("target vector %q: sparse vectors must be provided by the user, "+
+						"vectorizer needs to be %q", name, config.VectorizerModuleNone)
+				}
+			}
+		}
+		if dataType := vectorindex.DataType(cfg.VectorIndexConfig); dataType != vectorindexcommon.DefaultVectorDataType {
+			if vm, ok := cfg.Vectorizer.(map[string]interface{}); ok {
+				if _, ok := vm[config.VectorizerModuleNone]; !ok || len(vm) != 1 {
+					return fmt.Errorf("target vector %q: %s vectors must be provided by the user, "+
+						"vectorizer needs to be %q", name, dataType, config.VectorizerModuleNone)
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -833,7 +879,7 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeSPARSE:
 		return nil
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		if !h.asyncIndexingEnabled {
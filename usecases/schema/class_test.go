@@ -2012,7 +2012,7 @@ func TestRestoreClass_WithCircularRefs(t *testing.T) {
 		require.Nil(t, err)
 
 		descriptor := backup.ClassDescriptor{Name: classRaw.Class, Schema: schemaBytes, ShardingState: shardingBytes}
-		fakeSchemaManager.On("RestoreClass", mock.Anything, mock.Anything).Return(nil)
+		fakeSchemaManager.On("RestoreClass", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		err = handler.RestoreClass(context.Background(), &descriptor, map[string]string{})
 		assert.Nil(t, err, "class passes validation")
 		fakeSchemaManager.AssertExpectations(t)
@@ -2045,12 +2045,47 @@ func TestRestoreClass_WithNodeMapping(t *testing.T) {
 		expectedShardingState := shardingState
 		expectedShardingState.ApplyNodeMapping(map[string]string{"node1": "new-node1"})
 		expectedShardingState.SetLocalName("")
-		fakeSchemaManager.On("RestoreClass", mock.Anything, shardingState).Return(nil)
+		fakeSchemaManager.On("RestoreClass", mock.Anything, shardingState, mock.Anything).Return(nil)
 		err = handler.RestoreClass(context.Background(), &descriptor, map[string]string{"node1": "new-node1"})
 		assert.NoError(t, err)
 	}
 }
 
+func TestRestoreClass_TenantKeys(t *testing.T) {
+	class := &models.Class{
+		Class:              "Class_A",
+		Vectorizer:         "none",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}
+	schemaBytes, err := json.Marshal(class)
+	require.Nil(t, err)
+	shardingBytes, err := (&sharding.State{
+		PartitioningEnabled: true,
+		Physical: map[string]sharding.Physical{
+			"tenant1": {Name: "tenant1", Status: models.TenantActivityStatusHOT, BelongsToNodes: []string{"node1"}, EncryptionKeyID: "id1"},
+		},
+	}).JSON()
+	require.Nil(t, err)
+
+	t.Run("missing key", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		descriptor := backup.ClassDescriptor{Name: class.Class, Schema: schemaBytes, ShardingState: shardingBytes}
+		err := handler.RestoreClass(context.Background(), &descriptor, map[string]string{})
+		assert.ErrorIs(t, err, ErrTenantKeyDeleted)
+		fakeSchemaManager.AssertNotCalled(t, "RestoreClass", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("key of the backup", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		keys := map[string][]byte{"id1": []byte("key1")}
+		descriptor := backup.ClassDescriptor{Name: class.Class, Schema: schemaBytes, ShardingState: shardingBytes, TenantKeys: keys}
+		fakeSchemaManager.On("RestoreClass", mock.Anything, mock.Anything, keys).Return(nil)
+		err := handler.RestoreClass(context.Background(), &descriptor, map[string]string{})
+		assert.NoError(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
+
 func Test_DeleteClass(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
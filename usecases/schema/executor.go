@@ -124,6 +124,13 @@ func (e *executor) DeleteReplicaFromShard(class string, shard string, targetNode
 	return e.migrator.DropShard(ctx, class, shard)
 }
 
+// SplitShard loads the shard created by splitting shard, if it is placed on
+// this node, and drops the objects which now belong to the other one
+func (e *executor) SplitShard(class string, shard string, targetShard string) error {
+	ctx := context.Background()
+	return e.migrator.SplitShard(ctx, class, shard, targetShard)
+}
+
 func (e *executor) LoadShard(class string, shard string) {
 	ctx := context.Background()
 	if err := e.migrator.LoadShard(ctx, class, shard); err != nil {
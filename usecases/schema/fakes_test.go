@@ -36,8 +36,8 @@ func (f *fakeSchemaManager) AddClass(_ context.Context, cls *models.Class, ss *s
 	return 0, args.Error(0)
 }
 
-func (f *fakeSchemaManager) RestoreClass(_ context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
-	args := f.Called(cls, ss)
+func (f *fakeSchemaManager) RestoreClass(_ context.Context, cls *models.Class, ss *sharding.State, tenantKeys map[string][]byte) (uint64, error) {
+	args := f.Called(cls, ss, tenantKeys)
 	return 0, args.Error(0)
 }
 
@@ -66,6 +66,11 @@ func (f *fakeSchemaManager) AddTenants(_ context.Context, class string, req *com
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) AddRangeShards(_ context.Context, class string, req *command.AddRangeShardsRequest) (uint64, error) {
+	args := f.Called(class, req)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) UpdateTenants(_ context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error) {
 	args := f.Called(class, req)
 	return 0, args.Error(0)
@@ -215,6 +220,10 @@ func (f *fakeSchemaManager) Aliases() map[string]string {
 	return nil
 }
 
+func (f *fakeSchemaManager) TenantKey(id string) ([]byte, bool) {
+	return nil, false
+}
+
 func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
 	args := f.Called(class)
 	return args.Get(0).(*sharding.State)
@@ -230,6 +239,11 @@ func (f *fakeSchemaManager) ShardReplicas(class, shard string) ([]string, error)
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (f *fakeSchemaManager) ShardReadOnlyReplicas(class, shard string) ([]string, error) {
+	args := f.Called(class, shard)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (f *fakeSchemaManager) ShardReplicasWithVersion(ctx context.Context, class, shard string, version uint64) ([]string, error) {
 	args := f.Called(ctx, class, shard, version)
 	return args.Get(0).([]string), args.Error(1)
@@ -262,6 +276,9 @@ func (f *fakeSchemaManager) TenantsShardsWithVersion(ctx context.Context, versio
 
 func (f *fakeSchemaManager) Read(class string, reader func(*models.Class, *sharding.State) error) error {
 	args := f.Called(class, reader)
+	if len(args) > 1 {
+		return reader(args.Get(0).(*models.Class), args.Get(1).(*sharding.State))
+	}
 	return args.Error(0)
 }
 
@@ -30,12 +30,16 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
 	ErrNotFound           = errors.New("not found")
 	ErrUnexpectedMultiple = errors.New("unexpected multiple results")
+	// ErrTenantKeyDeleted means that the data of a tenant can't be decrypted
+	// anymore, because the tenant's data key was deleted
+	ErrTenantKeyDeleted = errors.New("encryption key of tenant was deleted")
 )
 
 // SchemaManager is responsible for consistent schema operations.
@@ -46,7 +50,7 @@ var (
 type SchemaManager interface {
 	// Schema writes operation.
 	AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
-	RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
+	RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State, tenantKeys map[string][]byte) (uint64, error)
 	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	DeleteClass(ctx context.Context, name string) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
@@ -54,6 +58,7 @@ type SchemaManager interface {
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error)
+	AddRangeShards(ctx context.Context, class string, req *command.AddRangeShardsRequest) (uint64, error)
 
 	// Cluster related operations
 	Join(_ context.Context, nodeID, raftAddr string, voter bool) error
@@ -97,10 +102,12 @@ type SchemaReader interface {
 	Aliases() map[string]string
 	CopyShardingState(class string) *sharding.State
 	ShardReplicas(class, shard string) ([]string, error)
+	ShardReadOnlyReplicas(class, shard string) ([]string, error)
 	ShardFromUUID(class string, uuid []byte) string
 	ShardOwner(class, shard string) (string, error)
 	Read(class string, reader func(*models.Class, *sharding.State) error) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	TenantKey(id string) ([]byte, bool)
 
 	// These schema reads function (...WithVersion) return the metadata once the local schema has caught up to the
 	// version parameter. If version is 0 is behaves exactly the same as eventual consistent reads.
@@ -146,6 +153,9 @@ type Handler struct {
 	scaleOut                scaleOut
 	parser                  Parser
 	classGetter             *ClassGetter
+	// encryptionMasterKey wraps the data keys of new tenants, nil if
+	// encryption at rest is disabled
+	encryptionMasterKey *encryption.MasterKey
 
 	asyncIndexingEnabled bool
 }
@@ -185,6 +195,15 @@ func NewHandler(
 		asyncIndexingEnabled: entcfg.Enabled(os.Getenv("ASYNC_INDEXING")),
 	}
 
+	if persistence := config.Persistence; persistence.EncryptionAtRestEnabled {
+		masterKey, err := encryption.LoadMasterKey(persistence.EncryptionAtRestMasterKey,
+			persistence.EncryptionAtRestMasterKeyFile)
+		if err != nil {
+			return Handler{}, fmt.Errorf("load encryption at rest master key: %w", err)
+		}
+		handler.encryptionMasterKey = masterKey
+	}
+
 	handler.scaleOut.SetSchemaReader(schemaReader)
 
 	return handler, nil
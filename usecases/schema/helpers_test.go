@@ -107,6 +107,10 @@ func (f *fakeDB) DeleteReplicaFromShard(class string, shard string, targetNode s
 	return nil
 }
 
+func (f *fakeDB) SplitShard(class string, shard string, targetShard string) error {
+	return nil
+}
+
 func (f *fakeDB) LoadShard(class string, shard string) {
 }
 
@@ -325,6 +329,11 @@ func (f *fakeMigrator) DropShard(ctx context.Context, class string, shard string
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) SplitShard(ctx context.Context, class, shard, targetShard string) error {
+	args := f.Called(ctx, class, shard, targetShard)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) ShutdownShard(ctx context.Context, class string, shard string) error {
 	args := f.Called(ctx, class, shard)
 	return args.Error(0)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -70,6 +71,8 @@ type SchemaGetter interface {
 	OptimisticTenantStatus(ctx context.Context, class string, tenants string) (map[string]string, error)
 	ShardFromUUID(class string, uuid []byte) string
 	ShardReplicas(class, shard string) ([]string, error)
+	TenantQuotas(class, tenant string) *models.TenantQuotas
+	TenantEncryptionKey(class, tenant string) ([]byte, error)
 }
 
 type VectorizerValidator interface {
@@ -401,6 +404,50 @@ func (m *Manager) OptimisticTenantStatus(ctx context.Context, class string, tena
 	}, nil
 }
 
+// TenantQuotas returns the quotas of a tenant as found in the local state. It
+// returns nil if the tenant doesn't exist or has no quotas.
+func (m *Manager) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	var quotas *models.TenantQuotas
+	_ = m.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		quotas = ss.Physical[tenant].QuotasCopy()
+		return nil
+	})
+	return quotas
+}
+
+// TenantEncryptionKey returns the wrapped data key of a tenant as found in the
+// local state. It returns nil if the tenant doesn't have its own data key,
+// i.e. it was added before encryption at rest was enabled, and
+// ErrTenantKeyDeleted if the key doesn't exist anymore. A tenant which isn't
+// part of the local state is an error, its key can't be told apart from a
+// missing one.
+func (m *Manager) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	var (
+		id    string
+		found bool
+	)
+	if err := m.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		var physical sharding.Physical
+		physical, found = ss.Physical[tenant]
+		id = physical.EncryptionKeyID
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("read encryption key of tenant %s: %w", tenant, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("read encryption key: %w: %s", enterrors.ErrTenantNotFound, tenant)
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	key, ok := m.schemaReader.TenantKey(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTenantKeyDeleted, tenant)
+	}
+	return key, nil
+}
+
 func (m *Manager) activateTenantIfInactive(ctx context.Context, class string,
 	status map[string]string,
 ) (map[string]string, error) {
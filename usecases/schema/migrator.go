@@ -40,6 +40,7 @@ type Migrator interface {
 	GetShardsQueueSize(ctx context.Context, className, tenant string) (map[string]int64, error)
 	LoadShard(ctx context.Context, class, shard string) error
 	DropShard(ctx context.Context, class, shard string) error
+	SplitShard(ctx context.Context, class, shard, targetShard string) error
 	ShutdownShard(ctx context.Context, class, shard string) error
 
 	AddProperty(ctx context.Context, className string,
@@ -644,6 +644,114 @@ func (_c *MockSchemaGetter_Statistics_Call) RunAndReturn(run func() map[string]i
 	return _c
 }
 
+// TenantEncryptionKey provides a mock function with given fields: class, tenant
+func (_m *MockSchemaGetter) TenantEncryptionKey(class string, tenant string) ([]byte, error) {
+	ret := _m.Called(class, tenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantEncryptionKey")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]byte, error)); ok {
+		return rf(class, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []byte); ok {
+		r0 = rf(class, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(class, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchemaGetter_TenantEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantEncryptionKey'
+type MockSchemaGetter_TenantEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// TenantEncryptionKey is a helper method to define mock.On call
+//   - class string
+//   - tenant string
+func (_e *MockSchemaGetter_Expecter) TenantEncryptionKey(class interface{}, tenant interface{}) *MockSchemaGetter_TenantEncryptionKey_Call {
+	return &MockSchemaGetter_TenantEncryptionKey_Call{Call: _e.mock.On("TenantEncryptionKey", class, tenant)}
+}
+
+func (_c *MockSchemaGetter_TenantEncryptionKey_Call) Run(run func(class string, tenant string)) *MockSchemaGetter_TenantEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSchemaGetter_TenantEncryptionKey_Call) Return(_a0 []byte, _a1 error) *MockSchemaGetter_TenantEncryptionKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchemaGetter_TenantEncryptionKey_Call) RunAndReturn(run func(string, string) ([]byte, error)) *MockSchemaGetter_TenantEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantQuotas provides a mock function with given fields: class, tenant
+func (_m *MockSchemaGetter) TenantQuotas(class string, tenant string) *models.TenantQuotas {
+	ret := _m.Called(class, tenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantQuotas")
+	}
+
+	var r0 *models.TenantQuotas
+	if rf, ok := ret.Get(0).(func(string, string) *models.TenantQuotas); ok {
+		r0 = rf(class, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TenantQuotas)
+		}
+	}
+
+	return r0
+}
+
+// MockSchemaGetter_TenantQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantQuotas'
+type MockSchemaGetter_TenantQuotas_Call struct {
+	*mock.Call
+}
+
+// TenantQuotas is a helper method to define mock.On call
+//   - class string
+//   - tenant string
+func (_e *MockSchemaGetter_Expecter) TenantQuotas(class interface{}, tenant interface{}) *MockSchemaGetter_TenantQuotas_Call {
+	return &MockSchemaGetter_TenantQuotas_Call{Call: _e.mock.On("TenantQuotas", class, tenant)}
+}
+
+func (_c *MockSchemaGetter_TenantQuotas_Call) Run(run func(class string, tenant string)) *MockSchemaGetter_TenantQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSchemaGetter_TenantQuotas_Call) Return(_a0 *models.TenantQuotas) *MockSchemaGetter_TenantQuotas_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSchemaGetter_TenantQuotas_Call) RunAndReturn(run func(string, string) *models.TenantQuotas) *MockSchemaGetter_TenantQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TenantsShards provides a mock function with given fields: ctx, class, tenants
 func (_m *MockSchemaGetter) TenantsShards(ctx context.Context, class string, tenants ...string) (map[string]string, error) {
 	_va := make([]interface{}, len(tenants))
@@ -177,12 +177,53 @@ func (p *Parser) parseShardingConfig(class *models.Class) (err error) {
 		if err != nil {
 			return err
 		}
-
+		if cfg.ShardedByProperty() {
+			cfg.Key = schema.LowercaseFirstLetter(cfg.Key)
+			if err := validateShardingKey(class, cfg); err != nil {
+				return err
+			}
+		}
 	}
 	class.ShardingConfig = cfg
 	return nil
 }
 
+// validateShardingKey makes sure objects can be routed by the given property:
+// every object has to resolve to exactly one value, so only scalar text, uuid
+// and int properties qualify for hashing. Ranges can only be formed over int
+// and date properties.
+func validateShardingKey(class *models.Class, cfg shardingConfig.Config) error {
+	key := cfg.Key
+	var prop *models.Property
+	for _, p := range class.Properties {
+		if p.Name == key {
+			prop = p
+			break
+		}
+	}
+	if prop == nil {
+		return fmt.Errorf("sharding key %q is not a property of class %q", key, class.Class)
+	}
+	if len(prop.DataType) != 1 {
+		return fmt.Errorf("sharding key %q must be of a single primitive data type", key)
+	}
+	dt := schema.DataType(prop.DataType[0])
+	if cfg.ShardedByRange() {
+		switch dt {
+		case schema.DataTypeInt, schema.DataTypeDate:
+			return nil
+		default:
+			return fmt.Errorf("range sharding key %q must be of data type int or date, got %q", key, dt)
+		}
+	}
+	switch dt {
+	case schema.DataTypeText, schema.DataTypeUUID, schema.DataTypeInt:
+		return nil
+	default:
+		return fmt.Errorf("sharding key %q must be of data type text, uuid or int, got %q", key, dt)
+	}
+}
+
 func (p *Parser) parseTargetVectorsIndexConfig(class *models.Class) error {
 	for targetVector, vectorConfig := range class.VectorConfig {
 		isMultiVector := false
@@ -209,7 +250,8 @@ func (p *Parser) parseTargetVectorsIndexConfig(class *models.Class) error {
 func (p *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{}, isMultiVector bool,
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT &&
+		vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC && vectorIndexType != vectorindex.VectorIndexTypeSPARSE {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -563,8 +605,18 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool) error
 	if !ok {
 		return fmt.Errorf("updated config is not well-formed")
 	}
-	if first.DesiredCount != second.DesiredCount {
-		return fmt.Errorf("re-sharding not supported yet: shard count is immutable: "+
+	if second.DesiredCount < first.DesiredCount {
+		return fmt.Errorf("shard count can only be increased: "+
+			"attempted change from \"%d\" to \"%d\"", first.DesiredCount,
+			second.DesiredCount)
+	}
+	if second.DesiredCount > first.ActualVirtualCount && first.ActualVirtualCount > 0 {
+		return fmt.Errorf("shard count cannot exceed the number of virtual shards: "+
+			"attempted change from \"%d\" to \"%d\" with %d virtual shards", first.DesiredCount,
+			second.DesiredCount, first.ActualVirtualCount)
+	}
+	if second.DesiredCount != first.DesiredCount && first.ShardedByRange() {
+		return fmt.Errorf("shard count of a collection sharded by range is managed by its range width: "+
 			"attempted change from \"%d\" to \"%d\"", first.DesiredCount,
 			second.DesiredCount)
 	}
@@ -574,5 +626,16 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool) error
 			"attempted change from \"%d\" to \"%d\"", first.VirtualPerPhysical,
 			second.VirtualPerPhysical)
 	}
+
+	if first.Key != second.Key {
+		return fmt.Errorf("sharding key is immutable: "+
+			"attempted change from %q to %q", first.Key, second.Key)
+	}
+
+	if first.Strategy != second.Strategy || first.RangeWidth != second.RangeWidth {
+		return fmt.Errorf("sharding strategy is immutable: "+
+			"attempted change from %q (range width %d) to %q (range width %d)",
+			first.Strategy, first.RangeWidth, second.Strategy, second.RangeWidth)
+	}
 	return nil
 }
@@ -180,3 +180,73 @@ func (m fakeModulesProvider) IsGenerative(name string) bool {
 func (m fakeModulesProvider) IsMultiVector(name string) bool {
 	return strings.Contains(name, "colbert")
 }
+
+func TestParseShardingKey(t *testing.T) {
+	cs := fakes.NewFakeClusterState()
+	p := NewParser(cs, dummyParseVectorConfig, fakeValidator{}, fakeModulesProvider{})
+
+	props := []*models.Property{
+		{Name: "customerId", DataType: []string{"uuid"}},
+		{Name: "region", DataType: []string{"text"}},
+		{Name: "tags", DataType: []string{"text[]"}},
+		{Name: "price", DataType: []string{"number"}},
+		{Name: "createdAt", DataType: []string{"date"}},
+	}
+
+	testCases := []struct {
+		name        string
+		key         string
+		strategy    string
+		expectedKey string
+		error       string
+	}{
+		{name: "uuid property", key: "customerId", expectedKey: "customerId"},
+		{name: "text property, capitalized", key: "Region", expectedKey: "region"},
+		{name: "unknown property", key: "tenantId", error: "not a property"},
+		{name: "array property", key: "tags", error: "must be of data type"},
+		{name: "number property", key: "price", error: "must be of data type"},
+		{name: "date property", key: "createdAt", error: "must be of data type"},
+		{name: "range over date property", key: "createdAt", strategy: "range", expectedKey: "createdAt"},
+		{name: "range over uuid property", key: "customerId", strategy: "range", error: "must be of data type int or date"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			shardingCfg := map[string]interface{}{"key": test.key}
+			if test.strategy != "" {
+				shardingCfg["strategy"] = test.strategy
+				shardingCfg["rangeWidth"] = 86400
+			}
+			class := &models.Class{
+				Class:          "Test",
+				Properties:     props,
+				ShardingConfig: shardingCfg,
+			}
+			err := p.parseShardingConfig(class)
+			if test.error != "" {
+				require.ErrorContains(t, err, test.error)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedKey, class.ShardingConfig.(config.Config).Key)
+		})
+	}
+}
+
+func TestValidateShardingConfigShardCount(t *testing.T) {
+	class := func(cfg config.Config) *models.Class {
+		return &models.Class{Class: "Test", ShardingConfig: cfg}
+	}
+	hash := config.Config{DesiredCount: 2, VirtualPerPhysical: 2, ActualVirtualCount: 4, Key: "_id", Strategy: "hash"}
+	more, fewer, tooMany := hash, hash, hash
+	more.DesiredCount, fewer.DesiredCount, tooMany.DesiredCount = 4, 1, 5
+
+	require.NoError(t, validateShardingConfig(class(hash), class(more), false))
+	require.ErrorContains(t, validateShardingConfig(class(hash), class(fewer), false), "can only be increased")
+	require.ErrorContains(t, validateShardingConfig(class(hash), class(tooMany), false), "virtual shards")
+
+	ranged := config.Config{DesiredCount: 1, Key: "createdAt", Strategy: config.StrategyRange, RangeWidth: 10}
+	rangedMore := ranged
+	rangedMore.DesiredCount = 2
+	require.ErrorContains(t, validateShardingConfig(class(ranged), class(rangedMore), false), "range width")
+}
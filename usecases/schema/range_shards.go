@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// AddRangeShards makes sure a collection which is sharded by range has a
+// shard for each of the ranges starting at starts. It returns the schema
+// version to wait for, which is 0 if all shards exist already.
+//
+// Adding shards changes the collection, so it requires the principal to be
+// allowed to update it. Ranges which fell out of the retention are skipped,
+// writes to them fail as they don't have a shard.
+func (h *Handler) AddRangeShards(ctx context.Context, principal *models.Principal, class string, starts []int64) (uint64, error) {
+	var missing []string
+	err := h.schemaReader.Read(class, func(_ *models.Class, state *sharding.State) error {
+		if !state.Config.ShardedByRange() {
+			return fmt.Errorf("collection %q is not sharded by range", class)
+		}
+		newest, found := int64(0), false
+		for _, start := range starts {
+			if !found || start > newest {
+				newest, found = start, true
+			}
+		}
+		for name := range state.Physical {
+			if start, ok := shardingConfig.ParseRangeShardName(name); ok && start > newest {
+				newest = start
+			}
+		}
+		for _, start := range starts {
+			name := shardingConfig.RangeShardName(start)
+			if shardingConfig.RangeExpired(start, newest, state.Config.RangeRetention) {
+				continue
+			}
+			if _, ok := state.Physical[name]; !ok && !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+		}
+		return nil
+	})
+	if err != nil || len(missing) == 0 {
+		return 0, err
+	}
+
+	if err := h.Authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.CollectionsMetadata(class)...); err != nil {
+		return 0, err
+	}
+
+	return h.schemaManager.AddRangeShards(ctx, class, &api.AddRangeShardsRequest{
+		ClusterNodes: h.schemaManager.StorageCandidates(),
+		Shards:       missing,
+	})
+}
@@ -25,6 +25,7 @@ import (
 	modsloads3 "github.com/weaviate/weaviate/modules/offload-s3"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -57,20 +58,38 @@ func (h *Handler) AddTenants(ctx context.Context,
 		return 0, err
 	}
 
+	if err = h.validateQuotas(validated); err != nil {
+		return 0, err
+	}
+
 	request := api.AddTenantsRequest{
 		ClusterNodes: h.schemaManager.StorageCandidates(),
 		Tenants:      make([]*api.Tenant, 0, len(validated)),
 	}
 	for i, tenant := range validated {
-		request.Tenants = append(request.Tenants, &api.Tenant{
+		t := &api.Tenant{
 			Name:   tenant.Name,
 			Status: schema.ActivityStatus(validated[i].ActivityStatus),
-		})
+			Quotas: api.TenantQuotasFromModel(tenant.Quotas),
+		}
+		h.addTenantKey(t)
+		request.Tenants = append(request.Tenants, t)
 	}
 
 	return h.schemaManager.AddTenants(ctx, class, &request)
 }
 
+// addTenantKey gives the tenant its own data key if encryption at rest is
+// enabled. Deleting the tenant deletes the key, which makes all copies of the
+// tenant's data unreadable. The key is ignored if the tenant exists already.
+func (h *Handler) addTenantKey(t *api.Tenant) {
+	if h.encryptionMasterKey == nil {
+		return
+	}
+	t.EncryptionKeyId = encryption.NewKeyID()
+	t.EncryptionKey = h.encryptionMasterKey.Wrap(encryption.GenerateDataKey())
+}
+
 func validateTenants(tenants []*models.Tenant, allowOverHundred bool) (validated []*models.Tenant, err error) {
 	if !allowOverHundred && len(tenants) > 100 {
 		err = uco.NewErrInvalidUserInput(ErrMsgMaxAllowedTenants)
@@ -139,7 +158,27 @@ func (h *Handler) validateActivityStatuses(ctx context.Context, tenants []*model
 	return nil
 }
 
+// validateQuotas makes sure that the quotas of the tenants can be enforced.
+// Quotas set to 0 are unlimited.
+func (h *Handler) validateQuotas(tenants []*models.Tenant) error {
+	for _, tenant := range tenants {
+		q := tenant.Quotas
+		if q == nil {
+			continue
+		}
+		if q.MaxObjects < 0 || q.MaxBytes < 0 || q.MaxVectorDimensions < 0 {
+			return uco.NewErrInvalidUserInput("quotas of tenant %q must not be negative", tenant.Name)
+		}
+		if q.MaxVectorDimensions > 0 && !h.config.TrackVectorDimensions {
+			return uco.NewErrInvalidUserInput("quotas of tenant %q: maxVectorDimensions requires "+
+				"vector dimension tracking, set TRACK_VECTOR_DIMENSIONS=true", tenant.Name)
+		}
+	}
+	return nil
+}
+
 // UpdateTenants is used to set activity status of tenants of a class.
+// Quotas of tenants are only changed if they are part of the request.
 //
 // Class must exist and has partitioning enabled
 func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal,
@@ -166,6 +205,9 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 	if err := h.validateActivityStatuses(ctx, validated, false, true); err != nil {
 		return nil, err
 	}
+	if err := h.validateQuotas(validated); err != nil {
+		return nil, err
+	}
 
 	req := api.UpdateTenantsRequest{
 		Tenants:      make([]*api.Tenant, len(tenants)),
@@ -174,7 +216,11 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 	tNames := make([]string, len(tenants))
 	for i, tenant := range tenants {
 		tNames[i] = tenant.Name
-		req.Tenants[i] = &api.Tenant{Name: tenant.Name, Status: tenant.ActivityStatus}
+		req.Tenants[i] = &api.Tenant{
+			Name:   tenant.Name,
+			Status: tenant.ActivityStatus,
+			Quotas: api.TenantQuotasFromModel(tenant.Quotas),
+		}
 	}
 
 	if _, err = h.schemaManager.UpdateTenants(ctx, class, &req); err != nil {
@@ -324,6 +370,7 @@ func (h *Handler) getTenantsByNames(class string, names []string) ([]*models.Ten
 			ts = append(ts, &models.Tenant{
 				Name:           name,
 				ActivityStatus: schema.ActivityStatus(physical.Status),
+				Quotas:         physical.QuotasCopy(),
 			})
 		}
 		return nil
@@ -19,8 +19,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 func TestAddTenants(t *testing.T) {
@@ -134,6 +136,38 @@ func TestAddTenants(t *testing.T) {
 				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
+		{
+			name:  "NegativeQuotas",
+			class: mtEnabledClass.Class,
+			tenants: []*models.Tenant{
+				{Name: "Aaaa", Quotas: &models.TenantQuotas{MaxObjects: -1}},
+			},
+			errMsgs:   []string{"must not be negative"},
+			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {},
+		},
+		{
+			name:  "VectorDimensionQuotasWithoutTracking",
+			class: mtEnabledClass.Class,
+			tenants: []*models.Tenant{
+				{Name: "Aaaa", Quotas: &models.TenantQuotas{MaxVectorDimensions: 1000}},
+			},
+			errMsgs:   []string{"TRACK_VECTOR_DIMENSIONS"},
+			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {},
+		},
+		{
+			name:  "SuccessWithQuotas",
+			class: mtEnabledClass.Class,
+			tenants: []*models.Tenant{
+				{Name: "Aaaa", Quotas: &models.TenantQuotas{MaxObjects: 100, MaxBytes: 1 << 30}},
+			},
+			errMsgs: []string{},
+			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("AddTenants", mock.Anything, mock.MatchedBy(func(req *api.AddTenantsRequest) bool {
+					return len(req.Tenants) == 1 && req.Tenants[0].Quotas.GetMaxObjects() == 100 &&
+						req.Tenants[0].Quotas.GetMaxBytes() == 1<<30
+				})).Return(nil)
+			},
+		},
 		// TODO test with replication factor >= 2
 	}
 
@@ -160,6 +194,31 @@ func TestAddTenants(t *testing.T) {
 	}
 }
 
+func TestAddTenantsWithEncryption(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	master, err := encryption.NewMasterKey(make([]byte, encryption.KeySize))
+	require.NoError(t, err)
+	handler.encryptionMasterKey = master
+
+	var req *api.AddTenantsRequest
+	fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { req = args.Get(1).(*api.AddTenantsRequest) }).
+		Return(nil)
+
+	_, err = handler.AddTenants(context.Background(), nil, "MTenabled",
+		[]*models.Tenant{{Name: "USER1"}, {Name: "USER2"}})
+	require.NoError(t, err)
+	require.Len(t, req.Tenants, 2)
+
+	// every tenant gets its own key
+	assert.NotEqual(t, req.Tenants[0].EncryptionKeyId, req.Tenants[1].EncryptionKeyId)
+	for _, tenant := range req.Tenants {
+		require.NotEmpty(t, tenant.EncryptionKeyId)
+		_, err := master.Unwrap(tenant.EncryptionKey)
+		require.NoError(t, err)
+	}
+}
+
 func TestUpdateTenants(t *testing.T) {
 	var (
 		ctx     = context.Background()
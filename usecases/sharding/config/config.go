@@ -23,6 +23,13 @@ const (
 	DefaultKey                = "_id"
 	DefaultStrategy           = "hash"
 	DefaultFunction           = "murmur3"
+
+	// StrategyRange assigns contiguous ranges of an int or date property to
+	// shards, new shards are added as new ranges are written to
+	StrategyRange = "range"
+	// DefaultRangeMaxShards limits the number of shards of a collection which
+	// is sharded by range, as every write to a new range adds one
+	DefaultRangeMaxShards = 1000
 )
 
 type Config struct {
@@ -34,6 +41,16 @@ type Config struct {
 	Key                 string `json:"key"`
 	Strategy            string `json:"strategy"`
 	Function            string `json:"function"`
+	// RangeWidth is the size of the key range each shard owns when using the
+	// range strategy. Date keys are measured in seconds.
+	RangeWidth int64 `json:"rangeWidth,omitempty"`
+	// RangeRetention is how far behind the newest range the ranges are kept,
+	// in the same unit as RangeWidth. Shards of older ranges are dropped. 0
+	// keeps all of them.
+	RangeRetention int64 `json:"rangeRetention,omitempty"`
+	// RangeMaxShards is the maximum number of shards of a collection which
+	// is sharded by range
+	RangeMaxShards int `json:"rangeMaxShards,omitempty"`
 }
 
 func (c *Config) setDefaults(nodeCount int) {
@@ -51,13 +68,30 @@ func (c *Config) setDefaults(nodeCount int) {
 }
 
 func (c *Config) validate() error {
-	if c.Key != "_id" {
-		return errors.Errorf("sharding only supported on key '_id' for now, "+
-			"got: %s", c.Key)
+	if c.Key == "" {
+		return errors.Errorf("sharding key must not be empty")
 	}
 
-	if c.Strategy != "hash" {
-		return errors.Errorf("sharding only supported with strategy 'hash' for now, "+
+	switch c.Strategy {
+	case DefaultStrategy:
+	case StrategyRange:
+		if !c.ShardedByProperty() {
+			return errors.Errorf("sharding strategy 'range' requires a property as sharding key")
+		}
+		if c.RangeWidth <= 0 {
+			return errors.Errorf("sharding strategy 'range' requires a positive rangeWidth, "+
+				"got: %d", c.RangeWidth)
+		}
+		if c.RangeRetention < 0 {
+			return errors.Errorf("sharding strategy 'range' requires a non-negative "+
+				"rangeRetention, got: %d", c.RangeRetention)
+		}
+		if c.RangeMaxShards <= 0 {
+			return errors.Errorf("sharding strategy 'range' requires a positive rangeMaxShards, "+
+				"got: %d", c.RangeMaxShards)
+		}
+	default:
+		return errors.Errorf("sharding only supported with strategies 'hash' and 'range', "+
 			"got: %s", c.Strategy)
 	}
 
@@ -69,6 +103,20 @@ func (c *Config) validate() error {
 	return nil
 }
 
+// ShardedByProperty is true if objects are routed by the value of a property
+// rather than by their id. Ids are then only unique per value of the key, the
+// same id written with different values ends up in different shards.
+// Requests by id are routed by the sharding key hint of the request, see
+// sharding.ContextWithKeyHint.
+func (c Config) ShardedByProperty() bool {
+	return c.Key != "" && c.Key != DefaultKey
+}
+
+// ShardedByRange is true if shards own contiguous ranges of the sharding key
+func (c Config) ShardedByRange() bool {
+	return c.Strategy == StrategyRange
+}
+
 func (c Config) DeepCopy() Config {
 	return Config{
 		VirtualPerPhysical:  c.VirtualPerPhysical,
@@ -79,6 +127,9 @@ func (c Config) DeepCopy() Config {
 		Key:                 c.Key,
 		Strategy:            c.Strategy,
 		Function:            c.Function,
+		RangeWidth:          c.RangeWidth,
+		RangeRetention:      c.RangeRetention,
+		RangeMaxShards:      c.RangeMaxShards,
 	}
 }
 
@@ -133,6 +184,27 @@ func ParseConfig(input interface{}, nodeCount int) (Config, error) {
 		return out, err
 	}
 
+	if err := optionalIntFromMap(asMap, "rangeWidth", func(v int) {
+		out.RangeWidth = int64(v)
+	}); err != nil {
+		return out, err
+	}
+
+	if err := optionalIntFromMap(asMap, "rangeRetention", func(v int) {
+		out.RangeRetention = int64(v)
+	}); err != nil {
+		return out, err
+	}
+
+	if out.ShardedByRange() {
+		out.RangeMaxShards = DefaultRangeMaxShards
+	}
+	if err := optionalIntFromMap(asMap, "rangeMaxShards", func(v int) {
+		out.RangeMaxShards = v
+	}); err != nil {
+		return out, err
+	}
+
 	// these will only differ once there is an async component through replication
 	// or dynamic scaling. For now they have to be the same
 	out.ActualCount = out.DesiredCount
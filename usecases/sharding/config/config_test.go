@@ -91,25 +91,121 @@ func Test_Config(t *testing.T) {
 		},
 
 		{
-			name: "unsupported sharding key",
+			name: "custom sharding key",
 			input: map[string]interface{}{
 				"key":      "myCustomField",
 				"strategy": "hash",
 				"function": "murmur3",
 			},
-			expectedErr: errors.New("sharding only supported on key '_id' " +
-				"for now, got: myCustomField"),
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        7,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 7,
+				ActualCount:         7,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 7,
+				Key:                 "myCustomField",
+				Strategy:            "hash",
+				Function:            "murmur3",
+			},
+		},
+
+		{
+			name: "empty sharding key",
+			input: map[string]interface{}{
+				"key":      "",
+				"strategy": "hash",
+				"function": "murmur3",
+			},
+			expectedErr: errors.New("sharding key must not be empty"),
+		},
+
+		{
+			name: "range sharding",
+			input: map[string]interface{}{
+				"key":        "createdAt",
+				"strategy":   "range",
+				"rangeWidth": json.Number("86400"),
+			},
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        7,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 7,
+				ActualCount:         7,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 7,
+				Key:                 "createdAt",
+				Strategy:            "range",
+				Function:            DefaultFunction,
+				RangeWidth:          86400,
+				RangeMaxShards:      DefaultRangeMaxShards,
+			},
+		},
+
+		{
+			name: "range sharding with retention and limit",
+			input: map[string]interface{}{
+				"key":            "createdAt",
+				"strategy":       "range",
+				"rangeWidth":     json.Number("86400"),
+				"rangeRetention": json.Number("7776000"),
+				"rangeMaxShards": json.Number("100"),
+			},
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        7,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 7,
+				ActualCount:         7,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 7,
+				Key:                 "createdAt",
+				Strategy:            "range",
+				Function:            DefaultFunction,
+				RangeWidth:          86400,
+				RangeRetention:      7776000,
+				RangeMaxShards:      100,
+			},
+		},
+
+		{
+			name: "range sharding without shards",
+			input: map[string]interface{}{
+				"key":            "createdAt",
+				"strategy":       "range",
+				"rangeWidth":     json.Number("86400"),
+				"rangeMaxShards": json.Number("0"),
+			},
+			expectedErr: errors.New("sharding strategy 'range' requires a positive " +
+				"rangeMaxShards, got: 0"),
+		},
+
+		{
+			name: "range sharding by id",
+			input: map[string]interface{}{
+				"key":        "_id",
+				"strategy":   "range",
+				"rangeWidth": json.Number("86400"),
+			},
+			expectedErr: errors.New("sharding strategy 'range' requires a property " +
+				"as sharding key"),
+		},
+
+		{
+			name: "range sharding without width",
+			input: map[string]interface{}{
+				"key":      "createdAt",
+				"strategy": "range",
+			},
+			expectedErr: errors.New("sharding strategy 'range' requires a positive " +
+				"rangeWidth, got: 0"),
 		},
 
 		{
 			name: "unsupported sharding strategy",
 			input: map[string]interface{}{
 				"key":      "_id",
-				"strategy": "range",
+				"strategy": "consistent",
 				"function": "murmur3",
 			},
-			expectedErr: errors.New("sharding only supported with strategy 'hash' " +
-				"for now, got: range"),
+			expectedErr: errors.New("sharding only supported with strategies 'hash' " +
+				"and 'range', got: consistent"),
 		},
 
 		{
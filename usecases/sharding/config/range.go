@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rangeShardPrefix is prepended to the start of the range a shard owns to
+// form its name. Deriving names from ranges lets every node route objects
+// without having to consult the sharding state.
+const rangeShardPrefix = "range_"
+
+// RangeValue turns the value of a range sharding key into the integer the
+// ranges are defined over. Ints are used as they are, dates as unix seconds.
+func RangeValue(in any) (int64, error) {
+	switch typed := in.(type) {
+	case nil:
+		return 0, fmt.Errorf("sharding key has no value")
+	case time.Time:
+		return typed.Unix(), nil
+	case string:
+		date, err := time.Parse(time.RFC3339Nano, typed)
+		if err != nil {
+			return 0, fmt.Errorf("sharding key must be a RFC3339 formatted date, got %q", typed)
+		}
+		return date.Unix(), nil
+	case int:
+		return int64(typed), nil
+	case int64:
+		return typed, nil
+	case float64:
+		if typed != math.Trunc(typed) {
+			return 0, fmt.Errorf("sharding key must be an integer, got %v", typed)
+		}
+		return int64(typed), nil
+	case json.Number:
+		asInt, err := typed.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("sharding key must be an integer, got %v", typed)
+		}
+		return asInt, nil
+	default:
+		return 0, fmt.Errorf("unsupported range sharding key value of type %T", in)
+	}
+}
+
+// RangeStart returns the start of the range of the given width the value
+// falls into
+func RangeStart(value, width int64) int64 {
+	start := value / width * width
+	if value < 0 && start != value {
+		start -= width
+	}
+	return start
+}
+
+// RangeExpired is true if the range starting at start is outside of the
+// retention counted back from the range starting at newest. A retention of 0
+// keeps all ranges.
+func RangeExpired(start, newest, retention int64) bool {
+	return retention > 0 && start <= newest-retention
+}
+
+// RangeShardName returns the name of the shard owning the range starting at
+// start
+func RangeShardName(start int64) string {
+	return rangeShardPrefix + strconv.FormatInt(start, 10)
+}
+
+// ParseRangeShardName returns the start of the range owned by the shard.
+// The second return value is false if name is not the name of a range shard.
+func ParseRangeShardName(name string) (int64, bool) {
+	if !strings.HasPrefix(name, rangeShardPrefix) {
+		return 0, false
+	}
+	start, err := strconv.ParseInt(strings.TrimPrefix(name, rangeShardPrefix), 10, 64)
+	return start, err == nil
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeSharding(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		date := "2025-03-01T12:00:00Z"
+		expected := int64(1740830400)
+		for _, in := range []any{date, "2025-03-01T13:00:00+01:00"} {
+			got, err := RangeValue(in)
+			require.Nil(t, err)
+			assert.Equal(t, expected, got, in)
+		}
+
+		for _, in := range []any{7, int64(7), float64(7), json.Number("7")} {
+			got, err := RangeValue(in)
+			require.Nil(t, err)
+			assert.Equal(t, int64(7), got, "%T", in)
+		}
+
+		for _, in := range []any{nil, "yesterday", 7.5, true} {
+			_, err := RangeValue(in)
+			assert.NotNil(t, err, "%v", in)
+		}
+	})
+
+	t.Run("range starts", func(t *testing.T) {
+		assert.Equal(t, int64(0), RangeStart(0, 10))
+		assert.Equal(t, int64(0), RangeStart(9, 10))
+		assert.Equal(t, int64(10), RangeStart(10, 10))
+		assert.Equal(t, int64(-10), RangeStart(-1, 10))
+		assert.Equal(t, int64(-10), RangeStart(-10, 10))
+		assert.Equal(t, int64(-20), RangeStart(-11, 10))
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		assert.False(t, RangeExpired(0, 1000, 0))
+		assert.False(t, RangeExpired(920, 1000, 90))
+		assert.True(t, RangeExpired(910, 1000, 90))
+		assert.True(t, RangeExpired(-10, 1000, 90))
+	})
+
+	t.Run("shard names", func(t *testing.T) {
+		for _, start := range []int64{0, 86400, -86400} {
+			got, ok := ParseRangeShardName(RangeShardName(start))
+			require.True(t, ok)
+			assert.Equal(t, start, got)
+		}
+		_, ok := ParseRangeShardName("cTSGnMkGFHsh")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// KeyValue turns the value of a sharding key property into the bytes that are
+// hashed to pick a shard. Values reach us in different shapes depending on
+// whether they come from the REST API, gRPC, disk or a filter, so all of them
+// are normalized first: uuids to their binary form and numbers to their
+// decimal integer representation.
+func KeyValue(in any) ([]byte, error) {
+	switch typed := in.(type) {
+	case nil:
+		return nil, fmt.Errorf("sharding key has no value")
+	case string:
+		if id, err := uuid.Parse(typed); err == nil {
+			return id[:], nil
+		}
+		return []byte(typed), nil
+	case strfmt.UUID:
+		return KeyValue(string(typed))
+	case uuid.UUID:
+		return typed[:], nil
+	case int:
+		return intKey(int64(typed)), nil
+	case int64:
+		return intKey(typed), nil
+	case float64:
+		if typed != math.Trunc(typed) {
+			return nil, fmt.Errorf("sharding key must be an integer, got %v", typed)
+		}
+		return intKey(int64(typed)), nil
+	case json.Number:
+		asInt, err := typed.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("sharding key must be an integer, got %v", typed)
+		}
+		return intKey(asInt), nil
+	default:
+		return nil, fmt.Errorf("unsupported sharding key value of type %T", in)
+	}
+}
+
+func intKey(in int64) []byte {
+	return []byte(strconv.FormatInt(in, 10))
+}
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import "context"
+
+// KeyHintHeader carries the value of the sharding key of the object a
+// request by id refers to, if the collection is sharded by a property
+const KeyHintHeader = "X-Weaviate-Sharding-Key"
+
+type keyHintCtxKey struct{}
+
+// ContextWithKeyHint returns a copy of ctx which carries the value of the
+// sharding key of the object a request by id refers to. Objects of
+// collections sharded by a property can't be found by their id alone, with
+// the hint the request is routed to the shard owning the object instead of
+// asking every shard.
+func ContextWithKeyHint(ctx context.Context, value string) context.Context {
+	return context.WithValue(ctx, keyHintCtxKey{}, value)
+}
+
+// KeyHintFromContext returns the sharding key hint carried by ctx, ok is
+// false if there is none
+func KeyHintFromContext(ctx context.Context) (value string, ok bool) {
+	value, ok = ctx.Value(keyHintCtxKey{}).(string)
+	return value, ok && value != ""
+}
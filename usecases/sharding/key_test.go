@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyValue(t *testing.T) {
+	id := uuid.MustParse("73f2eb5f-5abf-447a-81ca-74b1dd168241")
+
+	t.Run("uuids are normalized", func(t *testing.T) {
+		expected := id[:]
+		for _, in := range []any{
+			id,
+			strfmt.UUID(id.String()),
+			"73F2EB5F-5ABF-447A-81CA-74B1DD168241",
+		} {
+			got, err := KeyValue(in)
+			require.Nil(t, err)
+			assert.Equal(t, expected, got, "%T", in)
+		}
+	})
+
+	t.Run("integers are normalized", func(t *testing.T) {
+		for _, in := range []any{42, int64(42), float64(42), json.Number("42")} {
+			got, err := KeyValue(in)
+			require.Nil(t, err)
+			assert.Equal(t, []byte("42"), got, "%T", in)
+		}
+	})
+
+	t.Run("text is used as is", func(t *testing.T) {
+		got, err := KeyValue("acme")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("acme"), got)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for _, in := range []any{nil, 4.2, json.Number("4.2"), true, []string{"a"}} {
+			_, err := KeyValue(in)
+			assert.NotNil(t, err, "%v", in)
+		}
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
@@ -98,6 +99,8 @@ type RemoteIndexClient interface {
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
+	GetShardChanges(ctx context.Context, hostName, indexName, shardName string,
+		after uint64, since int64, limit int) ([]changes.Change, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
 
@@ -121,6 +124,12 @@ type RemoteIndexClient interface {
 	AddAsyncReplicationTargetNode(ctx context.Context, hostName, indexName, shardName string, targetNodeOverride additional.AsyncReplicationTargetNodeOverride, schemaVersion uint64) error
 	// RemoveAsyncReplicationTargetNode removes the async replication target node for a shard.
 	RemoveAsyncReplicationTargetNode(ctx context.Context, hostName, indexName, shardName string, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error
+	// SplitShardReplica copies the shard replica on the specified node into the replica of the
+	// shard split off it on the same node.
+	SplitShardReplica(ctx context.Context, hostName, indexName, shardName, targetShard string, schemaVersion uint64) error
+	// DropUnownedObjects deletes the objects of the shard replica on the specified node which
+	// belong to a different shard after a split.
+	DropUnownedObjects(ctx context.Context, hostName, indexName, shardName string, schemaVersion uint64) error
 }
 
 func (ri *RemoteIndex) PutObject(ctx context.Context, shardName string,
@@ -403,6 +412,27 @@ func (ri *RemoteIndex) GetShardQueueSize(ctx context.Context, shardName string)
 	return ri.client.GetShardQueueSize(ctx, host, ri.class, shardName)
 }
 
+// GetShardChanges reads the change log of the shard on the node, or on the
+// owner of the shard if node is empty
+func (ri *RemoteIndex) GetShardChanges(ctx context.Context, node, shardName string,
+	after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	if node == "" {
+		owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
+		if err != nil {
+			return nil, fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
+		}
+		node = owner
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.GetShardChanges(ctx, host, ri.class, shardName, after, since, limit)
+}
+
 func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (string, error) {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
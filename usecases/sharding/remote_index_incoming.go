@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -76,6 +77,8 @@ type RemoteIndexIncomingRepo interface {
 	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
+	IncomingGetShardChanges(ctx context.Context, shardName string,
+		after uint64, since int64, limit int) ([]changes.Change, error)
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
@@ -106,6 +109,10 @@ type RemoteIndexIncomingRepo interface {
 	IncomingAddAsyncReplicationTargetNode(ctx context.Context, shardName string, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error
 	// IncomingRemoveAsyncReplicationTargetNode See adapters/clients.RemoteIndex.RemoveAsyncReplicationTargetNode
 	IncomingRemoveAsyncReplicationTargetNode(ctx context.Context, shardName string, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error
+	// IncomingSplitShardReplica See adapters/clients.RemoteIndex.SplitShardReplica
+	IncomingSplitShardReplica(ctx context.Context, shardName, targetShard string) error
+	// IncomingDropUnownedObjects See adapters/clients.RemoteIndex.DropUnownedObjects
+	IncomingDropUnownedObjects(ctx context.Context, shardName string) error
 }
 
 type RemoteIndexIncoming struct {
@@ -270,6 +277,17 @@ func (rii *RemoteIndexIncoming) GetShardQueueSize(ctx context.Context,
 	return index.IncomingGetShardQueueSize(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) GetShardChanges(ctx context.Context,
+	indexName, shardName string, after uint64, since int64, limit int,
+) ([]changes.Change, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingGetShardChanges(ctx, shardName, after, since, limit)
+}
+
 func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	indexName, shardName string,
 ) (string, error) {
@@ -361,6 +379,30 @@ func (rii *RemoteIndexIncoming) ListFiles(ctx context.Context,
 	return index.IncomingListFiles(ctx, shardName)
 }
 
+// SplitShardReplica see adapters/clients.RemoteIndex.SplitShardReplica
+func (rii *RemoteIndexIncoming) SplitShardReplica(ctx context.Context,
+	indexName, shardName, targetShard string, schemaVersion uint64,
+) error {
+	index, err := rii.indexForIncomingWrite(ctx, indexName, schemaVersion)
+	if err != nil {
+		return fmt.Errorf("local index %q not found: %w", indexName, err)
+	}
+
+	return index.IncomingSplitShardReplica(ctx, shardName, targetShard)
+}
+
+// DropUnownedObjects see adapters/clients.RemoteIndex.DropUnownedObjects
+func (rii *RemoteIndexIncoming) DropUnownedObjects(ctx context.Context,
+	indexName, shardName string, schemaVersion uint64,
+) error {
+	index, err := rii.indexForIncomingWrite(ctx, indexName, schemaVersion)
+	if err != nil {
+		return fmt.Errorf("local index %q not found: %w", indexName, err)
+	}
+
+	return index.IncomingDropUnownedObjects(ctx, shardName)
+}
+
 // GetFileMetadata see adapters/clients.RemoteIndex.GetFileMetadata
 func (rii *RemoteIndexIncoming) GetFileMetadata(ctx context.Context,
 	indexName, shardName, relativeFilePath string,
@@ -142,8 +142,20 @@ type Physical struct {
 
 	LegacyBelongsToNodeForBackwardCompat string   `json:"belongsToNode,omitempty"`
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
+	// ReadOnlyReplicas are the replicas in BelongsToNodes which are hosted on
+	// read-only nodes. They are kept up to date by async replication and are
+	// not part of write quorums.
+	ReadOnlyReplicas []string `json:"readOnlyReplicas,omitempty"`
 
 	Status string `json:"status,omitempty"`
+
+	// Quotas limit what a tenant may store, nil means unlimited
+	Quotas *models.TenantQuotas `json:"quotas,omitempty"`
+
+	// EncryptionKeyID references the data key of a tenant which has its own
+	// one. The key itself is kept apart from the sharding state, so that it is
+	// not part of offloaded tenants and can be deleted on its own.
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -167,6 +179,18 @@ func (s *State) AddReplicaToShard(shard string, replica string) error {
 	return nil
 }
 
+// AddReadOnlyReplicaToShard adds a replica hosted on a read-only node to
+// shard
+func (s *State) AddReadOnlyReplicaToShard(shard string, replica string) error {
+	if err := s.AddReplicaToShard(shard, replica); err != nil {
+		return err
+	}
+	phys := s.Physical[shard]
+	phys.ReadOnlyReplicas = append(phys.ReadOnlyReplicas, replica)
+	s.Physical[shard] = phys
+	return nil
+}
+
 func (s *State) DeleteReplicaFromShard(shard string, replica string) error {
 	if err := s.MigrateShardingStateReplicationFactor(); err != nil {
 		return fmt.Errorf("error while migrating sharding state: %w", err)
@@ -192,6 +216,85 @@ func (s *State) DeleteReplicaFromShard(shard string, replica string) error {
 	return nil
 }
 
+// SplitShard moves half of the virtual shards owned by the physical shard
+// source to a new physical shard target, which is placed on nodes. The
+// virtual shards with the highest tokens are moved, so that applying the same
+// split on every node always leads to the same state.
+func (s *State) SplitShard(source, target string, nodes []string) error {
+	if s.PartitioningEnabled {
+		return fmt.Errorf("shards of a multi-tenant collection cannot be split")
+	}
+	if len(nodes) == 0 {
+		return fmt.Errorf("no nodes given for shard %s", target)
+	}
+	phys, ok := s.Physical[source]
+	if !ok {
+		return fmt.Errorf("could not find shard %s", source)
+	}
+	if _, ok := s.Physical[target]; ok {
+		return fmt.Errorf("shard %s already exists", target)
+	}
+	if len(phys.OwnsVirtual) < 2 {
+		return fmt.Errorf("shard %s owns %d virtual shards, at least 2 are needed to split it",
+			source, len(phys.OwnsVirtual))
+	}
+
+	owned := make([]*Virtual, 0, len(phys.OwnsVirtual))
+	for _, name := range phys.OwnsVirtual {
+		virtual := s.VirtualByName(name)
+		if virtual == nil {
+			return fmt.Errorf("could not find virtual shard %s of shard %s", name, source)
+		}
+		owned = append(owned, virtual)
+	}
+	sort.Slice(owned, func(a, b int) bool {
+		return owned[a].Upper < owned[b].Upper
+	})
+
+	keep, move := owned[:len(owned)/2], owned[len(owned)/2:]
+	split := Physical{
+		Name:           target,
+		OwnsVirtual:    make([]string, 0, len(move)),
+		BelongsToNodes: slices.Clone(nodes),
+		Status:         phys.Status,
+	}
+	for _, virtual := range move {
+		virtual.AssignedToPhysical = target
+		split.OwnsVirtual = append(split.OwnsVirtual, virtual.Name)
+		split.OwnsPercentage += virtual.OwnsPercentage
+	}
+
+	phys.OwnsVirtual = make([]string, 0, len(keep))
+	phys.OwnsPercentage = 0
+	for _, virtual := range keep {
+		phys.OwnsVirtual = append(phys.OwnsVirtual, virtual.Name)
+		phys.OwnsPercentage += virtual.OwnsPercentage
+	}
+
+	s.Physical[source] = phys
+	s.Physical[target] = split
+	s.Config.ActualCount = len(s.Physical)
+	return nil
+}
+
+// SplitShardNodes returns the nodes a shard split off a shard with the given
+// replicas is placed on. The new shard has as many replicas as the source
+// shard. Apart from targetNode they are placed next to the source replicas,
+// so that they can be created from a local copy of the source shard.
+func SplitShardNodes(replicas []string, targetNode string) []string {
+	nodes := make([]string, 0, max(len(replicas), 1))
+	nodes = append(nodes, targetNode)
+	for _, replica := range replicas {
+		if len(nodes) >= len(replicas) {
+			break
+		}
+		if replica != targetNode {
+			nodes = append(nodes, replica)
+		}
+	}
+	return nodes
+}
+
 func (s *State) NumberOfReplicas(shard string) (int64, error) {
 	phys, ok := s.Physical[shard]
 	if !ok {
@@ -215,9 +318,18 @@ func (p *Physical) DeleteReplica(replica string) error {
 	}
 	idx := slices.Index(p.BelongsToNodes, replica)
 	p.BelongsToNodes = slices.Delete(p.BelongsToNodes, idx, idx+1)
+	if idx := slices.Index(p.ReadOnlyReplicas, replica); idx >= 0 {
+		p.ReadOnlyReplicas = slices.Delete(p.ReadOnlyReplicas, idx, idx+1)
+	}
 	return nil
 }
 
+// IsReadOnlyReplica returns whether the replica on node is hosted on a
+// read-only node
+func (p Physical) IsReadOnlyReplica(node string) bool {
+	return slices.Contains(p.ReadOnlyReplicas, node)
+}
+
 // AdjustReplicas shrinks or extends the replica set (p.BelongsToNodes)
 func (p *Physical) AdjustReplicas(count int, nodes cluster.NodeSelector) error {
 	if count < 0 {
@@ -247,15 +359,27 @@ func (p *Physical) AdjustReplicas(count int, nodes cluster.NodeSelector) error {
 		return fmt.Errorf("not enough storage replicas: found %d want %d", len(names), count)
 	}
 
-	// make sure included nodes are unique
-	for _, n := range names {
-		if !available[n] {
+	// make sure included nodes are unique. The first pass only picks nodes in
+	// zones which don't hold a replica yet, the second one fills up the rest.
+	zones := cluster.ZonesOf(p.BelongsToNodes, nodes.NodeZone)
+	for _, spreadZones := range []bool{true, false} {
+		for _, n := range names {
+			if len(available) == count {
+				return nil
+			}
+			if available[n] {
+				continue
+			}
+			if spreadZones {
+				zone := nodes.NodeZone(n)
+				if _, ok := zones[zone]; ok || zone == "" {
+					continue
+				}
+				zones[zone] = struct{}{}
+			}
 			p.BelongsToNodes = append(p.BelongsToNodes, n)
 			available[n] = true
 		}
-		if len(available) == count {
-			break
-		}
 	}
 
 	return nil
@@ -297,6 +421,12 @@ func InitState(id string, config config.Config, nodeLocalName string, names []st
 		return out, nil
 	}
 
+	if config.ShardedByRange() {
+		// shards are added as objects for new ranges are written
+		out.Physical = map[string]Physical{}
+		return out, nil
+	}
+
 	if err := out.initPhysical(names, replFactor); err != nil {
 		return nil, err
 	}
@@ -426,7 +556,7 @@ func (s *State) initPhysical(nodes []string, replFactor int64) error {
 
 	nodeSet := make(map[string]bool)
 	for i := 0; i < s.Config.DesiredCount; i++ {
-		name := generateShardName()
+		name := GenerateShardName()
 		shard := Physical{Name: name}
 		shard.BelongsToNodes = make([]string, 0, replFactor)
 		for { // select shard
@@ -561,7 +691,7 @@ func (s *State) initVirtual() {
 	s.Virtual = make([]Virtual, count)
 
 	for i := range s.Virtual {
-		name := generateShardName()
+		name := GenerateShardName()
 		h := murmur3.New64()
 		h.Write([]byte(name))
 		s.Virtual[i] = Virtual{Name: name, Upper: h.Sum64()}
@@ -639,7 +769,8 @@ func (s *State) virtualByToken(token uint64) *Virtual {
 
 const shardNameChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
-func generateShardName() string {
+// GenerateShardName returns a random name for a new physical shard
+func GenerateShardName() string {
 	b := make([]byte, shardNameLength)
 	for i := range b {
 		b[i] = shardNameChars[rand.Intn(len(shardNameChars))]
@@ -694,12 +825,24 @@ func (p Physical) DeepCopy() Physical {
 	copy(belongsCopy, p.BelongsToNodes)
 
 	return Physical{
-		Name:           p.Name,
-		OwnsVirtual:    ownsVirtualCopy,
-		OwnsPercentage: p.OwnsPercentage,
-		BelongsToNodes: belongsCopy,
-		Status:         p.Status,
+		Name:             p.Name,
+		OwnsVirtual:      ownsVirtualCopy,
+		OwnsPercentage:   p.OwnsPercentage,
+		BelongsToNodes:   belongsCopy,
+		ReadOnlyReplicas: slices.Clone(p.ReadOnlyReplicas),
+		Status:           p.Status,
+		Quotas:           p.QuotasCopy(),
+		EncryptionKeyID:  p.EncryptionKeyID,
+	}
+}
+
+// QuotasCopy returns a copy of the quotas of the shard, nil if it has none
+func (p Physical) QuotasCopy() *models.TenantQuotas {
+	if p.Quotas == nil {
+		return nil
 	}
+	q := *p.Quotas
+	return &q
 }
 
 func (v Virtual) DeepCopy() Virtual {
@@ -236,6 +236,18 @@ func TestAdjustReplicas(t *testing.T) {
 		require.Nil(t, shard.AdjustReplicas(4, nodes)) // correct
 		require.ElementsMatch(t, names, shard.BelongsToNodes)
 	})
+	t.Run("Zones", func(t *testing.T) {
+		nodes := mocks.NewMockNodeSelector("N1", "N2", "N3", "N4", "N5").WithZones(map[string]string{
+			"N1": "a", "N2": "a", "N3": "b", "N4": "b", "N5": "c",
+		})
+		shard := Physical{BelongsToNodes: []string{"N1"}}
+		require.Nil(t, shard.AdjustReplicas(3, nodes))
+		assert.ElementsMatch(t, []string{"N1", "N3", "N5"}, shard.BelongsToNodes)
+
+		shard = Physical{BelongsToNodes: []string{"N1"}}
+		require.Nil(t, shard.AdjustReplicas(5, nodes))
+		assert.ElementsMatch(t, []string{"N1", "N2", "N3", "N4", "N5"}, shard.BelongsToNodes)
+	})
 }
 
 func TestGetPartitions(t *testing.T) {
@@ -310,6 +322,68 @@ func TestAddPartition(t *testing.T) {
 	require.Equal(t, want, s.Physical)
 }
 
+func TestSplitShard(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, 14)
+	require.Nil(t, err)
+
+	nodes := mocks.NewMockNodeSelector("node1", "node2")
+	s, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 1, false)
+	require.Nil(t, err)
+
+	source := s.AllPhysicalShards()[0]
+	before := s.Physical[source]
+	keys := make([][]byte, 1000)
+	owners := make([]string, len(keys))
+	for i := range keys {
+		keys[i] = make([]byte, 16)
+		rand.Read(keys[i])
+		owners[i] = s.PhysicalShard(keys[i])
+	}
+
+	other := s.DeepCopy()
+	require.Nil(t, s.SplitShard(source, "split", []string{"node2"}))
+	require.Nil(t, other.SplitShard(source, "split", []string{"node2"}))
+	assert.Equal(t, other.Physical, s.Physical, "splits must be deterministic")
+
+	split := s.Physical["split"]
+	kept := s.Physical[source]
+	assert.Len(t, kept.OwnsVirtual, len(before.OwnsVirtual)/2)
+	assert.Len(t, split.OwnsVirtual, len(before.OwnsVirtual)-len(before.OwnsVirtual)/2)
+	assert.InDelta(t, before.OwnsPercentage, kept.OwnsPercentage+split.OwnsPercentage, 1e-9)
+	assert.Equal(t, []string{"node2"}, split.BelongsToNodes)
+
+	for i, key := range keys {
+		owner := s.PhysicalShard(key)
+		if owners[i] == source {
+			assert.Contains(t, []string{source, "split"}, owner)
+		} else {
+			assert.Equal(t, owners[i], owner, "keys of other shards must not move")
+		}
+	}
+
+	assert.NotNil(t, s.SplitShard("unknown", "other", []string{"node1"}))
+	assert.NotNil(t, s.SplitShard(source, "split", []string{"node1"}))
+	assert.NotNil(t, s.SplitShard(source, "other", nil))
+}
+
+func TestSplitShardNodes(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		replicas []string
+		target   string
+		want     []string
+	}{
+		{"single replica", []string{"node1"}, "node2", []string{"node2"}},
+		{"target holds a replica", []string{"node1", "node2", "node3"}, "node2", []string{"node2", "node1", "node3"}},
+		{"target holds no replica", []string{"node1", "node2", "node3"}, "node4", []string{"node4", "node1", "node2"}},
+		{"no replicas", nil, "node1", []string{"node1"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SplitShardNodes(tc.replicas, tc.target))
+		})
+	}
+}
+
 func TestStateDeepCopy(t *testing.T) {
 	original := State{
 		IndexID: "original",
@@ -326,11 +400,14 @@ func TestStateDeepCopy(t *testing.T) {
 		localNodeName: "original",
 		Physical: map[string]Physical{
 			"physical1": {
-				Name:           "original",
-				OwnsVirtual:    []string{"original"},
-				OwnsPercentage: 7,
-				BelongsToNodes: []string{"original"},
-				Status:         models.TenantActivityStatusHOT,
+				Name:             "original",
+				OwnsVirtual:      []string{"original"},
+				OwnsPercentage:   7,
+				BelongsToNodes:   []string{"original"},
+				Status:           models.TenantActivityStatusHOT,
+				Quotas:           &models.TenantQuotas{MaxObjects: 10},
+				EncryptionKeyID:  "original",
+				ReadOnlyReplicas: []string{"original"},
 			},
 		},
 		Virtual: []Virtual{
@@ -359,11 +436,14 @@ func TestStateDeepCopy(t *testing.T) {
 		localNodeName: "original",
 		Physical: map[string]Physical{
 			"physical1": {
-				Name:           "original",
-				OwnsVirtual:    []string{"original"},
-				OwnsPercentage: 7,
-				BelongsToNodes: []string{"original"},
-				Status:         models.TenantActivityStatusHOT,
+				Name:             "original",
+				OwnsVirtual:      []string{"original"},
+				OwnsPercentage:   7,
+				BelongsToNodes:   []string{"original"},
+				Status:           models.TenantActivityStatusHOT,
+				Quotas:           &models.TenantQuotas{MaxObjects: 10},
+				EncryptionKeyID:  "original",
+				ReadOnlyReplicas: []string{"original"},
 			},
 		},
 		Virtual: []Virtual{
@@ -399,6 +479,9 @@ func TestStateDeepCopy(t *testing.T) {
 	physical1.OwnsPercentage = 100
 	physical1.OwnsVirtual = append(physical1.OwnsVirtual, "changed")
 	physical1.Status = models.TenantActivityStatusCOLD
+	physical1.Quotas.MaxObjects = 20
+	physical1.EncryptionKeyID = "changed"
+	physical1.ReadOnlyReplicas = append(physical1.ReadOnlyReplicas, "changed")
 	copied.Physical["physical1"] = physical1
 	copied.Physical["physical2"] = Physical{}
 	copied.Virtual[0].Name = "original"
@@ -649,6 +732,27 @@ func TestShardReplicationFactor(t *testing.T) {
 		require.NoErrorf(t, err, "unexpected error while deleting replica from shard")
 	})
 
+	t.Run("add and delete read-only replica", func(t *testing.T) {
+		nodes := mocks.NewMockNodeSelector("N1", "N2", "N3", "N4", "N5")
+		cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 3)
+		require.NoErrorf(t, err, "unexpected error while parsing config")
+
+		state, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 2, false)
+		require.NoErrorf(t, err, "unexpected error while initializing state")
+
+		shardName := state.AllPhysicalShards()[0]
+		err = state.AddReadOnlyReplicaToShard(shardName, "query1")
+		require.NoError(t, err, "unexpected error while adding read-only replica")
+		require.Equal(t, 3, len(state.Physical[shardName].BelongsToNodes))
+		require.True(t, state.Physical[shardName].IsReadOnlyReplica("query1"))
+		require.False(t, state.Physical[shardName].IsReadOnlyReplica(state.Physical[shardName].BelongsToNodes[0]))
+
+		err = state.DeleteReplicaFromShard(shardName, "query1")
+		require.NoErrorf(t, err, "unexpected error while deleting replica from shard")
+		require.Equal(t, 2, len(state.Physical[shardName].BelongsToNodes))
+		require.False(t, state.Physical[shardName].IsReadOnlyReplica("query1"))
+	})
+
 	t.Run("delete replica failure", func(t *testing.T) {
 		nodes := mocks.NewMockNodeSelector("N1", "N2", "N3", "N4", "N5")
 		cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 3)
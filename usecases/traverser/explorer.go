@@ -174,7 +174,11 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
+	if params.KeywordRanking.Type == "sparse" {
+		if params.KeywordRanking.SparseVector == nil {
+			return nil, errors.Errorf("sparse vector search must have a sparse vector set")
+		}
+	} else if len(params.KeywordRanking.Query) == 0 {
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
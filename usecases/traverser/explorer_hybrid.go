@@ -30,12 +30,22 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a sparse vector search if a sparse query vector is
+// given. The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
-	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+	searchName := "keyword,bm25"
+	if params.HybridSearch.NearSparseVectorParams != nil {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: params.HybridSearch.NearSparseVectorParams,
+		}
+		searchName = "sparse,nearSparseVector"
+	} else {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Query:      params.HybridSearch.Query,
+			Type:       "bm25",
+			Properties: params.HybridSearch.Properties,
+		}
 	}
 
 	params.Group = nil
@@ -76,7 +86,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, searchName, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
@@ -102,3 +102,11 @@ func (f *fakeSchemaManager) OptimisticTenantStatus(_ context.Context, class stri
 	res[tenant] = models.TenantActivityStatusHOT
 	return res, nil
 }
+
+func (f *fakeSchemaManager) TenantQuotas(class, tenant string) *models.TenantQuotas {
+	return nil
+}
+
+func (f *fakeSchemaManager) TenantEncryptionKey(class, tenant string) ([]byte, error) {
+	return nil, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -39,6 +40,7 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	quotas                  *ratelimiter.Quotas
 }
 
 type VectorSearcher interface {
@@ -60,7 +62,7 @@ func NewTraverser(config *config.WeaviateConfig, logger logrus.FieldLogger, auth
 	vectorSearcher VectorSearcher,
 	explorer explorer, schemaGetter schema.SchemaGetter,
 	modulesProvider ModulesProvider,
-	metrics *Metrics, maxGetRequests int,
+	metrics *Metrics, maxGetRequests int, quotas *ratelimiter.Quotas,
 ) *Traverser {
 	return &Traverser{
 		config:                  config,
@@ -73,9 +75,24 @@ func NewTraverser(config *config.WeaviateConfig, logger logrus.FieldLogger, auth
 		targetVectorParamHelper: NewTargetParamHelper(),
 		metrics:                 metrics,
 		ratelimiter:             ratelimiter.New(maxGetRequests),
+		quotas:                  quotas,
 	}
 }
 
+// takeQueryQuotas enforces the rate limits for a single query. Every module
+// param of the query is resolved by a module, e.g. nearText is vectorized and
+// generate calls a generative module, so each counts as a module call.
+func (t *Traverser) takeQueryQuotas(principal *models.Principal, className string, moduleCalls int) error {
+	if err := t.quotas.Take(ratelimiter.KindQuery, principal, className, 1); err != nil {
+		return err
+	}
+	if err := t.quotas.Take(ratelimiter.KindModuleCalls, principal, className, moduleCalls); err != nil {
+		t.quotas.Return(ratelimiter.KindQuery, principal, className, 1)
+		return err
+	}
+	return nil
+}
+
 // SearchResult is a single search result. See wrapping Search Results for the Type
 type SearchResult struct {
 	Name      string
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/models"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 	"github.com/weaviate/weaviate/usecases/modules"
 )
 
@@ -28,8 +29,30 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
+	if err := t.takeQueryQuotas(principal, params.ClassName.String(), len(params.ModuleParams)); err != nil {
+		return nil, err
+	}
+
 	inspector := newTypeInspector(t.schemaGetter.ReadOnlyClass)
 
+	mask := authzfilter.NewPropertyMask(ctx, t.authorizer, principal, params.Tenant)
+	if err := t.checkAggregatePropertyAccess(mask, params); err != nil {
+		return nil, err
+	}
+
+	// only aggregate objects matching the read_data filters of the principal's roles
+	restricted, err := authzfilter.WithReadData(ctx, t.authorizer, principal, params.ClassName.String(), params.Tenant, params.Filters)
+	if err != nil {
+		return nil, err
+	}
+	params.Filters = restricted
+	if err := authzfilter.WithReferenceReadData(ctx, t.authorizer, principal, params.Tenant, params.Filters); err != nil {
+		return nil, err
+	}
+	if err := t.checkNearObjectReadData(ctx, principal, params.ClassName.String(), params.NearObject, params.Tenant); err != nil {
+		return nil, err
+	}
+
 	// validate here, because filters can contain references that need to be authorized
 	if err := t.validateFilters(ctx, principal, params.Filters); err != nil {
 		return nil, errors.Wrap(err, "invalid 'where' filter")
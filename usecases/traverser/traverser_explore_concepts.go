@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 )
 
 // Explore through unstructured search terms
@@ -36,7 +37,36 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.CrossClassVectorSearch(ctx, params)
+	res, err := t.explorer.CrossClassVectorSearch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return t.withoutRestrictedClasses(ctx, principal, res)
+}
+
+// withoutRestrictedClasses removes the results of classes in which the reads
+// of the principal are restricted by read_data filters. Explore does not
+// support filters, so these objects can not be checked.
+func (t *Traverser) withoutRestrictedClasses(ctx context.Context,
+	principal *models.Principal, res []search.Result,
+) ([]search.Result, error) {
+	restricted := map[string]bool{}
+	filtered := res[:0]
+	for _, item := range res {
+		isRestricted, ok := restricted[item.ClassName]
+		if !ok {
+			restriction, err := authzfilter.ReadData(ctx, t.authorizer, principal, item.ClassName, "")
+			if err != nil {
+				return nil, err
+			}
+			isRestricted = restriction != nil
+			restricted[item.ClassName] = isRestricted
+		}
+		if !isRestricted {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
 }
 
 // ExploreParams are the parameters used by the GraphQL `Explore { }` API
@@ -36,7 +36,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{}
 
 		_, err := traverser.Explore(context.Background(), nil, params)
@@ -52,7 +52,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil)
 		params := ExploreParams{
 			NearVector: &searchparams.NearVector{},
 			ModuleParams: map[string]interface{}{
@@ -72,7 +72,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -134,7 +134,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil)
 		params := ExploreParams{
 			NearVector: &searchparams.NearVector{
 				Vectors: []models.Vector{[]float32{7.8, 9}},
@@ -193,7 +193,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil)
 		params := ExploreParams{
 			NearObject: &searchparams.NearObject{
 				ID: "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
@@ -258,7 +258,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil)
 		params := ExploreParams{
 			NearObject: &searchparams.NearObject{
 				Beacon: "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
@@ -323,7 +323,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			Limit: 100,
 			NearVector: &searchparams.NearVector{
@@ -366,7 +366,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			Limit: 100,
 			NearVector: &searchparams.NearVector{
@@ -406,7 +406,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -434,7 +434,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -474,7 +474,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 		params := ExploreParams{
 			Limit: 100,
 			ModuleParams: map[string]interface{}{
@@ -546,7 +546,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil)
 
 		params := ExploreParams{
 			Limit: 100,
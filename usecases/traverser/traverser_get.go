@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -41,6 +42,11 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	defer t.ratelimiter.Dec()
 
+	if err := t.takeQueryQuotas(principal, params.ClassName,
+		len(params.ModuleParams)+len(params.AdditionalProperties.ModuleParams)); err != nil {
+		return nil, err
+	}
+
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
@@ -49,6 +55,24 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	mask := authzfilter.NewPropertyMask(ctx, t.authorizer, principal, params.Tenant)
+	if err := t.checkGetPropertyAccess(mask, &params); err != nil {
+		return nil, err
+	}
+
+	// only return objects matching the read_data filters of the principal's roles
+	restricted, err := authzfilter.WithReadData(ctx, t.authorizer, principal, params.ClassName, params.Tenant, params.Filters)
+	if err != nil {
+		return nil, err
+	}
+	params.Filters = restricted
+	if err := authzfilter.WithReferenceReadData(ctx, t.authorizer, principal, params.Tenant, params.Filters); err != nil {
+		return nil, err
+	}
+	if err := t.checkNearObjectReadData(ctx, principal, params.ClassName, params.NearObject, params.Tenant); err != nil {
+		return nil, err
+	}
+
 	// validate here, because filters can contain references that need to be authorized
 	if err := t.validateFilters(ctx, principal, params.Filters); err != nil {
 		return nil, errors.Wrap(err, "invalid 'where' filter")
@@ -64,7 +88,21 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.pruneUnreadableRefs(ctx, principal, params.Tenant, res); err != nil {
+		return nil, err
+	}
+	for _, r := range res {
+		if props, ok := r.(map[string]interface{}); ok {
+			if err := mask.Prune(params.ClassName, props); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
 }
 
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit
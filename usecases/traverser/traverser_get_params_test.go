@@ -171,7 +171,7 @@ func TestGet_NestedRefDepthLimit(t *testing.T) {
 			},
 		}
 		return NewTraverser(&cfg, logger, mocks.NewMockAuthorizer(),
-			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1)
+			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1, nil)
 	}
 
 	tests := []testcase{
@@ -260,7 +260,7 @@ func Test_GetClass_WithFilters(t *testing.T) {
 		schemaGetter := &fakeSchemaGetter{schemaForFiltersValidation()}
 		cfg := config.WeaviateConfig{}
 		return NewTraverser(&cfg, logger, mocks.NewMockAuthorizer(),
-			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1)
+			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1, nil)
 	}
 
 	buildInvalidRefCountTests := func(op filters.Operator, path []interface{},
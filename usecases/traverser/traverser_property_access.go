@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+)
+
+// propertyExtractor is implemented by the parameters of modules which read
+// properties of the results, e.g. generative prompts
+type propertyExtractor interface {
+	GetPropertiesToExtract() []string
+}
+
+// checkGetPropertyAccess rejects searches which use properties the principal
+// is not allowed to read. Keyword searches over all properties are limited to
+// the readable ones.
+func (t *Traverser) checkGetPropertyAccess(mask *authzfilter.PropertyMask, params *dto.GetParams) error {
+	className := params.ClassName
+	if err := mask.CheckFilter(params.Filters); err != nil {
+		return err
+	}
+	for _, s := range params.Sort {
+		if len(s.Path) > 0 {
+			if err := mask.Check(className, s.Path[0]); err != nil {
+				return err
+			}
+		}
+	}
+	if params.GroupBy != nil {
+		if err := mask.Check(className, params.GroupBy.Property); err != nil {
+			return err
+		}
+	}
+	if params.KeywordRanking != nil {
+		if err := t.checkKeywordProperties(mask, className, &params.KeywordRanking.Properties); err != nil {
+			return err
+		}
+	}
+	if params.HybridSearch != nil {
+		if err := t.checkKeywordProperties(mask, className, &params.HybridSearch.Properties); err != nil {
+			return err
+		}
+	}
+	if err := checkModuleParamsPropertyAccess(mask, className, params.ModuleParams); err != nil {
+		return err
+	}
+	return checkModuleParamsPropertyAccess(mask, className, params.AdditionalProperties.ModuleParams)
+}
+
+// checkAggregatePropertyAccess rejects aggregations which use properties the
+// principal is not allowed to read
+func (t *Traverser) checkAggregatePropertyAccess(mask *authzfilter.PropertyMask, params *aggregation.Params) error {
+	className := params.ClassName.String()
+	if err := mask.CheckFilter(params.Filters); err != nil {
+		return err
+	}
+	for _, prop := range params.Properties {
+		if err := mask.Check(className, prop.Name.String()); err != nil {
+			return err
+		}
+	}
+	if params.GroupBy != nil {
+		groupBy := &filters.LocalFilter{Root: &filters.Clause{On: params.GroupBy}}
+		if err := mask.CheckFilter(groupBy); err != nil {
+			return err
+		}
+	}
+	if params.Hybrid != nil {
+		if err := t.checkKeywordProperties(mask, className, &params.Hybrid.Properties); err != nil {
+			return err
+		}
+	}
+	return checkModuleParamsPropertyAccess(mask, className, params.ModuleParams)
+}
+
+// checkKeywordProperties checks the properties of a keyword search. If none
+// are given, all searchable properties are used, they are limited to the
+// readable ones in that case.
+func (t *Traverser) checkKeywordProperties(mask *authzfilter.PropertyMask, className string, properties *[]string) error {
+	if len(*properties) > 0 {
+		return mask.Check(className, *properties...)
+	}
+
+	access, err := mask.Access(className)
+	if err != nil || access == nil {
+		return err
+	}
+	class := t.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return nil
+	}
+	readable := make([]string, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		if access.Allowed(prop.Name) {
+			readable = append(readable, prop.Name)
+		}
+	}
+	if len(readable) == 0 {
+		// an empty list would search all properties
+		return mask.Check(className, schema.GetPropertyNamesFromClass(class, false)...)
+	}
+	*properties = readable
+	return nil
+}
+
+func checkModuleParamsPropertyAccess(mask *authzfilter.PropertyMask, className string, moduleParams map[string]interface{}) error {
+	for _, param := range moduleParams {
+		if extractor, ok := param.(propertyExtractor); ok {
+			if err := mask.Check(className, extractor.GetPropertiesToExtract()...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeDataFilterer struct {
+	*mocks.FakeAuthorizer
+	properties authorization.PropertyAccess
+}
+
+func (f *fakeDataFilterer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return nil, nil
+}
+
+func (f *fakeDataFilterer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeDataFilterer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return f.properties, nil
+}
+
+type fakePromptParams struct {
+	properties []string
+}
+
+func (p fakePromptParams) GetPropertiesToExtract() []string {
+	return p.properties
+}
+
+func Test_Traverser_PropertyAccess(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	authorizer := &fakeDataFilterer{
+		FakeAuthorizer: mocks.NewMockAuthorizer(),
+		properties:     authorization.PropertyAccess{{ExcludedProperties: []string{"ssn"}}},
+	}
+	schemaGetter := &fakeSchemaGetter{schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class: "Person",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "ssn", DataType: schema.DataTypeText.PropString()},
+			},
+		}},
+	}}}
+	traverser := NewTraverser(&config.WeaviateConfig{}, logger, authorizer,
+		&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1, nil)
+	newMask := func() *authzfilter.PropertyMask {
+		return authzfilter.NewPropertyMask(context.Background(), authorizer, &models.Principal{Username: "user"}, "")
+	}
+
+	t.Run("readable properties", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:      "Person",
+			Sort:           []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			KeywordRanking: &searchparams.KeywordRanking{Query: "jane", Properties: []string{"name^2"}},
+		}
+		require.NoError(t, traverser.checkGetPropertyAccess(newMask(), &params))
+	})
+
+	t.Run("sort by hidden property", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "Person",
+			Sort:      []filters.Sort{{Path: []string{"ssn"}, Order: "asc"}},
+		}
+		assert.ErrorContains(t, traverser.checkGetPropertyAccess(newMask(), &params), "property Person.ssn")
+	})
+
+	t.Run("filter on hidden property", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "Person",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Person", Property: "ssn"},
+			}},
+		}
+		assert.ErrorContains(t, traverser.checkGetPropertyAccess(newMask(), &params), "property Person.ssn")
+	})
+
+	t.Run("keyword search over all properties", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:      "Person",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "jane"},
+			HybridSearch:   &searchparams.HybridSearch{Query: "jane"},
+		}
+		require.NoError(t, traverser.checkGetPropertyAccess(newMask(), &params))
+		assert.Equal(t, []string{"name"}, params.KeywordRanking.Properties)
+		assert.Equal(t, []string{"name"}, params.HybridSearch.Properties)
+	})
+
+	t.Run("prompt with hidden property", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "Person",
+			AdditionalProperties: additional.Properties{ModuleParams: map[string]interface{}{
+				"generate": fakePromptParams{properties: []string{"name", "ssn"}},
+			}},
+		}
+		assert.ErrorContains(t, traverser.checkGetPropertyAccess(newMask(), &params), "property Person.ssn")
+	})
+
+	t.Run("aggregate hidden property", func(t *testing.T) {
+		params := aggregation.Params{
+			ClassName:  "Person",
+			Properties: []aggregation.ParamProperty{{Name: "ssn"}},
+		}
+		assert.ErrorContains(t, traverser.checkAggregatePropertyAccess(newMask(), &params), "property Person.ssn")
+	})
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	authzfilter "github.com/weaviate/weaviate/usecases/auth/authorization/filter"
+)
+
+// readableIDsBatchSize limits the number of ids checked against the read_data
+// filters of a class in a single query
+const readableIDsBatchSize = 100
+
+// checkNearObjectReadData rejects nearObject searches with an object the
+// principal may not read. The error is the same as for a missing object, so
+// that the existence of the object is not leaked.
+func (t *Traverser) checkNearObjectReadData(ctx context.Context, principal *models.Principal,
+	className string, params *searchparams.NearObject, tenant string,
+) error {
+	if params == nil {
+		return nil
+	}
+
+	id := strfmt.UUID(params.ID)
+	if id == "" && params.Beacon != "" {
+		ref, err := crossref.Parse(params.Beacon)
+		if err != nil {
+			return err
+		}
+		id = ref.TargetID
+		if ref.Class != "" {
+			className = ref.Class
+		}
+	}
+	if id == "" || className == "" {
+		return nil
+	}
+
+	readable, err := t.readableIDs(ctx, principal, className, tenant, []strfmt.UUID{id})
+	if err != nil {
+		return err
+	}
+	if readable != nil {
+		if _, ok := readable[id]; !ok {
+			return errors.New("vector not found")
+		}
+	}
+	return nil
+}
+
+// pruneUnreadableRefs removes resolved references to objects which do not
+// match the read_data filters of the principal on the referenced class. They
+// are treated like references to deleted objects.
+func (t *Traverser) pruneUnreadableRefs(ctx context.Context, principal *models.Principal,
+	tenant string, res []interface{},
+) error {
+	refs := map[string][]strfmt.UUID{}
+	for _, r := range res {
+		collectRefs(r, refs)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	readable := map[string]map[strfmt.UUID]struct{}{}
+	for className, ids := range refs {
+		ids, err := t.readableIDs(ctx, principal, className, tenant, ids)
+		if err != nil {
+			return err
+		}
+		if ids != nil {
+			readable[className] = ids
+		}
+	}
+	if len(readable) == 0 {
+		return nil
+	}
+
+	for _, r := range res {
+		pruneRefs(r, readable)
+	}
+	return nil
+}
+
+// readableIDs returns the subset of ids in the class which match the read_data
+// filters of the principal. It returns nil if reads of the class are not
+// restricted by a filter.
+func (t *Traverser) readableIDs(ctx context.Context, principal *models.Principal,
+	className, tenant string, ids []strfmt.UUID,
+) (map[strfmt.UUID]struct{}, error) {
+	if class := t.schemaGetter.ReadOnlyClass(className); class != nil && !schema.MultiTenancyEnabled(class) {
+		tenant = ""
+	}
+	restriction, err := authzfilter.ReadData(ctx, t.authorizer, principal, className, tenant)
+	if err != nil || restriction == nil {
+		return nil, err
+	}
+
+	readable := make(map[strfmt.UUID]struct{}, len(ids))
+	for start := 0; start < len(ids); start += readableIDsBatchSize {
+		batch := ids[start:min(start+readableIDsBatchSize, len(ids))]
+		operands := make([]filters.Clause, len(batch))
+		for i, id := range batch {
+			operands[i] = filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: filters.InternalPropID,
+				},
+				Value: &filters.Value{Value: id.String(), Type: schema.DataTypeText},
+			}
+		}
+		byID := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: operands,
+		}}
+
+		res, err := t.explorer.GetClass(ctx, dto.GetParams{
+			ClassName:            className,
+			Filters:              authzfilter.And(byID, restriction),
+			Pagination:           &filters.Pagination{Limit: len(batch)},
+			AdditionalProperties: additional.Properties{ID: true},
+			Tenant:               tenant,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range res {
+			if id, ok := resultID(r); ok {
+				readable[id] = struct{}{}
+			}
+		}
+	}
+	return readable, nil
+}
+
+// collectRefs collects the ids of all resolved references in a result by
+// class, including nested references and the hits of groups
+func collectRefs(value interface{}, refs map[string][]strfmt.UUID) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, prop := range v {
+			if key == "_additional" {
+				if props, ok := prop.(map[string]interface{}); ok {
+					if group, ok := props["group"].(*additional.Group); ok {
+						for _, hit := range group.Hits {
+							collectRefs(hit, refs)
+						}
+					}
+				}
+				continue
+			}
+			collectRefs(prop, refs)
+		}
+	case []interface{}:
+		for _, item := range v {
+			ref, ok := item.(search.LocalRef)
+			if !ok {
+				continue
+			}
+			if id, ok := refID(ref); ok {
+				refs[ref.Class] = append(refs[ref.Class], id)
+			}
+			collectRefs(ref.Fields, refs)
+		}
+	}
+}
+
+// pruneRefs removes the references which are not contained in readable. Only
+// classes present in readable are restricted.
+func pruneRefs(value interface{}, readable map[string]map[strfmt.UUID]struct{}) {
+	props, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, prop := range props {
+		if key == "_additional" {
+			if additionalProps, ok := prop.(map[string]interface{}); ok {
+				if group, ok := additionalProps["group"].(*additional.Group); ok {
+					for _, hit := range group.Hits {
+						pruneRefs(hit, readable)
+					}
+				}
+			}
+			continue
+		}
+		refs, ok := prop.([]interface{})
+		if !ok {
+			continue
+		}
+		kept := refs[:0]
+		for _, item := range refs {
+			if ref, ok := item.(search.LocalRef); ok {
+				if ids, restricted := readable[ref.Class]; restricted {
+					if id, ok := refID(ref); !ok {
+						continue
+					} else if _, ok := ids[id]; !ok {
+						continue
+					}
+				}
+				pruneRefs(ref.Fields, readable)
+			}
+			kept = append(kept, item)
+		}
+		props[key] = kept
+	}
+}
+
+func refID(ref search.LocalRef) (strfmt.UUID, bool) {
+	switch id := ref.Fields["id"].(type) {
+	case strfmt.UUID:
+		return id, true
+	case string:
+		return strfmt.UUID(id), true
+	default:
+		return "", false
+	}
+}
+
+func resultID(result interface{}) (strfmt.UUID, bool) {
+	props, ok := result.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	additionalProps, ok := props["_additional"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	id, ok := additionalProps["id"].(strfmt.UUID)
+	return id, ok
+}
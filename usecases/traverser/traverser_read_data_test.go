@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2025 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// classDataFilterer restricts reads of single classes by a filter
+type classDataFilterer struct {
+	*mocks.FakeAuthorizer
+	filters map[string][]*models.WhereFilter
+}
+
+func (f *classDataFilterer) ReadDataFilters(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) ([]*models.WhereFilter, error) {
+	return f.filters[collection], nil
+}
+
+func (f *classDataFilterer) ReadDataFiltered(ctx context.Context, principal *models.Principal) (bool, error) {
+	return len(f.filters) > 0, nil
+}
+
+func (f *classDataFilterer) ReadDataProperties(ctx context.Context, principal *models.Principal,
+	collection, tenant string,
+) (authorization.PropertyAccess, error) {
+	return nil, nil
+}
+
+// readDataExplorer answers queries of the main class with results and
+// readability queries with the readable ids
+type readDataExplorer struct {
+	results  []interface{}
+	readable []strfmt.UUID
+	queries  []dto.GetParams
+}
+
+func (e *readDataExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	e.queries = append(e.queries, p)
+	if p.ClassName == "Article" {
+		return e.results, nil
+	}
+	res := make([]interface{}, len(e.readable))
+	for i, id := range e.readable {
+		res[i] = map[string]interface{}{"_additional": map[string]interface{}{"id": id}}
+	}
+	return res, nil
+}
+
+func (e *readDataExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
+	return nil, nil
+}
+
+func TestTraverserReadDataReferences(t *testing.T) {
+	var (
+		logger, _ = test.NewNullLogger()
+		principal = &models.Principal{Username: "user"}
+		readable  = strfmt.UUID("8f9a1b2c-0000-4000-8000-000000000001")
+		hidden    = strfmt.UUID("8f9a1b2c-0000-4000-8000-000000000002")
+	)
+	authorizer := &classDataFilterer{
+		FakeAuthorizer: mocks.NewMockAuthorizer(),
+		filters: map[string][]*models.WhereFilter{"Author": {{
+			Operator:  "Equal",
+			Path:      []string{"department"},
+			ValueText: authorization.String("legal"),
+		}}},
+	}
+	schemaGetter := &fakeSchemaGetter{schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+					{Name: "author", DataType: []string{"Author"}},
+				},
+			},
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "department", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	}}}
+	newTraverser := func(explorer explorer) *Traverser {
+		cfg := &config.WeaviateConfig{Config: config.Config{
+			QueryCrossReferenceDepthLimit: config.DefaultQueryCrossReferenceDepthLimit,
+		}}
+		return NewTraverser(cfg, logger, authorizer,
+			&fakeVectorRepo{}, explorer, schemaGetter, nil, nil, -1, nil)
+	}
+
+	t.Run("unreadable references are removed", func(t *testing.T) {
+		explorer := &readDataExplorer{
+			readable: []strfmt.UUID{readable},
+			results: []interface{}{map[string]interface{}{
+				"title": "contract",
+				"author": []interface{}{
+					search.LocalRef{Class: "Author", Fields: map[string]interface{}{"id": readable, "name": "alice"}},
+					search.LocalRef{Class: "Author", Fields: map[string]interface{}{"id": hidden, "name": "bob"}},
+				},
+			}},
+		}
+		res, err := newTraverser(explorer).GetClass(context.Background(), principal, dto.GetParams{
+			ClassName:  "Article",
+			Properties: search.SelectProperties{{Name: "author", Refs: []search.SelectClass{{ClassName: "Author"}}}},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		refs := res[0].(map[string]interface{})["author"].([]interface{})
+		require.Len(t, refs, 1)
+		assert.Equal(t, readable, refs[0].(search.LocalRef).Fields["id"])
+
+		require.Len(t, explorer.queries, 2)
+		check := explorer.queries[1]
+		assert.Equal(t, "Author", check.ClassName)
+		assert.True(t, check.AdditionalProperties.ID)
+		require.NotNil(t, check.Filters)
+		assert.Equal(t, filters.OperatorAnd, check.Filters.Root.Operator)
+	})
+
+	t.Run("reference filters are restricted", func(t *testing.T) {
+		explorer := &readDataExplorer{}
+		where := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class: "Article", Property: "author",
+				Child: &filters.Path{Class: "Author", Property: "name"},
+			},
+			Value: &filters.Value{Value: "alice", Type: schema.DataTypeText},
+		}}
+		_, err := newTraverser(explorer).GetClass(context.Background(), principal, dto.GetParams{
+			ClassName: "Article",
+			Filters:   where,
+		})
+		require.NoError(t, err)
+		require.Len(t, explorer.queries, 1)
+		restriction := explorer.queries[0].Filters.Root.On.Child.Restriction
+		require.NotNil(t, restriction)
+		assert.Equal(t, schema.PropertyName("department"), restriction.On.Property)
+	})
+
+	t.Run("nearObject with unreadable object", func(t *testing.T) {
+		explorer := &readDataExplorer{readable: []strfmt.UUID{readable}}
+		_, err := newTraverser(explorer).GetClass(context.Background(), principal, dto.GetParams{
+			ClassName: "Article",
+			NearObject: &searchparams.NearObject{
+				Beacon: "weaviate://localhost/Author/" + hidden.String(),
+			},
+		})
+		assert.ErrorContains(t, err, "vector not found")
+		require.Len(t, explorer.queries, 1)
+		assert.Equal(t, "Author", explorer.queries[0].ClassName)
+	})
+
+	t.Run("unrestricted class", func(t *testing.T) {
+		explorer := &readDataExplorer{results: []interface{}{map[string]interface{}{
+			"author": []interface{}{
+				search.LocalRef{Class: "Article", Fields: map[string]interface{}{"id": hidden}},
+			},
+		}}}
+		res, err := newTraverser(explorer).GetClass(context.Background(), principal, dto.GetParams{
+			ClassName:            "Article",
+			AdditionalProperties: additional.Properties{ID: true},
+		})
+		require.NoError(t, err)
+		assert.Len(t, res[0].(map[string]interface{})["author"], 1)
+		assert.Len(t, explorer.queries, 1)
+	})
+}